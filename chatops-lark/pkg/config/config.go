@@ -64,6 +64,14 @@ type Config struct {
 		GithubToken string `yaml:"github_token" json:"github_token"`
 	} `yaml:"repo_admins" json:"repo_admins"`
 
+	// Subscribe command configuration
+	Subscribe *struct {
+		BaseCmdConfig `yaml:",inline" json:",inline"`
+
+		ApiURL string `yaml:"api_url" json:"api_url"`
+		Token  string `yaml:"token" json:"token"`
+	} `yaml:"subscribe" json:"subscribe"`
+
 	// Debug mode
 	Debug bool `yaml:"debug" json:"debug"`
 }
@@ -67,13 +67,7 @@ func parseGroupCommand(event *larkim.P2MessageReceiveV1) *Command {
 	}
 
 	commandName := matches[1]
-	argsStr := strings.TrimSpace(matches[2])
-	var args []string
-	if argsStr != "" {
-		args = normalizeCommandTokens(strings.Fields(argsStr))
-	}
-
-	return &Command{Name: commandName, Args: args}
+	return &Command{Name: commandName, Args: splitCommandArgs(matches[2])}
 }
 
 // parsePrivateCommand parses commands from private messages
@@ -121,6 +115,12 @@ func parseUserCustomAttr(attrID string, info *larkcontact.User) *string {
 	return nil
 }
 
+// splitCommandArgs splits the command arguments by the white spaces, quotes
+// are not interpreted.
+func splitCommandArgs(text string) []string {
+	return normalizeCommandTokens(strings.Fields(text))
+}
+
 func normalizeCommandTokens(tokens []string) []string {
 	if len(tokens) == 0 {
 		return nil
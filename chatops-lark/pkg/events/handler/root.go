@@ -127,6 +127,9 @@ func (r *rootHandler) Handle(ctx context.Context, event *larkim.P2MessageReceive
 			return r.sendResponse(messageID, replyInThread, status, message)
 		})
 		handlerCtx = withCommandResponseMeta(handlerCtx, responseMeta)
+		if chatType == "group" && event.Event.Message.ChatId != nil {
+			handlerCtx = context.WithValue(handlerCtx, ctxKeyLarkChatID, *event.Event.Message.ChatId)
+		}
 
 		asyncLog.Info().Msg("Processing command")
 		message, err := r.handleCommand(handlerCtx, command)
@@ -231,6 +234,14 @@ func (r *rootHandler) initialize() error {
 			SetupContext: setupCtxHotfix,
 		}
 	}
+	if r.Config.Subscribe != nil {
+		r.commandRegistry["/subscribe"] = CommandConfig{
+			Description:  "Subscribe the chat to Tekton pipeline run notifications",
+			Handler:      runCommandSubscribe,
+			Audit:        r.Config.Subscribe.Audit,
+			SetupContext: setupCtxSubscribe,
+		}
+	}
 
 	return nil
 }
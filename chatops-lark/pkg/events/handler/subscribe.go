@@ -0,0 +1,316 @@
+package handler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	"github.com/PingCAP-QE/ee-apps/chatops-lark/pkg/config"
+)
+
+// ctx keys store subscribe service configuration
+const subscribeCfgKey string = "subscribe.cfg"
+
+const subscribeHelpText = `Usage: /subscribe <subcommand> [args...]
+
+Description:
+  Manage the Tekton pipeline run notifications of this chat. In group chats the
+  group is notified, in private chats you are notified.
+
+Subcommands:
+  list                 - List the subscriptions
+  add [options]        - Subscribe the pipeline runs
+  remove <id>          - Remove the subscription
+
+Options for add (the runs matching all the given options are notified):
+  --namespace string   Namespace of the pipeline runs
+  --pipeline string    Glob pattern of the pipeline name (e.g., build-*)
+  --selector string    Label selector of the pipeline runs (e.g., repo=tidb),
+                       quote it when it has spaces (e.g., "repo in (tidb, tikv)")
+  --events string      Comma separated events: started, running, succeeded, failed
+                       or glob patterns of the event types (default: all)
+
+Examples:
+  /subscribe add --namespace ee-cd --pipeline build-* --events failed
+  /subscribe add --selector "repo in (tidb, tikv)" --events succeeded,failed
+  /subscribe list
+  /subscribe remove 12`
+
+// tektonEventTypes maps the short event names to the tekton event types.
+var tektonEventTypes = map[string]string{
+	"started":   "dev.tekton.event.pipelinerun.started.v1",
+	"running":   "dev.tekton.event.pipelinerun.running.v1",
+	"succeeded": "dev.tekton.event.pipelinerun.successful.v1",
+	"failed":    "dev.tekton.event.pipelinerun.failed.v1",
+}
+
+type subscribeRuntimeConfig struct {
+	APIURL   string
+	Token    string
+	Receiver string
+	Actor    string
+}
+
+// tektonSubscription is the subscription of the cloudevents-server API.
+type tektonSubscription struct {
+	ID            int      `json:"id,omitempty"`
+	Receiver      string   `json:"receiver"`
+	Namespace     string   `json:"namespace,omitempty"`
+	Pipeline      string   `json:"pipeline,omitempty"`
+	LabelSelector string   `json:"label_selector,omitempty"`
+	EventTypes    []string `json:"event_types,omitempty"`
+	CreatedBy     string   `json:"created_by,omitempty"`
+}
+
+type subscribeAPIError struct {
+	Error string `json:"error"`
+}
+
+// setupCtxSubscribe prepares the runtime context, the receiver is the group
+// chat or the sender in private chats.
+func setupCtxSubscribe(ctx context.Context, cfg config.Config, actor *CommandActor) context.Context {
+	runtime := subscribeRuntimeConfig{
+		APIURL:   cfg.Subscribe.ApiURL,
+		Token:    cfg.Subscribe.Token,
+		Receiver: actor.Email,
+		Actor:    actor.Email,
+	}
+	if chatID, ok := ctx.Value(ctxKeyLarkChatID).(string); ok && chatID != "" {
+		runtime.Receiver = chatID
+	}
+	return context.WithValue(ctx, subscribeCfgKey, &runtime)
+}
+
+// runCommandSubscribe handles `/subscribe` command.
+func runCommandSubscribe(ctx context.Context, args []string) (string, error) {
+	if len(args) == 0 {
+		return subscribeHelpText, NewInformationError("Missing subcommand")
+	}
+	if args[0] == "-h" || args[0] == "--help" {
+		return subscribeHelpText, NewSkipError("Help requested")
+	}
+
+	runtime, ok := ctx.Value(subscribeCfgKey).(*subscribeRuntimeConfig)
+	if !ok || runtime == nil || runtime.APIURL == "" {
+		return "", fmt.Errorf("subscribe API URL is not configured")
+	}
+	if runtime.Receiver == "" {
+		return "", fmt.Errorf("can not determine the receiver of the chat")
+	}
+
+	switch subCmd := args[0]; subCmd {
+	case "list":
+		return runCommandSubscribeList(ctx, runtime)
+	case "add":
+		return runCommandSubscribeAdd(ctx, runtime, args[1:])
+	case "remove":
+		return runCommandSubscribeRemove(ctx, runtime, args[1:])
+	default:
+		return subscribeHelpText, NewInformationError(fmt.Sprintf("Unknown subcommand: %s", subCmd))
+	}
+}
+
+func runCommandSubscribeList(ctx context.Context, runtime *subscribeRuntimeConfig) (string, error) {
+	var subs []tektonSubscription
+	_, err := runtime.request(ctx).
+		SetQueryParam("receiver", runtime.Receiver).
+		SetResult(&subs).
+		Get(runtime.url())
+	if err != nil {
+		return "", err
+	}
+
+	if len(subs) == 0 {
+		return "No subscriptions yet, add one with `/subscribe add`.", nil
+	}
+	lines := []string{"Subscriptions:"}
+	for _, s := range subs {
+		lines = append(lines, fmt.Sprintf("• #%d %s", s.ID, s.summary()))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// quotePairs are the opening and closing quotes of the quoted values, the
+// lark clients may replace the straight quotes with the curly ones.
+var quotePairs = map[string]string{`"`: `"`, `'`: `'`, "“": "”", "‘": "’"}
+
+const quoteChars = `"'“”‘’`
+
+// joinQuotedArgs joins the args split from the quoted values with spaces and
+// strips the quotes, since the command args are split by the white spaces.
+func joinQuotedArgs(args []string) ([]string, error) {
+	var (
+		ret    []string
+		quoted []string
+		close  string
+	)
+	for _, arg := range args {
+		if quoted == nil {
+			open := ""
+			for o := range quotePairs {
+				if strings.HasPrefix(arg, o) {
+					open = o
+				}
+			}
+			if open == "" {
+				ret = append(ret, arg)
+				continue
+			}
+			arg, close = strings.TrimPrefix(arg, open), quotePairs[open]
+			quoted = []string{}
+		}
+		if value, ok := strings.CutSuffix(arg, close); ok {
+			ret = append(ret, strings.Join(append(quoted, value), " "))
+			quoted = nil
+			continue
+		}
+		quoted = append(quoted, arg)
+	}
+	if quoted != nil {
+		return nil, NewInformationError(fmt.Sprintf("Unclosed quote: %s", strings.Join(quoted, " ")))
+	}
+
+	return ret, nil
+}
+
+func trimQuotes(value string) string {
+	for open, close := range quotePairs {
+		if len(value) >= len(open)+len(close) && strings.HasPrefix(value, open) && strings.HasSuffix(value, close) {
+			return value[len(open) : len(value)-len(close)]
+		}
+	}
+	return value
+}
+
+func parseCommandSubscribeAdd(args []string) (*tektonSubscription, error) {
+	fs := flag.NewFlagSet("/subscribe add", flag.ContinueOnError)
+	// silence default usage output
+	fs.SetOutput(new(strings.Builder))
+
+	args, err := joinQuotedArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &tektonSubscription{}
+	var events string
+	fs.StringVar(&ret.Namespace, "namespace", "", "Namespace of the pipeline runs")
+	fs.StringVar(&ret.Pipeline, "pipeline", "", "Glob pattern of the pipeline name")
+	fs.StringVar(&ret.LabelSelector, "selector", "", "Label selector of the pipeline runs")
+	fs.StringVar(&events, "events", "", "Comma separated events")
+	if err := fs.Parse(args); err != nil {
+		return nil, NewInformationError(err.Error())
+	}
+	if fs.NArg() > 0 {
+		return nil, NewInformationError(fmt.Sprintf("Unexpected argument(s): %s", strings.Join(fs.Args(), " ")))
+	}
+	// such as `--pipeline="build-*"`, the quotes left would never match.
+	for _, value := range []*string{&ret.Namespace, &ret.Pipeline, &ret.LabelSelector, &events} {
+		*value = trimQuotes(*value)
+		if strings.ContainsAny(*value, quoteChars) {
+			return nil, NewInformationError(fmt.Sprintf("Unexpected quotes in %s", *value))
+		}
+	}
+
+	for _, e := range strings.Split(events, ",") {
+		e = strings.TrimSpace(e)
+		switch {
+		case e == "":
+			continue
+		case tektonEventTypes[e] != "":
+			ret.EventTypes = append(ret.EventTypes, tektonEventTypes[e])
+		case strings.Contains(e, "."):
+			ret.EventTypes = append(ret.EventTypes, e)
+		default:
+			return nil, NewInformationError(fmt.Sprintf("Unknown event: %s", e))
+		}
+	}
+
+	return ret, nil
+}
+
+func runCommandSubscribeAdd(ctx context.Context, runtime *subscribeRuntimeConfig, args []string) (string, error) {
+	sub, err := parseCommandSubscribeAdd(args)
+	if err != nil {
+		return subscribeHelpText, err
+	}
+	sub.Receiver = runtime.Receiver
+	sub.CreatedBy = runtime.Actor
+
+	var created tektonSubscription
+	_, err = runtime.request(ctx).
+		SetBody(sub).
+		SetResult(&created).
+		Post(runtime.url())
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Subscribed #%d %s", created.ID, created.summary()), nil
+}
+
+func runCommandSubscribeRemove(ctx context.Context, runtime *subscribeRuntimeConfig, args []string) (string, error) {
+	if len(args) != 1 {
+		return subscribeHelpText, NewInformationError("Missing required argument: <id>")
+	}
+
+	_, err := runtime.request(ctx).
+		SetQueryParam("receiver", runtime.Receiver).
+		Delete(runtime.url() + "/" + args[0])
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Subscription #%s removed.", args[0]), nil
+}
+
+func (rt *subscribeRuntimeConfig) url() string {
+	return strings.TrimRight(rt.APIURL, "/") + "/tekton/subscriptions"
+}
+
+// request returns the request with the token, the non-2xx responses are
+// returned as errors.
+func (rt *subscribeRuntimeConfig) request(ctx context.Context) *resty.Request {
+	client := resty.New().
+		SetTimeout(20 * time.Second).
+		OnAfterResponse(func(_ *resty.Client, r *resty.Response) error {
+			if r.IsSuccess() {
+				return nil
+			}
+			if apiErr, ok := r.Error().(*subscribeAPIError); ok && apiErr.Error != "" {
+				if r.StatusCode() == http.StatusBadRequest || r.StatusCode() == http.StatusNotFound {
+					return NewInformationError(apiErr.Error)
+				}
+				return fmt.Errorf("subscribe API error: %s (http: %d)", apiErr.Error, r.StatusCode())
+			}
+			return fmt.Errorf("subscribe API http status: %d", r.StatusCode())
+		})
+
+	return client.R().
+		SetContext(ctx).
+		SetAuthToken(rt.Token).
+		SetError(&subscribeAPIError{})
+}
+
+func (s tektonSubscription) summary() string {
+	var conditions []string
+	for _, c := range []struct{ name, value string }{
+		{"namespace", s.Namespace},
+		{"pipeline", s.Pipeline},
+		{"selector", s.LabelSelector},
+		{"events", strings.Join(s.EventTypes, ",")},
+	} {
+		if c.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s: %s", c.name, c.value))
+		}
+	}
+	if len(conditions) == 0 {
+		return "all pipeline runs"
+	}
+	return strings.Join(conditions, ", ")
+}
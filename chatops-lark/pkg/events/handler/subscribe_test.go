@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCommandSubscribeAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    *tektonSubscription
+		wantErr bool
+	}{
+		{name: "all runs", text: "", want: &tektonSubscription{}},
+		{
+			name: "all options",
+			text: "--namespace ee-cd --pipeline build-* --selector repo=tidb --events succeeded,failed,*.running.v1",
+			want: &tektonSubscription{
+				Namespace:     "ee-cd",
+				Pipeline:      "build-*",
+				LabelSelector: "repo=tidb",
+				EventTypes: []string{
+					"dev.tekton.event.pipelinerun.successful.v1",
+					"dev.tekton.event.pipelinerun.failed.v1",
+					"*.running.v1",
+				},
+			},
+		},
+		{
+			name: "quoted values",
+			text: `--pipeline "build-*" --selector "repo in (tidb, tikv)" --events=“failed”`,
+			want: &tektonSubscription{
+				Pipeline:      "build-*",
+				LabelSelector: "repo in (tidb, tikv)",
+				EventTypes:    []string{"dev.tekton.event.pipelinerun.failed.v1"},
+			},
+		},
+		{name: "quotes inside value", text: `--pipeline build-"*"`, wantErr: true},
+		{name: "unclosed quote", text: `--selector "repo in (tidb, tikv)`, wantErr: true},
+		{name: "unquoted spaces", text: "--selector repo in (tidb, tikv)", wantErr: true},
+		{name: "unknown event", text: "--events finished", wantErr: true},
+		{name: "unexpected argument", text: "build-*", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCommandSubscribeAdd(splitCommandArgs(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCommandSubscribeAdd() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseCommandSubscribeAdd() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCommandSubscribe(t *testing.T) {
+	var subs []tektonSubscription
+	mux := http.NewServeMux()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	mux.HandleFunc("GET /tekton/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		ret := []tektonSubscription{}
+		for _, s := range subs {
+			if s.Receiver == r.URL.Query().Get("receiver") {
+				ret = append(ret, s)
+			}
+		}
+		json.NewEncoder(w).Encode(ret)
+	})
+	mux.HandleFunc("POST /tekton/subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		var s tektonSubscription
+		json.NewDecoder(r.Body).Decode(&s)
+		s.ID = len(subs) + 1
+		subs = append(subs, s)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(s)
+	})
+	mux.HandleFunc("DELETE /tekton/subscriptions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "1" || r.URL.Query().Get("receiver") != "oc_group" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"subscription not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	newCtx := func(chatID string) context.Context {
+		ctx := context.WithValue(context.Background(), ctxKeyLarkChatID, chatID)
+		return context.WithValue(ctx, subscribeCfgKey, &subscribeRuntimeConfig{
+			APIURL:   server.URL,
+			Token:    "token",
+			Receiver: map[bool]string{true: chatID, false: "someone@pingcap.com"}[chatID != ""],
+			Actor:    "someone@pingcap.com",
+		})
+	}
+
+	got, err := runCommandSubscribe(newCtx("oc_group"), []string{"add", "--pipeline", "build-*", "--events", "failed"})
+	if err != nil {
+		t.Fatalf("add error = %v", err)
+	}
+	if !strings.Contains(got, "#1 pipeline: build-*") {
+		t.Errorf("add = %q", got)
+	}
+	if subs[0].Receiver != "oc_group" || subs[0].CreatedBy != "someone@pingcap.com" {
+		t.Errorf("created subscription = %+v", subs[0])
+	}
+
+	if got, err := runCommandSubscribe(newCtx(""), []string{"list"}); err != nil || !strings.Contains(got, "No subscriptions") {
+		t.Errorf("list of private chat = %q, %v", got, err)
+	}
+	if got, err := runCommandSubscribe(newCtx("oc_group"), []string{"list"}); err != nil || !strings.Contains(got, "#1") {
+		t.Errorf("list of group chat = %q, %v", got, err)
+	}
+
+	_, err = runCommandSubscribe(newCtx(""), []string{"remove", "1"})
+	if _, ok := err.(*InformationError); !ok {
+		t.Errorf("remove of others error = %v, want information error", err)
+	}
+	if _, err := runCommandSubscribe(newCtx("oc_group"), []string{"remove", "1"}); err != nil {
+		t.Errorf("remove error = %v", err)
+	}
+}
@@ -9,6 +9,7 @@ import (
 const (
 	ctxKeyGithubToken     = "github_token"
 	ctxKeyLarkSenderEmail = "lark.sender.email"
+	ctxKeyLarkChatID      = "lark.chat.id"
 
 	// Message types
 	msgTypePrivate = "private"
@@ -0,0 +1,108 @@
+// Command replay replays the archived or dead letter events through the admin
+// endpoint of the cloudevents server.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/replay"
+)
+
+func main() {
+	var (
+		server = flag.String("server", "http://localhost", "cloudevents server url")
+		token  = flag.String("token", os.Getenv("CLOUDEVENTS_ADMIN_TOKEN"), "admin token, default from env CLOUDEVENTS_ADMIN_TOKEN")
+		req    replay.Request
+	)
+	flag.StringVar(&req.Source, "source", replay.SourceArchive, "source of the events: archive or dlq")
+	flag.StringVar(&req.Target, "target", replay.TargetHandler, "replay target: handler or topic")
+	flag.BoolVar(&req.DryRun, "dry-run", false, "only show what would be replayed")
+	flag.Float64Var(&req.Rate, "rate", 0, "events replayed per second, default by the server")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] EVENT_ID... (or - to read ids from stdin)\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ids, err := readIDs(flag.Args(), os.Stdin)
+	if err != nil || len(ids) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	req.IDs = ids
+
+	results, err := send(*server, *token, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	failed := false
+	for _, r := range results {
+		fmt.Printf("%s\t%s\t%s\t%s\t%s\n", r.ID, r.Status, r.Type, r.Topic, r.Error)
+		failed = failed || r.Status == replay.StatusFailed || r.Status == replay.StatusNotFound
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func readIDs(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) != 1 || args[0] != "-" {
+		return args, nil
+	}
+
+	var ids []string
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if id := strings.TrimSpace(scanner.Text()); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, scanner.Err()
+}
+
+func send(server, token string, req replay.Request) ([]replay.Result, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(server, "/")+"/admin/replay", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+
+	// replaying is rate limited, leave enough time for it.
+	client := &http.Client{Timeout: 30 * time.Minute}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var ret struct {
+		Results []replay.Result `json:"results"`
+		Error   string          `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return nil, fmt.Errorf("%s: %w", resp.Status, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ret.Results, fmt.Errorf("%s: %s", resp.Status, ret.Error)
+	}
+
+	return ret.Results, nil
+}
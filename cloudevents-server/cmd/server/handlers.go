@@ -1,15 +1,26 @@
 package main
 
 import (
+	"context"
+	"crypto/subtle"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
 
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/archive"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/cdevents"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/custom/testcaserun"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/replay"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/routing"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/tekton"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/tibuild"
 )
@@ -22,12 +33,27 @@ func healthzHandler(c *gin.Context) {
 	c.String(http.StatusOK, "OK")
 }
 
-func newEventsHandlerFunc(cfg *config.Config) gin.HandlerFunc {
-	handler, err := handler.NewEventProducer(cfg.Kafka)
+// newEventArchiver avoids the non-nil interface of nil archive.
+func newEventArchiver(ar *archive.Archive) handler.EventArchiver {
+	if ar == nil {
+		return nil
+	}
+	return ar
+}
+
+func newEventProducer(cfg *config.Config, ar *archive.Archive) *handler.EventProducer {
+	producer, err := handler.NewEventProducer(cfg.Kafka, newEventArchiver(ar))
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create broker handler")
 	}
+	if cfg.CDEvents != nil {
+		producer.ForwardCDEvents(cdevents.EventTypes()...)
+	}
+
+	return producer
+}
 
+func newEventsHandlerFunc(handler *handler.EventProducer) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		p, err := cloudevents.NewHTTP()
 		if err != nil {
@@ -43,9 +69,210 @@ func newEventsHandlerFunc(cfg *config.Config) gin.HandlerFunc {
 	}
 }
 
+func newArchiveSearchHandlerFunc(ar *archive.Archive) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := archive.Query{
+			Type:    c.Query("type"),
+			Subject: c.Query("subject"),
+		}
+		for param, t := range map[string]*time.Time{"since": &query.Since, "until": &query.Until} {
+			v := c.Query(param)
+			if v == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + param + ", want RFC3339 time: " + err.Error()})
+				return
+			}
+			*t = parsed
+		}
+		if v := c.Query("limit"); v != "" {
+			limit, err := strconv.Atoi(v)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit: " + err.Error()})
+				return
+			}
+			query.Limit = limit
+		}
+
+		events, err := ar.Search(c, query)
+		if err != nil {
+			log.Err(err).Msg("failed to search archived events")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		records := make([]archive.Record, 0, len(events))
+		for _, e := range events {
+			records = append(records, archive.NewRecord(e, false))
+		}
+		c.JSON(http.StatusOK, records)
+	}
+}
+
+func newArchiveGetHandlerFunc(ar *archive.Archive) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		event, err := ar.Get(c, c.Param("id"), c.Query("source"))
+		switch {
+		case ent.IsNotFound(err):
+			c.JSON(http.StatusNotFound, gin.H{"error": "event not found"})
+		case err != nil:
+			log.Err(err).Msg("failed to get archived event")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusOK, archive.NewRecord(event, true))
+		}
+	}
+}
+
+func newReplayer(cfg *config.Config, ar *archive.Archive, hd handler.EventHandler, producer *handler.EventProducer) *replay.Replayer {
+	ret := replay.NewReplayer(hd, producer)
+	if ar != nil {
+		ret.AddSource(replay.SourceArchive, &replay.ArchiveSource{Archive: ar})
+	}
+	if cfg.Kafka.Consumer.DeadLetterTopic != "" {
+		ret.AddSource(replay.SourceDLQ, &replay.DLQSource{Kafka: cfg.Kafka})
+	}
+
+	return ret
+}
+
+// adminAuth requires one of the bearer tokens, the empty tokens are ignored and
+// the requests are all rejected when no token is set.
+func adminAuth(tokens ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		got := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		for _, token := range tokens {
+			if token != "" && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1 {
+				c.Next()
+				return
+			}
+		}
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+	}
+}
+
+func newReplayHandlerFunc(replayer *replay.Replayer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req replay.Request
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		results, err := replayer.Replay(c, req)
+		switch {
+		case errors.Is(err, replay.ErrInvalidRequest):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		case err != nil:
+			log.Err(err).Str("source", req.Source).Str("target", req.Target).Msg("failed to replay events")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "results": results})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"results": results})
+	}
+}
+
+func newSubscriptionListHandlerFunc(store *tekton.SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subs, err := store.List(c, c.Query("receiver"))
+		if err != nil {
+			log.Err(err).Msg("failed to list tekton subscriptions")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, subs)
+	}
+}
+
+func newSubscriptionCreateHandlerFunc(store *tekton.SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var sub tekton.Subscription
+		if err := c.ShouldBindJSON(&sub); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		created, err := store.Create(c, sub)
+		switch {
+		case errors.Is(err, tekton.ErrInvalidSubscription):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case err != nil:
+			log.Err(err).Msg("failed to create tekton subscription")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusCreated, created)
+		}
+	}
+}
+
+// newSubscriptionDeleteHandlerFunc deletes the subscription, it must belong to
+// the `receiver` parameter when it is given.
+func newSubscriptionDeleteHandlerFunc(store *tekton.SubscriptionStore) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid id: " + err.Error()})
+			return
+		}
+
+		err = store.Delete(c, id, c.Query("receiver"))
+		switch {
+		case errors.Is(err, tekton.ErrSubscriptionNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case err != nil:
+			log.Err(err).Int("id", id).Msg("failed to delete tekton subscription")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			c.Status(http.StatusNoContent)
+		}
+	}
+}
+
+// newQueryHandlerFunc binds the query of type Q from the url parameters and
+// responds the results of fn, errors matching errInvalid are bad requests.
+func newQueryHandlerFunc[Q, T any](errInvalid error, fn func(context.Context, Q) ([]T, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var query Q
+		if err := c.ShouldBindQuery(&query); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		results, err := fn(c, query)
+		switch {
+		case errors.Is(err, errInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case err != nil:
+			log.Err(err).Str("path", c.FullPath()).Msg("failed to query")
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		default:
+			if results == nil {
+				results = []T{}
+			}
+			c.JSON(http.StatusOK, results)
+		}
+	}
+}
+
+// runHistoryStore returns the store of the run history, the tekton and the
+// cdevents runs are recorded in the same history.
+func runHistoryStore(cfg *config.Config) *config.Store {
+	switch {
+	case cfg.Tekton != nil && cfg.Tekton.Store != nil:
+		return cfg.Tekton.Store
+	case cfg.CDEvents != nil && cfg.CDEvents.Store != nil:
+		return cfg.CDEvents.Store
+	default:
+		return nil
+	}
+}
+
 // receiver creates a receiverFn wrapper class that is used by the client to
 // validate and invoke the provided function.
-func newCloudEventsHandler(cfg *config.Config) (handler.EventHandler, error) {
+func newCloudEventsHandler(cfg *config.Config, stores storeClients, rt *routing.Router) (handler.EventHandler, error) {
 	type handlerInitFunc func() (handler.EventHandler, error)
 	var inits = []handlerInitFunc{
 		// testcase run handler
@@ -53,14 +280,33 @@ func newCloudEventsHandler(cfg *config.Config) (handler.EventHandler, error) {
 			if cfg.TestCaseRun == nil {
 				return nil, nil
 			}
-			return testcaserun.NewHandler(cfg.TestCaseRun.Store)
+			db, err := stores.open(cfg.TestCaseRun.Store)
+			if err != nil {
+				return nil, err
+			}
+			return testcaserun.NewHandler(*cfg.TestCaseRun, db), nil
 		},
 		// tekton runs handler
 		func() (handler.EventHandler, error) {
 			if cfg.Tekton == nil {
 				return nil, nil
 			}
-			return tekton.NewHandler(*cfg.Tekton)
+			db, err := stores.openOptional(cfg.Tekton.Store)
+			if err != nil {
+				return nil, err
+			}
+			return tekton.NewHandler(*cfg.Tekton, db)
+		},
+		// cdevents runs handler
+		func() (handler.EventHandler, error) {
+			if cfg.CDEvents == nil {
+				return nil, nil
+			}
+			db, err := stores.openOptional(cfg.CDEvents.Store)
+			if err != nil {
+				return nil, err
+			}
+			return cdevents.NewHandler(*cfg.CDEvents, db)
 		},
 		// tibuild handler
 		func() (handler.EventHandler, error) {
@@ -69,6 +315,13 @@ func newCloudEventsHandler(cfg *config.Config) (handler.EventHandler, error) {
 			}
 			return tibuild.NewHandler(*cfg.TiBuild)
 		},
+		// declarative routing handler
+		func() (handler.EventHandler, error) {
+			if rt == nil {
+				return nil, nil
+			}
+			return rt, nil
+		},
 	}
 
 	ret := new(handler.CompositeEventHandler)
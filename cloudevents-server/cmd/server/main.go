@@ -15,7 +15,11 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/archive"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/custom/testcaserun"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/routing"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/tekton"
 )
 
 func main() {
@@ -50,24 +54,60 @@ func main() {
 	ginEngine := gin.Default()
 	_ = ginEngine.SetTrustedProxies(nil)
 
-	setRouters(ginEngine, cfg)
+	stores := make(storeClients)
+	defer stores.close()
 
-	hd, err := newCloudEventsHandler(cfg)
+	var ar *archive.Archive
+	if cfg.Archive != nil {
+		db, err := stores.open(cfg.Archive.Store)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create event archive")
+		}
+		ar = archive.New(db.ArchivedEvent, *cfg.Archive)
+	}
+
+	producer := newEventProducer(cfg, ar)
+
+	var rt *routing.Router
+	if cfg.Routing != nil {
+		var err error
+		rt, err = routing.NewRouter(*cfg.Routing, producer, newEventArchiver(ar))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create event router")
+		}
+	}
+
+	hd, err := newCloudEventsHandler(cfg, stores, rt)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create cloudevents handler")
 	}
 	log.Debug().Any("types", hd.SupportEventTypes()).Msgf("registered event handlers")
 
+	setRouters(ginEngine, cfg, stores, ar, hd, producer)
+
 	cg, err := handler.NewEventConsumerGroup(cfg.Kafka, hd)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to create consumer group")
 	}
 
+	workers := []worker{cg}
+	if ar != nil {
+		workers = append(workers, ar)
+	}
+	if rt != nil {
+		workers = append(workers, rt)
+	}
+
 	srv := &http.Server{Addr: serveAddr, Handler: ginEngine}
-	startServices(srv, cg)
+	startServices(srv, workers...)
 }
 
-func startServices(srv *http.Server, cg handler.EventConsumerGroup) {
+// worker runs in background until the context is done.
+type worker interface {
+	Start(ctx context.Context, wg *sync.WaitGroup)
+}
+
+func startServices(srv *http.Server, workers ...worker) {
 	// Create channel used by both the signal handler and server goroutines
 	// to notify the main goroutine when to stop the server.
 	errc := make(chan error)
@@ -91,7 +131,9 @@ func startServices(srv *http.Server, cg handler.EventConsumerGroup) {
 	// Start consumer workers
 	cgWg := new(sync.WaitGroup)
 	cgCtx, cgCancel := context.WithCancel(context.Background())
-	cg.Start(cgCtx, cgWg)
+	for _, w := range workers {
+		w.Start(cgCtx, cgWg)
+	}
 
 	// Wait for signal.
 	log.Warn().Msgf("exiting (%v)", <-errc)
@@ -108,8 +150,49 @@ func startServices(srv *http.Server, cg handler.EventConsumerGroup) {
 	log.Warn().Msg("server gracefully stopped")
 }
 
-func setRouters(r gin.IRoutes, cfg *config.Config) {
+func setRouters(r gin.IRoutes, cfg *config.Config, stores storeClients, ar *archive.Archive, hd handler.EventHandler, producer *handler.EventProducer) {
 	r.GET("/", indexHandler)
 	r.GET("/healthz", healthzHandler)
-	r.POST("/events", newEventsHandlerFunc(cfg))
+	r.POST("/events", newEventsHandlerFunc(producer))
+	if cfg.TestCaseRun != nil {
+		db, err := stores.open(cfg.TestCaseRun.Store)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create test case analytics")
+		}
+		analytics := testcaserun.NewAnalytics(db)
+		r.GET("/testcases/flaky/top", newQueryHandlerFunc(testcaserun.ErrInvalidQuery, analytics.TopFlaky))
+		r.GET("/testcases/flaky/new", newQueryHandlerFunc(testcaserun.ErrInvalidQuery, analytics.NewFlaky))
+		r.GET("/testcases/flaky/trend", newQueryHandlerFunc(testcaserun.ErrInvalidQuery, analytics.FlakyTrend))
+		r.GET("/testcases/slow", newQueryHandlerFunc(testcaserun.ErrInvalidQuery, analytics.Slowest))
+	}
+	if store := runHistoryStore(cfg); store != nil {
+		db, err := stores.open(*store)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to create tekton run history")
+		}
+		history := tekton.NewRunHistory(db)
+		r.GET("/tekton/runs/stats", newQueryHandlerFunc(tekton.ErrInvalidHistoryQuery, history.Stats))
+		r.GET("/tekton/runs/trend", newQueryHandlerFunc(tekton.ErrInvalidHistoryQuery, history.Trend))
+	}
+	if cfg.Admin != nil {
+		r.POST("/admin/replay", adminAuth(cfg.Admin.Token), newReplayHandlerFunc(newReplayer(cfg, ar, hd, producer)))
+
+		// the archived events carry the payloads, they are readable to the admin only.
+		if ar != nil {
+			r.GET("/events", adminAuth(cfg.Admin.Token), newArchiveSearchHandlerFunc(ar))
+			r.GET("/events/:id", adminAuth(cfg.Admin.Token), newArchiveGetHandlerFunc(ar))
+		}
+
+		if cfg.Tekton != nil && cfg.Tekton.Store != nil {
+			db, err := stores.open(*cfg.Tekton.Store)
+			if err != nil {
+				log.Fatal().Err(err).Msg("failed to create tekton subscription store")
+			}
+			store := tekton.NewSubscriptionStore(db)
+			subscriptionsAuth := adminAuth(cfg.Admin.Token, cfg.Admin.SubscriptionsToken)
+			r.GET("/tekton/subscriptions", subscriptionsAuth, newSubscriptionListHandlerFunc(store))
+			r.POST("/tekton/subscriptions", subscriptionsAuth, newSubscriptionCreateHandlerFunc(store))
+			r.DELETE("/tekton/subscriptions/:id", subscriptionsAuth, newSubscriptionDeleteHandlerFunc(store))
+		}
+	}
 }
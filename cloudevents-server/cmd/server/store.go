@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+// storeClients opens the clients of the stores, the features configured with
+// the same store share one client.
+type storeClients map[config.Store]*ent.Client
+
+// open returns the client of the store, it is migrated when opened first.
+func (s storeClients) open(cfg config.Store) (*ent.Client, error) {
+	if db, ok := s[cfg]; ok {
+		return db, nil
+	}
+
+	db, err := ent.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to %s: %w", cfg.Driver, err)
+	}
+
+	// Run the auto migration tool.
+	if err := db.Schema.Create(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed creating schema resources: %w", err)
+	}
+
+	s[cfg] = db
+	return db, nil
+}
+
+// openOptional returns nil when the store is not configured.
+func (s storeClients) openOptional(cfg *config.Store) (*ent.Client, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	return s.open(*cfg)
+}
+
+func (s storeClients) close() {
+	for _, db := range s {
+		db.Close()
+	}
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+)
+
+// ArchivedEvent is the model entity for the ArchivedEvent schema.
+type ArchivedEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// cloud event id
+	EventID string `json:"event_id,omitempty"`
+	// cloud event type
+	Type string `json:"type,omitempty"`
+	// cloud event source
+	Source string `json:"source,omitempty"`
+	// cloud event subject
+	Subject string `json:"subject,omitempty"`
+	// cloud event time, the received time when the event has none
+	Time time.Time `json:"time,omitempty"`
+	// resolved kafka topic, empty when the event is ignored
+	Topic string `json:"topic,omitempty"`
+	// content type of the payload
+	DataContentType string `json:"data_content_type,omitempty"`
+	// cloud event extension attributes
+	Extensions map[string]string `json:"extensions,omitempty"`
+	// cloud event data, may be truncated
+	Payload []byte `json:"payload,omitempty"`
+	// is the payload truncated?
+	Truncated bool `json:"truncated,omitempty"`
+	// received timestamp
+	ReceivedAt   time.Time `json:"received_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchivedEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivedevent.FieldExtensions, archivedevent.FieldPayload:
+			values[i] = new([]byte)
+		case archivedevent.FieldTruncated:
+			values[i] = new(sql.NullBool)
+		case archivedevent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case archivedevent.FieldEventID, archivedevent.FieldType, archivedevent.FieldSource, archivedevent.FieldSubject, archivedevent.FieldTopic, archivedevent.FieldDataContentType:
+			values[i] = new(sql.NullString)
+		case archivedevent.FieldTime, archivedevent.FieldReceivedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchivedEvent fields.
+func (_m *ArchivedEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivedevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case archivedevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				_m.EventID = value.String
+			}
+		case archivedevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case archivedevent.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case archivedevent.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case archivedevent.FieldTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time", values[i])
+			} else if value.Valid {
+				_m.Time = value.Time
+			}
+		case archivedevent.FieldTopic:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field topic", values[i])
+			} else if value.Valid {
+				_m.Topic = value.String
+			}
+		case archivedevent.FieldDataContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_content_type", values[i])
+			} else if value.Valid {
+				_m.DataContentType = value.String
+			}
+		case archivedevent.FieldExtensions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extensions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Extensions); err != nil {
+					return fmt.Errorf("unmarshal field extensions: %w", err)
+				}
+			}
+		case archivedevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				_m.Payload = *value
+			}
+		case archivedevent.FieldTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field truncated", values[i])
+			} else if value.Valid {
+				_m.Truncated = value.Bool
+			}
+		case archivedevent.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchivedEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *ArchivedEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ArchivedEvent.
+// Note that you need to call ArchivedEvent.Unwrap() before calling this method if this ArchivedEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ArchivedEvent) Update() *ArchivedEventUpdateOne {
+	return NewArchivedEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ArchivedEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ArchivedEvent) Unwrap() *ArchivedEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchivedEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ArchivedEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchivedEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(_m.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("time=")
+	builder.WriteString(_m.Time.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("topic=")
+	builder.WriteString(_m.Topic)
+	builder.WriteString(", ")
+	builder.WriteString("data_content_type=")
+	builder.WriteString(_m.DataContentType)
+	builder.WriteString(", ")
+	builder.WriteString("extensions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Extensions))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("truncated=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Truncated))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchivedEvents is a parsable slice of ArchivedEvent.
+type ArchivedEvents []*ArchivedEvent
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivedevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldEventID, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldType, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldSubject, v))
+}
+
+// Time applies equality check predicate on the "time" field. It's identical to TimeEQ.
+func Time(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTime, v))
+}
+
+// Topic applies equality check predicate on the "topic" field. It's identical to TopicEQ.
+func Topic(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTopic, v))
+}
+
+// DataContentType applies equality check predicate on the "data_content_type" field. It's identical to DataContentTypeEQ.
+func DataContentType(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldDataContentType, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldPayload, v))
+}
+
+// Truncated applies equality check predicate on the "truncated" field. It's identical to TruncatedEQ.
+func Truncated(v bool) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTruncated, v))
+}
+
+// ReceivedAt applies equality check predicate on the "received_at" field. It's identical to ReceivedAtEQ.
+func ReceivedAt(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldEventID, v))
+}
+
+// EventIDContains applies the Contains predicate on the "event_id" field.
+func EventIDContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldEventID, v))
+}
+
+// EventIDHasPrefix applies the HasPrefix predicate on the "event_id" field.
+func EventIDHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldEventID, v))
+}
+
+// EventIDHasSuffix applies the HasSuffix predicate on the "event_id" field.
+func EventIDHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldEventID, v))
+}
+
+// EventIDEqualFold applies the EqualFold predicate on the "event_id" field.
+func EventIDEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldEventID, v))
+}
+
+// EventIDContainsFold applies the ContainsFold predicate on the "event_id" field.
+func EventIDContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldEventID, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldType, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldSource, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// TimeEQ applies the EQ predicate on the "time" field.
+func TimeEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTime, v))
+}
+
+// TimeNEQ applies the NEQ predicate on the "time" field.
+func TimeNEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldTime, v))
+}
+
+// TimeIn applies the In predicate on the "time" field.
+func TimeIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldTime, vs...))
+}
+
+// TimeNotIn applies the NotIn predicate on the "time" field.
+func TimeNotIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldTime, vs...))
+}
+
+// TimeGT applies the GT predicate on the "time" field.
+func TimeGT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldTime, v))
+}
+
+// TimeGTE applies the GTE predicate on the "time" field.
+func TimeGTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldTime, v))
+}
+
+// TimeLT applies the LT predicate on the "time" field.
+func TimeLT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldTime, v))
+}
+
+// TimeLTE applies the LTE predicate on the "time" field.
+func TimeLTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldTime, v))
+}
+
+// TopicEQ applies the EQ predicate on the "topic" field.
+func TopicEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTopic, v))
+}
+
+// TopicNEQ applies the NEQ predicate on the "topic" field.
+func TopicNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldTopic, v))
+}
+
+// TopicIn applies the In predicate on the "topic" field.
+func TopicIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldTopic, vs...))
+}
+
+// TopicNotIn applies the NotIn predicate on the "topic" field.
+func TopicNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldTopic, vs...))
+}
+
+// TopicGT applies the GT predicate on the "topic" field.
+func TopicGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldTopic, v))
+}
+
+// TopicGTE applies the GTE predicate on the "topic" field.
+func TopicGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldTopic, v))
+}
+
+// TopicLT applies the LT predicate on the "topic" field.
+func TopicLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldTopic, v))
+}
+
+// TopicLTE applies the LTE predicate on the "topic" field.
+func TopicLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldTopic, v))
+}
+
+// TopicContains applies the Contains predicate on the "topic" field.
+func TopicContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldTopic, v))
+}
+
+// TopicHasPrefix applies the HasPrefix predicate on the "topic" field.
+func TopicHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldTopic, v))
+}
+
+// TopicHasSuffix applies the HasSuffix predicate on the "topic" field.
+func TopicHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldTopic, v))
+}
+
+// TopicEqualFold applies the EqualFold predicate on the "topic" field.
+func TopicEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldTopic, v))
+}
+
+// TopicContainsFold applies the ContainsFold predicate on the "topic" field.
+func TopicContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldTopic, v))
+}
+
+// DataContentTypeEQ applies the EQ predicate on the "data_content_type" field.
+func DataContentTypeEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldDataContentType, v))
+}
+
+// DataContentTypeNEQ applies the NEQ predicate on the "data_content_type" field.
+func DataContentTypeNEQ(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldDataContentType, v))
+}
+
+// DataContentTypeIn applies the In predicate on the "data_content_type" field.
+func DataContentTypeIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldDataContentType, vs...))
+}
+
+// DataContentTypeNotIn applies the NotIn predicate on the "data_content_type" field.
+func DataContentTypeNotIn(vs ...string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldDataContentType, vs...))
+}
+
+// DataContentTypeGT applies the GT predicate on the "data_content_type" field.
+func DataContentTypeGT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldDataContentType, v))
+}
+
+// DataContentTypeGTE applies the GTE predicate on the "data_content_type" field.
+func DataContentTypeGTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldDataContentType, v))
+}
+
+// DataContentTypeLT applies the LT predicate on the "data_content_type" field.
+func DataContentTypeLT(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldDataContentType, v))
+}
+
+// DataContentTypeLTE applies the LTE predicate on the "data_content_type" field.
+func DataContentTypeLTE(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldDataContentType, v))
+}
+
+// DataContentTypeContains applies the Contains predicate on the "data_content_type" field.
+func DataContentTypeContains(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContains(FieldDataContentType, v))
+}
+
+// DataContentTypeHasPrefix applies the HasPrefix predicate on the "data_content_type" field.
+func DataContentTypeHasPrefix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasPrefix(FieldDataContentType, v))
+}
+
+// DataContentTypeHasSuffix applies the HasSuffix predicate on the "data_content_type" field.
+func DataContentTypeHasSuffix(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldHasSuffix(FieldDataContentType, v))
+}
+
+// DataContentTypeEqualFold applies the EqualFold predicate on the "data_content_type" field.
+func DataContentTypeEqualFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEqualFold(FieldDataContentType, v))
+}
+
+// DataContentTypeContainsFold applies the ContainsFold predicate on the "data_content_type" field.
+func DataContentTypeContainsFold(v string) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldContainsFold(FieldDataContentType, v))
+}
+
+// ExtensionsIsNil applies the IsNil predicate on the "extensions" field.
+func ExtensionsIsNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIsNull(FieldExtensions))
+}
+
+// ExtensionsNotNil applies the NotNil predicate on the "extensions" field.
+func ExtensionsNotNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotNull(FieldExtensions))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...[]byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...[]byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v []byte) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadIsNil applies the IsNil predicate on the "payload" field.
+func PayloadIsNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIsNull(FieldPayload))
+}
+
+// PayloadNotNil applies the NotNil predicate on the "payload" field.
+func PayloadNotNil() predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotNull(FieldPayload))
+}
+
+// TruncatedEQ applies the EQ predicate on the "truncated" field.
+func TruncatedEQ(v bool) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldTruncated, v))
+}
+
+// TruncatedNEQ applies the NEQ predicate on the "truncated" field.
+func TruncatedNEQ(v bool) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldTruncated, v))
+}
+
+// ReceivedAtEQ applies the EQ predicate on the "received_at" field.
+func ReceivedAtEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtNEQ applies the NEQ predicate on the "received_at" field.
+func ReceivedAtNEQ(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNEQ(FieldReceivedAt, v))
+}
+
+// ReceivedAtIn applies the In predicate on the "received_at" field.
+func ReceivedAtIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtNotIn applies the NotIn predicate on the "received_at" field.
+func ReceivedAtNotIn(vs ...time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldNotIn(FieldReceivedAt, vs...))
+}
+
+// ReceivedAtGT applies the GT predicate on the "received_at" field.
+func ReceivedAtGT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGT(FieldReceivedAt, v))
+}
+
+// ReceivedAtGTE applies the GTE predicate on the "received_at" field.
+func ReceivedAtGTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldGTE(FieldReceivedAt, v))
+}
+
+// ReceivedAtLT applies the LT predicate on the "received_at" field.
+func ReceivedAtLT(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLT(FieldReceivedAt, v))
+}
+
+// ReceivedAtLTE applies the LTE predicate on the "received_at" field.
+func ReceivedAtLTE(v time.Time) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.FieldLTE(FieldReceivedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchivedEvent) predicate.ArchivedEvent {
+	return predicate.ArchivedEvent(sql.NotPredicates(p))
+}
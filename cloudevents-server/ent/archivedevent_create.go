@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+)
+
+// ArchivedEventCreate is the builder for creating a ArchivedEvent entity.
+type ArchivedEventCreate struct {
+	config
+	mutation *ArchivedEventMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *ArchivedEventCreate) SetEventID(v string) *ArchivedEventCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ArchivedEventCreate) SetType(v string) *ArchivedEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ArchivedEventCreate) SetSource(v string) *ArchivedEventCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ArchivedEventCreate) SetSubject(v string) *ArchivedEventCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *ArchivedEventCreate) SetNillableSubject(v *string) *ArchivedEventCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetTime sets the "time" field.
+func (_c *ArchivedEventCreate) SetTime(v time.Time) *ArchivedEventCreate {
+	_c.mutation.SetTime(v)
+	return _c
+}
+
+// SetTopic sets the "topic" field.
+func (_c *ArchivedEventCreate) SetTopic(v string) *ArchivedEventCreate {
+	_c.mutation.SetTopic(v)
+	return _c
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_c *ArchivedEventCreate) SetNillableTopic(v *string) *ArchivedEventCreate {
+	if v != nil {
+		_c.SetTopic(*v)
+	}
+	return _c
+}
+
+// SetDataContentType sets the "data_content_type" field.
+func (_c *ArchivedEventCreate) SetDataContentType(v string) *ArchivedEventCreate {
+	_c.mutation.SetDataContentType(v)
+	return _c
+}
+
+// SetNillableDataContentType sets the "data_content_type" field if the given value is not nil.
+func (_c *ArchivedEventCreate) SetNillableDataContentType(v *string) *ArchivedEventCreate {
+	if v != nil {
+		_c.SetDataContentType(*v)
+	}
+	return _c
+}
+
+// SetExtensions sets the "extensions" field.
+func (_c *ArchivedEventCreate) SetExtensions(v map[string]string) *ArchivedEventCreate {
+	_c.mutation.SetExtensions(v)
+	return _c
+}
+
+// SetPayload sets the "payload" field.
+func (_c *ArchivedEventCreate) SetPayload(v []byte) *ArchivedEventCreate {
+	_c.mutation.SetPayload(v)
+	return _c
+}
+
+// SetTruncated sets the "truncated" field.
+func (_c *ArchivedEventCreate) SetTruncated(v bool) *ArchivedEventCreate {
+	_c.mutation.SetTruncated(v)
+	return _c
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_c *ArchivedEventCreate) SetNillableTruncated(v *bool) *ArchivedEventCreate {
+	if v != nil {
+		_c.SetTruncated(*v)
+	}
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *ArchivedEventCreate) SetReceivedAt(v time.Time) *ArchivedEventCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_c *ArchivedEventCreate) Mutation() *ArchivedEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ArchivedEvent in the database.
+func (_c *ArchivedEventCreate) Save(ctx context.Context) (*ArchivedEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArchivedEventCreate) SaveX(ctx context.Context) *ArchivedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchivedEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchivedEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ArchivedEventCreate) defaults() {
+	if _, ok := _c.mutation.Subject(); !ok {
+		v := archivedevent.DefaultSubject
+		_c.mutation.SetSubject(v)
+	}
+	if _, ok := _c.mutation.Topic(); !ok {
+		v := archivedevent.DefaultTopic
+		_c.mutation.SetTopic(v)
+	}
+	if _, ok := _c.mutation.DataContentType(); !ok {
+		v := archivedevent.DefaultDataContentType
+		_c.mutation.SetDataContentType(v)
+	}
+	if _, ok := _c.mutation.Truncated(); !ok {
+		v := archivedevent.DefaultTruncated
+		_c.mutation.SetTruncated(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArchivedEventCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "ArchivedEvent.event_id"`)}
+	}
+	if v, ok := _c.mutation.EventID(); ok {
+		if err := archivedevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.event_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ArchivedEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ArchivedEvent.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := archivedevent.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "ArchivedEvent.subject"`)}
+	}
+	if v, ok := _c.mutation.Subject(); ok {
+		if err := archivedevent.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.subject": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Time(); !ok {
+		return &ValidationError{Name: "time", err: errors.New(`ent: missing required field "ArchivedEvent.time"`)}
+	}
+	if _, ok := _c.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`ent: missing required field "ArchivedEvent.topic"`)}
+	}
+	if v, ok := _c.mutation.Topic(); ok {
+		if err := archivedevent.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.topic": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DataContentType(); !ok {
+		return &ValidationError{Name: "data_content_type", err: errors.New(`ent: missing required field "ArchivedEvent.data_content_type"`)}
+	}
+	if v, ok := _c.mutation.DataContentType(); ok {
+		if err := archivedevent.DataContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "data_content_type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.data_content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Truncated(); !ok {
+		return &ValidationError{Name: "truncated", err: errors.New(`ent: missing required field "ArchivedEvent.truncated"`)}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "ArchivedEvent.received_at"`)}
+	}
+	return nil
+}
+
+func (_c *ArchivedEventCreate) sqlSave(ctx context.Context) (*ArchivedEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArchivedEventCreate) createSpec() (*ArchivedEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchivedEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(archivedevent.Table, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(archivedevent.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(archivedevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(archivedevent.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Time(); ok {
+		_spec.SetField(archivedevent.FieldTime, field.TypeTime, value)
+		_node.Time = value
+	}
+	if value, ok := _c.mutation.Topic(); ok {
+		_spec.SetField(archivedevent.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := _c.mutation.DataContentType(); ok {
+		_spec.SetField(archivedevent.FieldDataContentType, field.TypeString, value)
+		_node.DataContentType = value
+	}
+	if value, ok := _c.mutation.Extensions(); ok {
+		_spec.SetField(archivedevent.FieldExtensions, field.TypeJSON, value)
+		_node.Extensions = value
+	}
+	if value, ok := _c.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := _c.mutation.Truncated(); ok {
+		_spec.SetField(archivedevent.FieldTruncated, field.TypeBool, value)
+		_node.Truncated = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(archivedevent.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	return _node, _spec
+}
+
+// ArchivedEventCreateBulk is the builder for creating many ArchivedEvent entities in bulk.
+type ArchivedEventCreateBulk struct {
+	config
+	err      error
+	builders []*ArchivedEventCreate
+}
+
+// Save creates the ArchivedEvent entities in the database.
+func (_c *ArchivedEventCreateBulk) Save(ctx context.Context) ([]*ArchivedEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArchivedEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchivedEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArchivedEventCreateBulk) SaveX(ctx context.Context) []*ArchivedEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchivedEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchivedEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ArchivedEventUpdate is the builder for updating ArchivedEvent entities.
+type ArchivedEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArchivedEventMutation
+}
+
+// Where appends a list predicates to the ArchivedEventUpdate builder.
+func (_u *ArchivedEventUpdate) Where(ps ...predicate.ArchivedEvent) *ArchivedEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *ArchivedEventUpdate) SetEventID(v string) *ArchivedEventUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableEventID(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ArchivedEventUpdate) SetType(v string) *ArchivedEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableType(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ArchivedEventUpdate) SetSource(v string) *ArchivedEventUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableSource(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ArchivedEventUpdate) SetSubject(v string) *ArchivedEventUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableSubject(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *ArchivedEventUpdate) SetTime(v time.Time) *ArchivedEventUpdate {
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableTime(v *time.Time) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *ArchivedEventUpdate) SetTopic(v string) *ArchivedEventUpdate {
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableTopic(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// SetDataContentType sets the "data_content_type" field.
+func (_u *ArchivedEventUpdate) SetDataContentType(v string) *ArchivedEventUpdate {
+	_u.mutation.SetDataContentType(v)
+	return _u
+}
+
+// SetNillableDataContentType sets the "data_content_type" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableDataContentType(v *string) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetDataContentType(*v)
+	}
+	return _u
+}
+
+// SetExtensions sets the "extensions" field.
+func (_u *ArchivedEventUpdate) SetExtensions(v map[string]string) *ArchivedEventUpdate {
+	_u.mutation.SetExtensions(v)
+	return _u
+}
+
+// ClearExtensions clears the value of the "extensions" field.
+func (_u *ArchivedEventUpdate) ClearExtensions() *ArchivedEventUpdate {
+	_u.mutation.ClearExtensions()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ArchivedEventUpdate) SetPayload(v []byte) *ArchivedEventUpdate {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *ArchivedEventUpdate) ClearPayload() *ArchivedEventUpdate {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *ArchivedEventUpdate) SetTruncated(v bool) *ArchivedEventUpdate {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableTruncated(v *bool) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *ArchivedEventUpdate) SetReceivedAt(v time.Time) *ArchivedEventUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdate) SetNillableReceivedAt(v *time.Time) *ArchivedEventUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_u *ArchivedEventUpdate) Mutation() *ArchivedEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArchivedEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchivedEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArchivedEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchivedEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchivedEventUpdate) check() error {
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := archivedevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := archivedevent.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := archivedevent.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Topic(); ok {
+		if err := archivedevent.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.topic": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DataContentType(); ok {
+		if err := archivedevent.DataContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "data_content_type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.data_content_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ArchivedEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedevent.Table, archivedevent.Columns, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(archivedevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(archivedevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(archivedevent.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(archivedevent.FieldTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(archivedevent.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DataContentType(); ok {
+		_spec.SetField(archivedevent.FieldDataContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Extensions(); ok {
+		_spec.SetField(archivedevent.FieldExtensions, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtensionsCleared() {
+		_spec.ClearField(archivedevent.FieldExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeBytes, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(archivedevent.FieldPayload, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(archivedevent.FieldTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(archivedevent.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArchivedEventUpdateOne is the builder for updating a single ArchivedEvent entity.
+type ArchivedEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArchivedEventMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *ArchivedEventUpdateOne) SetEventID(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableEventID(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ArchivedEventUpdateOne) SetType(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableType(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *ArchivedEventUpdateOne) SetSource(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableSource(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ArchivedEventUpdateOne) SetSubject(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableSubject(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// SetTime sets the "time" field.
+func (_u *ArchivedEventUpdateOne) SetTime(v time.Time) *ArchivedEventUpdateOne {
+	_u.mutation.SetTime(v)
+	return _u
+}
+
+// SetNillableTime sets the "time" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableTime(v *time.Time) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetTime(*v)
+	}
+	return _u
+}
+
+// SetTopic sets the "topic" field.
+func (_u *ArchivedEventUpdateOne) SetTopic(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetTopic(v)
+	return _u
+}
+
+// SetNillableTopic sets the "topic" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableTopic(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetTopic(*v)
+	}
+	return _u
+}
+
+// SetDataContentType sets the "data_content_type" field.
+func (_u *ArchivedEventUpdateOne) SetDataContentType(v string) *ArchivedEventUpdateOne {
+	_u.mutation.SetDataContentType(v)
+	return _u
+}
+
+// SetNillableDataContentType sets the "data_content_type" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableDataContentType(v *string) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetDataContentType(*v)
+	}
+	return _u
+}
+
+// SetExtensions sets the "extensions" field.
+func (_u *ArchivedEventUpdateOne) SetExtensions(v map[string]string) *ArchivedEventUpdateOne {
+	_u.mutation.SetExtensions(v)
+	return _u
+}
+
+// ClearExtensions clears the value of the "extensions" field.
+func (_u *ArchivedEventUpdateOne) ClearExtensions() *ArchivedEventUpdateOne {
+	_u.mutation.ClearExtensions()
+	return _u
+}
+
+// SetPayload sets the "payload" field.
+func (_u *ArchivedEventUpdateOne) SetPayload(v []byte) *ArchivedEventUpdateOne {
+	_u.mutation.SetPayload(v)
+	return _u
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (_u *ArchivedEventUpdateOne) ClearPayload() *ArchivedEventUpdateOne {
+	_u.mutation.ClearPayload()
+	return _u
+}
+
+// SetTruncated sets the "truncated" field.
+func (_u *ArchivedEventUpdateOne) SetTruncated(v bool) *ArchivedEventUpdateOne {
+	_u.mutation.SetTruncated(v)
+	return _u
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableTruncated(v *bool) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetTruncated(*v)
+	}
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *ArchivedEventUpdateOne) SetReceivedAt(v time.Time) *ArchivedEventUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *ArchivedEventUpdateOne) SetNillableReceivedAt(v *time.Time) *ArchivedEventUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the ArchivedEventMutation object of the builder.
+func (_u *ArchivedEventUpdateOne) Mutation() *ArchivedEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ArchivedEventUpdate builder.
+func (_u *ArchivedEventUpdateOne) Where(ps ...predicate.ArchivedEvent) *ArchivedEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArchivedEventUpdateOne) Select(field string, fields ...string) *ArchivedEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArchivedEvent entity.
+func (_u *ArchivedEventUpdateOne) Save(ctx context.Context) (*ArchivedEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchivedEventUpdateOne) SaveX(ctx context.Context) *ArchivedEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArchivedEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchivedEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchivedEventUpdateOne) check() error {
+	if v, ok := _u.mutation.EventID(); ok {
+		if err := archivedevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.event_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := archivedevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := archivedevent.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Subject(); ok {
+		if err := archivedevent.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.subject": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Topic(); ok {
+		if err := archivedevent.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.topic": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DataContentType(); ok {
+		if err := archivedevent.DataContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "data_content_type", err: fmt.Errorf(`ent: validator failed for field "ArchivedEvent.data_content_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ArchivedEventUpdateOne) sqlSave(ctx context.Context) (_node *ArchivedEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivedevent.Table, archivedevent.Columns, sqlgraph.NewFieldSpec(archivedevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchivedEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivedevent.FieldID)
+		for _, f := range fields {
+			if !archivedevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archivedevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EventID(); ok {
+		_spec.SetField(archivedevent.FieldEventID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(archivedevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(archivedevent.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(archivedevent.FieldSubject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Time(); ok {
+		_spec.SetField(archivedevent.FieldTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Topic(); ok {
+		_spec.SetField(archivedevent.FieldTopic, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DataContentType(); ok {
+		_spec.SetField(archivedevent.FieldDataContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Extensions(); ok {
+		_spec.SetField(archivedevent.FieldExtensions, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtensionsCleared() {
+		_spec.ClearField(archivedevent.FieldExtensions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Payload(); ok {
+		_spec.SetField(archivedevent.FieldPayload, field.TypeBytes, value)
+	}
+	if _u.mutation.PayloadCleared() {
+		_spec.ClearField(archivedevent.FieldPayload, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Truncated(); ok {
+		_spec.SetField(archivedevent.FieldTruncated, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(archivedevent.FieldReceivedAt, field.TypeTime, value)
+	}
+	_node = &ArchivedEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivedevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,7 +14,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +28,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ArchivedEvent is the client for interacting with the ArchivedEvent builders.
+	ArchivedEvent *ArchivedEventClient
+	// LarkRunCard is the client for interacting with the LarkRunCard builders.
+	LarkRunCard *LarkRunCardClient
+	// ProblemCaseIssue is the client for interacting with the ProblemCaseIssue builders.
+	ProblemCaseIssue *ProblemCaseIssueClient
 	// ProblemCaseRun is the client for interacting with the ProblemCaseRun builders.
 	ProblemCaseRun *ProblemCaseRunClient
+	// TektonRun is the client for interacting with the TektonRun builders.
+	TektonRun *TektonRunClient
+	// TektonRunFailure is the client for interacting with the TektonRunFailure builders.
+	TektonRunFailure *TektonRunFailureClient
+	// TektonSubscription is the client for interacting with the TektonSubscription builders.
+	TektonSubscription *TektonSubscriptionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +53,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ArchivedEvent = NewArchivedEventClient(c.config)
+	c.LarkRunCard = NewLarkRunCardClient(c.config)
+	c.ProblemCaseIssue = NewProblemCaseIssueClient(c.config)
 	c.ProblemCaseRun = NewProblemCaseRunClient(c.config)
+	c.TektonRun = NewTektonRunClient(c.config)
+	c.TektonRunFailure = NewTektonRunFailureClient(c.config)
+	c.TektonSubscription = NewTektonSubscriptionClient(c.config)
 }
 
 type (
@@ -126,9 +150,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ProblemCaseRun: NewProblemCaseRunClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		ArchivedEvent:      NewArchivedEventClient(cfg),
+		LarkRunCard:        NewLarkRunCardClient(cfg),
+		ProblemCaseIssue:   NewProblemCaseIssueClient(cfg),
+		ProblemCaseRun:     NewProblemCaseRunClient(cfg),
+		TektonRun:          NewTektonRunClient(cfg),
+		TektonRunFailure:   NewTektonRunFailureClient(cfg),
+		TektonSubscription: NewTektonSubscriptionClient(cfg),
 	}, nil
 }
 
@@ -146,16 +176,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ProblemCaseRun: NewProblemCaseRunClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		ArchivedEvent:      NewArchivedEventClient(cfg),
+		LarkRunCard:        NewLarkRunCardClient(cfg),
+		ProblemCaseIssue:   NewProblemCaseIssueClient(cfg),
+		ProblemCaseRun:     NewProblemCaseRunClient(cfg),
+		TektonRun:          NewTektonRunClient(cfg),
+		TektonRunFailure:   NewTektonRunFailureClient(cfg),
+		TektonSubscription: NewTektonSubscriptionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ProblemCaseRun.
+//		ArchivedEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,25 +213,446 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ProblemCaseRun.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ArchivedEvent, c.LarkRunCard, c.ProblemCaseIssue, c.ProblemCaseRun,
+		c.TektonRun, c.TektonRunFailure, c.TektonSubscription,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ProblemCaseRun.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ArchivedEvent, c.LarkRunCard, c.ProblemCaseIssue, c.ProblemCaseRun,
+		c.TektonRun, c.TektonRunFailure, c.TektonSubscription,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ArchivedEventMutation:
+		return c.ArchivedEvent.mutate(ctx, m)
+	case *LarkRunCardMutation:
+		return c.LarkRunCard.mutate(ctx, m)
+	case *ProblemCaseIssueMutation:
+		return c.ProblemCaseIssue.mutate(ctx, m)
 	case *ProblemCaseRunMutation:
 		return c.ProblemCaseRun.mutate(ctx, m)
+	case *TektonRunMutation:
+		return c.TektonRun.mutate(ctx, m)
+	case *TektonRunFailureMutation:
+		return c.TektonRunFailure.mutate(ctx, m)
+	case *TektonSubscriptionMutation:
+		return c.TektonSubscription.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ArchivedEventClient is a client for the ArchivedEvent schema.
+type ArchivedEventClient struct {
+	config
+}
+
+// NewArchivedEventClient returns a client for the ArchivedEvent from the given config.
+func NewArchivedEventClient(c config) *ArchivedEventClient {
+	return &ArchivedEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archivedevent.Hooks(f(g(h())))`.
+func (c *ArchivedEventClient) Use(hooks ...Hook) {
+	c.hooks.ArchivedEvent = append(c.hooks.ArchivedEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archivedevent.Intercept(f(g(h())))`.
+func (c *ArchivedEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchivedEvent = append(c.inters.ArchivedEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ArchivedEvent entity.
+func (c *ArchivedEventClient) Create() *ArchivedEventCreate {
+	mutation := newArchivedEventMutation(c.config, OpCreate)
+	return &ArchivedEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchivedEvent entities.
+func (c *ArchivedEventClient) CreateBulk(builders ...*ArchivedEventCreate) *ArchivedEventCreateBulk {
+	return &ArchivedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchivedEventClient) MapCreateBulk(slice any, setFunc func(*ArchivedEventCreate, int)) *ArchivedEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchivedEventCreateBulk{err: fmt.Errorf("calling to ArchivedEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchivedEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchivedEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchivedEvent.
+func (c *ArchivedEventClient) Update() *ArchivedEventUpdate {
+	mutation := newArchivedEventMutation(c.config, OpUpdate)
+	return &ArchivedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchivedEventClient) UpdateOne(_m *ArchivedEvent) *ArchivedEventUpdateOne {
+	mutation := newArchivedEventMutation(c.config, OpUpdateOne, withArchivedEvent(_m))
+	return &ArchivedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchivedEventClient) UpdateOneID(id int) *ArchivedEventUpdateOne {
+	mutation := newArchivedEventMutation(c.config, OpUpdateOne, withArchivedEventID(id))
+	return &ArchivedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchivedEvent.
+func (c *ArchivedEventClient) Delete() *ArchivedEventDelete {
+	mutation := newArchivedEventMutation(c.config, OpDelete)
+	return &ArchivedEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchivedEventClient) DeleteOne(_m *ArchivedEvent) *ArchivedEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchivedEventClient) DeleteOneID(id int) *ArchivedEventDeleteOne {
+	builder := c.Delete().Where(archivedevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchivedEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchivedEvent.
+func (c *ArchivedEventClient) Query() *ArchivedEventQuery {
+	return &ArchivedEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchivedEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchivedEvent entity by its id.
+func (c *ArchivedEventClient) Get(ctx context.Context, id int) (*ArchivedEvent, error) {
+	return c.Query().Where(archivedevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchivedEventClient) GetX(ctx context.Context, id int) *ArchivedEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ArchivedEventClient) Hooks() []Hook {
+	return c.hooks.ArchivedEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchivedEventClient) Interceptors() []Interceptor {
+	return c.inters.ArchivedEvent
+}
+
+func (c *ArchivedEventClient) mutate(ctx context.Context, m *ArchivedEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchivedEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchivedEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchivedEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchivedEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArchivedEvent mutation op: %q", m.Op())
+	}
+}
+
+// LarkRunCardClient is a client for the LarkRunCard schema.
+type LarkRunCardClient struct {
+	config
+}
+
+// NewLarkRunCardClient returns a client for the LarkRunCard from the given config.
+func NewLarkRunCardClient(c config) *LarkRunCardClient {
+	return &LarkRunCardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `larkruncard.Hooks(f(g(h())))`.
+func (c *LarkRunCardClient) Use(hooks ...Hook) {
+	c.hooks.LarkRunCard = append(c.hooks.LarkRunCard, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `larkruncard.Intercept(f(g(h())))`.
+func (c *LarkRunCardClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LarkRunCard = append(c.inters.LarkRunCard, interceptors...)
+}
+
+// Create returns a builder for creating a LarkRunCard entity.
+func (c *LarkRunCardClient) Create() *LarkRunCardCreate {
+	mutation := newLarkRunCardMutation(c.config, OpCreate)
+	return &LarkRunCardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LarkRunCard entities.
+func (c *LarkRunCardClient) CreateBulk(builders ...*LarkRunCardCreate) *LarkRunCardCreateBulk {
+	return &LarkRunCardCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LarkRunCardClient) MapCreateBulk(slice any, setFunc func(*LarkRunCardCreate, int)) *LarkRunCardCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LarkRunCardCreateBulk{err: fmt.Errorf("calling to LarkRunCardClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LarkRunCardCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LarkRunCardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LarkRunCard.
+func (c *LarkRunCardClient) Update() *LarkRunCardUpdate {
+	mutation := newLarkRunCardMutation(c.config, OpUpdate)
+	return &LarkRunCardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LarkRunCardClient) UpdateOne(_m *LarkRunCard) *LarkRunCardUpdateOne {
+	mutation := newLarkRunCardMutation(c.config, OpUpdateOne, withLarkRunCard(_m))
+	return &LarkRunCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LarkRunCardClient) UpdateOneID(id int) *LarkRunCardUpdateOne {
+	mutation := newLarkRunCardMutation(c.config, OpUpdateOne, withLarkRunCardID(id))
+	return &LarkRunCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LarkRunCard.
+func (c *LarkRunCardClient) Delete() *LarkRunCardDelete {
+	mutation := newLarkRunCardMutation(c.config, OpDelete)
+	return &LarkRunCardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LarkRunCardClient) DeleteOne(_m *LarkRunCard) *LarkRunCardDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LarkRunCardClient) DeleteOneID(id int) *LarkRunCardDeleteOne {
+	builder := c.Delete().Where(larkruncard.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LarkRunCardDeleteOne{builder}
+}
+
+// Query returns a query builder for LarkRunCard.
+func (c *LarkRunCardClient) Query() *LarkRunCardQuery {
+	return &LarkRunCardQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLarkRunCard},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LarkRunCard entity by its id.
+func (c *LarkRunCardClient) Get(ctx context.Context, id int) (*LarkRunCard, error) {
+	return c.Query().Where(larkruncard.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LarkRunCardClient) GetX(ctx context.Context, id int) *LarkRunCard {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LarkRunCardClient) Hooks() []Hook {
+	return c.hooks.LarkRunCard
+}
+
+// Interceptors returns the client interceptors.
+func (c *LarkRunCardClient) Interceptors() []Interceptor {
+	return c.inters.LarkRunCard
+}
+
+func (c *LarkRunCardClient) mutate(ctx context.Context, m *LarkRunCardMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LarkRunCardCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LarkRunCardUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LarkRunCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LarkRunCardDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LarkRunCard mutation op: %q", m.Op())
+	}
+}
+
+// ProblemCaseIssueClient is a client for the ProblemCaseIssue schema.
+type ProblemCaseIssueClient struct {
+	config
+}
+
+// NewProblemCaseIssueClient returns a client for the ProblemCaseIssue from the given config.
+func NewProblemCaseIssueClient(c config) *ProblemCaseIssueClient {
+	return &ProblemCaseIssueClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `problemcaseissue.Hooks(f(g(h())))`.
+func (c *ProblemCaseIssueClient) Use(hooks ...Hook) {
+	c.hooks.ProblemCaseIssue = append(c.hooks.ProblemCaseIssue, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `problemcaseissue.Intercept(f(g(h())))`.
+func (c *ProblemCaseIssueClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProblemCaseIssue = append(c.inters.ProblemCaseIssue, interceptors...)
+}
+
+// Create returns a builder for creating a ProblemCaseIssue entity.
+func (c *ProblemCaseIssueClient) Create() *ProblemCaseIssueCreate {
+	mutation := newProblemCaseIssueMutation(c.config, OpCreate)
+	return &ProblemCaseIssueCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProblemCaseIssue entities.
+func (c *ProblemCaseIssueClient) CreateBulk(builders ...*ProblemCaseIssueCreate) *ProblemCaseIssueCreateBulk {
+	return &ProblemCaseIssueCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProblemCaseIssueClient) MapCreateBulk(slice any, setFunc func(*ProblemCaseIssueCreate, int)) *ProblemCaseIssueCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProblemCaseIssueCreateBulk{err: fmt.Errorf("calling to ProblemCaseIssueClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProblemCaseIssueCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProblemCaseIssueCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProblemCaseIssue.
+func (c *ProblemCaseIssueClient) Update() *ProblemCaseIssueUpdate {
+	mutation := newProblemCaseIssueMutation(c.config, OpUpdate)
+	return &ProblemCaseIssueUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProblemCaseIssueClient) UpdateOne(_m *ProblemCaseIssue) *ProblemCaseIssueUpdateOne {
+	mutation := newProblemCaseIssueMutation(c.config, OpUpdateOne, withProblemCaseIssue(_m))
+	return &ProblemCaseIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProblemCaseIssueClient) UpdateOneID(id int) *ProblemCaseIssueUpdateOne {
+	mutation := newProblemCaseIssueMutation(c.config, OpUpdateOne, withProblemCaseIssueID(id))
+	return &ProblemCaseIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProblemCaseIssue.
+func (c *ProblemCaseIssueClient) Delete() *ProblemCaseIssueDelete {
+	mutation := newProblemCaseIssueMutation(c.config, OpDelete)
+	return &ProblemCaseIssueDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProblemCaseIssueClient) DeleteOne(_m *ProblemCaseIssue) *ProblemCaseIssueDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProblemCaseIssueClient) DeleteOneID(id int) *ProblemCaseIssueDeleteOne {
+	builder := c.Delete().Where(problemcaseissue.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProblemCaseIssueDeleteOne{builder}
+}
+
+// Query returns a query builder for ProblemCaseIssue.
+func (c *ProblemCaseIssueClient) Query() *ProblemCaseIssueQuery {
+	return &ProblemCaseIssueQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProblemCaseIssue},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProblemCaseIssue entity by its id.
+func (c *ProblemCaseIssueClient) Get(ctx context.Context, id int) (*ProblemCaseIssue, error) {
+	return c.Query().Where(problemcaseissue.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProblemCaseIssueClient) GetX(ctx context.Context, id int) *ProblemCaseIssue {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProblemCaseIssueClient) Hooks() []Hook {
+	return c.hooks.ProblemCaseIssue
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProblemCaseIssueClient) Interceptors() []Interceptor {
+	return c.inters.ProblemCaseIssue
+}
+
+func (c *ProblemCaseIssueClient) mutate(ctx context.Context, m *ProblemCaseIssueMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProblemCaseIssueCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProblemCaseIssueUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProblemCaseIssueUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProblemCaseIssueDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProblemCaseIssue mutation op: %q", m.Op())
+	}
+}
+
 // ProblemCaseRunClient is a client for the ProblemCaseRun schema.
 type ProblemCaseRunClient struct {
 	config
@@ -251,8 +708,8 @@ func (c *ProblemCaseRunClient) Update() *ProblemCaseRunUpdate {
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProblemCaseRunClient) UpdateOne(pcr *ProblemCaseRun) *ProblemCaseRunUpdateOne {
-	mutation := newProblemCaseRunMutation(c.config, OpUpdateOne, withProblemCaseRun(pcr))
+func (c *ProblemCaseRunClient) UpdateOne(_m *ProblemCaseRun) *ProblemCaseRunUpdateOne {
+	mutation := newProblemCaseRunMutation(c.config, OpUpdateOne, withProblemCaseRun(_m))
 	return &ProblemCaseRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -269,8 +726,8 @@ func (c *ProblemCaseRunClient) Delete() *ProblemCaseRunDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProblemCaseRunClient) DeleteOne(pcr *ProblemCaseRun) *ProblemCaseRunDeleteOne {
-	return c.DeleteOneID(pcr.ID)
+func (c *ProblemCaseRunClient) DeleteOne(_m *ProblemCaseRun) *ProblemCaseRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
@@ -329,12 +786,413 @@ func (c *ProblemCaseRunClient) mutate(ctx context.Context, m *ProblemCaseRunMuta
 	}
 }
 
+// TektonRunClient is a client for the TektonRun schema.
+type TektonRunClient struct {
+	config
+}
+
+// NewTektonRunClient returns a client for the TektonRun from the given config.
+func NewTektonRunClient(c config) *TektonRunClient {
+	return &TektonRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tektonrun.Hooks(f(g(h())))`.
+func (c *TektonRunClient) Use(hooks ...Hook) {
+	c.hooks.TektonRun = append(c.hooks.TektonRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tektonrun.Intercept(f(g(h())))`.
+func (c *TektonRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TektonRun = append(c.inters.TektonRun, interceptors...)
+}
+
+// Create returns a builder for creating a TektonRun entity.
+func (c *TektonRunClient) Create() *TektonRunCreate {
+	mutation := newTektonRunMutation(c.config, OpCreate)
+	return &TektonRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TektonRun entities.
+func (c *TektonRunClient) CreateBulk(builders ...*TektonRunCreate) *TektonRunCreateBulk {
+	return &TektonRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TektonRunClient) MapCreateBulk(slice any, setFunc func(*TektonRunCreate, int)) *TektonRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TektonRunCreateBulk{err: fmt.Errorf("calling to TektonRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TektonRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TektonRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TektonRun.
+func (c *TektonRunClient) Update() *TektonRunUpdate {
+	mutation := newTektonRunMutation(c.config, OpUpdate)
+	return &TektonRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TektonRunClient) UpdateOne(_m *TektonRun) *TektonRunUpdateOne {
+	mutation := newTektonRunMutation(c.config, OpUpdateOne, withTektonRun(_m))
+	return &TektonRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TektonRunClient) UpdateOneID(id int) *TektonRunUpdateOne {
+	mutation := newTektonRunMutation(c.config, OpUpdateOne, withTektonRunID(id))
+	return &TektonRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TektonRun.
+func (c *TektonRunClient) Delete() *TektonRunDelete {
+	mutation := newTektonRunMutation(c.config, OpDelete)
+	return &TektonRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TektonRunClient) DeleteOne(_m *TektonRun) *TektonRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TektonRunClient) DeleteOneID(id int) *TektonRunDeleteOne {
+	builder := c.Delete().Where(tektonrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TektonRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TektonRun.
+func (c *TektonRunClient) Query() *TektonRunQuery {
+	return &TektonRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTektonRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TektonRun entity by its id.
+func (c *TektonRunClient) Get(ctx context.Context, id int) (*TektonRun, error) {
+	return c.Query().Where(tektonrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TektonRunClient) GetX(ctx context.Context, id int) *TektonRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TektonRunClient) Hooks() []Hook {
+	return c.hooks.TektonRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *TektonRunClient) Interceptors() []Interceptor {
+	return c.inters.TektonRun
+}
+
+func (c *TektonRunClient) mutate(ctx context.Context, m *TektonRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TektonRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TektonRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TektonRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TektonRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TektonRun mutation op: %q", m.Op())
+	}
+}
+
+// TektonRunFailureClient is a client for the TektonRunFailure schema.
+type TektonRunFailureClient struct {
+	config
+}
+
+// NewTektonRunFailureClient returns a client for the TektonRunFailure from the given config.
+func NewTektonRunFailureClient(c config) *TektonRunFailureClient {
+	return &TektonRunFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tektonrunfailure.Hooks(f(g(h())))`.
+func (c *TektonRunFailureClient) Use(hooks ...Hook) {
+	c.hooks.TektonRunFailure = append(c.hooks.TektonRunFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tektonrunfailure.Intercept(f(g(h())))`.
+func (c *TektonRunFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TektonRunFailure = append(c.inters.TektonRunFailure, interceptors...)
+}
+
+// Create returns a builder for creating a TektonRunFailure entity.
+func (c *TektonRunFailureClient) Create() *TektonRunFailureCreate {
+	mutation := newTektonRunFailureMutation(c.config, OpCreate)
+	return &TektonRunFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TektonRunFailure entities.
+func (c *TektonRunFailureClient) CreateBulk(builders ...*TektonRunFailureCreate) *TektonRunFailureCreateBulk {
+	return &TektonRunFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TektonRunFailureClient) MapCreateBulk(slice any, setFunc func(*TektonRunFailureCreate, int)) *TektonRunFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TektonRunFailureCreateBulk{err: fmt.Errorf("calling to TektonRunFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TektonRunFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TektonRunFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TektonRunFailure.
+func (c *TektonRunFailureClient) Update() *TektonRunFailureUpdate {
+	mutation := newTektonRunFailureMutation(c.config, OpUpdate)
+	return &TektonRunFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TektonRunFailureClient) UpdateOne(_m *TektonRunFailure) *TektonRunFailureUpdateOne {
+	mutation := newTektonRunFailureMutation(c.config, OpUpdateOne, withTektonRunFailure(_m))
+	return &TektonRunFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TektonRunFailureClient) UpdateOneID(id int) *TektonRunFailureUpdateOne {
+	mutation := newTektonRunFailureMutation(c.config, OpUpdateOne, withTektonRunFailureID(id))
+	return &TektonRunFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TektonRunFailure.
+func (c *TektonRunFailureClient) Delete() *TektonRunFailureDelete {
+	mutation := newTektonRunFailureMutation(c.config, OpDelete)
+	return &TektonRunFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TektonRunFailureClient) DeleteOne(_m *TektonRunFailure) *TektonRunFailureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TektonRunFailureClient) DeleteOneID(id int) *TektonRunFailureDeleteOne {
+	builder := c.Delete().Where(tektonrunfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TektonRunFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for TektonRunFailure.
+func (c *TektonRunFailureClient) Query() *TektonRunFailureQuery {
+	return &TektonRunFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTektonRunFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TektonRunFailure entity by its id.
+func (c *TektonRunFailureClient) Get(ctx context.Context, id int) (*TektonRunFailure, error) {
+	return c.Query().Where(tektonrunfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TektonRunFailureClient) GetX(ctx context.Context, id int) *TektonRunFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TektonRunFailureClient) Hooks() []Hook {
+	return c.hooks.TektonRunFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *TektonRunFailureClient) Interceptors() []Interceptor {
+	return c.inters.TektonRunFailure
+}
+
+func (c *TektonRunFailureClient) mutate(ctx context.Context, m *TektonRunFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TektonRunFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TektonRunFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TektonRunFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TektonRunFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TektonRunFailure mutation op: %q", m.Op())
+	}
+}
+
+// TektonSubscriptionClient is a client for the TektonSubscription schema.
+type TektonSubscriptionClient struct {
+	config
+}
+
+// NewTektonSubscriptionClient returns a client for the TektonSubscription from the given config.
+func NewTektonSubscriptionClient(c config) *TektonSubscriptionClient {
+	return &TektonSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tektonsubscription.Hooks(f(g(h())))`.
+func (c *TektonSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.TektonSubscription = append(c.hooks.TektonSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tektonsubscription.Intercept(f(g(h())))`.
+func (c *TektonSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TektonSubscription = append(c.inters.TektonSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a TektonSubscription entity.
+func (c *TektonSubscriptionClient) Create() *TektonSubscriptionCreate {
+	mutation := newTektonSubscriptionMutation(c.config, OpCreate)
+	return &TektonSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TektonSubscription entities.
+func (c *TektonSubscriptionClient) CreateBulk(builders ...*TektonSubscriptionCreate) *TektonSubscriptionCreateBulk {
+	return &TektonSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TektonSubscriptionClient) MapCreateBulk(slice any, setFunc func(*TektonSubscriptionCreate, int)) *TektonSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TektonSubscriptionCreateBulk{err: fmt.Errorf("calling to TektonSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TektonSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TektonSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TektonSubscription.
+func (c *TektonSubscriptionClient) Update() *TektonSubscriptionUpdate {
+	mutation := newTektonSubscriptionMutation(c.config, OpUpdate)
+	return &TektonSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TektonSubscriptionClient) UpdateOne(_m *TektonSubscription) *TektonSubscriptionUpdateOne {
+	mutation := newTektonSubscriptionMutation(c.config, OpUpdateOne, withTektonSubscription(_m))
+	return &TektonSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TektonSubscriptionClient) UpdateOneID(id int) *TektonSubscriptionUpdateOne {
+	mutation := newTektonSubscriptionMutation(c.config, OpUpdateOne, withTektonSubscriptionID(id))
+	return &TektonSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TektonSubscription.
+func (c *TektonSubscriptionClient) Delete() *TektonSubscriptionDelete {
+	mutation := newTektonSubscriptionMutation(c.config, OpDelete)
+	return &TektonSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TektonSubscriptionClient) DeleteOne(_m *TektonSubscription) *TektonSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TektonSubscriptionClient) DeleteOneID(id int) *TektonSubscriptionDeleteOne {
+	builder := c.Delete().Where(tektonsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TektonSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for TektonSubscription.
+func (c *TektonSubscriptionClient) Query() *TektonSubscriptionQuery {
+	return &TektonSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTektonSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TektonSubscription entity by its id.
+func (c *TektonSubscriptionClient) Get(ctx context.Context, id int) (*TektonSubscription, error) {
+	return c.Query().Where(tektonsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TektonSubscriptionClient) GetX(ctx context.Context, id int) *TektonSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TektonSubscriptionClient) Hooks() []Hook {
+	return c.hooks.TektonSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *TektonSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.TektonSubscription
+}
+
+func (c *TektonSubscriptionClient) mutate(ctx context.Context, m *TektonSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TektonSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TektonSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TektonSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TektonSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TektonSubscription mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ProblemCaseRun []ent.Hook
+		ArchivedEvent, LarkRunCard, ProblemCaseIssue, ProblemCaseRun, TektonRun,
+		TektonRunFailure, TektonSubscription []ent.Hook
 	}
 	inters struct {
-		ProblemCaseRun []ent.Interceptor
+		ArchivedEvent, LarkRunCard, ProblemCaseIssue, ProblemCaseRun, TektonRun,
+		TektonRunFailure, TektonSubscription []ent.Interceptor
 	}
 )
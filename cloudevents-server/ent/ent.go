@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -70,13 +76,19 @@ var (
 )
 
 // checkColumn checks if the column exists in the given table.
-func checkColumn(table, column string) error {
+func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			problemcaserun.Table: problemcaserun.ValidColumn,
+			archivedevent.Table:      archivedevent.ValidColumn,
+			larkruncard.Table:        larkruncard.ValidColumn,
+			problemcaseissue.Table:   problemcaseissue.ValidColumn,
+			problemcaserun.Table:     problemcaserun.ValidColumn,
+			tektonrun.Table:          tektonrun.ValidColumn,
+			tektonrunfailure.Table:   tektonrunfailure.ValidColumn,
+			tektonsubscription.Table: tektonsubscription.ValidColumn,
 		})
 	})
-	return columnCheck(table, column)
+	return columnCheck(t, c)
 }
 
 // Asc applies the given fields in ASC order.
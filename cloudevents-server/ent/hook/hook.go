@@ -9,6 +9,42 @@ import (
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
 )
 
+// The ArchivedEventFunc type is an adapter to allow the use of ordinary
+// function as ArchivedEvent mutator.
+type ArchivedEventFunc func(context.Context, *ent.ArchivedEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchivedEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArchivedEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchivedEventMutation", m)
+}
+
+// The LarkRunCardFunc type is an adapter to allow the use of ordinary
+// function as LarkRunCard mutator.
+type LarkRunCardFunc func(context.Context, *ent.LarkRunCardMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LarkRunCardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LarkRunCardMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LarkRunCardMutation", m)
+}
+
+// The ProblemCaseIssueFunc type is an adapter to allow the use of ordinary
+// function as ProblemCaseIssue mutator.
+type ProblemCaseIssueFunc func(context.Context, *ent.ProblemCaseIssueMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProblemCaseIssueFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProblemCaseIssueMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProblemCaseIssueMutation", m)
+}
+
 // The ProblemCaseRunFunc type is an adapter to allow the use of ordinary
 // function as ProblemCaseRun mutator.
 type ProblemCaseRunFunc func(context.Context, *ent.ProblemCaseRunMutation) (ent.Value, error)
@@ -21,6 +57,42 @@ func (f ProblemCaseRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProblemCaseRunMutation", m)
 }
 
+// The TektonRunFunc type is an adapter to allow the use of ordinary
+// function as TektonRun mutator.
+type TektonRunFunc func(context.Context, *ent.TektonRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TektonRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TektonRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TektonRunMutation", m)
+}
+
+// The TektonRunFailureFunc type is an adapter to allow the use of ordinary
+// function as TektonRunFailure mutator.
+type TektonRunFailureFunc func(context.Context, *ent.TektonRunFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TektonRunFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TektonRunFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TektonRunFailureMutation", m)
+}
+
+// The TektonSubscriptionFunc type is an adapter to allow the use of ordinary
+// function as TektonSubscription mutator.
+type TektonSubscriptionFunc func(context.Context, *ent.TektonSubscriptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TektonSubscriptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TektonSubscriptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TektonSubscriptionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
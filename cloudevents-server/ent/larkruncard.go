@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+)
+
+// LarkRunCard is the model entity for the LarkRunCard schema.
+type LarkRunCard struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// uid of the tekton run
+	RunUID string `json:"run_uid,omitempty"`
+	// lark receiver of the card
+	Receiver string `json:"receiver,omitempty"`
+	// lark message id of the card
+	MessageID string `json:"message_id,omitempty"`
+	// type of the last event updated the card
+	EventType string `json:"event_type,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LarkRunCard) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case larkruncard.FieldID:
+			values[i] = new(sql.NullInt64)
+		case larkruncard.FieldRunUID, larkruncard.FieldReceiver, larkruncard.FieldMessageID, larkruncard.FieldEventType:
+			values[i] = new(sql.NullString)
+		case larkruncard.FieldCreatedAt, larkruncard.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LarkRunCard fields.
+func (_m *LarkRunCard) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case larkruncard.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case larkruncard.FieldRunUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_uid", values[i])
+			} else if value.Valid {
+				_m.RunUID = value.String
+			}
+		case larkruncard.FieldReceiver:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receiver", values[i])
+			} else if value.Valid {
+				_m.Receiver = value.String
+			}
+		case larkruncard.FieldMessageID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = value.String
+			}
+		case larkruncard.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				_m.EventType = value.String
+			}
+		case larkruncard.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case larkruncard.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LarkRunCard.
+// This includes values selected through modifiers, order, etc.
+func (_m *LarkRunCard) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LarkRunCard.
+// Note that you need to call LarkRunCard.Unwrap() before calling this method if this LarkRunCard
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LarkRunCard) Update() *LarkRunCardUpdateOne {
+	return NewLarkRunCardClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LarkRunCard entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LarkRunCard) Unwrap() *LarkRunCard {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LarkRunCard is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LarkRunCard) String() string {
+	var builder strings.Builder
+	builder.WriteString("LarkRunCard(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_uid=")
+	builder.WriteString(_m.RunUID)
+	builder.WriteString(", ")
+	builder.WriteString("receiver=")
+	builder.WriteString(_m.Receiver)
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(_m.MessageID)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(_m.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LarkRunCards is a parsable slice of LarkRunCard.
+type LarkRunCards []*LarkRunCard
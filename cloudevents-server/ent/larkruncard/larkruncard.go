@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package larkruncard
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the larkruncard type in the database.
+	Label = "lark_run_card"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunUID holds the string denoting the run_uid field in the database.
+	FieldRunUID = "run_uid"
+	// FieldReceiver holds the string denoting the receiver field in the database.
+	FieldReceiver = "receiver"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldEventType holds the string denoting the event_type field in the database.
+	FieldEventType = "event_type"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the larkruncard in the database.
+	Table = "lark_run_cards"
+)
+
+// Columns holds all SQL columns for larkruncard fields.
+var Columns = []string{
+	FieldID,
+	FieldRunUID,
+	FieldReceiver,
+	FieldMessageID,
+	FieldEventType,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the LarkRunCard queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunUID orders the results by the run_uid field.
+func ByRunUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunUID, opts...).ToFunc()
+}
+
+// ByReceiver orders the results by the receiver field.
+func ByReceiver(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiver, opts...).ToFunc()
+}
+
+// ByMessageID orders the results by the message_id field.
+func ByMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageID, opts...).ToFunc()
+}
+
+// ByEventType orders the results by the event_type field.
+func ByEventType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventType, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package larkruncard
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldID, id))
+}
+
+// RunUID applies equality check predicate on the "run_uid" field. It's identical to RunUIDEQ.
+func RunUID(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldRunUID, v))
+}
+
+// Receiver applies equality check predicate on the "receiver" field. It's identical to ReceiverEQ.
+func Receiver(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldReceiver, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldMessageID, v))
+}
+
+// EventType applies equality check predicate on the "event_type" field. It's identical to EventTypeEQ.
+func EventType(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldEventType, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RunUIDEQ applies the EQ predicate on the "run_uid" field.
+func RunUIDEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldRunUID, v))
+}
+
+// RunUIDNEQ applies the NEQ predicate on the "run_uid" field.
+func RunUIDNEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldRunUID, v))
+}
+
+// RunUIDIn applies the In predicate on the "run_uid" field.
+func RunUIDIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldRunUID, vs...))
+}
+
+// RunUIDNotIn applies the NotIn predicate on the "run_uid" field.
+func RunUIDNotIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldRunUID, vs...))
+}
+
+// RunUIDGT applies the GT predicate on the "run_uid" field.
+func RunUIDGT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldRunUID, v))
+}
+
+// RunUIDGTE applies the GTE predicate on the "run_uid" field.
+func RunUIDGTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldRunUID, v))
+}
+
+// RunUIDLT applies the LT predicate on the "run_uid" field.
+func RunUIDLT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldRunUID, v))
+}
+
+// RunUIDLTE applies the LTE predicate on the "run_uid" field.
+func RunUIDLTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldRunUID, v))
+}
+
+// RunUIDContains applies the Contains predicate on the "run_uid" field.
+func RunUIDContains(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContains(FieldRunUID, v))
+}
+
+// RunUIDHasPrefix applies the HasPrefix predicate on the "run_uid" field.
+func RunUIDHasPrefix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasPrefix(FieldRunUID, v))
+}
+
+// RunUIDHasSuffix applies the HasSuffix predicate on the "run_uid" field.
+func RunUIDHasSuffix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasSuffix(FieldRunUID, v))
+}
+
+// RunUIDEqualFold applies the EqualFold predicate on the "run_uid" field.
+func RunUIDEqualFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEqualFold(FieldRunUID, v))
+}
+
+// RunUIDContainsFold applies the ContainsFold predicate on the "run_uid" field.
+func RunUIDContainsFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContainsFold(FieldRunUID, v))
+}
+
+// ReceiverEQ applies the EQ predicate on the "receiver" field.
+func ReceiverEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldReceiver, v))
+}
+
+// ReceiverNEQ applies the NEQ predicate on the "receiver" field.
+func ReceiverNEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldReceiver, v))
+}
+
+// ReceiverIn applies the In predicate on the "receiver" field.
+func ReceiverIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldReceiver, vs...))
+}
+
+// ReceiverNotIn applies the NotIn predicate on the "receiver" field.
+func ReceiverNotIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldReceiver, vs...))
+}
+
+// ReceiverGT applies the GT predicate on the "receiver" field.
+func ReceiverGT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldReceiver, v))
+}
+
+// ReceiverGTE applies the GTE predicate on the "receiver" field.
+func ReceiverGTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldReceiver, v))
+}
+
+// ReceiverLT applies the LT predicate on the "receiver" field.
+func ReceiverLT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldReceiver, v))
+}
+
+// ReceiverLTE applies the LTE predicate on the "receiver" field.
+func ReceiverLTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldReceiver, v))
+}
+
+// ReceiverContains applies the Contains predicate on the "receiver" field.
+func ReceiverContains(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContains(FieldReceiver, v))
+}
+
+// ReceiverHasPrefix applies the HasPrefix predicate on the "receiver" field.
+func ReceiverHasPrefix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasPrefix(FieldReceiver, v))
+}
+
+// ReceiverHasSuffix applies the HasSuffix predicate on the "receiver" field.
+func ReceiverHasSuffix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasSuffix(FieldReceiver, v))
+}
+
+// ReceiverEqualFold applies the EqualFold predicate on the "receiver" field.
+func ReceiverEqualFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEqualFold(FieldReceiver, v))
+}
+
+// ReceiverContainsFold applies the ContainsFold predicate on the "receiver" field.
+func ReceiverContainsFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContainsFold(FieldReceiver, v))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDContains applies the Contains predicate on the "message_id" field.
+func MessageIDContains(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContains(FieldMessageID, v))
+}
+
+// MessageIDHasPrefix applies the HasPrefix predicate on the "message_id" field.
+func MessageIDHasPrefix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasPrefix(FieldMessageID, v))
+}
+
+// MessageIDHasSuffix applies the HasSuffix predicate on the "message_id" field.
+func MessageIDHasSuffix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasSuffix(FieldMessageID, v))
+}
+
+// MessageIDEqualFold applies the EqualFold predicate on the "message_id" field.
+func MessageIDEqualFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEqualFold(FieldMessageID, v))
+}
+
+// MessageIDContainsFold applies the ContainsFold predicate on the "message_id" field.
+func MessageIDContainsFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContainsFold(FieldMessageID, v))
+}
+
+// EventTypeEQ applies the EQ predicate on the "event_type" field.
+func EventTypeEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldEventType, v))
+}
+
+// EventTypeNEQ applies the NEQ predicate on the "event_type" field.
+func EventTypeNEQ(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldEventType, v))
+}
+
+// EventTypeIn applies the In predicate on the "event_type" field.
+func EventTypeIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldEventType, vs...))
+}
+
+// EventTypeNotIn applies the NotIn predicate on the "event_type" field.
+func EventTypeNotIn(vs ...string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldEventType, vs...))
+}
+
+// EventTypeGT applies the GT predicate on the "event_type" field.
+func EventTypeGT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldEventType, v))
+}
+
+// EventTypeGTE applies the GTE predicate on the "event_type" field.
+func EventTypeGTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldEventType, v))
+}
+
+// EventTypeLT applies the LT predicate on the "event_type" field.
+func EventTypeLT(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldEventType, v))
+}
+
+// EventTypeLTE applies the LTE predicate on the "event_type" field.
+func EventTypeLTE(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldEventType, v))
+}
+
+// EventTypeContains applies the Contains predicate on the "event_type" field.
+func EventTypeContains(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContains(FieldEventType, v))
+}
+
+// EventTypeHasPrefix applies the HasPrefix predicate on the "event_type" field.
+func EventTypeHasPrefix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasPrefix(FieldEventType, v))
+}
+
+// EventTypeHasSuffix applies the HasSuffix predicate on the "event_type" field.
+func EventTypeHasSuffix(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldHasSuffix(FieldEventType, v))
+}
+
+// EventTypeEqualFold applies the EqualFold predicate on the "event_type" field.
+func EventTypeEqualFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEqualFold(FieldEventType, v))
+}
+
+// EventTypeContainsFold applies the ContainsFold predicate on the "event_type" field.
+func EventTypeContainsFold(v string) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldContainsFold(FieldEventType, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LarkRunCard) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LarkRunCard) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LarkRunCard) predicate.LarkRunCard {
+	return predicate.LarkRunCard(sql.NotPredicates(p))
+}
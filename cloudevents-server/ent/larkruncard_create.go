@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+)
+
+// LarkRunCardCreate is the builder for creating a LarkRunCard entity.
+type LarkRunCardCreate struct {
+	config
+	mutation *LarkRunCardMutation
+	hooks    []Hook
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_c *LarkRunCardCreate) SetRunUID(v string) *LarkRunCardCreate {
+	_c.mutation.SetRunUID(v)
+	return _c
+}
+
+// SetReceiver sets the "receiver" field.
+func (_c *LarkRunCardCreate) SetReceiver(v string) *LarkRunCardCreate {
+	_c.mutation.SetReceiver(v)
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *LarkRunCardCreate) SetMessageID(v string) *LarkRunCardCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetEventType sets the "event_type" field.
+func (_c *LarkRunCardCreate) SetEventType(v string) *LarkRunCardCreate {
+	_c.mutation.SetEventType(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LarkRunCardCreate) SetCreatedAt(v time.Time) *LarkRunCardCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LarkRunCardCreate) SetNillableCreatedAt(v *time.Time) *LarkRunCardCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LarkRunCardCreate) SetUpdatedAt(v time.Time) *LarkRunCardCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LarkRunCardCreate) SetNillableUpdatedAt(v *time.Time) *LarkRunCardCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LarkRunCardMutation object of the builder.
+func (_c *LarkRunCardCreate) Mutation() *LarkRunCardMutation {
+	return _c.mutation
+}
+
+// Save creates the LarkRunCard in the database.
+func (_c *LarkRunCardCreate) Save(ctx context.Context) (*LarkRunCard, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LarkRunCardCreate) SaveX(ctx context.Context) *LarkRunCard {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LarkRunCardCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LarkRunCardCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LarkRunCardCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := larkruncard.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := larkruncard.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LarkRunCardCreate) check() error {
+	if _, ok := _c.mutation.RunUID(); !ok {
+		return &ValidationError{Name: "run_uid", err: errors.New(`ent: missing required field "LarkRunCard.run_uid"`)}
+	}
+	if _, ok := _c.mutation.Receiver(); !ok {
+		return &ValidationError{Name: "receiver", err: errors.New(`ent: missing required field "LarkRunCard.receiver"`)}
+	}
+	if _, ok := _c.mutation.MessageID(); !ok {
+		return &ValidationError{Name: "message_id", err: errors.New(`ent: missing required field "LarkRunCard.message_id"`)}
+	}
+	if _, ok := _c.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "LarkRunCard.event_type"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LarkRunCard.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LarkRunCard.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *LarkRunCardCreate) sqlSave(ctx context.Context) (*LarkRunCard, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LarkRunCardCreate) createSpec() (*LarkRunCard, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LarkRunCard{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(larkruncard.Table, sqlgraph.NewFieldSpec(larkruncard.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RunUID(); ok {
+		_spec.SetField(larkruncard.FieldRunUID, field.TypeString, value)
+		_node.RunUID = value
+	}
+	if value, ok := _c.mutation.Receiver(); ok {
+		_spec.SetField(larkruncard.FieldReceiver, field.TypeString, value)
+		_node.Receiver = value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(larkruncard.FieldMessageID, field.TypeString, value)
+		_node.MessageID = value
+	}
+	if value, ok := _c.mutation.EventType(); ok {
+		_spec.SetField(larkruncard.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(larkruncard.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(larkruncard.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// LarkRunCardCreateBulk is the builder for creating many LarkRunCard entities in bulk.
+type LarkRunCardCreateBulk struct {
+	config
+	err      error
+	builders []*LarkRunCardCreate
+}
+
+// Save creates the LarkRunCard entities in the database.
+func (_c *LarkRunCardCreateBulk) Save(ctx context.Context) ([]*LarkRunCard, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LarkRunCard, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LarkRunCardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LarkRunCardCreateBulk) SaveX(ctx context.Context) []*LarkRunCard {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LarkRunCardCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LarkRunCardCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
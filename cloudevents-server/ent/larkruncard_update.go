@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// LarkRunCardUpdate is the builder for updating LarkRunCard entities.
+type LarkRunCardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LarkRunCardMutation
+}
+
+// Where appends a list predicates to the LarkRunCardUpdate builder.
+func (_u *LarkRunCardUpdate) Where(ps ...predicate.LarkRunCard) *LarkRunCardUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *LarkRunCardUpdate) SetRunUID(v string) *LarkRunCardUpdate {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *LarkRunCardUpdate) SetNillableRunUID(v *string) *LarkRunCardUpdate {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetReceiver sets the "receiver" field.
+func (_u *LarkRunCardUpdate) SetReceiver(v string) *LarkRunCardUpdate {
+	_u.mutation.SetReceiver(v)
+	return _u
+}
+
+// SetNillableReceiver sets the "receiver" field if the given value is not nil.
+func (_u *LarkRunCardUpdate) SetNillableReceiver(v *string) *LarkRunCardUpdate {
+	if v != nil {
+		_u.SetReceiver(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *LarkRunCardUpdate) SetMessageID(v string) *LarkRunCardUpdate {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *LarkRunCardUpdate) SetNillableMessageID(v *string) *LarkRunCardUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *LarkRunCardUpdate) SetEventType(v string) *LarkRunCardUpdate {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *LarkRunCardUpdate) SetNillableEventType(v *string) *LarkRunCardUpdate {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LarkRunCardUpdate) SetUpdatedAt(v time.Time) *LarkRunCardUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LarkRunCardMutation object of the builder.
+func (_u *LarkRunCardUpdate) Mutation() *LarkRunCardMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LarkRunCardUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LarkRunCardUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LarkRunCardUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LarkRunCardUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LarkRunCardUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := larkruncard.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *LarkRunCardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(larkruncard.Table, larkruncard.Columns, sqlgraph.NewFieldSpec(larkruncard.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(larkruncard.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Receiver(); ok {
+		_spec.SetField(larkruncard.FieldReceiver, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(larkruncard.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(larkruncard.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(larkruncard.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{larkruncard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LarkRunCardUpdateOne is the builder for updating a single LarkRunCard entity.
+type LarkRunCardUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LarkRunCardMutation
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *LarkRunCardUpdateOne) SetRunUID(v string) *LarkRunCardUpdateOne {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *LarkRunCardUpdateOne) SetNillableRunUID(v *string) *LarkRunCardUpdateOne {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetReceiver sets the "receiver" field.
+func (_u *LarkRunCardUpdateOne) SetReceiver(v string) *LarkRunCardUpdateOne {
+	_u.mutation.SetReceiver(v)
+	return _u
+}
+
+// SetNillableReceiver sets the "receiver" field if the given value is not nil.
+func (_u *LarkRunCardUpdateOne) SetNillableReceiver(v *string) *LarkRunCardUpdateOne {
+	if v != nil {
+		_u.SetReceiver(*v)
+	}
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *LarkRunCardUpdateOne) SetMessageID(v string) *LarkRunCardUpdateOne {
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *LarkRunCardUpdateOne) SetNillableMessageID(v *string) *LarkRunCardUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// SetEventType sets the "event_type" field.
+func (_u *LarkRunCardUpdateOne) SetEventType(v string) *LarkRunCardUpdateOne {
+	_u.mutation.SetEventType(v)
+	return _u
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (_u *LarkRunCardUpdateOne) SetNillableEventType(v *string) *LarkRunCardUpdateOne {
+	if v != nil {
+		_u.SetEventType(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LarkRunCardUpdateOne) SetUpdatedAt(v time.Time) *LarkRunCardUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LarkRunCardMutation object of the builder.
+func (_u *LarkRunCardUpdateOne) Mutation() *LarkRunCardMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LarkRunCardUpdate builder.
+func (_u *LarkRunCardUpdateOne) Where(ps ...predicate.LarkRunCard) *LarkRunCardUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LarkRunCardUpdateOne) Select(field string, fields ...string) *LarkRunCardUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LarkRunCard entity.
+func (_u *LarkRunCardUpdateOne) Save(ctx context.Context) (*LarkRunCard, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LarkRunCardUpdateOne) SaveX(ctx context.Context) *LarkRunCard {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LarkRunCardUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LarkRunCardUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LarkRunCardUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := larkruncard.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *LarkRunCardUpdateOne) sqlSave(ctx context.Context) (_node *LarkRunCard, err error) {
+	_spec := sqlgraph.NewUpdateSpec(larkruncard.Table, larkruncard.Columns, sqlgraph.NewFieldSpec(larkruncard.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LarkRunCard.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, larkruncard.FieldID)
+		for _, f := range fields {
+			if !larkruncard.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != larkruncard.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(larkruncard.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Receiver(); ok {
+		_spec.SetField(larkruncard.FieldReceiver, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(larkruncard.FieldMessageID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventType(); ok {
+		_spec.SetField(larkruncard.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(larkruncard.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &LarkRunCard{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{larkruncard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,97 @@ import (
 )
 
 var (
+	// ArchivedEventsColumns holds the columns for the "archived_events" table.
+	ArchivedEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeString, Size: 255},
+		{Name: "type", Type: field.TypeString, Size: 255},
+		{Name: "source", Type: field.TypeString, Size: 1024},
+		{Name: "subject", Type: field.TypeString, Size: 1024, Default: ""},
+		{Name: "time", Type: field.TypeTime},
+		{Name: "topic", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "data_content_type", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "extensions", Type: field.TypeJSON, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes, Nullable: true},
+		{Name: "truncated", Type: field.TypeBool, Default: false},
+		{Name: "received_at", Type: field.TypeTime},
+	}
+	// ArchivedEventsTable holds the schema information for the "archived_events" table.
+	ArchivedEventsTable = &schema.Table{
+		Name:       "archived_events",
+		Columns:    ArchivedEventsColumns,
+		PrimaryKey: []*schema.Column{ArchivedEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivedevent_event_id",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[1]},
+			},
+			{
+				Name:    "archivedevent_type_time",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[2], ArchivedEventsColumns[5]},
+			},
+			{
+				Name:    "archivedevent_time",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[5]},
+			},
+			{
+				Name:    "archivedevent_received_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArchivedEventsColumns[11]},
+			},
+		},
+	}
+	// LarkRunCardsColumns holds the columns for the "lark_run_cards" table.
+	LarkRunCardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "run_uid", Type: field.TypeString},
+		{Name: "receiver", Type: field.TypeString},
+		{Name: "message_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// LarkRunCardsTable holds the schema information for the "lark_run_cards" table.
+	LarkRunCardsTable = &schema.Table{
+		Name:       "lark_run_cards",
+		Columns:    LarkRunCardsColumns,
+		PrimaryKey: []*schema.Column{LarkRunCardsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "larkruncard_run_uid_receiver",
+				Unique:  true,
+				Columns: []*schema.Column{LarkRunCardsColumns[1], LarkRunCardsColumns[2]},
+			},
+		},
+	}
+	// ProblemCaseIssuesColumns holds the columns for the "problem_case_issues" table.
+	ProblemCaseIssuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "repo", Type: field.TypeString},
+		{Name: "suite_name", Type: field.TypeString},
+		{Name: "case_name", Type: field.TypeString},
+		{Name: "issue_repo", Type: field.TypeString},
+		{Name: "issue_number", Type: field.TypeInt},
+		{Name: "issue_url", Type: field.TypeString, Size: 1024},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProblemCaseIssuesTable holds the schema information for the "problem_case_issues" table.
+	ProblemCaseIssuesTable = &schema.Table{
+		Name:       "problem_case_issues",
+		Columns:    ProblemCaseIssuesColumns,
+		PrimaryKey: []*schema.Column{ProblemCaseIssuesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "problemcaseissue_repo_suite_name_case_name",
+				Unique:  true,
+				Columns: []*schema.Column{ProblemCaseIssuesColumns[1], ProblemCaseIssuesColumns[2], ProblemCaseIssuesColumns[3]},
+			},
+		},
+	}
 	// ProblemCaseRunsColumns holds the columns for the "problem_case_runs" table.
 	ProblemCaseRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -20,16 +111,140 @@ var (
 		{Name: "report_time", Type: field.TypeTime},
 		{Name: "build_url", Type: field.TypeString, Size: 1024},
 		{Name: "reason", Type: field.TypeString, Size: 32},
+		{Name: "status", Type: field.TypeString, Default: ""},
 	}
 	// ProblemCaseRunsTable holds the schema information for the "problem_case_runs" table.
 	ProblemCaseRunsTable = &schema.Table{
 		Name:       "problem_case_runs",
 		Columns:    ProblemCaseRunsColumns,
 		PrimaryKey: []*schema.Column{ProblemCaseRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "problemcaserun_repo_branch_report_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProblemCaseRunsColumns[1], ProblemCaseRunsColumns[2], ProblemCaseRunsColumns[7]},
+			},
+			{
+				Name:    "problemcaserun_repo_suite_name_case_name_report_time",
+				Unique:  false,
+				Columns: []*schema.Column{ProblemCaseRunsColumns[1], ProblemCaseRunsColumns[3], ProblemCaseRunsColumns[4], ProblemCaseRunsColumns[7]},
+			},
+		},
+	}
+	// TektonRunsColumns holds the columns for the "tekton_runs" table.
+	TektonRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "run_uid", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "pipeline", Type: field.TypeString, Default: ""},
+		{Name: "pipeline_run", Type: field.TypeString, Default: ""},
+		{Name: "trigger_user", Type: field.TypeString, Default: ""},
+		{Name: "params", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "failed_tasks", Type: field.TypeJSON, Nullable: true},
+		{Name: "start_time", Type: field.TypeTime, Nullable: true},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TektonRunsTable holds the schema information for the "tekton_runs" table.
+	TektonRunsTable = &schema.Table{
+		Name:       "tekton_runs",
+		Columns:    TektonRunsColumns,
+		PrimaryKey: []*schema.Column{TektonRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tektonrun_run_uid",
+				Unique:  true,
+				Columns: []*schema.Column{TektonRunsColumns[1]},
+			},
+			{
+				Name:    "tektonrun_kind_namespace_pipeline_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{TektonRunsColumns[2], TektonRunsColumns[3], TektonRunsColumns[5], TektonRunsColumns[12]},
+			},
+			{
+				Name:    "tektonrun_kind_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{TektonRunsColumns[2], TektonRunsColumns[12]},
+			},
+		},
+	}
+	// TektonRunFailuresColumns holds the columns for the "tekton_run_failures" table.
+	TektonRunFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "run_uid", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "pipeline", Type: field.TypeString, Default: ""},
+		{Name: "category", Type: field.TypeString},
+		{Name: "task", Type: field.TypeString, Default: ""},
+		{Name: "step", Type: field.TypeString, Default: ""},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "failed_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TektonRunFailuresTable holds the schema information for the "tekton_run_failures" table.
+	TektonRunFailuresTable = &schema.Table{
+		Name:       "tekton_run_failures",
+		Columns:    TektonRunFailuresColumns,
+		PrimaryKey: []*schema.Column{TektonRunFailuresColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tektonrunfailure_run_uid",
+				Unique:  true,
+				Columns: []*schema.Column{TektonRunFailuresColumns[1]},
+			},
+			{
+				Name:    "tektonrunfailure_category_failed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TektonRunFailuresColumns[6], TektonRunFailuresColumns[10]},
+			},
+			{
+				Name:    "tektonrunfailure_namespace_pipeline_failed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TektonRunFailuresColumns[3], TektonRunFailuresColumns[5], TektonRunFailuresColumns[10]},
+			},
+		},
+	}
+	// TektonSubscriptionsColumns holds the columns for the "tekton_subscriptions" table.
+	TektonSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "receiver", Type: field.TypeString},
+		{Name: "namespace", Type: field.TypeString, Default: ""},
+		{Name: "pipeline", Type: field.TypeString, Default: ""},
+		{Name: "label_selector", Type: field.TypeString, Default: ""},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TektonSubscriptionsTable holds the schema information for the "tekton_subscriptions" table.
+	TektonSubscriptionsTable = &schema.Table{
+		Name:       "tekton_subscriptions",
+		Columns:    TektonSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{TektonSubscriptionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tektonsubscription_receiver",
+				Unique:  false,
+				Columns: []*schema.Column{TektonSubscriptionsColumns[1]},
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ArchivedEventsTable,
+		LarkRunCardsTable,
+		ProblemCaseIssuesTable,
 		ProblemCaseRunsTable,
+		TektonRunsTable,
+		TektonRunFailuresTable,
+		TektonSubscriptionsTable,
 	}
 )
 
@@ -11,8 +11,14 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
 )
 
 const (
@@ -24,42 +30,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProblemCaseRun = "ProblemCaseRun"
+	TypeArchivedEvent      = "ArchivedEvent"
+	TypeLarkRunCard        = "LarkRunCard"
+	TypeProblemCaseIssue   = "ProblemCaseIssue"
+	TypeProblemCaseRun     = "ProblemCaseRun"
+	TypeTektonRun          = "TektonRun"
+	TypeTektonRunFailure   = "TektonRunFailure"
+	TypeTektonSubscription = "TektonSubscription"
 )
 
-// ProblemCaseRunMutation represents an operation that mutates the ProblemCaseRun nodes in the graph.
-type ProblemCaseRunMutation struct {
+// ArchivedEventMutation represents an operation that mutates the ArchivedEvent nodes in the graph.
+type ArchivedEventMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	repo           *string
-	branch         *string
-	suite_name     *string
-	case_name      *string
-	flaky          *bool
-	timecost_ms    *int
-	addtimecost_ms *int
-	report_time    *time.Time
-	build_url      *string
-	reason         *string
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*ProblemCaseRun, error)
-	predicates     []predicate.ProblemCaseRun
+	op                Op
+	typ               string
+	id                *int
+	event_id          *string
+	_type             *string
+	source            *string
+	subject           *string
+	time              *time.Time
+	topic             *string
+	data_content_type *string
+	extensions        *map[string]string
+	payload           *[]byte
+	truncated         *bool
+	received_at       *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*ArchivedEvent, error)
+	predicates        []predicate.ArchivedEvent
 }
 
-var _ ent.Mutation = (*ProblemCaseRunMutation)(nil)
+var _ ent.Mutation = (*ArchivedEventMutation)(nil)
 
-// problemcaserunOption allows management of the mutation configuration using functional options.
-type problemcaserunOption func(*ProblemCaseRunMutation)
+// archivedeventOption allows management of the mutation configuration using functional options.
+type archivedeventOption func(*ArchivedEventMutation)
 
-// newProblemCaseRunMutation creates new mutation for the ProblemCaseRun entity.
-func newProblemCaseRunMutation(c config, op Op, opts ...problemcaserunOption) *ProblemCaseRunMutation {
-	m := &ProblemCaseRunMutation{
+// newArchivedEventMutation creates new mutation for the ArchivedEvent entity.
+func newArchivedEventMutation(c config, op Op, opts ...archivedeventOption) *ArchivedEventMutation {
+	m := &ArchivedEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblemCaseRun,
+		typ:           TypeArchivedEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -68,20 +81,20 @@ func newProblemCaseRunMutation(c config, op Op, opts ...problemcaserunOption) *P
 	return m
 }
 
-// withProblemCaseRunID sets the ID field of the mutation.
-func withProblemCaseRunID(id int) problemcaserunOption {
-	return func(m *ProblemCaseRunMutation) {
+// withArchivedEventID sets the ID field of the mutation.
+func withArchivedEventID(id int) archivedeventOption {
+	return func(m *ArchivedEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ProblemCaseRun
+			value *ArchivedEvent
 		)
-		m.oldValue = func(ctx context.Context) (*ProblemCaseRun, error) {
+		m.oldValue = func(ctx context.Context) (*ArchivedEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ProblemCaseRun.Get(ctx, id)
+					value, err = m.Client().ArchivedEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -90,10 +103,10 @@ func withProblemCaseRunID(id int) problemcaserunOption {
 	}
 }
 
-// withProblemCaseRun sets the old ProblemCaseRun of the mutation.
-func withProblemCaseRun(node *ProblemCaseRun) problemcaserunOption {
-	return func(m *ProblemCaseRunMutation) {
-		m.oldValue = func(context.Context) (*ProblemCaseRun, error) {
+// withArchivedEvent sets the old ArchivedEvent of the mutation.
+func withArchivedEvent(node *ArchivedEvent) archivedeventOption {
+	return func(m *ArchivedEventMutation) {
+		m.oldValue = func(context.Context) (*ArchivedEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -102,7 +115,7 @@ func withProblemCaseRun(node *ProblemCaseRun) problemcaserunOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemCaseRunMutation) Client() *Client {
+func (m ArchivedEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -110,7 +123,7 @@ func (m ProblemCaseRunMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemCaseRunMutation) Tx() (*Tx, error) {
+func (m ArchivedEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -121,7 +134,7 @@ func (m ProblemCaseRunMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemCaseRunMutation) ID() (id int, exists bool) {
+func (m *ArchivedEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -132,7 +145,7 @@ func (m *ProblemCaseRunMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemCaseRunMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArchivedEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -141,365 +154,443 @@ func (m *ProblemCaseRunMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ProblemCaseRun.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchivedEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRepo sets the "repo" field.
-func (m *ProblemCaseRunMutation) SetRepo(s string) {
-	m.repo = &s
+// SetEventID sets the "event_id" field.
+func (m *ArchivedEventMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// Repo returns the value of the "repo" field in the mutation.
-func (m *ProblemCaseRunMutation) Repo() (r string, exists bool) {
-	v := m.repo
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *ArchivedEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepo returns the old "repo" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldRepo(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepo requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.Repo, nil
+	return oldValue.EventID, nil
 }
 
-// ResetRepo resets all changes to the "repo" field.
-func (m *ProblemCaseRunMutation) ResetRepo() {
-	m.repo = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *ArchivedEventMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetBranch sets the "branch" field.
-func (m *ProblemCaseRunMutation) SetBranch(s string) {
-	m.branch = &s
+// SetType sets the "type" field.
+func (m *ArchivedEventMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Branch returns the value of the "branch" field in the mutation.
-func (m *ProblemCaseRunMutation) Branch() (r string, exists bool) {
-	v := m.branch
+// GetType returns the value of the "type" field in the mutation.
+func (m *ArchivedEventMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBranch returns the old "branch" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldBranch(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBranch is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBranch requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBranch: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Branch, nil
+	return oldValue.Type, nil
 }
 
-// ResetBranch resets all changes to the "branch" field.
-func (m *ProblemCaseRunMutation) ResetBranch() {
-	m.branch = nil
+// ResetType resets all changes to the "type" field.
+func (m *ArchivedEventMutation) ResetType() {
+	m._type = nil
 }
 
-// SetSuiteName sets the "suite_name" field.
-func (m *ProblemCaseRunMutation) SetSuiteName(s string) {
-	m.suite_name = &s
+// SetSource sets the "source" field.
+func (m *ArchivedEventMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// SuiteName returns the value of the "suite_name" field in the mutation.
-func (m *ProblemCaseRunMutation) SuiteName() (r string, exists bool) {
-	v := m.suite_name
+// Source returns the value of the "source" field in the mutation.
+func (m *ArchivedEventMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuiteName returns the old "suite_name" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldSuiteName(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuiteName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuiteName requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuiteName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.SuiteName, nil
+	return oldValue.Source, nil
 }
 
-// ResetSuiteName resets all changes to the "suite_name" field.
-func (m *ProblemCaseRunMutation) ResetSuiteName() {
-	m.suite_name = nil
+// ResetSource resets all changes to the "source" field.
+func (m *ArchivedEventMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetCaseName sets the "case_name" field.
-func (m *ProblemCaseRunMutation) SetCaseName(s string) {
-	m.case_name = &s
+// SetSubject sets the "subject" field.
+func (m *ArchivedEventMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// CaseName returns the value of the "case_name" field in the mutation.
-func (m *ProblemCaseRunMutation) CaseName() (r string, exists bool) {
-	v := m.case_name
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ArchivedEventMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaseName returns the old "case_name" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldCaseName(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaseName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaseName requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaseName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.CaseName, nil
+	return oldValue.Subject, nil
 }
 
-// ResetCaseName resets all changes to the "case_name" field.
-func (m *ProblemCaseRunMutation) ResetCaseName() {
-	m.case_name = nil
+// ResetSubject resets all changes to the "subject" field.
+func (m *ArchivedEventMutation) ResetSubject() {
+	m.subject = nil
 }
 
-// SetFlaky sets the "flaky" field.
-func (m *ProblemCaseRunMutation) SetFlaky(b bool) {
-	m.flaky = &b
+// SetTime sets the "time" field.
+func (m *ArchivedEventMutation) SetTime(t time.Time) {
+	m.time = &t
 }
 
-// Flaky returns the value of the "flaky" field in the mutation.
-func (m *ProblemCaseRunMutation) Flaky() (r bool, exists bool) {
-	v := m.flaky
+// Time returns the value of the "time" field in the mutation.
+func (m *ArchivedEventMutation) Time() (r time.Time, exists bool) {
+	v := m.time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlaky returns the old "flaky" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldTime returns the old "time" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldFlaky(ctx context.Context) (v bool, err error) {
+func (m *ArchivedEventMutation) OldTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlaky is only allowed on UpdateOne operations")
+		return v, errors.New("OldTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlaky requires an ID field in the mutation")
+		return v, errors.New("OldTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlaky: %w", err)
+		return v, fmt.Errorf("querying old value for OldTime: %w", err)
 	}
-	return oldValue.Flaky, nil
+	return oldValue.Time, nil
 }
 
-// ResetFlaky resets all changes to the "flaky" field.
-func (m *ProblemCaseRunMutation) ResetFlaky() {
-	m.flaky = nil
+// ResetTime resets all changes to the "time" field.
+func (m *ArchivedEventMutation) ResetTime() {
+	m.time = nil
 }
 
-// SetTimecostMs sets the "timecost_ms" field.
-func (m *ProblemCaseRunMutation) SetTimecostMs(i int) {
-	m.timecost_ms = &i
-	m.addtimecost_ms = nil
+// SetTopic sets the "topic" field.
+func (m *ArchivedEventMutation) SetTopic(s string) {
+	m.topic = &s
 }
 
-// TimecostMs returns the value of the "timecost_ms" field in the mutation.
-func (m *ProblemCaseRunMutation) TimecostMs() (r int, exists bool) {
-	v := m.timecost_ms
+// Topic returns the value of the "topic" field in the mutation.
+func (m *ArchivedEventMutation) Topic() (r string, exists bool) {
+	v := m.topic
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimecostMs returns the old "timecost_ms" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldTopic returns the old "topic" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldTimecostMs(ctx context.Context) (v int, err error) {
+func (m *ArchivedEventMutation) OldTopic(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimecostMs is only allowed on UpdateOne operations")
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimecostMs requires an ID field in the mutation")
+		return v, errors.New("OldTopic requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimecostMs: %w", err)
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
 	}
-	return oldValue.TimecostMs, nil
+	return oldValue.Topic, nil
 }
 
-// AddTimecostMs adds i to the "timecost_ms" field.
-func (m *ProblemCaseRunMutation) AddTimecostMs(i int) {
-	if m.addtimecost_ms != nil {
-		*m.addtimecost_ms += i
-	} else {
-		m.addtimecost_ms = &i
+// ResetTopic resets all changes to the "topic" field.
+func (m *ArchivedEventMutation) ResetTopic() {
+	m.topic = nil
+}
+
+// SetDataContentType sets the "data_content_type" field.
+func (m *ArchivedEventMutation) SetDataContentType(s string) {
+	m.data_content_type = &s
+}
+
+// DataContentType returns the value of the "data_content_type" field in the mutation.
+func (m *ArchivedEventMutation) DataContentType() (r string, exists bool) {
+	v := m.data_content_type
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedTimecostMs returns the value that was added to the "timecost_ms" field in this mutation.
-func (m *ProblemCaseRunMutation) AddedTimecostMs() (r int, exists bool) {
-	v := m.addtimecost_ms
+// OldDataContentType returns the old "data_content_type" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedEventMutation) OldDataContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataContentType: %w", err)
+	}
+	return oldValue.DataContentType, nil
+}
+
+// ResetDataContentType resets all changes to the "data_content_type" field.
+func (m *ArchivedEventMutation) ResetDataContentType() {
+	m.data_content_type = nil
+}
+
+// SetExtensions sets the "extensions" field.
+func (m *ArchivedEventMutation) SetExtensions(value map[string]string) {
+	m.extensions = &value
+}
+
+// Extensions returns the value of the "extensions" field in the mutation.
+func (m *ArchivedEventMutation) Extensions() (r map[string]string, exists bool) {
+	v := m.extensions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimecostMs resets all changes to the "timecost_ms" field.
-func (m *ProblemCaseRunMutation) ResetTimecostMs() {
-	m.timecost_ms = nil
-	m.addtimecost_ms = nil
+// OldExtensions returns the old "extensions" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchivedEventMutation) OldExtensions(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtensions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtensions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtensions: %w", err)
+	}
+	return oldValue.Extensions, nil
 }
 
-// SetReportTime sets the "report_time" field.
-func (m *ProblemCaseRunMutation) SetReportTime(t time.Time) {
-	m.report_time = &t
+// ClearExtensions clears the value of the "extensions" field.
+func (m *ArchivedEventMutation) ClearExtensions() {
+	m.extensions = nil
+	m.clearedFields[archivedevent.FieldExtensions] = struct{}{}
 }
 
-// ReportTime returns the value of the "report_time" field in the mutation.
-func (m *ProblemCaseRunMutation) ReportTime() (r time.Time, exists bool) {
-	v := m.report_time
+// ExtensionsCleared returns if the "extensions" field was cleared in this mutation.
+func (m *ArchivedEventMutation) ExtensionsCleared() bool {
+	_, ok := m.clearedFields[archivedevent.FieldExtensions]
+	return ok
+}
+
+// ResetExtensions resets all changes to the "extensions" field.
+func (m *ArchivedEventMutation) ResetExtensions() {
+	m.extensions = nil
+	delete(m.clearedFields, archivedevent.FieldExtensions)
+}
+
+// SetPayload sets the "payload" field.
+func (m *ArchivedEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *ArchivedEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReportTime returns the old "report_time" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldReportTime(ctx context.Context) (v time.Time, err error) {
+func (m *ArchivedEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReportTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReportTime requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReportTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ReportTime, nil
+	return oldValue.Payload, nil
 }
 
-// ResetReportTime resets all changes to the "report_time" field.
-func (m *ProblemCaseRunMutation) ResetReportTime() {
-	m.report_time = nil
+// ClearPayload clears the value of the "payload" field.
+func (m *ArchivedEventMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[archivedevent.FieldPayload] = struct{}{}
 }
 
-// SetBuildURL sets the "build_url" field.
-func (m *ProblemCaseRunMutation) SetBuildURL(s string) {
-	m.build_url = &s
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *ArchivedEventMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[archivedevent.FieldPayload]
+	return ok
 }
 
-// BuildURL returns the value of the "build_url" field in the mutation.
-func (m *ProblemCaseRunMutation) BuildURL() (r string, exists bool) {
-	v := m.build_url
+// ResetPayload resets all changes to the "payload" field.
+func (m *ArchivedEventMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, archivedevent.FieldPayload)
+}
+
+// SetTruncated sets the "truncated" field.
+func (m *ArchivedEventMutation) SetTruncated(b bool) {
+	m.truncated = &b
+}
+
+// Truncated returns the value of the "truncated" field in the mutation.
+func (m *ArchivedEventMutation) Truncated() (r bool, exists bool) {
+	v := m.truncated
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuildURL returns the old "build_url" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldTruncated returns the old "truncated" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldBuildURL(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldTruncated(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuildURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldTruncated is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuildURL requires an ID field in the mutation")
+		return v, errors.New("OldTruncated requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuildURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldTruncated: %w", err)
 	}
-	return oldValue.BuildURL, nil
+	return oldValue.Truncated, nil
 }
 
-// ResetBuildURL resets all changes to the "build_url" field.
-func (m *ProblemCaseRunMutation) ResetBuildURL() {
-	m.build_url = nil
+// ResetTruncated resets all changes to the "truncated" field.
+func (m *ArchivedEventMutation) ResetTruncated() {
+	m.truncated = nil
 }
 
-// SetReason sets the "reason" field.
-func (m *ProblemCaseRunMutation) SetReason(s string) {
-	m.reason = &s
+// SetReceivedAt sets the "received_at" field.
+func (m *ArchivedEventMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *ProblemCaseRunMutation) Reason() (r string, exists bool) {
-	v := m.reason
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *ArchivedEventMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReason returns the old "reason" field's value of the ProblemCaseRun entity.
-// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// OldReceivedAt returns the old "received_at" field's value of the ArchivedEvent entity.
+// If the ArchivedEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemCaseRunMutation) OldReason(ctx context.Context) (v string, err error) {
+func (m *ArchivedEventMutation) OldReceivedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
 	}
-	return oldValue.Reason, nil
+	return oldValue.ReceivedAt, nil
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *ProblemCaseRunMutation) ResetReason() {
-	m.reason = nil
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *ArchivedEventMutation) ResetReceivedAt() {
+	m.received_at = nil
 }
 
-// Where appends a list predicates to the ProblemCaseRunMutation builder.
-func (m *ProblemCaseRunMutation) Where(ps ...predicate.ProblemCaseRun) {
+// Where appends a list predicates to the ArchivedEventMutation builder.
+func (m *ArchivedEventMutation) Where(ps ...predicate.ArchivedEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemCaseRunMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchivedEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemCaseRunMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ProblemCaseRun, len(ps))
+func (m *ArchivedEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchivedEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -507,51 +598,57 @@ func (m *ProblemCaseRunMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemCaseRunMutation) Op() Op {
+func (m *ArchivedEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemCaseRunMutation) SetOp(op Op) {
+func (m *ArchivedEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ProblemCaseRun).
-func (m *ProblemCaseRunMutation) Type() string {
+// Type returns the node type of this mutation (ArchivedEvent).
+func (m *ArchivedEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemCaseRunMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.repo != nil {
-		fields = append(fields, problemcaserun.FieldRepo)
+func (m *ArchivedEventMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.event_id != nil {
+		fields = append(fields, archivedevent.FieldEventID)
 	}
-	if m.branch != nil {
-		fields = append(fields, problemcaserun.FieldBranch)
+	if m._type != nil {
+		fields = append(fields, archivedevent.FieldType)
 	}
-	if m.suite_name != nil {
-		fields = append(fields, problemcaserun.FieldSuiteName)
+	if m.source != nil {
+		fields = append(fields, archivedevent.FieldSource)
 	}
-	if m.case_name != nil {
-		fields = append(fields, problemcaserun.FieldCaseName)
+	if m.subject != nil {
+		fields = append(fields, archivedevent.FieldSubject)
 	}
-	if m.flaky != nil {
-		fields = append(fields, problemcaserun.FieldFlaky)
+	if m.time != nil {
+		fields = append(fields, archivedevent.FieldTime)
 	}
-	if m.timecost_ms != nil {
-		fields = append(fields, problemcaserun.FieldTimecostMs)
+	if m.topic != nil {
+		fields = append(fields, archivedevent.FieldTopic)
 	}
-	if m.report_time != nil {
-		fields = append(fields, problemcaserun.FieldReportTime)
+	if m.data_content_type != nil {
+		fields = append(fields, archivedevent.FieldDataContentType)
 	}
-	if m.build_url != nil {
-		fields = append(fields, problemcaserun.FieldBuildURL)
+	if m.extensions != nil {
+		fields = append(fields, archivedevent.FieldExtensions)
 	}
-	if m.reason != nil {
-		fields = append(fields, problemcaserun.FieldReason)
+	if m.payload != nil {
+		fields = append(fields, archivedevent.FieldPayload)
+	}
+	if m.truncated != nil {
+		fields = append(fields, archivedevent.FieldTruncated)
+	}
+	if m.received_at != nil {
+		fields = append(fields, archivedevent.FieldReceivedAt)
 	}
 	return fields
 }
@@ -559,26 +656,30 @@ func (m *ProblemCaseRunMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemCaseRunMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchivedEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problemcaserun.FieldRepo:
-		return m.Repo()
-	case problemcaserun.FieldBranch:
-		return m.Branch()
-	case problemcaserun.FieldSuiteName:
-		return m.SuiteName()
-	case problemcaserun.FieldCaseName:
-		return m.CaseName()
-	case problemcaserun.FieldFlaky:
-		return m.Flaky()
-	case problemcaserun.FieldTimecostMs:
-		return m.TimecostMs()
-	case problemcaserun.FieldReportTime:
-		return m.ReportTime()
-	case problemcaserun.FieldBuildURL:
-		return m.BuildURL()
-	case problemcaserun.FieldReason:
-		return m.Reason()
+	case archivedevent.FieldEventID:
+		return m.EventID()
+	case archivedevent.FieldType:
+		return m.GetType()
+	case archivedevent.FieldSource:
+		return m.Source()
+	case archivedevent.FieldSubject:
+		return m.Subject()
+	case archivedevent.FieldTime:
+		return m.Time()
+	case archivedevent.FieldTopic:
+		return m.Topic()
+	case archivedevent.FieldDataContentType:
+		return m.DataContentType()
+	case archivedevent.FieldExtensions:
+		return m.Extensions()
+	case archivedevent.FieldPayload:
+		return m.Payload()
+	case archivedevent.FieldTruncated:
+		return m.Truncated()
+	case archivedevent.FieldReceivedAt:
+		return m.ReceivedAt()
 	}
 	return nil, false
 }
@@ -586,237 +687,5268 @@ func (m *ProblemCaseRunMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemCaseRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchivedEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problemcaserun.FieldRepo:
-		return m.OldRepo(ctx)
-	case problemcaserun.FieldBranch:
-		return m.OldBranch(ctx)
-	case problemcaserun.FieldSuiteName:
-		return m.OldSuiteName(ctx)
-	case problemcaserun.FieldCaseName:
-		return m.OldCaseName(ctx)
-	case problemcaserun.FieldFlaky:
-		return m.OldFlaky(ctx)
-	case problemcaserun.FieldTimecostMs:
-		return m.OldTimecostMs(ctx)
-	case problemcaserun.FieldReportTime:
-		return m.OldReportTime(ctx)
-	case problemcaserun.FieldBuildURL:
-		return m.OldBuildURL(ctx)
-	case problemcaserun.FieldReason:
-		return m.OldReason(ctx)
+	case archivedevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case archivedevent.FieldType:
+		return m.OldType(ctx)
+	case archivedevent.FieldSource:
+		return m.OldSource(ctx)
+	case archivedevent.FieldSubject:
+		return m.OldSubject(ctx)
+	case archivedevent.FieldTime:
+		return m.OldTime(ctx)
+	case archivedevent.FieldTopic:
+		return m.OldTopic(ctx)
+	case archivedevent.FieldDataContentType:
+		return m.OldDataContentType(ctx)
+	case archivedevent.FieldExtensions:
+		return m.OldExtensions(ctx)
+	case archivedevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case archivedevent.FieldTruncated:
+		return m.OldTruncated(ctx)
+	case archivedevent.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ProblemCaseRun field %s", name)
+	return nil, fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemCaseRunMutation) SetField(name string, value ent.Value) error {
+func (m *ArchivedEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problemcaserun.FieldRepo:
+	case archivedevent.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRepo(v)
+		m.SetEventID(v)
 		return nil
-	case problemcaserun.FieldBranch:
+	case archivedevent.FieldType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBranch(v)
+		m.SetType(v)
 		return nil
-	case problemcaserun.FieldSuiteName:
+	case archivedevent.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuiteName(v)
+		m.SetSource(v)
 		return nil
-	case problemcaserun.FieldCaseName:
+	case archivedevent.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCaseName(v)
+		m.SetSubject(v)
 		return nil
-	case problemcaserun.FieldFlaky:
-		v, ok := value.(bool)
+	case archivedevent.FieldTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlaky(v)
+		m.SetTime(v)
 		return nil
-	case problemcaserun.FieldTimecostMs:
-		v, ok := value.(int)
+	case archivedevent.FieldTopic:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimecostMs(v)
+		m.SetTopic(v)
 		return nil
-	case problemcaserun.FieldReportTime:
-		v, ok := value.(time.Time)
+	case archivedevent.FieldDataContentType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReportTime(v)
+		m.SetDataContentType(v)
 		return nil
-	case problemcaserun.FieldBuildURL:
-		v, ok := value.(string)
+	case archivedevent.FieldExtensions:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuildURL(v)
+		m.SetExtensions(v)
 		return nil
-	case problemcaserun.FieldReason:
-		v, ok := value.(string)
+	case archivedevent.FieldPayload:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetPayload(v)
+		return nil
+	case archivedevent.FieldTruncated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTruncated(v)
+		return nil
+	case archivedevent.FieldReceivedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceivedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ProblemCaseRun field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemCaseRunMutation) AddedFields() []string {
-	var fields []string
-	if m.addtimecost_ms != nil {
-		fields = append(fields, problemcaserun.FieldTimecostMs)
-	}
-	return fields
+func (m *ArchivedEventMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemCaseRunMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case problemcaserun.FieldTimecostMs:
-		return m.AddedTimecostMs()
-	}
+func (m *ArchivedEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemCaseRunMutation) AddField(name string, value ent.Value) error {
+func (m *ArchivedEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case problemcaserun.FieldTimecostMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTimecostMs(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ProblemCaseRun numeric field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemCaseRunMutation) ClearedFields() []string {
-	return nil
+func (m *ArchivedEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(archivedevent.FieldExtensions) {
+		fields = append(fields, archivedevent.FieldExtensions)
+	}
+	if m.FieldCleared(archivedevent.FieldPayload) {
+		fields = append(fields, archivedevent.FieldPayload)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemCaseRunMutation) FieldCleared(name string) bool {
+func (m *ArchivedEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemCaseRunMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ProblemCaseRun nullable field %s", name)
+func (m *ArchivedEventMutation) ClearField(name string) error {
+	switch name {
+	case archivedevent.FieldExtensions:
+		m.ClearExtensions()
+		return nil
+	case archivedevent.FieldPayload:
+		m.ClearPayload()
+		return nil
+	}
+	return fmt.Errorf("unknown ArchivedEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemCaseRunMutation) ResetField(name string) error {
+func (m *ArchivedEventMutation) ResetField(name string) error {
 	switch name {
-	case problemcaserun.FieldRepo:
-		m.ResetRepo()
+	case archivedevent.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case problemcaserun.FieldBranch:
-		m.ResetBranch()
+	case archivedevent.FieldType:
+		m.ResetType()
 		return nil
-	case problemcaserun.FieldSuiteName:
-		m.ResetSuiteName()
+	case archivedevent.FieldSource:
+		m.ResetSource()
 		return nil
-	case problemcaserun.FieldCaseName:
-		m.ResetCaseName()
+	case archivedevent.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case problemcaserun.FieldFlaky:
-		m.ResetFlaky()
+	case archivedevent.FieldTime:
+		m.ResetTime()
 		return nil
-	case problemcaserun.FieldTimecostMs:
-		m.ResetTimecostMs()
+	case archivedevent.FieldTopic:
+		m.ResetTopic()
 		return nil
-	case problemcaserun.FieldReportTime:
-		m.ResetReportTime()
+	case archivedevent.FieldDataContentType:
+		m.ResetDataContentType()
 		return nil
-	case problemcaserun.FieldBuildURL:
-		m.ResetBuildURL()
+	case archivedevent.FieldExtensions:
+		m.ResetExtensions()
 		return nil
-	case problemcaserun.FieldReason:
-		m.ResetReason()
+	case archivedevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case archivedevent.FieldTruncated:
+		m.ResetTruncated()
+		return nil
+	case archivedevent.FieldReceivedAt:
+		m.ResetReceivedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ProblemCaseRun field %s", name)
+	return fmt.Errorf("unknown ArchivedEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemCaseRunMutation) AddedEdges() []string {
+func (m *ArchivedEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemCaseRunMutation) AddedIDs(name string) []ent.Value {
+func (m *ArchivedEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemCaseRunMutation) RemovedEdges() []string {
+func (m *ArchivedEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemCaseRunMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArchivedEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemCaseRunMutation) ClearedEdges() []string {
+func (m *ArchivedEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemCaseRunMutation) EdgeCleared(name string) bool {
+func (m *ArchivedEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemCaseRunMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ProblemCaseRun unique edge %s", name)
+func (m *ArchivedEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProblemCaseRunMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ProblemCaseRun edge %s", name)
+func (m *ArchivedEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ArchivedEvent edge %s", name)
+}
+
+// LarkRunCardMutation represents an operation that mutates the LarkRunCard nodes in the graph.
+type LarkRunCardMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	run_uid       *string
+	receiver      *string
+	message_id    *string
+	event_type    *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*LarkRunCard, error)
+	predicates    []predicate.LarkRunCard
+}
+
+var _ ent.Mutation = (*LarkRunCardMutation)(nil)
+
+// larkruncardOption allows management of the mutation configuration using functional options.
+type larkruncardOption func(*LarkRunCardMutation)
+
+// newLarkRunCardMutation creates new mutation for the LarkRunCard entity.
+func newLarkRunCardMutation(c config, op Op, opts ...larkruncardOption) *LarkRunCardMutation {
+	m := &LarkRunCardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLarkRunCard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLarkRunCardID sets the ID field of the mutation.
+func withLarkRunCardID(id int) larkruncardOption {
+	return func(m *LarkRunCardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LarkRunCard
+		)
+		m.oldValue = func(ctx context.Context) (*LarkRunCard, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LarkRunCard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLarkRunCard sets the old LarkRunCard of the mutation.
+func withLarkRunCard(node *LarkRunCard) larkruncardOption {
+	return func(m *LarkRunCardMutation) {
+		m.oldValue = func(context.Context) (*LarkRunCard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LarkRunCardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LarkRunCardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LarkRunCardMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LarkRunCardMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LarkRunCard.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunUID sets the "run_uid" field.
+func (m *LarkRunCardMutation) SetRunUID(s string) {
+	m.run_uid = &s
+}
+
+// RunUID returns the value of the "run_uid" field in the mutation.
+func (m *LarkRunCardMutation) RunUID() (r string, exists bool) {
+	v := m.run_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunUID returns the old "run_uid" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldRunUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunUID: %w", err)
+	}
+	return oldValue.RunUID, nil
+}
+
+// ResetRunUID resets all changes to the "run_uid" field.
+func (m *LarkRunCardMutation) ResetRunUID() {
+	m.run_uid = nil
+}
+
+// SetReceiver sets the "receiver" field.
+func (m *LarkRunCardMutation) SetReceiver(s string) {
+	m.receiver = &s
+}
+
+// Receiver returns the value of the "receiver" field in the mutation.
+func (m *LarkRunCardMutation) Receiver() (r string, exists bool) {
+	v := m.receiver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiver returns the old "receiver" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldReceiver(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiver is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiver requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiver: %w", err)
+	}
+	return oldValue.Receiver, nil
+}
+
+// ResetReceiver resets all changes to the "receiver" field.
+func (m *LarkRunCardMutation) ResetReceiver() {
+	m.receiver = nil
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *LarkRunCardMutation) SetMessageID(s string) {
+	m.message_id = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *LarkRunCardMutation) MessageID() (r string, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *LarkRunCardMutation) ResetMessageID() {
+	m.message_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *LarkRunCardMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *LarkRunCardMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *LarkRunCardMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LarkRunCardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LarkRunCardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LarkRunCardMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LarkRunCardMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LarkRunCardMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LarkRunCard entity.
+// If the LarkRunCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LarkRunCardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LarkRunCardMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the LarkRunCardMutation builder.
+func (m *LarkRunCardMutation) Where(ps ...predicate.LarkRunCard) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LarkRunCardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LarkRunCardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LarkRunCard, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LarkRunCardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LarkRunCardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LarkRunCard).
+func (m *LarkRunCardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LarkRunCardMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.run_uid != nil {
+		fields = append(fields, larkruncard.FieldRunUID)
+	}
+	if m.receiver != nil {
+		fields = append(fields, larkruncard.FieldReceiver)
+	}
+	if m.message_id != nil {
+		fields = append(fields, larkruncard.FieldMessageID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, larkruncard.FieldEventType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, larkruncard.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, larkruncard.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LarkRunCardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case larkruncard.FieldRunUID:
+		return m.RunUID()
+	case larkruncard.FieldReceiver:
+		return m.Receiver()
+	case larkruncard.FieldMessageID:
+		return m.MessageID()
+	case larkruncard.FieldEventType:
+		return m.EventType()
+	case larkruncard.FieldCreatedAt:
+		return m.CreatedAt()
+	case larkruncard.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LarkRunCardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case larkruncard.FieldRunUID:
+		return m.OldRunUID(ctx)
+	case larkruncard.FieldReceiver:
+		return m.OldReceiver(ctx)
+	case larkruncard.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case larkruncard.FieldEventType:
+		return m.OldEventType(ctx)
+	case larkruncard.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case larkruncard.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LarkRunCard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LarkRunCardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case larkruncard.FieldRunUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunUID(v)
+		return nil
+	case larkruncard.FieldReceiver:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiver(v)
+		return nil
+	case larkruncard.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case larkruncard.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case larkruncard.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case larkruncard.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LarkRunCard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LarkRunCardMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LarkRunCardMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LarkRunCardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LarkRunCard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LarkRunCardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LarkRunCardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LarkRunCardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LarkRunCard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LarkRunCardMutation) ResetField(name string) error {
+	switch name {
+	case larkruncard.FieldRunUID:
+		m.ResetRunUID()
+		return nil
+	case larkruncard.FieldReceiver:
+		m.ResetReceiver()
+		return nil
+	case larkruncard.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case larkruncard.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case larkruncard.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case larkruncard.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LarkRunCard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LarkRunCardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LarkRunCardMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LarkRunCardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LarkRunCardMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LarkRunCardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LarkRunCardMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LarkRunCardMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LarkRunCard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LarkRunCardMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LarkRunCard edge %s", name)
+}
+
+// ProblemCaseIssueMutation represents an operation that mutates the ProblemCaseIssue nodes in the graph.
+type ProblemCaseIssueMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	repo            *string
+	suite_name      *string
+	case_name       *string
+	issue_repo      *string
+	issue_number    *int
+	addissue_number *int
+	issue_url       *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*ProblemCaseIssue, error)
+	predicates      []predicate.ProblemCaseIssue
+}
+
+var _ ent.Mutation = (*ProblemCaseIssueMutation)(nil)
+
+// problemcaseissueOption allows management of the mutation configuration using functional options.
+type problemcaseissueOption func(*ProblemCaseIssueMutation)
+
+// newProblemCaseIssueMutation creates new mutation for the ProblemCaseIssue entity.
+func newProblemCaseIssueMutation(c config, op Op, opts ...problemcaseissueOption) *ProblemCaseIssueMutation {
+	m := &ProblemCaseIssueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblemCaseIssue,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemCaseIssueID sets the ID field of the mutation.
+func withProblemCaseIssueID(id int) problemcaseissueOption {
+	return func(m *ProblemCaseIssueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProblemCaseIssue
+		)
+		m.oldValue = func(ctx context.Context) (*ProblemCaseIssue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProblemCaseIssue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProblemCaseIssue sets the old ProblemCaseIssue of the mutation.
+func withProblemCaseIssue(node *ProblemCaseIssue) problemcaseissueOption {
+	return func(m *ProblemCaseIssueMutation) {
+		m.oldValue = func(context.Context) (*ProblemCaseIssue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemCaseIssueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemCaseIssueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemCaseIssueMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemCaseIssueMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProblemCaseIssue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRepo sets the "repo" field.
+func (m *ProblemCaseIssueMutation) SetRepo(s string) {
+	m.repo = &s
+}
+
+// Repo returns the value of the "repo" field in the mutation.
+func (m *ProblemCaseIssueMutation) Repo() (r string, exists bool) {
+	v := m.repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepo returns the old "repo" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
+	}
+	return oldValue.Repo, nil
+}
+
+// ResetRepo resets all changes to the "repo" field.
+func (m *ProblemCaseIssueMutation) ResetRepo() {
+	m.repo = nil
+}
+
+// SetSuiteName sets the "suite_name" field.
+func (m *ProblemCaseIssueMutation) SetSuiteName(s string) {
+	m.suite_name = &s
+}
+
+// SuiteName returns the value of the "suite_name" field in the mutation.
+func (m *ProblemCaseIssueMutation) SuiteName() (r string, exists bool) {
+	v := m.suite_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuiteName returns the old "suite_name" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldSuiteName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuiteName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuiteName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuiteName: %w", err)
+	}
+	return oldValue.SuiteName, nil
+}
+
+// ResetSuiteName resets all changes to the "suite_name" field.
+func (m *ProblemCaseIssueMutation) ResetSuiteName() {
+	m.suite_name = nil
+}
+
+// SetCaseName sets the "case_name" field.
+func (m *ProblemCaseIssueMutation) SetCaseName(s string) {
+	m.case_name = &s
+}
+
+// CaseName returns the value of the "case_name" field in the mutation.
+func (m *ProblemCaseIssueMutation) CaseName() (r string, exists bool) {
+	v := m.case_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaseName returns the old "case_name" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldCaseName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseName: %w", err)
+	}
+	return oldValue.CaseName, nil
+}
+
+// ResetCaseName resets all changes to the "case_name" field.
+func (m *ProblemCaseIssueMutation) ResetCaseName() {
+	m.case_name = nil
+}
+
+// SetIssueRepo sets the "issue_repo" field.
+func (m *ProblemCaseIssueMutation) SetIssueRepo(s string) {
+	m.issue_repo = &s
+}
+
+// IssueRepo returns the value of the "issue_repo" field in the mutation.
+func (m *ProblemCaseIssueMutation) IssueRepo() (r string, exists bool) {
+	v := m.issue_repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueRepo returns the old "issue_repo" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldIssueRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueRepo: %w", err)
+	}
+	return oldValue.IssueRepo, nil
+}
+
+// ResetIssueRepo resets all changes to the "issue_repo" field.
+func (m *ProblemCaseIssueMutation) ResetIssueRepo() {
+	m.issue_repo = nil
+}
+
+// SetIssueNumber sets the "issue_number" field.
+func (m *ProblemCaseIssueMutation) SetIssueNumber(i int) {
+	m.issue_number = &i
+	m.addissue_number = nil
+}
+
+// IssueNumber returns the value of the "issue_number" field in the mutation.
+func (m *ProblemCaseIssueMutation) IssueNumber() (r int, exists bool) {
+	v := m.issue_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueNumber returns the old "issue_number" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldIssueNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueNumber: %w", err)
+	}
+	return oldValue.IssueNumber, nil
+}
+
+// AddIssueNumber adds i to the "issue_number" field.
+func (m *ProblemCaseIssueMutation) AddIssueNumber(i int) {
+	if m.addissue_number != nil {
+		*m.addissue_number += i
+	} else {
+		m.addissue_number = &i
+	}
+}
+
+// AddedIssueNumber returns the value that was added to the "issue_number" field in this mutation.
+func (m *ProblemCaseIssueMutation) AddedIssueNumber() (r int, exists bool) {
+	v := m.addissue_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIssueNumber resets all changes to the "issue_number" field.
+func (m *ProblemCaseIssueMutation) ResetIssueNumber() {
+	m.issue_number = nil
+	m.addissue_number = nil
+}
+
+// SetIssueURL sets the "issue_url" field.
+func (m *ProblemCaseIssueMutation) SetIssueURL(s string) {
+	m.issue_url = &s
+}
+
+// IssueURL returns the value of the "issue_url" field in the mutation.
+func (m *ProblemCaseIssueMutation) IssueURL() (r string, exists bool) {
+	v := m.issue_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIssueURL returns the old "issue_url" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldIssueURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssueURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssueURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssueURL: %w", err)
+	}
+	return oldValue.IssueURL, nil
+}
+
+// ResetIssueURL resets all changes to the "issue_url" field.
+func (m *ProblemCaseIssueMutation) ResetIssueURL() {
+	m.issue_url = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProblemCaseIssueMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProblemCaseIssueMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProblemCaseIssueMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProblemCaseIssueMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProblemCaseIssueMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProblemCaseIssue entity.
+// If the ProblemCaseIssue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseIssueMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProblemCaseIssueMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ProblemCaseIssueMutation builder.
+func (m *ProblemCaseIssueMutation) Where(ps ...predicate.ProblemCaseIssue) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProblemCaseIssueMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProblemCaseIssueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProblemCaseIssue, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProblemCaseIssueMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProblemCaseIssueMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProblemCaseIssue).
+func (m *ProblemCaseIssueMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProblemCaseIssueMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.repo != nil {
+		fields = append(fields, problemcaseissue.FieldRepo)
+	}
+	if m.suite_name != nil {
+		fields = append(fields, problemcaseissue.FieldSuiteName)
+	}
+	if m.case_name != nil {
+		fields = append(fields, problemcaseissue.FieldCaseName)
+	}
+	if m.issue_repo != nil {
+		fields = append(fields, problemcaseissue.FieldIssueRepo)
+	}
+	if m.issue_number != nil {
+		fields = append(fields, problemcaseissue.FieldIssueNumber)
+	}
+	if m.issue_url != nil {
+		fields = append(fields, problemcaseissue.FieldIssueURL)
+	}
+	if m.created_at != nil {
+		fields = append(fields, problemcaseissue.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, problemcaseissue.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProblemCaseIssueMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case problemcaseissue.FieldRepo:
+		return m.Repo()
+	case problemcaseissue.FieldSuiteName:
+		return m.SuiteName()
+	case problemcaseissue.FieldCaseName:
+		return m.CaseName()
+	case problemcaseissue.FieldIssueRepo:
+		return m.IssueRepo()
+	case problemcaseissue.FieldIssueNumber:
+		return m.IssueNumber()
+	case problemcaseissue.FieldIssueURL:
+		return m.IssueURL()
+	case problemcaseissue.FieldCreatedAt:
+		return m.CreatedAt()
+	case problemcaseissue.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProblemCaseIssueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case problemcaseissue.FieldRepo:
+		return m.OldRepo(ctx)
+	case problemcaseissue.FieldSuiteName:
+		return m.OldSuiteName(ctx)
+	case problemcaseissue.FieldCaseName:
+		return m.OldCaseName(ctx)
+	case problemcaseissue.FieldIssueRepo:
+		return m.OldIssueRepo(ctx)
+	case problemcaseissue.FieldIssueNumber:
+		return m.OldIssueNumber(ctx)
+	case problemcaseissue.FieldIssueURL:
+		return m.OldIssueURL(ctx)
+	case problemcaseissue.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case problemcaseissue.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProblemCaseIssue field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemCaseIssueMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case problemcaseissue.FieldRepo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepo(v)
+		return nil
+	case problemcaseissue.FieldSuiteName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuiteName(v)
+		return nil
+	case problemcaseissue.FieldCaseName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseName(v)
+		return nil
+	case problemcaseissue.FieldIssueRepo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueRepo(v)
+		return nil
+	case problemcaseissue.FieldIssueNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueNumber(v)
+		return nil
+	case problemcaseissue.FieldIssueURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssueURL(v)
+		return nil
+	case problemcaseissue.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case problemcaseissue.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseIssue field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProblemCaseIssueMutation) AddedFields() []string {
+	var fields []string
+	if m.addissue_number != nil {
+		fields = append(fields, problemcaseissue.FieldIssueNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProblemCaseIssueMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case problemcaseissue.FieldIssueNumber:
+		return m.AddedIssueNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemCaseIssueMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case problemcaseissue.FieldIssueNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssueNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseIssue numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProblemCaseIssueMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProblemCaseIssueMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProblemCaseIssueMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProblemCaseIssue nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProblemCaseIssueMutation) ResetField(name string) error {
+	switch name {
+	case problemcaseissue.FieldRepo:
+		m.ResetRepo()
+		return nil
+	case problemcaseissue.FieldSuiteName:
+		m.ResetSuiteName()
+		return nil
+	case problemcaseissue.FieldCaseName:
+		m.ResetCaseName()
+		return nil
+	case problemcaseissue.FieldIssueRepo:
+		m.ResetIssueRepo()
+		return nil
+	case problemcaseissue.FieldIssueNumber:
+		m.ResetIssueNumber()
+		return nil
+	case problemcaseissue.FieldIssueURL:
+		m.ResetIssueURL()
+		return nil
+	case problemcaseissue.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case problemcaseissue.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseIssue field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProblemCaseIssueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProblemCaseIssueMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProblemCaseIssueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProblemCaseIssueMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProblemCaseIssueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProblemCaseIssueMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProblemCaseIssueMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProblemCaseIssue unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProblemCaseIssueMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProblemCaseIssue edge %s", name)
+}
+
+// ProblemCaseRunMutation represents an operation that mutates the ProblemCaseRun nodes in the graph.
+type ProblemCaseRunMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	repo           *string
+	branch         *string
+	suite_name     *string
+	case_name      *string
+	flaky          *bool
+	timecost_ms    *int
+	addtimecost_ms *int
+	report_time    *time.Time
+	build_url      *string
+	reason         *string
+	status         *string
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ProblemCaseRun, error)
+	predicates     []predicate.ProblemCaseRun
+}
+
+var _ ent.Mutation = (*ProblemCaseRunMutation)(nil)
+
+// problemcaserunOption allows management of the mutation configuration using functional options.
+type problemcaserunOption func(*ProblemCaseRunMutation)
+
+// newProblemCaseRunMutation creates new mutation for the ProblemCaseRun entity.
+func newProblemCaseRunMutation(c config, op Op, opts ...problemcaserunOption) *ProblemCaseRunMutation {
+	m := &ProblemCaseRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblemCaseRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemCaseRunID sets the ID field of the mutation.
+func withProblemCaseRunID(id int) problemcaserunOption {
+	return func(m *ProblemCaseRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProblemCaseRun
+		)
+		m.oldValue = func(ctx context.Context) (*ProblemCaseRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProblemCaseRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProblemCaseRun sets the old ProblemCaseRun of the mutation.
+func withProblemCaseRun(node *ProblemCaseRun) problemcaserunOption {
+	return func(m *ProblemCaseRunMutation) {
+		m.oldValue = func(context.Context) (*ProblemCaseRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemCaseRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemCaseRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemCaseRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemCaseRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProblemCaseRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRepo sets the "repo" field.
+func (m *ProblemCaseRunMutation) SetRepo(s string) {
+	m.repo = &s
+}
+
+// Repo returns the value of the "repo" field in the mutation.
+func (m *ProblemCaseRunMutation) Repo() (r string, exists bool) {
+	v := m.repo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepo returns the old "repo" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldRepo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepo: %w", err)
+	}
+	return oldValue.Repo, nil
+}
+
+// ResetRepo resets all changes to the "repo" field.
+func (m *ProblemCaseRunMutation) ResetRepo() {
+	m.repo = nil
+}
+
+// SetBranch sets the "branch" field.
+func (m *ProblemCaseRunMutation) SetBranch(s string) {
+	m.branch = &s
+}
+
+// Branch returns the value of the "branch" field in the mutation.
+func (m *ProblemCaseRunMutation) Branch() (r string, exists bool) {
+	v := m.branch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBranch returns the old "branch" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldBranch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBranch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBranch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBranch: %w", err)
+	}
+	return oldValue.Branch, nil
+}
+
+// ResetBranch resets all changes to the "branch" field.
+func (m *ProblemCaseRunMutation) ResetBranch() {
+	m.branch = nil
+}
+
+// SetSuiteName sets the "suite_name" field.
+func (m *ProblemCaseRunMutation) SetSuiteName(s string) {
+	m.suite_name = &s
+}
+
+// SuiteName returns the value of the "suite_name" field in the mutation.
+func (m *ProblemCaseRunMutation) SuiteName() (r string, exists bool) {
+	v := m.suite_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuiteName returns the old "suite_name" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldSuiteName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuiteName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuiteName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuiteName: %w", err)
+	}
+	return oldValue.SuiteName, nil
+}
+
+// ResetSuiteName resets all changes to the "suite_name" field.
+func (m *ProblemCaseRunMutation) ResetSuiteName() {
+	m.suite_name = nil
+}
+
+// SetCaseName sets the "case_name" field.
+func (m *ProblemCaseRunMutation) SetCaseName(s string) {
+	m.case_name = &s
+}
+
+// CaseName returns the value of the "case_name" field in the mutation.
+func (m *ProblemCaseRunMutation) CaseName() (r string, exists bool) {
+	v := m.case_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaseName returns the old "case_name" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldCaseName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaseName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaseName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaseName: %w", err)
+	}
+	return oldValue.CaseName, nil
+}
+
+// ResetCaseName resets all changes to the "case_name" field.
+func (m *ProblemCaseRunMutation) ResetCaseName() {
+	m.case_name = nil
+}
+
+// SetFlaky sets the "flaky" field.
+func (m *ProblemCaseRunMutation) SetFlaky(b bool) {
+	m.flaky = &b
+}
+
+// Flaky returns the value of the "flaky" field in the mutation.
+func (m *ProblemCaseRunMutation) Flaky() (r bool, exists bool) {
+	v := m.flaky
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlaky returns the old "flaky" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldFlaky(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlaky is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlaky requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlaky: %w", err)
+	}
+	return oldValue.Flaky, nil
+}
+
+// ResetFlaky resets all changes to the "flaky" field.
+func (m *ProblemCaseRunMutation) ResetFlaky() {
+	m.flaky = nil
+}
+
+// SetTimecostMs sets the "timecost_ms" field.
+func (m *ProblemCaseRunMutation) SetTimecostMs(i int) {
+	m.timecost_ms = &i
+	m.addtimecost_ms = nil
+}
+
+// TimecostMs returns the value of the "timecost_ms" field in the mutation.
+func (m *ProblemCaseRunMutation) TimecostMs() (r int, exists bool) {
+	v := m.timecost_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimecostMs returns the old "timecost_ms" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldTimecostMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimecostMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimecostMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimecostMs: %w", err)
+	}
+	return oldValue.TimecostMs, nil
+}
+
+// AddTimecostMs adds i to the "timecost_ms" field.
+func (m *ProblemCaseRunMutation) AddTimecostMs(i int) {
+	if m.addtimecost_ms != nil {
+		*m.addtimecost_ms += i
+	} else {
+		m.addtimecost_ms = &i
+	}
+}
+
+// AddedTimecostMs returns the value that was added to the "timecost_ms" field in this mutation.
+func (m *ProblemCaseRunMutation) AddedTimecostMs() (r int, exists bool) {
+	v := m.addtimecost_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimecostMs resets all changes to the "timecost_ms" field.
+func (m *ProblemCaseRunMutation) ResetTimecostMs() {
+	m.timecost_ms = nil
+	m.addtimecost_ms = nil
+}
+
+// SetReportTime sets the "report_time" field.
+func (m *ProblemCaseRunMutation) SetReportTime(t time.Time) {
+	m.report_time = &t
+}
+
+// ReportTime returns the value of the "report_time" field in the mutation.
+func (m *ProblemCaseRunMutation) ReportTime() (r time.Time, exists bool) {
+	v := m.report_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReportTime returns the old "report_time" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldReportTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReportTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReportTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReportTime: %w", err)
+	}
+	return oldValue.ReportTime, nil
+}
+
+// ResetReportTime resets all changes to the "report_time" field.
+func (m *ProblemCaseRunMutation) ResetReportTime() {
+	m.report_time = nil
+}
+
+// SetBuildURL sets the "build_url" field.
+func (m *ProblemCaseRunMutation) SetBuildURL(s string) {
+	m.build_url = &s
+}
+
+// BuildURL returns the value of the "build_url" field in the mutation.
+func (m *ProblemCaseRunMutation) BuildURL() (r string, exists bool) {
+	v := m.build_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuildURL returns the old "build_url" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldBuildURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuildURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuildURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuildURL: %w", err)
+	}
+	return oldValue.BuildURL, nil
+}
+
+// ResetBuildURL resets all changes to the "build_url" field.
+func (m *ProblemCaseRunMutation) ResetBuildURL() {
+	m.build_url = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ProblemCaseRunMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ProblemCaseRunMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ProblemCaseRunMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ProblemCaseRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ProblemCaseRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ProblemCaseRun entity.
+// If the ProblemCaseRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProblemCaseRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ProblemCaseRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// Where appends a list predicates to the ProblemCaseRunMutation builder.
+func (m *ProblemCaseRunMutation) Where(ps ...predicate.ProblemCaseRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProblemCaseRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProblemCaseRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProblemCaseRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProblemCaseRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProblemCaseRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProblemCaseRun).
+func (m *ProblemCaseRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProblemCaseRunMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.repo != nil {
+		fields = append(fields, problemcaserun.FieldRepo)
+	}
+	if m.branch != nil {
+		fields = append(fields, problemcaserun.FieldBranch)
+	}
+	if m.suite_name != nil {
+		fields = append(fields, problemcaserun.FieldSuiteName)
+	}
+	if m.case_name != nil {
+		fields = append(fields, problemcaserun.FieldCaseName)
+	}
+	if m.flaky != nil {
+		fields = append(fields, problemcaserun.FieldFlaky)
+	}
+	if m.timecost_ms != nil {
+		fields = append(fields, problemcaserun.FieldTimecostMs)
+	}
+	if m.report_time != nil {
+		fields = append(fields, problemcaserun.FieldReportTime)
+	}
+	if m.build_url != nil {
+		fields = append(fields, problemcaserun.FieldBuildURL)
+	}
+	if m.reason != nil {
+		fields = append(fields, problemcaserun.FieldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, problemcaserun.FieldStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProblemCaseRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case problemcaserun.FieldRepo:
+		return m.Repo()
+	case problemcaserun.FieldBranch:
+		return m.Branch()
+	case problemcaserun.FieldSuiteName:
+		return m.SuiteName()
+	case problemcaserun.FieldCaseName:
+		return m.CaseName()
+	case problemcaserun.FieldFlaky:
+		return m.Flaky()
+	case problemcaserun.FieldTimecostMs:
+		return m.TimecostMs()
+	case problemcaserun.FieldReportTime:
+		return m.ReportTime()
+	case problemcaserun.FieldBuildURL:
+		return m.BuildURL()
+	case problemcaserun.FieldReason:
+		return m.Reason()
+	case problemcaserun.FieldStatus:
+		return m.Status()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProblemCaseRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case problemcaserun.FieldRepo:
+		return m.OldRepo(ctx)
+	case problemcaserun.FieldBranch:
+		return m.OldBranch(ctx)
+	case problemcaserun.FieldSuiteName:
+		return m.OldSuiteName(ctx)
+	case problemcaserun.FieldCaseName:
+		return m.OldCaseName(ctx)
+	case problemcaserun.FieldFlaky:
+		return m.OldFlaky(ctx)
+	case problemcaserun.FieldTimecostMs:
+		return m.OldTimecostMs(ctx)
+	case problemcaserun.FieldReportTime:
+		return m.OldReportTime(ctx)
+	case problemcaserun.FieldBuildURL:
+		return m.OldBuildURL(ctx)
+	case problemcaserun.FieldReason:
+		return m.OldReason(ctx)
+	case problemcaserun.FieldStatus:
+		return m.OldStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProblemCaseRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemCaseRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case problemcaserun.FieldRepo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepo(v)
+		return nil
+	case problemcaserun.FieldBranch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBranch(v)
+		return nil
+	case problemcaserun.FieldSuiteName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuiteName(v)
+		return nil
+	case problemcaserun.FieldCaseName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCaseName(v)
+		return nil
+	case problemcaserun.FieldFlaky:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlaky(v)
+		return nil
+	case problemcaserun.FieldTimecostMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimecostMs(v)
+		return nil
+	case problemcaserun.FieldReportTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReportTime(v)
+		return nil
+	case problemcaserun.FieldBuildURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuildURL(v)
+		return nil
+	case problemcaserun.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case problemcaserun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProblemCaseRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimecost_ms != nil {
+		fields = append(fields, problemcaserun.FieldTimecostMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProblemCaseRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case problemcaserun.FieldTimecostMs:
+		return m.AddedTimecostMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProblemCaseRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case problemcaserun.FieldTimecostMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimecostMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProblemCaseRunMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProblemCaseRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProblemCaseRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProblemCaseRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProblemCaseRunMutation) ResetField(name string) error {
+	switch name {
+	case problemcaserun.FieldRepo:
+		m.ResetRepo()
+		return nil
+	case problemcaserun.FieldBranch:
+		m.ResetBranch()
+		return nil
+	case problemcaserun.FieldSuiteName:
+		m.ResetSuiteName()
+		return nil
+	case problemcaserun.FieldCaseName:
+		m.ResetCaseName()
+		return nil
+	case problemcaserun.FieldFlaky:
+		m.ResetFlaky()
+		return nil
+	case problemcaserun.FieldTimecostMs:
+		m.ResetTimecostMs()
+		return nil
+	case problemcaserun.FieldReportTime:
+		m.ResetReportTime()
+		return nil
+	case problemcaserun.FieldBuildURL:
+		m.ResetBuildURL()
+		return nil
+	case problemcaserun.FieldReason:
+		m.ResetReason()
+		return nil
+	case problemcaserun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown ProblemCaseRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProblemCaseRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProblemCaseRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProblemCaseRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProblemCaseRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProblemCaseRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProblemCaseRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProblemCaseRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProblemCaseRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProblemCaseRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProblemCaseRun edge %s", name)
+}
+
+// TektonRunMutation represents an operation that mutates the TektonRun nodes in the graph.
+type TektonRunMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	run_uid            *string
+	kind               *string
+	namespace          *string
+	name               *string
+	pipeline           *string
+	pipeline_run       *string
+	trigger_user       *string
+	params             *map[string]string
+	status             *string
+	reason             *string
+	failed_tasks       *[]string
+	appendfailed_tasks []string
+	start_time         *time.Time
+	end_time           *time.Time
+	duration_ms        *int
+	addduration_ms     *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*TektonRun, error)
+	predicates         []predicate.TektonRun
+}
+
+var _ ent.Mutation = (*TektonRunMutation)(nil)
+
+// tektonrunOption allows management of the mutation configuration using functional options.
+type tektonrunOption func(*TektonRunMutation)
+
+// newTektonRunMutation creates new mutation for the TektonRun entity.
+func newTektonRunMutation(c config, op Op, opts ...tektonrunOption) *TektonRunMutation {
+	m := &TektonRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTektonRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTektonRunID sets the ID field of the mutation.
+func withTektonRunID(id int) tektonrunOption {
+	return func(m *TektonRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TektonRun
+		)
+		m.oldValue = func(ctx context.Context) (*TektonRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TektonRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTektonRun sets the old TektonRun of the mutation.
+func withTektonRun(node *TektonRun) tektonrunOption {
+	return func(m *TektonRunMutation) {
+		m.oldValue = func(context.Context) (*TektonRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TektonRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TektonRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TektonRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TektonRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TektonRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunUID sets the "run_uid" field.
+func (m *TektonRunMutation) SetRunUID(s string) {
+	m.run_uid = &s
+}
+
+// RunUID returns the value of the "run_uid" field in the mutation.
+func (m *TektonRunMutation) RunUID() (r string, exists bool) {
+	v := m.run_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunUID returns the old "run_uid" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldRunUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunUID: %w", err)
+	}
+	return oldValue.RunUID, nil
+}
+
+// ResetRunUID resets all changes to the "run_uid" field.
+func (m *TektonRunMutation) ResetRunUID() {
+	m.run_uid = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TektonRunMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TektonRunMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TektonRunMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *TektonRunMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *TektonRunMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *TektonRunMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *TektonRunMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TektonRunMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TektonRunMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPipeline sets the "pipeline" field.
+func (m *TektonRunMutation) SetPipeline(s string) {
+	m.pipeline = &s
+}
+
+// Pipeline returns the value of the "pipeline" field in the mutation.
+func (m *TektonRunMutation) Pipeline() (r string, exists bool) {
+	v := m.pipeline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipeline returns the old "pipeline" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldPipeline(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipeline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipeline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipeline: %w", err)
+	}
+	return oldValue.Pipeline, nil
+}
+
+// ResetPipeline resets all changes to the "pipeline" field.
+func (m *TektonRunMutation) ResetPipeline() {
+	m.pipeline = nil
+}
+
+// SetPipelineRun sets the "pipeline_run" field.
+func (m *TektonRunMutation) SetPipelineRun(s string) {
+	m.pipeline_run = &s
+}
+
+// PipelineRun returns the value of the "pipeline_run" field in the mutation.
+func (m *TektonRunMutation) PipelineRun() (r string, exists bool) {
+	v := m.pipeline_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipelineRun returns the old "pipeline_run" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldPipelineRun(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipelineRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipelineRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipelineRun: %w", err)
+	}
+	return oldValue.PipelineRun, nil
+}
+
+// ResetPipelineRun resets all changes to the "pipeline_run" field.
+func (m *TektonRunMutation) ResetPipelineRun() {
+	m.pipeline_run = nil
+}
+
+// SetTriggerUser sets the "trigger_user" field.
+func (m *TektonRunMutation) SetTriggerUser(s string) {
+	m.trigger_user = &s
+}
+
+// TriggerUser returns the value of the "trigger_user" field in the mutation.
+func (m *TektonRunMutation) TriggerUser() (r string, exists bool) {
+	v := m.trigger_user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerUser returns the old "trigger_user" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldTriggerUser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerUser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerUser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerUser: %w", err)
+	}
+	return oldValue.TriggerUser, nil
+}
+
+// ResetTriggerUser resets all changes to the "trigger_user" field.
+func (m *TektonRunMutation) ResetTriggerUser() {
+	m.trigger_user = nil
+}
+
+// SetParams sets the "params" field.
+func (m *TektonRunMutation) SetParams(value map[string]string) {
+	m.params = &value
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *TektonRunMutation) Params() (r map[string]string, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldParams(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// ClearParams clears the value of the "params" field.
+func (m *TektonRunMutation) ClearParams() {
+	m.params = nil
+	m.clearedFields[tektonrun.FieldParams] = struct{}{}
+}
+
+// ParamsCleared returns if the "params" field was cleared in this mutation.
+func (m *TektonRunMutation) ParamsCleared() bool {
+	_, ok := m.clearedFields[tektonrun.FieldParams]
+	return ok
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *TektonRunMutation) ResetParams() {
+	m.params = nil
+	delete(m.clearedFields, tektonrun.FieldParams)
+}
+
+// SetStatus sets the "status" field.
+func (m *TektonRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TektonRunMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TektonRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *TektonRunMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *TektonRunMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *TektonRunMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetFailedTasks sets the "failed_tasks" field.
+func (m *TektonRunMutation) SetFailedTasks(s []string) {
+	m.failed_tasks = &s
+	m.appendfailed_tasks = nil
+}
+
+// FailedTasks returns the value of the "failed_tasks" field in the mutation.
+func (m *TektonRunMutation) FailedTasks() (r []string, exists bool) {
+	v := m.failed_tasks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedTasks returns the old "failed_tasks" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldFailedTasks(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedTasks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedTasks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedTasks: %w", err)
+	}
+	return oldValue.FailedTasks, nil
+}
+
+// AppendFailedTasks adds s to the "failed_tasks" field.
+func (m *TektonRunMutation) AppendFailedTasks(s []string) {
+	m.appendfailed_tasks = append(m.appendfailed_tasks, s...)
+}
+
+// AppendedFailedTasks returns the list of values that were appended to the "failed_tasks" field in this mutation.
+func (m *TektonRunMutation) AppendedFailedTasks() ([]string, bool) {
+	if len(m.appendfailed_tasks) == 0 {
+		return nil, false
+	}
+	return m.appendfailed_tasks, true
+}
+
+// ClearFailedTasks clears the value of the "failed_tasks" field.
+func (m *TektonRunMutation) ClearFailedTasks() {
+	m.failed_tasks = nil
+	m.appendfailed_tasks = nil
+	m.clearedFields[tektonrun.FieldFailedTasks] = struct{}{}
+}
+
+// FailedTasksCleared returns if the "failed_tasks" field was cleared in this mutation.
+func (m *TektonRunMutation) FailedTasksCleared() bool {
+	_, ok := m.clearedFields[tektonrun.FieldFailedTasks]
+	return ok
+}
+
+// ResetFailedTasks resets all changes to the "failed_tasks" field.
+func (m *TektonRunMutation) ResetFailedTasks() {
+	m.failed_tasks = nil
+	m.appendfailed_tasks = nil
+	delete(m.clearedFields, tektonrun.FieldFailedTasks)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *TektonRunMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *TektonRunMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldStartTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (m *TektonRunMutation) ClearStartTime() {
+	m.start_time = nil
+	m.clearedFields[tektonrun.FieldStartTime] = struct{}{}
+}
+
+// StartTimeCleared returns if the "start_time" field was cleared in this mutation.
+func (m *TektonRunMutation) StartTimeCleared() bool {
+	_, ok := m.clearedFields[tektonrun.FieldStartTime]
+	return ok
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *TektonRunMutation) ResetStartTime() {
+	m.start_time = nil
+	delete(m.clearedFields, tektonrun.FieldStartTime)
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *TektonRunMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *TektonRunMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldEndTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (m *TektonRunMutation) ClearEndTime() {
+	m.end_time = nil
+	m.clearedFields[tektonrun.FieldEndTime] = struct{}{}
+}
+
+// EndTimeCleared returns if the "end_time" field was cleared in this mutation.
+func (m *TektonRunMutation) EndTimeCleared() bool {
+	_, ok := m.clearedFields[tektonrun.FieldEndTime]
+	return ok
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *TektonRunMutation) ResetEndTime() {
+	m.end_time = nil
+	delete(m.clearedFields, tektonrun.FieldEndTime)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *TektonRunMutation) SetDurationMs(i int) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *TektonRunMutation) DurationMs() (r int, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldDurationMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *TektonRunMutation) AddDurationMs(i int) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *TektonRunMutation) AddedDurationMs() (r int, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *TektonRunMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TektonRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TektonRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TektonRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TektonRunMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TektonRunMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TektonRun entity.
+// If the TektonRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TektonRunMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TektonRunMutation builder.
+func (m *TektonRunMutation) Where(ps ...predicate.TektonRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TektonRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TektonRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TektonRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TektonRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TektonRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TektonRun).
+func (m *TektonRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TektonRunMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.run_uid != nil {
+		fields = append(fields, tektonrun.FieldRunUID)
+	}
+	if m.kind != nil {
+		fields = append(fields, tektonrun.FieldKind)
+	}
+	if m.namespace != nil {
+		fields = append(fields, tektonrun.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, tektonrun.FieldName)
+	}
+	if m.pipeline != nil {
+		fields = append(fields, tektonrun.FieldPipeline)
+	}
+	if m.pipeline_run != nil {
+		fields = append(fields, tektonrun.FieldPipelineRun)
+	}
+	if m.trigger_user != nil {
+		fields = append(fields, tektonrun.FieldTriggerUser)
+	}
+	if m.params != nil {
+		fields = append(fields, tektonrun.FieldParams)
+	}
+	if m.status != nil {
+		fields = append(fields, tektonrun.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, tektonrun.FieldReason)
+	}
+	if m.failed_tasks != nil {
+		fields = append(fields, tektonrun.FieldFailedTasks)
+	}
+	if m.start_time != nil {
+		fields = append(fields, tektonrun.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, tektonrun.FieldEndTime)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, tektonrun.FieldDurationMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tektonrun.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tektonrun.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TektonRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tektonrun.FieldRunUID:
+		return m.RunUID()
+	case tektonrun.FieldKind:
+		return m.Kind()
+	case tektonrun.FieldNamespace:
+		return m.Namespace()
+	case tektonrun.FieldName:
+		return m.Name()
+	case tektonrun.FieldPipeline:
+		return m.Pipeline()
+	case tektonrun.FieldPipelineRun:
+		return m.PipelineRun()
+	case tektonrun.FieldTriggerUser:
+		return m.TriggerUser()
+	case tektonrun.FieldParams:
+		return m.Params()
+	case tektonrun.FieldStatus:
+		return m.Status()
+	case tektonrun.FieldReason:
+		return m.Reason()
+	case tektonrun.FieldFailedTasks:
+		return m.FailedTasks()
+	case tektonrun.FieldStartTime:
+		return m.StartTime()
+	case tektonrun.FieldEndTime:
+		return m.EndTime()
+	case tektonrun.FieldDurationMs:
+		return m.DurationMs()
+	case tektonrun.FieldCreatedAt:
+		return m.CreatedAt()
+	case tektonrun.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TektonRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tektonrun.FieldRunUID:
+		return m.OldRunUID(ctx)
+	case tektonrun.FieldKind:
+		return m.OldKind(ctx)
+	case tektonrun.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case tektonrun.FieldName:
+		return m.OldName(ctx)
+	case tektonrun.FieldPipeline:
+		return m.OldPipeline(ctx)
+	case tektonrun.FieldPipelineRun:
+		return m.OldPipelineRun(ctx)
+	case tektonrun.FieldTriggerUser:
+		return m.OldTriggerUser(ctx)
+	case tektonrun.FieldParams:
+		return m.OldParams(ctx)
+	case tektonrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case tektonrun.FieldReason:
+		return m.OldReason(ctx)
+	case tektonrun.FieldFailedTasks:
+		return m.OldFailedTasks(ctx)
+	case tektonrun.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case tektonrun.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case tektonrun.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case tektonrun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tektonrun.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TektonRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tektonrun.FieldRunUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunUID(v)
+		return nil
+	case tektonrun.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case tektonrun.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case tektonrun.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tektonrun.FieldPipeline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipeline(v)
+		return nil
+	case tektonrun.FieldPipelineRun:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipelineRun(v)
+		return nil
+	case tektonrun.FieldTriggerUser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerUser(v)
+		return nil
+	case tektonrun.FieldParams:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case tektonrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tektonrun.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case tektonrun.FieldFailedTasks:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedTasks(v)
+		return nil
+	case tektonrun.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case tektonrun.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case tektonrun.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case tektonrun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tektonrun.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TektonRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, tektonrun.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TektonRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tektonrun.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tektonrun.FieldDurationMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TektonRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tektonrun.FieldParams) {
+		fields = append(fields, tektonrun.FieldParams)
+	}
+	if m.FieldCleared(tektonrun.FieldFailedTasks) {
+		fields = append(fields, tektonrun.FieldFailedTasks)
+	}
+	if m.FieldCleared(tektonrun.FieldStartTime) {
+		fields = append(fields, tektonrun.FieldStartTime)
+	}
+	if m.FieldCleared(tektonrun.FieldEndTime) {
+		fields = append(fields, tektonrun.FieldEndTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TektonRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TektonRunMutation) ClearField(name string) error {
+	switch name {
+	case tektonrun.FieldParams:
+		m.ClearParams()
+		return nil
+	case tektonrun.FieldFailedTasks:
+		m.ClearFailedTasks()
+		return nil
+	case tektonrun.FieldStartTime:
+		m.ClearStartTime()
+		return nil
+	case tektonrun.FieldEndTime:
+		m.ClearEndTime()
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TektonRunMutation) ResetField(name string) error {
+	switch name {
+	case tektonrun.FieldRunUID:
+		m.ResetRunUID()
+		return nil
+	case tektonrun.FieldKind:
+		m.ResetKind()
+		return nil
+	case tektonrun.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case tektonrun.FieldName:
+		m.ResetName()
+		return nil
+	case tektonrun.FieldPipeline:
+		m.ResetPipeline()
+		return nil
+	case tektonrun.FieldPipelineRun:
+		m.ResetPipelineRun()
+		return nil
+	case tektonrun.FieldTriggerUser:
+		m.ResetTriggerUser()
+		return nil
+	case tektonrun.FieldParams:
+		m.ResetParams()
+		return nil
+	case tektonrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tektonrun.FieldReason:
+		m.ResetReason()
+		return nil
+	case tektonrun.FieldFailedTasks:
+		m.ResetFailedTasks()
+		return nil
+	case tektonrun.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case tektonrun.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case tektonrun.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case tektonrun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tektonrun.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TektonRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TektonRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TektonRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TektonRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TektonRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TektonRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TektonRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TektonRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TektonRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TektonRun edge %s", name)
+}
+
+// TektonRunFailureMutation represents an operation that mutates the TektonRunFailure nodes in the graph.
+type TektonRunFailureMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	run_uid       *string
+	kind          *string
+	namespace     *string
+	name          *string
+	pipeline      *string
+	category      *string
+	task          *string
+	step          *string
+	reason        *string
+	failed_at     *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TektonRunFailure, error)
+	predicates    []predicate.TektonRunFailure
+}
+
+var _ ent.Mutation = (*TektonRunFailureMutation)(nil)
+
+// tektonrunfailureOption allows management of the mutation configuration using functional options.
+type tektonrunfailureOption func(*TektonRunFailureMutation)
+
+// newTektonRunFailureMutation creates new mutation for the TektonRunFailure entity.
+func newTektonRunFailureMutation(c config, op Op, opts ...tektonrunfailureOption) *TektonRunFailureMutation {
+	m := &TektonRunFailureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTektonRunFailure,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTektonRunFailureID sets the ID field of the mutation.
+func withTektonRunFailureID(id int) tektonrunfailureOption {
+	return func(m *TektonRunFailureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TektonRunFailure
+		)
+		m.oldValue = func(ctx context.Context) (*TektonRunFailure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TektonRunFailure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTektonRunFailure sets the old TektonRunFailure of the mutation.
+func withTektonRunFailure(node *TektonRunFailure) tektonrunfailureOption {
+	return func(m *TektonRunFailureMutation) {
+		m.oldValue = func(context.Context) (*TektonRunFailure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TektonRunFailureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TektonRunFailureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TektonRunFailureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TektonRunFailureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TektonRunFailure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRunUID sets the "run_uid" field.
+func (m *TektonRunFailureMutation) SetRunUID(s string) {
+	m.run_uid = &s
+}
+
+// RunUID returns the value of the "run_uid" field in the mutation.
+func (m *TektonRunFailureMutation) RunUID() (r string, exists bool) {
+	v := m.run_uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunUID returns the old "run_uid" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldRunUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunUID: %w", err)
+	}
+	return oldValue.RunUID, nil
+}
+
+// ResetRunUID resets all changes to the "run_uid" field.
+func (m *TektonRunFailureMutation) ResetRunUID() {
+	m.run_uid = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TektonRunFailureMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TektonRunFailureMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TektonRunFailureMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *TektonRunFailureMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *TektonRunFailureMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *TektonRunFailureMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetName sets the "name" field.
+func (m *TektonRunFailureMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TektonRunFailureMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TektonRunFailureMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPipeline sets the "pipeline" field.
+func (m *TektonRunFailureMutation) SetPipeline(s string) {
+	m.pipeline = &s
+}
+
+// Pipeline returns the value of the "pipeline" field in the mutation.
+func (m *TektonRunFailureMutation) Pipeline() (r string, exists bool) {
+	v := m.pipeline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipeline returns the old "pipeline" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldPipeline(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipeline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipeline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipeline: %w", err)
+	}
+	return oldValue.Pipeline, nil
+}
+
+// ResetPipeline resets all changes to the "pipeline" field.
+func (m *TektonRunFailureMutation) ResetPipeline() {
+	m.pipeline = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *TektonRunFailureMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *TektonRunFailureMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *TektonRunFailureMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetTask sets the "task" field.
+func (m *TektonRunFailureMutation) SetTask(s string) {
+	m.task = &s
+}
+
+// Task returns the value of the "task" field in the mutation.
+func (m *TektonRunFailureMutation) Task() (r string, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTask returns the old "task" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldTask(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTask is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTask requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTask: %w", err)
+	}
+	return oldValue.Task, nil
+}
+
+// ResetTask resets all changes to the "task" field.
+func (m *TektonRunFailureMutation) ResetTask() {
+	m.task = nil
+}
+
+// SetStep sets the "step" field.
+func (m *TektonRunFailureMutation) SetStep(s string) {
+	m.step = &s
+}
+
+// Step returns the value of the "step" field in the mutation.
+func (m *TektonRunFailureMutation) Step() (r string, exists bool) {
+	v := m.step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStep returns the old "step" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldStep(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStep: %w", err)
+	}
+	return oldValue.Step, nil
+}
+
+// ResetStep resets all changes to the "step" field.
+func (m *TektonRunFailureMutation) ResetStep() {
+	m.step = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *TektonRunFailureMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *TektonRunFailureMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *TektonRunFailureMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (m *TektonRunFailureMutation) SetFailedAt(t time.Time) {
+	m.failed_at = &t
+}
+
+// FailedAt returns the value of the "failed_at" field in the mutation.
+func (m *TektonRunFailureMutation) FailedAt() (r time.Time, exists bool) {
+	v := m.failed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedAt returns the old "failed_at" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldFailedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedAt: %w", err)
+	}
+	return oldValue.FailedAt, nil
+}
+
+// ResetFailedAt resets all changes to the "failed_at" field.
+func (m *TektonRunFailureMutation) ResetFailedAt() {
+	m.failed_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TektonRunFailureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TektonRunFailureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TektonRunFailure entity.
+// If the TektonRunFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonRunFailureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TektonRunFailureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TektonRunFailureMutation builder.
+func (m *TektonRunFailureMutation) Where(ps ...predicate.TektonRunFailure) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TektonRunFailureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TektonRunFailureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TektonRunFailure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TektonRunFailureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TektonRunFailureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TektonRunFailure).
+func (m *TektonRunFailureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TektonRunFailureMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.run_uid != nil {
+		fields = append(fields, tektonrunfailure.FieldRunUID)
+	}
+	if m.kind != nil {
+		fields = append(fields, tektonrunfailure.FieldKind)
+	}
+	if m.namespace != nil {
+		fields = append(fields, tektonrunfailure.FieldNamespace)
+	}
+	if m.name != nil {
+		fields = append(fields, tektonrunfailure.FieldName)
+	}
+	if m.pipeline != nil {
+		fields = append(fields, tektonrunfailure.FieldPipeline)
+	}
+	if m.category != nil {
+		fields = append(fields, tektonrunfailure.FieldCategory)
+	}
+	if m.task != nil {
+		fields = append(fields, tektonrunfailure.FieldTask)
+	}
+	if m.step != nil {
+		fields = append(fields, tektonrunfailure.FieldStep)
+	}
+	if m.reason != nil {
+		fields = append(fields, tektonrunfailure.FieldReason)
+	}
+	if m.failed_at != nil {
+		fields = append(fields, tektonrunfailure.FieldFailedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tektonrunfailure.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TektonRunFailureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tektonrunfailure.FieldRunUID:
+		return m.RunUID()
+	case tektonrunfailure.FieldKind:
+		return m.Kind()
+	case tektonrunfailure.FieldNamespace:
+		return m.Namespace()
+	case tektonrunfailure.FieldName:
+		return m.Name()
+	case tektonrunfailure.FieldPipeline:
+		return m.Pipeline()
+	case tektonrunfailure.FieldCategory:
+		return m.Category()
+	case tektonrunfailure.FieldTask:
+		return m.Task()
+	case tektonrunfailure.FieldStep:
+		return m.Step()
+	case tektonrunfailure.FieldReason:
+		return m.Reason()
+	case tektonrunfailure.FieldFailedAt:
+		return m.FailedAt()
+	case tektonrunfailure.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TektonRunFailureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tektonrunfailure.FieldRunUID:
+		return m.OldRunUID(ctx)
+	case tektonrunfailure.FieldKind:
+		return m.OldKind(ctx)
+	case tektonrunfailure.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case tektonrunfailure.FieldName:
+		return m.OldName(ctx)
+	case tektonrunfailure.FieldPipeline:
+		return m.OldPipeline(ctx)
+	case tektonrunfailure.FieldCategory:
+		return m.OldCategory(ctx)
+	case tektonrunfailure.FieldTask:
+		return m.OldTask(ctx)
+	case tektonrunfailure.FieldStep:
+		return m.OldStep(ctx)
+	case tektonrunfailure.FieldReason:
+		return m.OldReason(ctx)
+	case tektonrunfailure.FieldFailedAt:
+		return m.OldFailedAt(ctx)
+	case tektonrunfailure.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TektonRunFailure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonRunFailureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tektonrunfailure.FieldRunUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunUID(v)
+		return nil
+	case tektonrunfailure.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case tektonrunfailure.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case tektonrunfailure.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tektonrunfailure.FieldPipeline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipeline(v)
+		return nil
+	case tektonrunfailure.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case tektonrunfailure.FieldTask:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTask(v)
+		return nil
+	case tektonrunfailure.FieldStep:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStep(v)
+		return nil
+	case tektonrunfailure.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case tektonrunfailure.FieldFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedAt(v)
+		return nil
+	case tektonrunfailure.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRunFailure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TektonRunFailureMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TektonRunFailureMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonRunFailureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TektonRunFailure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TektonRunFailureMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TektonRunFailureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TektonRunFailureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TektonRunFailure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TektonRunFailureMutation) ResetField(name string) error {
+	switch name {
+	case tektonrunfailure.FieldRunUID:
+		m.ResetRunUID()
+		return nil
+	case tektonrunfailure.FieldKind:
+		m.ResetKind()
+		return nil
+	case tektonrunfailure.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case tektonrunfailure.FieldName:
+		m.ResetName()
+		return nil
+	case tektonrunfailure.FieldPipeline:
+		m.ResetPipeline()
+		return nil
+	case tektonrunfailure.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case tektonrunfailure.FieldTask:
+		m.ResetTask()
+		return nil
+	case tektonrunfailure.FieldStep:
+		m.ResetStep()
+		return nil
+	case tektonrunfailure.FieldReason:
+		m.ResetReason()
+		return nil
+	case tektonrunfailure.FieldFailedAt:
+		m.ResetFailedAt()
+		return nil
+	case tektonrunfailure.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TektonRunFailure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TektonRunFailureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TektonRunFailureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TektonRunFailureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TektonRunFailureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TektonRunFailureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TektonRunFailureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TektonRunFailureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TektonRunFailure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TektonRunFailureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TektonRunFailure edge %s", name)
+}
+
+// TektonSubscriptionMutation represents an operation that mutates the TektonSubscription nodes in the graph.
+type TektonSubscriptionMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	receiver          *string
+	namespace         *string
+	pipeline          *string
+	label_selector    *string
+	event_types       *[]string
+	appendevent_types []string
+	created_by        *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*TektonSubscription, error)
+	predicates        []predicate.TektonSubscription
+}
+
+var _ ent.Mutation = (*TektonSubscriptionMutation)(nil)
+
+// tektonsubscriptionOption allows management of the mutation configuration using functional options.
+type tektonsubscriptionOption func(*TektonSubscriptionMutation)
+
+// newTektonSubscriptionMutation creates new mutation for the TektonSubscription entity.
+func newTektonSubscriptionMutation(c config, op Op, opts ...tektonsubscriptionOption) *TektonSubscriptionMutation {
+	m := &TektonSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTektonSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTektonSubscriptionID sets the ID field of the mutation.
+func withTektonSubscriptionID(id int) tektonsubscriptionOption {
+	return func(m *TektonSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TektonSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*TektonSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TektonSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTektonSubscription sets the old TektonSubscription of the mutation.
+func withTektonSubscription(node *TektonSubscription) tektonsubscriptionOption {
+	return func(m *TektonSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*TektonSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TektonSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TektonSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TektonSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TektonSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TektonSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReceiver sets the "receiver" field.
+func (m *TektonSubscriptionMutation) SetReceiver(s string) {
+	m.receiver = &s
+}
+
+// Receiver returns the value of the "receiver" field in the mutation.
+func (m *TektonSubscriptionMutation) Receiver() (r string, exists bool) {
+	v := m.receiver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiver returns the old "receiver" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldReceiver(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiver is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiver requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiver: %w", err)
+	}
+	return oldValue.Receiver, nil
+}
+
+// ResetReceiver resets all changes to the "receiver" field.
+func (m *TektonSubscriptionMutation) ResetReceiver() {
+	m.receiver = nil
+}
+
+// SetNamespace sets the "namespace" field.
+func (m *TektonSubscriptionMutation) SetNamespace(s string) {
+	m.namespace = &s
+}
+
+// Namespace returns the value of the "namespace" field in the mutation.
+func (m *TektonSubscriptionMutation) Namespace() (r string, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespace returns the old "namespace" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldNamespace(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespace: %w", err)
+	}
+	return oldValue.Namespace, nil
+}
+
+// ResetNamespace resets all changes to the "namespace" field.
+func (m *TektonSubscriptionMutation) ResetNamespace() {
+	m.namespace = nil
+}
+
+// SetPipeline sets the "pipeline" field.
+func (m *TektonSubscriptionMutation) SetPipeline(s string) {
+	m.pipeline = &s
+}
+
+// Pipeline returns the value of the "pipeline" field in the mutation.
+func (m *TektonSubscriptionMutation) Pipeline() (r string, exists bool) {
+	v := m.pipeline
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPipeline returns the old "pipeline" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldPipeline(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPipeline is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPipeline requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPipeline: %w", err)
+	}
+	return oldValue.Pipeline, nil
+}
+
+// ResetPipeline resets all changes to the "pipeline" field.
+func (m *TektonSubscriptionMutation) ResetPipeline() {
+	m.pipeline = nil
+}
+
+// SetLabelSelector sets the "label_selector" field.
+func (m *TektonSubscriptionMutation) SetLabelSelector(s string) {
+	m.label_selector = &s
+}
+
+// LabelSelector returns the value of the "label_selector" field in the mutation.
+func (m *TektonSubscriptionMutation) LabelSelector() (r string, exists bool) {
+	v := m.label_selector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabelSelector returns the old "label_selector" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldLabelSelector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabelSelector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabelSelector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabelSelector: %w", err)
+	}
+	return oldValue.LabelSelector, nil
+}
+
+// ResetLabelSelector resets all changes to the "label_selector" field.
+func (m *TektonSubscriptionMutation) ResetLabelSelector() {
+	m.label_selector = nil
+}
+
+// SetEventTypes sets the "event_types" field.
+func (m *TektonSubscriptionMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
+}
+
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *TektonSubscriptionMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventTypes returns the old "event_types" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
+	}
+	return oldValue.EventTypes, nil
+}
+
+// AppendEventTypes adds s to the "event_types" field.
+func (m *TektonSubscriptionMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
+}
+
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *TektonSubscriptionMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
+	}
+	return m.appendevent_types, true
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *TektonSubscriptionMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[tektonsubscription.FieldEventTypes] = struct{}{}
+}
+
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *TektonSubscriptionMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[tektonsubscription.FieldEventTypes]
+	return ok
+}
+
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *TektonSubscriptionMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, tektonsubscription.FieldEventTypes)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *TektonSubscriptionMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *TektonSubscriptionMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *TektonSubscriptionMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TektonSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TektonSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TektonSubscription entity.
+// If the TektonSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TektonSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TektonSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TektonSubscriptionMutation builder.
+func (m *TektonSubscriptionMutation) Where(ps ...predicate.TektonSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TektonSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TektonSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TektonSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TektonSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TektonSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TektonSubscription).
+func (m *TektonSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TektonSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.receiver != nil {
+		fields = append(fields, tektonsubscription.FieldReceiver)
+	}
+	if m.namespace != nil {
+		fields = append(fields, tektonsubscription.FieldNamespace)
+	}
+	if m.pipeline != nil {
+		fields = append(fields, tektonsubscription.FieldPipeline)
+	}
+	if m.label_selector != nil {
+		fields = append(fields, tektonsubscription.FieldLabelSelector)
+	}
+	if m.event_types != nil {
+		fields = append(fields, tektonsubscription.FieldEventTypes)
+	}
+	if m.created_by != nil {
+		fields = append(fields, tektonsubscription.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tektonsubscription.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TektonSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tektonsubscription.FieldReceiver:
+		return m.Receiver()
+	case tektonsubscription.FieldNamespace:
+		return m.Namespace()
+	case tektonsubscription.FieldPipeline:
+		return m.Pipeline()
+	case tektonsubscription.FieldLabelSelector:
+		return m.LabelSelector()
+	case tektonsubscription.FieldEventTypes:
+		return m.EventTypes()
+	case tektonsubscription.FieldCreatedBy:
+		return m.CreatedBy()
+	case tektonsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TektonSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tektonsubscription.FieldReceiver:
+		return m.OldReceiver(ctx)
+	case tektonsubscription.FieldNamespace:
+		return m.OldNamespace(ctx)
+	case tektonsubscription.FieldPipeline:
+		return m.OldPipeline(ctx)
+	case tektonsubscription.FieldLabelSelector:
+		return m.OldLabelSelector(ctx)
+	case tektonsubscription.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case tektonsubscription.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case tektonsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TektonSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tektonsubscription.FieldReceiver:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiver(v)
+		return nil
+	case tektonsubscription.FieldNamespace:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespace(v)
+		return nil
+	case tektonsubscription.FieldPipeline:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPipeline(v)
+		return nil
+	case tektonsubscription.FieldLabelSelector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabelSelector(v)
+		return nil
+	case tektonsubscription.FieldEventTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTypes(v)
+		return nil
+	case tektonsubscription.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case tektonsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TektonSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TektonSubscriptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TektonSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TektonSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TektonSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TektonSubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tektonsubscription.FieldEventTypes) {
+		fields = append(fields, tektonsubscription.FieldEventTypes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TektonSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TektonSubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case tektonsubscription.FieldEventTypes:
+		m.ClearEventTypes()
+		return nil
+	}
+	return fmt.Errorf("unknown TektonSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TektonSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case tektonsubscription.FieldReceiver:
+		m.ResetReceiver()
+		return nil
+	case tektonsubscription.FieldNamespace:
+		m.ResetNamespace()
+		return nil
+	case tektonsubscription.FieldPipeline:
+		m.ResetPipeline()
+		return nil
+	case tektonsubscription.FieldLabelSelector:
+		m.ResetLabelSelector()
+		return nil
+	case tektonsubscription.FieldEventTypes:
+		m.ResetEventTypes()
+		return nil
+	case tektonsubscription.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case tektonsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TektonSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TektonSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TektonSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TektonSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TektonSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TektonSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TektonSubscriptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TektonSubscriptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TektonSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TektonSubscriptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TektonSubscription edge %s", name)
 }
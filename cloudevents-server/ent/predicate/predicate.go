@@ -6,5 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ArchivedEvent is the predicate function for archivedevent builders.
+type ArchivedEvent func(*sql.Selector)
+
+// LarkRunCard is the predicate function for larkruncard builders.
+type LarkRunCard func(*sql.Selector)
+
+// ProblemCaseIssue is the predicate function for problemcaseissue builders.
+type ProblemCaseIssue func(*sql.Selector)
+
 // ProblemCaseRun is the predicate function for problemcaserun builders.
 type ProblemCaseRun func(*sql.Selector)
+
+// TektonRun is the predicate function for tektonrun builders.
+type TektonRun func(*sql.Selector)
+
+// TektonRunFailure is the predicate function for tektonrunfailure builders.
+type TektonRunFailure func(*sql.Selector)
+
+// TektonSubscription is the predicate function for tektonsubscription builders.
+type TektonSubscription func(*sql.Selector)
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
+)
+
+// ProblemCaseIssue is the model entity for the ProblemCaseIssue schema.
+type ProblemCaseIssue struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// repo full name of the test case
+	Repo string `json:"repo,omitempty"`
+	// suite name, target name in bazel.
+	SuiteName string `json:"suite_name,omitempty"`
+	// case name, may be TextXxx.TestYyy format.
+	CaseName string `json:"case_name,omitempty"`
+	// repo full name of the issue
+	IssueRepo string `json:"issue_repo,omitempty"`
+	// github issue number
+	IssueNumber int `json:"issue_number,omitempty"`
+	// github issue html url
+	IssueURL string `json:"issue_url,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProblemCaseIssue) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case problemcaseissue.FieldID, problemcaseissue.FieldIssueNumber:
+			values[i] = new(sql.NullInt64)
+		case problemcaseissue.FieldRepo, problemcaseissue.FieldSuiteName, problemcaseissue.FieldCaseName, problemcaseissue.FieldIssueRepo, problemcaseissue.FieldIssueURL:
+			values[i] = new(sql.NullString)
+		case problemcaseissue.FieldCreatedAt, problemcaseissue.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProblemCaseIssue fields.
+func (_m *ProblemCaseIssue) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case problemcaseissue.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case problemcaseissue.FieldRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field repo", values[i])
+			} else if value.Valid {
+				_m.Repo = value.String
+			}
+		case problemcaseissue.FieldSuiteName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suite_name", values[i])
+			} else if value.Valid {
+				_m.SuiteName = value.String
+			}
+		case problemcaseissue.FieldCaseName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field case_name", values[i])
+			} else if value.Valid {
+				_m.CaseName = value.String
+			}
+		case problemcaseissue.FieldIssueRepo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issue_repo", values[i])
+			} else if value.Valid {
+				_m.IssueRepo = value.String
+			}
+		case problemcaseissue.FieldIssueNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issue_number", values[i])
+			} else if value.Valid {
+				_m.IssueNumber = int(value.Int64)
+			}
+		case problemcaseissue.FieldIssueURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field issue_url", values[i])
+			} else if value.Valid {
+				_m.IssueURL = value.String
+			}
+		case problemcaseissue.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case problemcaseissue.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProblemCaseIssue.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProblemCaseIssue) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProblemCaseIssue.
+// Note that you need to call ProblemCaseIssue.Unwrap() before calling this method if this ProblemCaseIssue
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProblemCaseIssue) Update() *ProblemCaseIssueUpdateOne {
+	return NewProblemCaseIssueClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProblemCaseIssue entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProblemCaseIssue) Unwrap() *ProblemCaseIssue {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProblemCaseIssue is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProblemCaseIssue) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProblemCaseIssue(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("repo=")
+	builder.WriteString(_m.Repo)
+	builder.WriteString(", ")
+	builder.WriteString("suite_name=")
+	builder.WriteString(_m.SuiteName)
+	builder.WriteString(", ")
+	builder.WriteString("case_name=")
+	builder.WriteString(_m.CaseName)
+	builder.WriteString(", ")
+	builder.WriteString("issue_repo=")
+	builder.WriteString(_m.IssueRepo)
+	builder.WriteString(", ")
+	builder.WriteString("issue_number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IssueNumber))
+	builder.WriteString(", ")
+	builder.WriteString("issue_url=")
+	builder.WriteString(_m.IssueURL)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProblemCaseIssues is a parsable slice of ProblemCaseIssue.
+type ProblemCaseIssues []*ProblemCaseIssue
@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package problemcaseissue
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the problemcaseissue type in the database.
+	Label = "problem_case_issue"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRepo holds the string denoting the repo field in the database.
+	FieldRepo = "repo"
+	// FieldSuiteName holds the string denoting the suite_name field in the database.
+	FieldSuiteName = "suite_name"
+	// FieldCaseName holds the string denoting the case_name field in the database.
+	FieldCaseName = "case_name"
+	// FieldIssueRepo holds the string denoting the issue_repo field in the database.
+	FieldIssueRepo = "issue_repo"
+	// FieldIssueNumber holds the string denoting the issue_number field in the database.
+	FieldIssueNumber = "issue_number"
+	// FieldIssueURL holds the string denoting the issue_url field in the database.
+	FieldIssueURL = "issue_url"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the problemcaseissue in the database.
+	Table = "problem_case_issues"
+)
+
+// Columns holds all SQL columns for problemcaseissue fields.
+var Columns = []string{
+	FieldID,
+	FieldRepo,
+	FieldSuiteName,
+	FieldCaseName,
+	FieldIssueRepo,
+	FieldIssueNumber,
+	FieldIssueURL,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IssueURLValidator is a validator for the "issue_url" field. It is called by the builders before save.
+	IssueURLValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ProblemCaseIssue queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRepo orders the results by the repo field.
+func ByRepo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepo, opts...).ToFunc()
+}
+
+// BySuiteName orders the results by the suite_name field.
+func BySuiteName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuiteName, opts...).ToFunc()
+}
+
+// ByCaseName orders the results by the case_name field.
+func ByCaseName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCaseName, opts...).ToFunc()
+}
+
+// ByIssueRepo orders the results by the issue_repo field.
+func ByIssueRepo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssueRepo, opts...).ToFunc()
+}
+
+// ByIssueNumber orders the results by the issue_number field.
+func ByIssueNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssueNumber, opts...).ToFunc()
+}
+
+// ByIssueURL orders the results by the issue_url field.
+func ByIssueURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssueURL, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,555 @@
+// Code generated by ent, DO NOT EDIT.
+
+package problemcaseissue
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldID, id))
+}
+
+// Repo applies equality check predicate on the "repo" field. It's identical to RepoEQ.
+func Repo(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldRepo, v))
+}
+
+// SuiteName applies equality check predicate on the "suite_name" field. It's identical to SuiteNameEQ.
+func SuiteName(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldSuiteName, v))
+}
+
+// CaseName applies equality check predicate on the "case_name" field. It's identical to CaseNameEQ.
+func CaseName(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldCaseName, v))
+}
+
+// IssueRepo applies equality check predicate on the "issue_repo" field. It's identical to IssueRepoEQ.
+func IssueRepo(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueRepo, v))
+}
+
+// IssueNumber applies equality check predicate on the "issue_number" field. It's identical to IssueNumberEQ.
+func IssueNumber(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueNumber, v))
+}
+
+// IssueURL applies equality check predicate on the "issue_url" field. It's identical to IssueURLEQ.
+func IssueURL(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueURL, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RepoEQ applies the EQ predicate on the "repo" field.
+func RepoEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldRepo, v))
+}
+
+// RepoNEQ applies the NEQ predicate on the "repo" field.
+func RepoNEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldRepo, v))
+}
+
+// RepoIn applies the In predicate on the "repo" field.
+func RepoIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldRepo, vs...))
+}
+
+// RepoNotIn applies the NotIn predicate on the "repo" field.
+func RepoNotIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldRepo, vs...))
+}
+
+// RepoGT applies the GT predicate on the "repo" field.
+func RepoGT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldRepo, v))
+}
+
+// RepoGTE applies the GTE predicate on the "repo" field.
+func RepoGTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldRepo, v))
+}
+
+// RepoLT applies the LT predicate on the "repo" field.
+func RepoLT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldRepo, v))
+}
+
+// RepoLTE applies the LTE predicate on the "repo" field.
+func RepoLTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldRepo, v))
+}
+
+// RepoContains applies the Contains predicate on the "repo" field.
+func RepoContains(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContains(FieldRepo, v))
+}
+
+// RepoHasPrefix applies the HasPrefix predicate on the "repo" field.
+func RepoHasPrefix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasPrefix(FieldRepo, v))
+}
+
+// RepoHasSuffix applies the HasSuffix predicate on the "repo" field.
+func RepoHasSuffix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasSuffix(FieldRepo, v))
+}
+
+// RepoEqualFold applies the EqualFold predicate on the "repo" field.
+func RepoEqualFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEqualFold(FieldRepo, v))
+}
+
+// RepoContainsFold applies the ContainsFold predicate on the "repo" field.
+func RepoContainsFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContainsFold(FieldRepo, v))
+}
+
+// SuiteNameEQ applies the EQ predicate on the "suite_name" field.
+func SuiteNameEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldSuiteName, v))
+}
+
+// SuiteNameNEQ applies the NEQ predicate on the "suite_name" field.
+func SuiteNameNEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldSuiteName, v))
+}
+
+// SuiteNameIn applies the In predicate on the "suite_name" field.
+func SuiteNameIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldSuiteName, vs...))
+}
+
+// SuiteNameNotIn applies the NotIn predicate on the "suite_name" field.
+func SuiteNameNotIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldSuiteName, vs...))
+}
+
+// SuiteNameGT applies the GT predicate on the "suite_name" field.
+func SuiteNameGT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldSuiteName, v))
+}
+
+// SuiteNameGTE applies the GTE predicate on the "suite_name" field.
+func SuiteNameGTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldSuiteName, v))
+}
+
+// SuiteNameLT applies the LT predicate on the "suite_name" field.
+func SuiteNameLT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldSuiteName, v))
+}
+
+// SuiteNameLTE applies the LTE predicate on the "suite_name" field.
+func SuiteNameLTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldSuiteName, v))
+}
+
+// SuiteNameContains applies the Contains predicate on the "suite_name" field.
+func SuiteNameContains(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContains(FieldSuiteName, v))
+}
+
+// SuiteNameHasPrefix applies the HasPrefix predicate on the "suite_name" field.
+func SuiteNameHasPrefix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasPrefix(FieldSuiteName, v))
+}
+
+// SuiteNameHasSuffix applies the HasSuffix predicate on the "suite_name" field.
+func SuiteNameHasSuffix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasSuffix(FieldSuiteName, v))
+}
+
+// SuiteNameEqualFold applies the EqualFold predicate on the "suite_name" field.
+func SuiteNameEqualFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEqualFold(FieldSuiteName, v))
+}
+
+// SuiteNameContainsFold applies the ContainsFold predicate on the "suite_name" field.
+func SuiteNameContainsFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContainsFold(FieldSuiteName, v))
+}
+
+// CaseNameEQ applies the EQ predicate on the "case_name" field.
+func CaseNameEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldCaseName, v))
+}
+
+// CaseNameNEQ applies the NEQ predicate on the "case_name" field.
+func CaseNameNEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldCaseName, v))
+}
+
+// CaseNameIn applies the In predicate on the "case_name" field.
+func CaseNameIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldCaseName, vs...))
+}
+
+// CaseNameNotIn applies the NotIn predicate on the "case_name" field.
+func CaseNameNotIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldCaseName, vs...))
+}
+
+// CaseNameGT applies the GT predicate on the "case_name" field.
+func CaseNameGT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldCaseName, v))
+}
+
+// CaseNameGTE applies the GTE predicate on the "case_name" field.
+func CaseNameGTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldCaseName, v))
+}
+
+// CaseNameLT applies the LT predicate on the "case_name" field.
+func CaseNameLT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldCaseName, v))
+}
+
+// CaseNameLTE applies the LTE predicate on the "case_name" field.
+func CaseNameLTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldCaseName, v))
+}
+
+// CaseNameContains applies the Contains predicate on the "case_name" field.
+func CaseNameContains(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContains(FieldCaseName, v))
+}
+
+// CaseNameHasPrefix applies the HasPrefix predicate on the "case_name" field.
+func CaseNameHasPrefix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasPrefix(FieldCaseName, v))
+}
+
+// CaseNameHasSuffix applies the HasSuffix predicate on the "case_name" field.
+func CaseNameHasSuffix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasSuffix(FieldCaseName, v))
+}
+
+// CaseNameEqualFold applies the EqualFold predicate on the "case_name" field.
+func CaseNameEqualFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEqualFold(FieldCaseName, v))
+}
+
+// CaseNameContainsFold applies the ContainsFold predicate on the "case_name" field.
+func CaseNameContainsFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContainsFold(FieldCaseName, v))
+}
+
+// IssueRepoEQ applies the EQ predicate on the "issue_repo" field.
+func IssueRepoEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueRepo, v))
+}
+
+// IssueRepoNEQ applies the NEQ predicate on the "issue_repo" field.
+func IssueRepoNEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldIssueRepo, v))
+}
+
+// IssueRepoIn applies the In predicate on the "issue_repo" field.
+func IssueRepoIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldIssueRepo, vs...))
+}
+
+// IssueRepoNotIn applies the NotIn predicate on the "issue_repo" field.
+func IssueRepoNotIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldIssueRepo, vs...))
+}
+
+// IssueRepoGT applies the GT predicate on the "issue_repo" field.
+func IssueRepoGT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldIssueRepo, v))
+}
+
+// IssueRepoGTE applies the GTE predicate on the "issue_repo" field.
+func IssueRepoGTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldIssueRepo, v))
+}
+
+// IssueRepoLT applies the LT predicate on the "issue_repo" field.
+func IssueRepoLT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldIssueRepo, v))
+}
+
+// IssueRepoLTE applies the LTE predicate on the "issue_repo" field.
+func IssueRepoLTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldIssueRepo, v))
+}
+
+// IssueRepoContains applies the Contains predicate on the "issue_repo" field.
+func IssueRepoContains(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContains(FieldIssueRepo, v))
+}
+
+// IssueRepoHasPrefix applies the HasPrefix predicate on the "issue_repo" field.
+func IssueRepoHasPrefix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasPrefix(FieldIssueRepo, v))
+}
+
+// IssueRepoHasSuffix applies the HasSuffix predicate on the "issue_repo" field.
+func IssueRepoHasSuffix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasSuffix(FieldIssueRepo, v))
+}
+
+// IssueRepoEqualFold applies the EqualFold predicate on the "issue_repo" field.
+func IssueRepoEqualFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEqualFold(FieldIssueRepo, v))
+}
+
+// IssueRepoContainsFold applies the ContainsFold predicate on the "issue_repo" field.
+func IssueRepoContainsFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContainsFold(FieldIssueRepo, v))
+}
+
+// IssueNumberEQ applies the EQ predicate on the "issue_number" field.
+func IssueNumberEQ(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueNumber, v))
+}
+
+// IssueNumberNEQ applies the NEQ predicate on the "issue_number" field.
+func IssueNumberNEQ(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldIssueNumber, v))
+}
+
+// IssueNumberIn applies the In predicate on the "issue_number" field.
+func IssueNumberIn(vs ...int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldIssueNumber, vs...))
+}
+
+// IssueNumberNotIn applies the NotIn predicate on the "issue_number" field.
+func IssueNumberNotIn(vs ...int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldIssueNumber, vs...))
+}
+
+// IssueNumberGT applies the GT predicate on the "issue_number" field.
+func IssueNumberGT(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldIssueNumber, v))
+}
+
+// IssueNumberGTE applies the GTE predicate on the "issue_number" field.
+func IssueNumberGTE(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldIssueNumber, v))
+}
+
+// IssueNumberLT applies the LT predicate on the "issue_number" field.
+func IssueNumberLT(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldIssueNumber, v))
+}
+
+// IssueNumberLTE applies the LTE predicate on the "issue_number" field.
+func IssueNumberLTE(v int) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldIssueNumber, v))
+}
+
+// IssueURLEQ applies the EQ predicate on the "issue_url" field.
+func IssueURLEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldIssueURL, v))
+}
+
+// IssueURLNEQ applies the NEQ predicate on the "issue_url" field.
+func IssueURLNEQ(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldIssueURL, v))
+}
+
+// IssueURLIn applies the In predicate on the "issue_url" field.
+func IssueURLIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldIssueURL, vs...))
+}
+
+// IssueURLNotIn applies the NotIn predicate on the "issue_url" field.
+func IssueURLNotIn(vs ...string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldIssueURL, vs...))
+}
+
+// IssueURLGT applies the GT predicate on the "issue_url" field.
+func IssueURLGT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldIssueURL, v))
+}
+
+// IssueURLGTE applies the GTE predicate on the "issue_url" field.
+func IssueURLGTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldIssueURL, v))
+}
+
+// IssueURLLT applies the LT predicate on the "issue_url" field.
+func IssueURLLT(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldIssueURL, v))
+}
+
+// IssueURLLTE applies the LTE predicate on the "issue_url" field.
+func IssueURLLTE(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldIssueURL, v))
+}
+
+// IssueURLContains applies the Contains predicate on the "issue_url" field.
+func IssueURLContains(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContains(FieldIssueURL, v))
+}
+
+// IssueURLHasPrefix applies the HasPrefix predicate on the "issue_url" field.
+func IssueURLHasPrefix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasPrefix(FieldIssueURL, v))
+}
+
+// IssueURLHasSuffix applies the HasSuffix predicate on the "issue_url" field.
+func IssueURLHasSuffix(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldHasSuffix(FieldIssueURL, v))
+}
+
+// IssueURLEqualFold applies the EqualFold predicate on the "issue_url" field.
+func IssueURLEqualFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEqualFold(FieldIssueURL, v))
+}
+
+// IssueURLContainsFold applies the ContainsFold predicate on the "issue_url" field.
+func IssueURLContainsFold(v string) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldContainsFold(FieldIssueURL, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProblemCaseIssue) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProblemCaseIssue) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProblemCaseIssue) predicate.ProblemCaseIssue {
+	return predicate.ProblemCaseIssue(sql.NotPredicates(p))
+}
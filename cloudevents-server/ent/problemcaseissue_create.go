@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
+)
+
+// ProblemCaseIssueCreate is the builder for creating a ProblemCaseIssue entity.
+type ProblemCaseIssueCreate struct {
+	config
+	mutation *ProblemCaseIssueMutation
+	hooks    []Hook
+}
+
+// SetRepo sets the "repo" field.
+func (_c *ProblemCaseIssueCreate) SetRepo(v string) *ProblemCaseIssueCreate {
+	_c.mutation.SetRepo(v)
+	return _c
+}
+
+// SetSuiteName sets the "suite_name" field.
+func (_c *ProblemCaseIssueCreate) SetSuiteName(v string) *ProblemCaseIssueCreate {
+	_c.mutation.SetSuiteName(v)
+	return _c
+}
+
+// SetCaseName sets the "case_name" field.
+func (_c *ProblemCaseIssueCreate) SetCaseName(v string) *ProblemCaseIssueCreate {
+	_c.mutation.SetCaseName(v)
+	return _c
+}
+
+// SetIssueRepo sets the "issue_repo" field.
+func (_c *ProblemCaseIssueCreate) SetIssueRepo(v string) *ProblemCaseIssueCreate {
+	_c.mutation.SetIssueRepo(v)
+	return _c
+}
+
+// SetIssueNumber sets the "issue_number" field.
+func (_c *ProblemCaseIssueCreate) SetIssueNumber(v int) *ProblemCaseIssueCreate {
+	_c.mutation.SetIssueNumber(v)
+	return _c
+}
+
+// SetIssueURL sets the "issue_url" field.
+func (_c *ProblemCaseIssueCreate) SetIssueURL(v string) *ProblemCaseIssueCreate {
+	_c.mutation.SetIssueURL(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProblemCaseIssueCreate) SetCreatedAt(v time.Time) *ProblemCaseIssueCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProblemCaseIssueCreate) SetNillableCreatedAt(v *time.Time) *ProblemCaseIssueCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProblemCaseIssueCreate) SetUpdatedAt(v time.Time) *ProblemCaseIssueCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProblemCaseIssueCreate) SetNillableUpdatedAt(v *time.Time) *ProblemCaseIssueCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProblemCaseIssueMutation object of the builder.
+func (_c *ProblemCaseIssueCreate) Mutation() *ProblemCaseIssueMutation {
+	return _c.mutation
+}
+
+// Save creates the ProblemCaseIssue in the database.
+func (_c *ProblemCaseIssueCreate) Save(ctx context.Context) (*ProblemCaseIssue, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProblemCaseIssueCreate) SaveX(ctx context.Context) *ProblemCaseIssue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProblemCaseIssueCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProblemCaseIssueCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProblemCaseIssueCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := problemcaseissue.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := problemcaseissue.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProblemCaseIssueCreate) check() error {
+	if _, ok := _c.mutation.Repo(); !ok {
+		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "ProblemCaseIssue.repo"`)}
+	}
+	if _, ok := _c.mutation.SuiteName(); !ok {
+		return &ValidationError{Name: "suite_name", err: errors.New(`ent: missing required field "ProblemCaseIssue.suite_name"`)}
+	}
+	if _, ok := _c.mutation.CaseName(); !ok {
+		return &ValidationError{Name: "case_name", err: errors.New(`ent: missing required field "ProblemCaseIssue.case_name"`)}
+	}
+	if _, ok := _c.mutation.IssueRepo(); !ok {
+		return &ValidationError{Name: "issue_repo", err: errors.New(`ent: missing required field "ProblemCaseIssue.issue_repo"`)}
+	}
+	if _, ok := _c.mutation.IssueNumber(); !ok {
+		return &ValidationError{Name: "issue_number", err: errors.New(`ent: missing required field "ProblemCaseIssue.issue_number"`)}
+	}
+	if _, ok := _c.mutation.IssueURL(); !ok {
+		return &ValidationError{Name: "issue_url", err: errors.New(`ent: missing required field "ProblemCaseIssue.issue_url"`)}
+	}
+	if v, ok := _c.mutation.IssueURL(); ok {
+		if err := problemcaseissue.IssueURLValidator(v); err != nil {
+			return &ValidationError{Name: "issue_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseIssue.issue_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProblemCaseIssue.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProblemCaseIssue.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProblemCaseIssueCreate) sqlSave(ctx context.Context) (*ProblemCaseIssue, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProblemCaseIssueCreate) createSpec() (*ProblemCaseIssue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProblemCaseIssue{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(problemcaseissue.Table, sqlgraph.NewFieldSpec(problemcaseissue.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Repo(); ok {
+		_spec.SetField(problemcaseissue.FieldRepo, field.TypeString, value)
+		_node.Repo = value
+	}
+	if value, ok := _c.mutation.SuiteName(); ok {
+		_spec.SetField(problemcaseissue.FieldSuiteName, field.TypeString, value)
+		_node.SuiteName = value
+	}
+	if value, ok := _c.mutation.CaseName(); ok {
+		_spec.SetField(problemcaseissue.FieldCaseName, field.TypeString, value)
+		_node.CaseName = value
+	}
+	if value, ok := _c.mutation.IssueRepo(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueRepo, field.TypeString, value)
+		_node.IssueRepo = value
+	}
+	if value, ok := _c.mutation.IssueNumber(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueNumber, field.TypeInt, value)
+		_node.IssueNumber = value
+	}
+	if value, ok := _c.mutation.IssueURL(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueURL, field.TypeString, value)
+		_node.IssueURL = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(problemcaseissue.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(problemcaseissue.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProblemCaseIssueCreateBulk is the builder for creating many ProblemCaseIssue entities in bulk.
+type ProblemCaseIssueCreateBulk struct {
+	config
+	err      error
+	builders []*ProblemCaseIssueCreate
+}
+
+// Save creates the ProblemCaseIssue entities in the database.
+func (_c *ProblemCaseIssueCreateBulk) Save(ctx context.Context) ([]*ProblemCaseIssue, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProblemCaseIssue, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProblemCaseIssueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProblemCaseIssueCreateBulk) SaveX(ctx context.Context) []*ProblemCaseIssue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProblemCaseIssueCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProblemCaseIssueCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
+)
+
+// ProblemCaseIssueDelete is the builder for deleting a ProblemCaseIssue entity.
+type ProblemCaseIssueDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProblemCaseIssueMutation
+}
+
+// Where appends a list predicates to the ProblemCaseIssueDelete builder.
+func (_d *ProblemCaseIssueDelete) Where(ps ...predicate.ProblemCaseIssue) *ProblemCaseIssueDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ProblemCaseIssueDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProblemCaseIssueDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ProblemCaseIssueDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(problemcaseissue.Table, sqlgraph.NewFieldSpec(problemcaseissue.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ProblemCaseIssueDeleteOne is the builder for deleting a single ProblemCaseIssue entity.
+type ProblemCaseIssueDeleteOne struct {
+	_d *ProblemCaseIssueDelete
+}
+
+// Where appends a list predicates to the ProblemCaseIssueDelete builder.
+func (_d *ProblemCaseIssueDeleteOne) Where(ps ...predicate.ProblemCaseIssue) *ProblemCaseIssueDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ProblemCaseIssueDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{problemcaseissue.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ProblemCaseIssueDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,462 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
+)
+
+// ProblemCaseIssueUpdate is the builder for updating ProblemCaseIssue entities.
+type ProblemCaseIssueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProblemCaseIssueMutation
+}
+
+// Where appends a list predicates to the ProblemCaseIssueUpdate builder.
+func (_u *ProblemCaseIssueUpdate) Where(ps ...predicate.ProblemCaseIssue) *ProblemCaseIssueUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ProblemCaseIssueUpdate) SetRepo(v string) *ProblemCaseIssueUpdate {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableRepo(v *string) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetSuiteName sets the "suite_name" field.
+func (_u *ProblemCaseIssueUpdate) SetSuiteName(v string) *ProblemCaseIssueUpdate {
+	_u.mutation.SetSuiteName(v)
+	return _u
+}
+
+// SetNillableSuiteName sets the "suite_name" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableSuiteName(v *string) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetSuiteName(*v)
+	}
+	return _u
+}
+
+// SetCaseName sets the "case_name" field.
+func (_u *ProblemCaseIssueUpdate) SetCaseName(v string) *ProblemCaseIssueUpdate {
+	_u.mutation.SetCaseName(v)
+	return _u
+}
+
+// SetNillableCaseName sets the "case_name" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableCaseName(v *string) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetCaseName(*v)
+	}
+	return _u
+}
+
+// SetIssueRepo sets the "issue_repo" field.
+func (_u *ProblemCaseIssueUpdate) SetIssueRepo(v string) *ProblemCaseIssueUpdate {
+	_u.mutation.SetIssueRepo(v)
+	return _u
+}
+
+// SetNillableIssueRepo sets the "issue_repo" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableIssueRepo(v *string) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetIssueRepo(*v)
+	}
+	return _u
+}
+
+// SetIssueNumber sets the "issue_number" field.
+func (_u *ProblemCaseIssueUpdate) SetIssueNumber(v int) *ProblemCaseIssueUpdate {
+	_u.mutation.ResetIssueNumber()
+	_u.mutation.SetIssueNumber(v)
+	return _u
+}
+
+// SetNillableIssueNumber sets the "issue_number" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableIssueNumber(v *int) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetIssueNumber(*v)
+	}
+	return _u
+}
+
+// AddIssueNumber adds value to the "issue_number" field.
+func (_u *ProblemCaseIssueUpdate) AddIssueNumber(v int) *ProblemCaseIssueUpdate {
+	_u.mutation.AddIssueNumber(v)
+	return _u
+}
+
+// SetIssueURL sets the "issue_url" field.
+func (_u *ProblemCaseIssueUpdate) SetIssueURL(v string) *ProblemCaseIssueUpdate {
+	_u.mutation.SetIssueURL(v)
+	return _u
+}
+
+// SetNillableIssueURL sets the "issue_url" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdate) SetNillableIssueURL(v *string) *ProblemCaseIssueUpdate {
+	if v != nil {
+		_u.SetIssueURL(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProblemCaseIssueUpdate) SetUpdatedAt(v time.Time) *ProblemCaseIssueUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProblemCaseIssueMutation object of the builder.
+func (_u *ProblemCaseIssueUpdate) Mutation() *ProblemCaseIssueMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProblemCaseIssueUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProblemCaseIssueUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProblemCaseIssueUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProblemCaseIssueUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProblemCaseIssueUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := problemcaseissue.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProblemCaseIssueUpdate) check() error {
+	if v, ok := _u.mutation.IssueURL(); ok {
+		if err := problemcaseissue.IssueURLValidator(v); err != nil {
+			return &ValidationError{Name: "issue_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseIssue.issue_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProblemCaseIssueUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(problemcaseissue.Table, problemcaseissue.Columns, sqlgraph.NewFieldSpec(problemcaseissue.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(problemcaseissue.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SuiteName(); ok {
+		_spec.SetField(problemcaseissue.FieldSuiteName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CaseName(); ok {
+		_spec.SetField(problemcaseissue.FieldCaseName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssueRepo(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssueNumber(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueNumber(); ok {
+		_spec.AddField(problemcaseissue.FieldIssueNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueURL(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(problemcaseissue.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{problemcaseissue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProblemCaseIssueUpdateOne is the builder for updating a single ProblemCaseIssue entity.
+type ProblemCaseIssueUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProblemCaseIssueMutation
+}
+
+// SetRepo sets the "repo" field.
+func (_u *ProblemCaseIssueUpdateOne) SetRepo(v string) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetRepo(v)
+	return _u
+}
+
+// SetNillableRepo sets the "repo" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableRepo(v *string) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetRepo(*v)
+	}
+	return _u
+}
+
+// SetSuiteName sets the "suite_name" field.
+func (_u *ProblemCaseIssueUpdateOne) SetSuiteName(v string) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetSuiteName(v)
+	return _u
+}
+
+// SetNillableSuiteName sets the "suite_name" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableSuiteName(v *string) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetSuiteName(*v)
+	}
+	return _u
+}
+
+// SetCaseName sets the "case_name" field.
+func (_u *ProblemCaseIssueUpdateOne) SetCaseName(v string) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetCaseName(v)
+	return _u
+}
+
+// SetNillableCaseName sets the "case_name" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableCaseName(v *string) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetCaseName(*v)
+	}
+	return _u
+}
+
+// SetIssueRepo sets the "issue_repo" field.
+func (_u *ProblemCaseIssueUpdateOne) SetIssueRepo(v string) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetIssueRepo(v)
+	return _u
+}
+
+// SetNillableIssueRepo sets the "issue_repo" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableIssueRepo(v *string) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetIssueRepo(*v)
+	}
+	return _u
+}
+
+// SetIssueNumber sets the "issue_number" field.
+func (_u *ProblemCaseIssueUpdateOne) SetIssueNumber(v int) *ProblemCaseIssueUpdateOne {
+	_u.mutation.ResetIssueNumber()
+	_u.mutation.SetIssueNumber(v)
+	return _u
+}
+
+// SetNillableIssueNumber sets the "issue_number" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableIssueNumber(v *int) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetIssueNumber(*v)
+	}
+	return _u
+}
+
+// AddIssueNumber adds value to the "issue_number" field.
+func (_u *ProblemCaseIssueUpdateOne) AddIssueNumber(v int) *ProblemCaseIssueUpdateOne {
+	_u.mutation.AddIssueNumber(v)
+	return _u
+}
+
+// SetIssueURL sets the "issue_url" field.
+func (_u *ProblemCaseIssueUpdateOne) SetIssueURL(v string) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetIssueURL(v)
+	return _u
+}
+
+// SetNillableIssueURL sets the "issue_url" field if the given value is not nil.
+func (_u *ProblemCaseIssueUpdateOne) SetNillableIssueURL(v *string) *ProblemCaseIssueUpdateOne {
+	if v != nil {
+		_u.SetIssueURL(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProblemCaseIssueUpdateOne) SetUpdatedAt(v time.Time) *ProblemCaseIssueUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProblemCaseIssueMutation object of the builder.
+func (_u *ProblemCaseIssueUpdateOne) Mutation() *ProblemCaseIssueMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProblemCaseIssueUpdate builder.
+func (_u *ProblemCaseIssueUpdateOne) Where(ps ...predicate.ProblemCaseIssue) *ProblemCaseIssueUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProblemCaseIssueUpdateOne) Select(field string, fields ...string) *ProblemCaseIssueUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProblemCaseIssue entity.
+func (_u *ProblemCaseIssueUpdateOne) Save(ctx context.Context) (*ProblemCaseIssue, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProblemCaseIssueUpdateOne) SaveX(ctx context.Context) *ProblemCaseIssue {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProblemCaseIssueUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProblemCaseIssueUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProblemCaseIssueUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := problemcaseissue.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProblemCaseIssueUpdateOne) check() error {
+	if v, ok := _u.mutation.IssueURL(); ok {
+		if err := problemcaseissue.IssueURLValidator(v); err != nil {
+			return &ValidationError{Name: "issue_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseIssue.issue_url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProblemCaseIssueUpdateOne) sqlSave(ctx context.Context) (_node *ProblemCaseIssue, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(problemcaseissue.Table, problemcaseissue.Columns, sqlgraph.NewFieldSpec(problemcaseissue.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProblemCaseIssue.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, problemcaseissue.FieldID)
+		for _, f := range fields {
+			if !problemcaseissue.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != problemcaseissue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Repo(); ok {
+		_spec.SetField(problemcaseissue.FieldRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SuiteName(); ok {
+		_spec.SetField(problemcaseissue.FieldSuiteName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CaseName(); ok {
+		_spec.SetField(problemcaseissue.FieldCaseName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssueRepo(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueRepo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssueNumber(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedIssueNumber(); ok {
+		_spec.AddField(problemcaseissue.FieldIssueNumber, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssueURL(); ok {
+		_spec.SetField(problemcaseissue.FieldIssueURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(problemcaseissue.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProblemCaseIssue{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{problemcaseissue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -34,7 +34,9 @@ type ProblemCaseRun struct {
 	// CI build url
 	BuildURL string `json:"build_url,omitempty"`
 	// failure reason
-	Reason       string `json:"reason,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	// run status: passed, failed, skipped or flaky, empty for the legacy records
+	Status       string `json:"status,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -47,7 +49,7 @@ func (*ProblemCaseRun) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case problemcaserun.FieldID, problemcaserun.FieldTimecostMs:
 			values[i] = new(sql.NullInt64)
-		case problemcaserun.FieldRepo, problemcaserun.FieldBranch, problemcaserun.FieldSuiteName, problemcaserun.FieldCaseName, problemcaserun.FieldBuildURL, problemcaserun.FieldReason:
+		case problemcaserun.FieldRepo, problemcaserun.FieldBranch, problemcaserun.FieldSuiteName, problemcaserun.FieldCaseName, problemcaserun.FieldBuildURL, problemcaserun.FieldReason, problemcaserun.FieldStatus:
 			values[i] = new(sql.NullString)
 		case problemcaserun.FieldReportTime:
 			values[i] = new(sql.NullTime)
@@ -60,7 +62,7 @@ func (*ProblemCaseRun) scanValues(columns []string) ([]any, error) {
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
 // to the ProblemCaseRun fields.
-func (pcr *ProblemCaseRun) assignValues(columns []string, values []any) error {
+func (_m *ProblemCaseRun) assignValues(columns []string, values []any) error {
 	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
@@ -71,63 +73,69 @@ func (pcr *ProblemCaseRun) assignValues(columns []string, values []any) error {
 			if !ok {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
-			pcr.ID = int(value.Int64)
+			_m.ID = int(value.Int64)
 		case problemcaserun.FieldRepo:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field repo", values[i])
 			} else if value.Valid {
-				pcr.Repo = value.String
+				_m.Repo = value.String
 			}
 		case problemcaserun.FieldBranch:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field branch", values[i])
 			} else if value.Valid {
-				pcr.Branch = value.String
+				_m.Branch = value.String
 			}
 		case problemcaserun.FieldSuiteName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field suite_name", values[i])
 			} else if value.Valid {
-				pcr.SuiteName = value.String
+				_m.SuiteName = value.String
 			}
 		case problemcaserun.FieldCaseName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field case_name", values[i])
 			} else if value.Valid {
-				pcr.CaseName = value.String
+				_m.CaseName = value.String
 			}
 		case problemcaserun.FieldFlaky:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field flaky", values[i])
 			} else if value.Valid {
-				pcr.Flaky = value.Bool
+				_m.Flaky = value.Bool
 			}
 		case problemcaserun.FieldTimecostMs:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field timecost_ms", values[i])
 			} else if value.Valid {
-				pcr.TimecostMs = int(value.Int64)
+				_m.TimecostMs = int(value.Int64)
 			}
 		case problemcaserun.FieldReportTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field report_time", values[i])
 			} else if value.Valid {
-				pcr.ReportTime = value.Time
+				_m.ReportTime = value.Time
 			}
 		case problemcaserun.FieldBuildURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field build_url", values[i])
 			} else if value.Valid {
-				pcr.BuildURL = value.String
+				_m.BuildURL = value.String
 			}
 		case problemcaserun.FieldReason:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field reason", values[i])
 			} else if value.Valid {
-				pcr.Reason = value.String
+				_m.Reason = value.String
+			}
+		case problemcaserun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
 			}
 		default:
-			pcr.selectValues.Set(columns[i], values[i])
+			_m.selectValues.Set(columns[i], values[i])
 		}
 	}
 	return nil
@@ -135,59 +143,62 @@ func (pcr *ProblemCaseRun) assignValues(columns []string, values []any) error {
 
 // Value returns the ent.Value that was dynamically selected and assigned to the ProblemCaseRun.
 // This includes values selected through modifiers, order, etc.
-func (pcr *ProblemCaseRun) Value(name string) (ent.Value, error) {
-	return pcr.selectValues.Get(name)
+func (_m *ProblemCaseRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
 }
 
 // Update returns a builder for updating this ProblemCaseRun.
 // Note that you need to call ProblemCaseRun.Unwrap() before calling this method if this ProblemCaseRun
 // was returned from a transaction, and the transaction was committed or rolled back.
-func (pcr *ProblemCaseRun) Update() *ProblemCaseRunUpdateOne {
-	return NewProblemCaseRunClient(pcr.config).UpdateOne(pcr)
+func (_m *ProblemCaseRun) Update() *ProblemCaseRunUpdateOne {
+	return NewProblemCaseRunClient(_m.config).UpdateOne(_m)
 }
 
 // Unwrap unwraps the ProblemCaseRun entity that was returned from a transaction after it was closed,
 // so that all future queries will be executed through the driver which created the transaction.
-func (pcr *ProblemCaseRun) Unwrap() *ProblemCaseRun {
-	_tx, ok := pcr.config.driver.(*txDriver)
+func (_m *ProblemCaseRun) Unwrap() *ProblemCaseRun {
+	_tx, ok := _m.config.driver.(*txDriver)
 	if !ok {
 		panic("ent: ProblemCaseRun is not a transactional entity")
 	}
-	pcr.config.driver = _tx.drv
-	return pcr
+	_m.config.driver = _tx.drv
+	return _m
 }
 
 // String implements the fmt.Stringer.
-func (pcr *ProblemCaseRun) String() string {
+func (_m *ProblemCaseRun) String() string {
 	var builder strings.Builder
 	builder.WriteString("ProblemCaseRun(")
-	builder.WriteString(fmt.Sprintf("id=%v, ", pcr.ID))
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("repo=")
-	builder.WriteString(pcr.Repo)
+	builder.WriteString(_m.Repo)
 	builder.WriteString(", ")
 	builder.WriteString("branch=")
-	builder.WriteString(pcr.Branch)
+	builder.WriteString(_m.Branch)
 	builder.WriteString(", ")
 	builder.WriteString("suite_name=")
-	builder.WriteString(pcr.SuiteName)
+	builder.WriteString(_m.SuiteName)
 	builder.WriteString(", ")
 	builder.WriteString("case_name=")
-	builder.WriteString(pcr.CaseName)
+	builder.WriteString(_m.CaseName)
 	builder.WriteString(", ")
 	builder.WriteString("flaky=")
-	builder.WriteString(fmt.Sprintf("%v", pcr.Flaky))
+	builder.WriteString(fmt.Sprintf("%v", _m.Flaky))
 	builder.WriteString(", ")
 	builder.WriteString("timecost_ms=")
-	builder.WriteString(fmt.Sprintf("%v", pcr.TimecostMs))
+	builder.WriteString(fmt.Sprintf("%v", _m.TimecostMs))
 	builder.WriteString(", ")
 	builder.WriteString("report_time=")
-	builder.WriteString(pcr.ReportTime.Format(time.ANSIC))
+	builder.WriteString(_m.ReportTime.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("build_url=")
-	builder.WriteString(pcr.BuildURL)
+	builder.WriteString(_m.BuildURL)
 	builder.WriteString(", ")
 	builder.WriteString("reason=")
-	builder.WriteString(pcr.Reason)
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -29,6 +29,8 @@ const (
 	FieldBuildURL = "build_url"
 	// FieldReason holds the string denoting the reason field in the database.
 	FieldReason = "reason"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// Table holds the table name of the problemcaserun in the database.
 	Table = "problem_case_runs"
 )
@@ -45,6 +47,7 @@ var Columns = []string{
 	FieldReportTime,
 	FieldBuildURL,
 	FieldReason,
+	FieldStatus,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -64,6 +67,8 @@ var (
 	BuildURLValidator func(string) error
 	// ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	ReasonValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
 )
 
 // OrderOption defines the ordering options for the ProblemCaseRun queries.
@@ -118,3 +123,8 @@ func ByBuildURL(opts ...sql.OrderTermOption) OrderOption {
 func ByReason(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReason, opts...).ToFunc()
 }
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
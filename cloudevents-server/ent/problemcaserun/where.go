@@ -99,6 +99,11 @@ func Reason(v string) predicate.ProblemCaseRun {
 	return predicate.ProblemCaseRun(sql.FieldEQ(FieldReason, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldEQ(FieldStatus, v))
+}
+
 // RepoEQ applies the EQ predicate on the "repo" field.
 func RepoEQ(v string) predicate.ProblemCaseRun {
 	return predicate.ProblemCaseRun(sql.FieldEQ(FieldRepo, v))
@@ -579,6 +584,71 @@ func ReasonContainsFold(v string) predicate.ProblemCaseRun {
 	return predicate.ProblemCaseRun(sql.FieldContainsFold(FieldReason, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ProblemCaseRun {
+	return predicate.ProblemCaseRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ProblemCaseRun) predicate.ProblemCaseRun {
 	return predicate.ProblemCaseRun(sql.AndPredicates(predicates...))
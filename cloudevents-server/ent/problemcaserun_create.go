@@ -21,81 +21,95 @@ type ProblemCaseRunCreate struct {
 }
 
 // SetRepo sets the "repo" field.
-func (pcrc *ProblemCaseRunCreate) SetRepo(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetRepo(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetRepo(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetRepo(v)
+	return _c
 }
 
 // SetBranch sets the "branch" field.
-func (pcrc *ProblemCaseRunCreate) SetBranch(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetBranch(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetBranch(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetBranch(v)
+	return _c
 }
 
 // SetSuiteName sets the "suite_name" field.
-func (pcrc *ProblemCaseRunCreate) SetSuiteName(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetSuiteName(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetSuiteName(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetSuiteName(v)
+	return _c
 }
 
 // SetCaseName sets the "case_name" field.
-func (pcrc *ProblemCaseRunCreate) SetCaseName(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetCaseName(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetCaseName(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetCaseName(v)
+	return _c
 }
 
 // SetFlaky sets the "flaky" field.
-func (pcrc *ProblemCaseRunCreate) SetFlaky(b bool) *ProblemCaseRunCreate {
-	pcrc.mutation.SetFlaky(b)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetFlaky(v bool) *ProblemCaseRunCreate {
+	_c.mutation.SetFlaky(v)
+	return _c
 }
 
 // SetNillableFlaky sets the "flaky" field if the given value is not nil.
-func (pcrc *ProblemCaseRunCreate) SetNillableFlaky(b *bool) *ProblemCaseRunCreate {
-	if b != nil {
-		pcrc.SetFlaky(*b)
+func (_c *ProblemCaseRunCreate) SetNillableFlaky(v *bool) *ProblemCaseRunCreate {
+	if v != nil {
+		_c.SetFlaky(*v)
 	}
-	return pcrc
+	return _c
 }
 
 // SetTimecostMs sets the "timecost_ms" field.
-func (pcrc *ProblemCaseRunCreate) SetTimecostMs(i int) *ProblemCaseRunCreate {
-	pcrc.mutation.SetTimecostMs(i)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetTimecostMs(v int) *ProblemCaseRunCreate {
+	_c.mutation.SetTimecostMs(v)
+	return _c
 }
 
 // SetReportTime sets the "report_time" field.
-func (pcrc *ProblemCaseRunCreate) SetReportTime(t time.Time) *ProblemCaseRunCreate {
-	pcrc.mutation.SetReportTime(t)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetReportTime(v time.Time) *ProblemCaseRunCreate {
+	_c.mutation.SetReportTime(v)
+	return _c
 }
 
 // SetBuildURL sets the "build_url" field.
-func (pcrc *ProblemCaseRunCreate) SetBuildURL(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetBuildURL(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetBuildURL(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetBuildURL(v)
+	return _c
 }
 
 // SetReason sets the "reason" field.
-func (pcrc *ProblemCaseRunCreate) SetReason(s string) *ProblemCaseRunCreate {
-	pcrc.mutation.SetReason(s)
-	return pcrc
+func (_c *ProblemCaseRunCreate) SetReason(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ProblemCaseRunCreate) SetStatus(v string) *ProblemCaseRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ProblemCaseRunCreate) SetNillableStatus(v *string) *ProblemCaseRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
 }
 
 // Mutation returns the ProblemCaseRunMutation object of the builder.
-func (pcrc *ProblemCaseRunCreate) Mutation() *ProblemCaseRunMutation {
-	return pcrc.mutation
+func (_c *ProblemCaseRunCreate) Mutation() *ProblemCaseRunMutation {
+	return _c.mutation
 }
 
 // Save creates the ProblemCaseRun in the database.
-func (pcrc *ProblemCaseRunCreate) Save(ctx context.Context) (*ProblemCaseRun, error) {
-	pcrc.defaults()
-	return withHooks(ctx, pcrc.sqlSave, pcrc.mutation, pcrc.hooks)
+func (_c *ProblemCaseRunCreate) Save(ctx context.Context) (*ProblemCaseRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
 // SaveX calls Save and panics if Save returns an error.
-func (pcrc *ProblemCaseRunCreate) SaveX(ctx context.Context) *ProblemCaseRun {
-	v, err := pcrc.Save(ctx)
+func (_c *ProblemCaseRunCreate) SaveX(ctx context.Context) *ProblemCaseRun {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -103,74 +117,81 @@ func (pcrc *ProblemCaseRunCreate) SaveX(ctx context.Context) *ProblemCaseRun {
 }
 
 // Exec executes the query.
-func (pcrc *ProblemCaseRunCreate) Exec(ctx context.Context) error {
-	_, err := pcrc.Save(ctx)
+func (_c *ProblemCaseRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcrc *ProblemCaseRunCreate) ExecX(ctx context.Context) {
-	if err := pcrc.Exec(ctx); err != nil {
+func (_c *ProblemCaseRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // defaults sets the default values of the builder before save.
-func (pcrc *ProblemCaseRunCreate) defaults() {
-	if _, ok := pcrc.mutation.Flaky(); !ok {
+func (_c *ProblemCaseRunCreate) defaults() {
+	if _, ok := _c.mutation.Flaky(); !ok {
 		v := problemcaserun.DefaultFlaky
-		pcrc.mutation.SetFlaky(v)
+		_c.mutation.SetFlaky(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := problemcaserun.DefaultStatus
+		_c.mutation.SetStatus(v)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (pcrc *ProblemCaseRunCreate) check() error {
-	if _, ok := pcrc.mutation.Repo(); !ok {
+func (_c *ProblemCaseRunCreate) check() error {
+	if _, ok := _c.mutation.Repo(); !ok {
 		return &ValidationError{Name: "repo", err: errors.New(`ent: missing required field "ProblemCaseRun.repo"`)}
 	}
-	if _, ok := pcrc.mutation.Branch(); !ok {
+	if _, ok := _c.mutation.Branch(); !ok {
 		return &ValidationError{Name: "branch", err: errors.New(`ent: missing required field "ProblemCaseRun.branch"`)}
 	}
-	if _, ok := pcrc.mutation.SuiteName(); !ok {
+	if _, ok := _c.mutation.SuiteName(); !ok {
 		return &ValidationError{Name: "suite_name", err: errors.New(`ent: missing required field "ProblemCaseRun.suite_name"`)}
 	}
-	if _, ok := pcrc.mutation.CaseName(); !ok {
+	if _, ok := _c.mutation.CaseName(); !ok {
 		return &ValidationError{Name: "case_name", err: errors.New(`ent: missing required field "ProblemCaseRun.case_name"`)}
 	}
-	if _, ok := pcrc.mutation.Flaky(); !ok {
+	if _, ok := _c.mutation.Flaky(); !ok {
 		return &ValidationError{Name: "flaky", err: errors.New(`ent: missing required field "ProblemCaseRun.flaky"`)}
 	}
-	if _, ok := pcrc.mutation.TimecostMs(); !ok {
+	if _, ok := _c.mutation.TimecostMs(); !ok {
 		return &ValidationError{Name: "timecost_ms", err: errors.New(`ent: missing required field "ProblemCaseRun.timecost_ms"`)}
 	}
-	if _, ok := pcrc.mutation.ReportTime(); !ok {
+	if _, ok := _c.mutation.ReportTime(); !ok {
 		return &ValidationError{Name: "report_time", err: errors.New(`ent: missing required field "ProblemCaseRun.report_time"`)}
 	}
-	if _, ok := pcrc.mutation.BuildURL(); !ok {
+	if _, ok := _c.mutation.BuildURL(); !ok {
 		return &ValidationError{Name: "build_url", err: errors.New(`ent: missing required field "ProblemCaseRun.build_url"`)}
 	}
-	if v, ok := pcrc.mutation.BuildURL(); ok {
+	if v, ok := _c.mutation.BuildURL(); ok {
 		if err := problemcaserun.BuildURLValidator(v); err != nil {
 			return &ValidationError{Name: "build_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.build_url": %w`, err)}
 		}
 	}
-	if _, ok := pcrc.mutation.Reason(); !ok {
+	if _, ok := _c.mutation.Reason(); !ok {
 		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "ProblemCaseRun.reason"`)}
 	}
-	if v, ok := pcrc.mutation.Reason(); ok {
+	if v, ok := _c.mutation.Reason(); ok {
 		if err := problemcaserun.ReasonValidator(v); err != nil {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.reason": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProblemCaseRun.status"`)}
+	}
 	return nil
 }
 
-func (pcrc *ProblemCaseRunCreate) sqlSave(ctx context.Context) (*ProblemCaseRun, error) {
-	if err := pcrc.check(); err != nil {
+func (_c *ProblemCaseRunCreate) sqlSave(ctx context.Context) (*ProblemCaseRun, error) {
+	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := pcrc.createSpec()
-	if err := sqlgraph.CreateNode(ctx, pcrc.driver, _spec); err != nil {
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
@@ -178,52 +199,56 @@ func (pcrc *ProblemCaseRunCreate) sqlSave(ctx context.Context) (*ProblemCaseRun,
 	}
 	id := _spec.ID.Value.(int64)
 	_node.ID = int(id)
-	pcrc.mutation.id = &_node.ID
-	pcrc.mutation.done = true
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
 	return _node, nil
 }
 
-func (pcrc *ProblemCaseRunCreate) createSpec() (*ProblemCaseRun, *sqlgraph.CreateSpec) {
+func (_c *ProblemCaseRunCreate) createSpec() (*ProblemCaseRun, *sqlgraph.CreateSpec) {
 	var (
-		_node = &ProblemCaseRun{config: pcrc.config}
+		_node = &ProblemCaseRun{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(problemcaserun.Table, sqlgraph.NewFieldSpec(problemcaserun.FieldID, field.TypeInt))
 	)
-	if value, ok := pcrc.mutation.Repo(); ok {
+	if value, ok := _c.mutation.Repo(); ok {
 		_spec.SetField(problemcaserun.FieldRepo, field.TypeString, value)
 		_node.Repo = value
 	}
-	if value, ok := pcrc.mutation.Branch(); ok {
+	if value, ok := _c.mutation.Branch(); ok {
 		_spec.SetField(problemcaserun.FieldBranch, field.TypeString, value)
 		_node.Branch = value
 	}
-	if value, ok := pcrc.mutation.SuiteName(); ok {
+	if value, ok := _c.mutation.SuiteName(); ok {
 		_spec.SetField(problemcaserun.FieldSuiteName, field.TypeString, value)
 		_node.SuiteName = value
 	}
-	if value, ok := pcrc.mutation.CaseName(); ok {
+	if value, ok := _c.mutation.CaseName(); ok {
 		_spec.SetField(problemcaserun.FieldCaseName, field.TypeString, value)
 		_node.CaseName = value
 	}
-	if value, ok := pcrc.mutation.Flaky(); ok {
+	if value, ok := _c.mutation.Flaky(); ok {
 		_spec.SetField(problemcaserun.FieldFlaky, field.TypeBool, value)
 		_node.Flaky = value
 	}
-	if value, ok := pcrc.mutation.TimecostMs(); ok {
+	if value, ok := _c.mutation.TimecostMs(); ok {
 		_spec.SetField(problemcaserun.FieldTimecostMs, field.TypeInt, value)
 		_node.TimecostMs = value
 	}
-	if value, ok := pcrc.mutation.ReportTime(); ok {
+	if value, ok := _c.mutation.ReportTime(); ok {
 		_spec.SetField(problemcaserun.FieldReportTime, field.TypeTime, value)
 		_node.ReportTime = value
 	}
-	if value, ok := pcrc.mutation.BuildURL(); ok {
+	if value, ok := _c.mutation.BuildURL(); ok {
 		_spec.SetField(problemcaserun.FieldBuildURL, field.TypeString, value)
 		_node.BuildURL = value
 	}
-	if value, ok := pcrc.mutation.Reason(); ok {
+	if value, ok := _c.mutation.Reason(); ok {
 		_spec.SetField(problemcaserun.FieldReason, field.TypeString, value)
 		_node.Reason = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(problemcaserun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
 	return _node, _spec
 }
 
@@ -235,16 +260,16 @@ type ProblemCaseRunCreateBulk struct {
 }
 
 // Save creates the ProblemCaseRun entities in the database.
-func (pcrcb *ProblemCaseRunCreateBulk) Save(ctx context.Context) ([]*ProblemCaseRun, error) {
-	if pcrcb.err != nil {
-		return nil, pcrcb.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(pcrcb.builders))
-	nodes := make([]*ProblemCaseRun, len(pcrcb.builders))
-	mutators := make([]Mutator, len(pcrcb.builders))
-	for i := range pcrcb.builders {
+func (_c *ProblemCaseRunCreateBulk) Save(ctx context.Context) ([]*ProblemCaseRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProblemCaseRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
 		func(i int, root context.Context) {
-			builder := pcrcb.builders[i]
+			builder := _c.builders[i]
 			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ProblemCaseRunMutation)
@@ -258,11 +283,11 @@ func (pcrcb *ProblemCaseRunCreateBulk) Save(ctx context.Context) ([]*ProblemCase
 				var err error
 				nodes[i], specs[i] = builder.createSpec()
 				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, pcrcb.builders[i+1].mutation)
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, pcrcb.driver, spec); err != nil {
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
 							err = &ConstraintError{msg: err.Error(), wrap: err}
 						}
@@ -286,7 +311,7 @@ func (pcrcb *ProblemCaseRunCreateBulk) Save(ctx context.Context) ([]*ProblemCase
 		}(i, ctx)
 	}
 	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, pcrcb.builders[0].mutation); err != nil {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
 			return nil, err
 		}
 	}
@@ -294,8 +319,8 @@ func (pcrcb *ProblemCaseRunCreateBulk) Save(ctx context.Context) ([]*ProblemCase
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (pcrcb *ProblemCaseRunCreateBulk) SaveX(ctx context.Context) []*ProblemCaseRun {
-	v, err := pcrcb.Save(ctx)
+func (_c *ProblemCaseRunCreateBulk) SaveX(ctx context.Context) []*ProblemCaseRun {
+	v, err := _c.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -303,14 +328,14 @@ func (pcrcb *ProblemCaseRunCreateBulk) SaveX(ctx context.Context) []*ProblemCase
 }
 
 // Exec executes the query.
-func (pcrcb *ProblemCaseRunCreateBulk) Exec(ctx context.Context) error {
-	_, err := pcrcb.Save(ctx)
+func (_c *ProblemCaseRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcrcb *ProblemCaseRunCreateBulk) ExecX(ctx context.Context) {
-	if err := pcrcb.Exec(ctx); err != nil {
+func (_c *ProblemCaseRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
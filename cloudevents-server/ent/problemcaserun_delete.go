@@ -20,56 +20,56 @@ type ProblemCaseRunDelete struct {
 }
 
 // Where appends a list predicates to the ProblemCaseRunDelete builder.
-func (pcrd *ProblemCaseRunDelete) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunDelete {
-	pcrd.mutation.Where(ps...)
-	return pcrd
+func (_d *ProblemCaseRunDelete) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunDelete {
+	_d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query and returns how many vertices were deleted.
-func (pcrd *ProblemCaseRunDelete) Exec(ctx context.Context) (int, error) {
-	return withHooks(ctx, pcrd.sqlExec, pcrd.mutation, pcrd.hooks)
+func (_d *ProblemCaseRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcrd *ProblemCaseRunDelete) ExecX(ctx context.Context) int {
-	n, err := pcrd.Exec(ctx)
+func (_d *ProblemCaseRunDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
 	if err != nil {
 		panic(err)
 	}
 	return n
 }
 
-func (pcrd *ProblemCaseRunDelete) sqlExec(ctx context.Context) (int, error) {
+func (_d *ProblemCaseRunDelete) sqlExec(ctx context.Context) (int, error) {
 	_spec := sqlgraph.NewDeleteSpec(problemcaserun.Table, sqlgraph.NewFieldSpec(problemcaserun.FieldID, field.TypeInt))
-	if ps := pcrd.mutation.predicates; len(ps) > 0 {
+	if ps := _d.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	affected, err := sqlgraph.DeleteNodes(ctx, pcrd.driver, _spec)
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
 	if err != nil && sqlgraph.IsConstraintError(err) {
 		err = &ConstraintError{msg: err.Error(), wrap: err}
 	}
-	pcrd.mutation.done = true
+	_d.mutation.done = true
 	return affected, err
 }
 
 // ProblemCaseRunDeleteOne is the builder for deleting a single ProblemCaseRun entity.
 type ProblemCaseRunDeleteOne struct {
-	pcrd *ProblemCaseRunDelete
+	_d *ProblemCaseRunDelete
 }
 
 // Where appends a list predicates to the ProblemCaseRunDelete builder.
-func (pcrdo *ProblemCaseRunDeleteOne) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunDeleteOne {
-	pcrdo.pcrd.mutation.Where(ps...)
-	return pcrdo
+func (_d *ProblemCaseRunDeleteOne) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
 }
 
 // Exec executes the deletion query.
-func (pcrdo *ProblemCaseRunDeleteOne) Exec(ctx context.Context) error {
-	n, err := pcrdo.pcrd.Exec(ctx)
+func (_d *ProblemCaseRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
 	switch {
 	case err != nil:
 		return err
@@ -81,8 +81,8 @@ func (pcrdo *ProblemCaseRunDeleteOne) Exec(ctx context.Context) error {
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcrdo *ProblemCaseRunDeleteOne) ExecX(ctx context.Context) {
-	if err := pcrdo.Exec(ctx); err != nil {
+func (_d *ProblemCaseRunDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
@@ -28,40 +28,40 @@ type ProblemCaseRunQuery struct {
 }
 
 // Where adds a new predicate for the ProblemCaseRunQuery builder.
-func (pcrq *ProblemCaseRunQuery) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunQuery {
-	pcrq.predicates = append(pcrq.predicates, ps...)
-	return pcrq
+func (_q *ProblemCaseRunQuery) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
 }
 
 // Limit the number of records to be returned by this query.
-func (pcrq *ProblemCaseRunQuery) Limit(limit int) *ProblemCaseRunQuery {
-	pcrq.ctx.Limit = &limit
-	return pcrq
+func (_q *ProblemCaseRunQuery) Limit(limit int) *ProblemCaseRunQuery {
+	_q.ctx.Limit = &limit
+	return _q
 }
 
 // Offset to start from.
-func (pcrq *ProblemCaseRunQuery) Offset(offset int) *ProblemCaseRunQuery {
-	pcrq.ctx.Offset = &offset
-	return pcrq
+func (_q *ProblemCaseRunQuery) Offset(offset int) *ProblemCaseRunQuery {
+	_q.ctx.Offset = &offset
+	return _q
 }
 
 // Unique configures the query builder to filter duplicate records on query.
 // By default, unique is set to true, and can be disabled using this method.
-func (pcrq *ProblemCaseRunQuery) Unique(unique bool) *ProblemCaseRunQuery {
-	pcrq.ctx.Unique = &unique
-	return pcrq
+func (_q *ProblemCaseRunQuery) Unique(unique bool) *ProblemCaseRunQuery {
+	_q.ctx.Unique = &unique
+	return _q
 }
 
 // Order specifies how the records should be ordered.
-func (pcrq *ProblemCaseRunQuery) Order(o ...problemcaserun.OrderOption) *ProblemCaseRunQuery {
-	pcrq.order = append(pcrq.order, o...)
-	return pcrq
+func (_q *ProblemCaseRunQuery) Order(o ...problemcaserun.OrderOption) *ProblemCaseRunQuery {
+	_q.order = append(_q.order, o...)
+	return _q
 }
 
 // First returns the first ProblemCaseRun entity from the query.
 // Returns a *NotFoundError when no ProblemCaseRun was found.
-func (pcrq *ProblemCaseRunQuery) First(ctx context.Context) (*ProblemCaseRun, error) {
-	nodes, err := pcrq.Limit(1).All(setContextOp(ctx, pcrq.ctx, ent.OpQueryFirst))
+func (_q *ProblemCaseRunQuery) First(ctx context.Context) (*ProblemCaseRun, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
 	if err != nil {
 		return nil, err
 	}
@@ -72,8 +72,8 @@ func (pcrq *ProblemCaseRunQuery) First(ctx context.Context) (*ProblemCaseRun, er
 }
 
 // FirstX is like First, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) FirstX(ctx context.Context) *ProblemCaseRun {
-	node, err := pcrq.First(ctx)
+func (_q *ProblemCaseRunQuery) FirstX(ctx context.Context) *ProblemCaseRun {
+	node, err := _q.First(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -82,9 +82,9 @@ func (pcrq *ProblemCaseRunQuery) FirstX(ctx context.Context) *ProblemCaseRun {
 
 // FirstID returns the first ProblemCaseRun ID from the query.
 // Returns a *NotFoundError when no ProblemCaseRun ID was found.
-func (pcrq *ProblemCaseRunQuery) FirstID(ctx context.Context) (id int, err error) {
+func (_q *ProblemCaseRunQuery) FirstID(ctx context.Context) (id int, err error) {
 	var ids []int
-	if ids, err = pcrq.Limit(1).IDs(setContextOp(ctx, pcrq.ctx, ent.OpQueryFirstID)); err != nil {
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
 		return
 	}
 	if len(ids) == 0 {
@@ -95,8 +95,8 @@ func (pcrq *ProblemCaseRunQuery) FirstID(ctx context.Context) (id int, err error
 }
 
 // FirstIDX is like FirstID, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) FirstIDX(ctx context.Context) int {
-	id, err := pcrq.FirstID(ctx)
+func (_q *ProblemCaseRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
 	if err != nil && !IsNotFound(err) {
 		panic(err)
 	}
@@ -106,8 +106,8 @@ func (pcrq *ProblemCaseRunQuery) FirstIDX(ctx context.Context) int {
 // Only returns a single ProblemCaseRun entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one ProblemCaseRun entity is found.
 // Returns a *NotFoundError when no ProblemCaseRun entities are found.
-func (pcrq *ProblemCaseRunQuery) Only(ctx context.Context) (*ProblemCaseRun, error) {
-	nodes, err := pcrq.Limit(2).All(setContextOp(ctx, pcrq.ctx, ent.OpQueryOnly))
+func (_q *ProblemCaseRunQuery) Only(ctx context.Context) (*ProblemCaseRun, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
 	if err != nil {
 		return nil, err
 	}
@@ -122,8 +122,8 @@ func (pcrq *ProblemCaseRunQuery) Only(ctx context.Context) (*ProblemCaseRun, err
 }
 
 // OnlyX is like Only, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) OnlyX(ctx context.Context) *ProblemCaseRun {
-	node, err := pcrq.Only(ctx)
+func (_q *ProblemCaseRunQuery) OnlyX(ctx context.Context) *ProblemCaseRun {
+	node, err := _q.Only(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -133,9 +133,9 @@ func (pcrq *ProblemCaseRunQuery) OnlyX(ctx context.Context) *ProblemCaseRun {
 // OnlyID is like Only, but returns the only ProblemCaseRun ID in the query.
 // Returns a *NotSingularError when more than one ProblemCaseRun ID is found.
 // Returns a *NotFoundError when no entities are found.
-func (pcrq *ProblemCaseRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+func (_q *ProblemCaseRunQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
-	if ids, err = pcrq.Limit(2).IDs(setContextOp(ctx, pcrq.ctx, ent.OpQueryOnlyID)); err != nil {
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
 		return
 	}
 	switch len(ids) {
@@ -150,8 +150,8 @@ func (pcrq *ProblemCaseRunQuery) OnlyID(ctx context.Context) (id int, err error)
 }
 
 // OnlyIDX is like OnlyID, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) OnlyIDX(ctx context.Context) int {
-	id, err := pcrq.OnlyID(ctx)
+func (_q *ProblemCaseRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -159,18 +159,18 @@ func (pcrq *ProblemCaseRunQuery) OnlyIDX(ctx context.Context) int {
 }
 
 // All executes the query and returns a list of ProblemCaseRuns.
-func (pcrq *ProblemCaseRunQuery) All(ctx context.Context) ([]*ProblemCaseRun, error) {
-	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryAll)
-	if err := pcrq.prepareQuery(ctx); err != nil {
+func (_q *ProblemCaseRunQuery) All(ctx context.Context) ([]*ProblemCaseRun, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return nil, err
 	}
 	qr := querierAll[[]*ProblemCaseRun, *ProblemCaseRunQuery]()
-	return withInterceptors[[]*ProblemCaseRun](ctx, pcrq, qr, pcrq.inters)
+	return withInterceptors[[]*ProblemCaseRun](ctx, _q, qr, _q.inters)
 }
 
 // AllX is like All, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) AllX(ctx context.Context) []*ProblemCaseRun {
-	nodes, err := pcrq.All(ctx)
+func (_q *ProblemCaseRunQuery) AllX(ctx context.Context) []*ProblemCaseRun {
+	nodes, err := _q.All(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -178,20 +178,20 @@ func (pcrq *ProblemCaseRunQuery) AllX(ctx context.Context) []*ProblemCaseRun {
 }
 
 // IDs executes the query and returns a list of ProblemCaseRun IDs.
-func (pcrq *ProblemCaseRunQuery) IDs(ctx context.Context) (ids []int, err error) {
-	if pcrq.ctx.Unique == nil && pcrq.path != nil {
-		pcrq.Unique(true)
+func (_q *ProblemCaseRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
 	}
-	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryIDs)
-	if err = pcrq.Select(problemcaserun.FieldID).Scan(ctx, &ids); err != nil {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(problemcaserun.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
 	}
 	return ids, nil
 }
 
 // IDsX is like IDs, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) IDsX(ctx context.Context) []int {
-	ids, err := pcrq.IDs(ctx)
+func (_q *ProblemCaseRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -199,17 +199,17 @@ func (pcrq *ProblemCaseRunQuery) IDsX(ctx context.Context) []int {
 }
 
 // Count returns the count of the given query.
-func (pcrq *ProblemCaseRunQuery) Count(ctx context.Context) (int, error) {
-	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryCount)
-	if err := pcrq.prepareQuery(ctx); err != nil {
+func (_q *ProblemCaseRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
 		return 0, err
 	}
-	return withInterceptors[int](ctx, pcrq, querierCount[*ProblemCaseRunQuery](), pcrq.inters)
+	return withInterceptors[int](ctx, _q, querierCount[*ProblemCaseRunQuery](), _q.inters)
 }
 
 // CountX is like Count, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) CountX(ctx context.Context) int {
-	count, err := pcrq.Count(ctx)
+func (_q *ProblemCaseRunQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -217,9 +217,9 @@ func (pcrq *ProblemCaseRunQuery) CountX(ctx context.Context) int {
 }
 
 // Exist returns true if the query has elements in the graph.
-func (pcrq *ProblemCaseRunQuery) Exist(ctx context.Context) (bool, error) {
-	ctx = setContextOp(ctx, pcrq.ctx, ent.OpQueryExist)
-	switch _, err := pcrq.FirstID(ctx); {
+func (_q *ProblemCaseRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
 	case IsNotFound(err):
 		return false, nil
 	case err != nil:
@@ -230,8 +230,8 @@ func (pcrq *ProblemCaseRunQuery) Exist(ctx context.Context) (bool, error) {
 }
 
 // ExistX is like Exist, but panics if an error occurs.
-func (pcrq *ProblemCaseRunQuery) ExistX(ctx context.Context) bool {
-	exist, err := pcrq.Exist(ctx)
+func (_q *ProblemCaseRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -240,19 +240,19 @@ func (pcrq *ProblemCaseRunQuery) ExistX(ctx context.Context) bool {
 
 // Clone returns a duplicate of the ProblemCaseRunQuery builder, including all associated steps. It can be
 // used to prepare common query builders and use them differently after the clone is made.
-func (pcrq *ProblemCaseRunQuery) Clone() *ProblemCaseRunQuery {
-	if pcrq == nil {
+func (_q *ProblemCaseRunQuery) Clone() *ProblemCaseRunQuery {
+	if _q == nil {
 		return nil
 	}
 	return &ProblemCaseRunQuery{
-		config:     pcrq.config,
-		ctx:        pcrq.ctx.Clone(),
-		order:      append([]problemcaserun.OrderOption{}, pcrq.order...),
-		inters:     append([]Interceptor{}, pcrq.inters...),
-		predicates: append([]predicate.ProblemCaseRun{}, pcrq.predicates...),
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]problemcaserun.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ProblemCaseRun{}, _q.predicates...),
 		// clone intermediate query.
-		sql:  pcrq.sql.Clone(),
-		path: pcrq.path,
+		sql:  _q.sql.Clone(),
+		path: _q.path,
 	}
 }
 
@@ -270,10 +270,10 @@ func (pcrq *ProblemCaseRunQuery) Clone() *ProblemCaseRunQuery {
 //		GroupBy(problemcaserun.FieldRepo).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-func (pcrq *ProblemCaseRunQuery) GroupBy(field string, fields ...string) *ProblemCaseRunGroupBy {
-	pcrq.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &ProblemCaseRunGroupBy{build: pcrq}
-	grbuild.flds = &pcrq.ctx.Fields
+func (_q *ProblemCaseRunQuery) GroupBy(field string, fields ...string) *ProblemCaseRunGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProblemCaseRunGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
 	grbuild.label = problemcaserun.Label
 	grbuild.scan = grbuild.Scan
 	return grbuild
@@ -291,62 +291,62 @@ func (pcrq *ProblemCaseRunQuery) GroupBy(field string, fields ...string) *Proble
 //	client.ProblemCaseRun.Query().
 //		Select(problemcaserun.FieldRepo).
 //		Scan(ctx, &v)
-func (pcrq *ProblemCaseRunQuery) Select(fields ...string) *ProblemCaseRunSelect {
-	pcrq.ctx.Fields = append(pcrq.ctx.Fields, fields...)
-	sbuild := &ProblemCaseRunSelect{ProblemCaseRunQuery: pcrq}
+func (_q *ProblemCaseRunQuery) Select(fields ...string) *ProblemCaseRunSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ProblemCaseRunSelect{ProblemCaseRunQuery: _q}
 	sbuild.label = problemcaserun.Label
-	sbuild.flds, sbuild.scan = &pcrq.ctx.Fields, sbuild.Scan
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
 	return sbuild
 }
 
 // Aggregate returns a ProblemCaseRunSelect configured with the given aggregations.
-func (pcrq *ProblemCaseRunQuery) Aggregate(fns ...AggregateFunc) *ProblemCaseRunSelect {
-	return pcrq.Select().Aggregate(fns...)
+func (_q *ProblemCaseRunQuery) Aggregate(fns ...AggregateFunc) *ProblemCaseRunSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
-func (pcrq *ProblemCaseRunQuery) prepareQuery(ctx context.Context) error {
-	for _, inter := range pcrq.inters {
+func (_q *ProblemCaseRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
 		if inter == nil {
 			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
 		}
 		if trv, ok := inter.(Traverser); ok {
-			if err := trv.Traverse(ctx, pcrq); err != nil {
+			if err := trv.Traverse(ctx, _q); err != nil {
 				return err
 			}
 		}
 	}
-	for _, f := range pcrq.ctx.Fields {
+	for _, f := range _q.ctx.Fields {
 		if !problemcaserun.ValidColumn(f) {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
-	if pcrq.path != nil {
-		prev, err := pcrq.path(ctx)
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
 		if err != nil {
 			return err
 		}
-		pcrq.sql = prev
+		_q.sql = prev
 	}
 	return nil
 }
 
-func (pcrq *ProblemCaseRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProblemCaseRun, error) {
+func (_q *ProblemCaseRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProblemCaseRun, error) {
 	var (
 		nodes = []*ProblemCaseRun{}
-		_spec = pcrq.querySpec()
+		_spec = _q.querySpec()
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*ProblemCaseRun).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []any) error {
-		node := &ProblemCaseRun{config: pcrq.config}
+		node := &ProblemCaseRun{config: _q.config}
 		nodes = append(nodes, node)
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
-	if err := sqlgraph.QueryNodes(ctx, pcrq.driver, _spec); err != nil {
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
 		return nil, err
 	}
 	if len(nodes) == 0 {
@@ -355,24 +355,24 @@ func (pcrq *ProblemCaseRunQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	return nodes, nil
 }
 
-func (pcrq *ProblemCaseRunQuery) sqlCount(ctx context.Context) (int, error) {
-	_spec := pcrq.querySpec()
-	_spec.Node.Columns = pcrq.ctx.Fields
-	if len(pcrq.ctx.Fields) > 0 {
-		_spec.Unique = pcrq.ctx.Unique != nil && *pcrq.ctx.Unique
+func (_q *ProblemCaseRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
 	}
-	return sqlgraph.CountNodes(ctx, pcrq.driver, _spec)
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
 }
 
-func (pcrq *ProblemCaseRunQuery) querySpec() *sqlgraph.QuerySpec {
+func (_q *ProblemCaseRunQuery) querySpec() *sqlgraph.QuerySpec {
 	_spec := sqlgraph.NewQuerySpec(problemcaserun.Table, problemcaserun.Columns, sqlgraph.NewFieldSpec(problemcaserun.FieldID, field.TypeInt))
-	_spec.From = pcrq.sql
-	if unique := pcrq.ctx.Unique; unique != nil {
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
 		_spec.Unique = *unique
-	} else if pcrq.path != nil {
+	} else if _q.path != nil {
 		_spec.Unique = true
 	}
-	if fields := pcrq.ctx.Fields; len(fields) > 0 {
+	if fields := _q.ctx.Fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, problemcaserun.FieldID)
 		for i := range fields {
@@ -381,20 +381,20 @@ func (pcrq *ProblemCaseRunQuery) querySpec() *sqlgraph.QuerySpec {
 			}
 		}
 	}
-	if ps := pcrq.predicates; len(ps) > 0 {
+	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if limit := pcrq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		_spec.Limit = *limit
 	}
-	if offset := pcrq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		_spec.Offset = *offset
 	}
-	if ps := pcrq.order; len(ps) > 0 {
+	if ps := _q.order; len(ps) > 0 {
 		_spec.Order = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
@@ -404,33 +404,33 @@ func (pcrq *ProblemCaseRunQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (pcrq *ProblemCaseRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
-	builder := sql.Dialect(pcrq.driver.Dialect())
+func (_q *ProblemCaseRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
 	t1 := builder.Table(problemcaserun.Table)
-	columns := pcrq.ctx.Fields
+	columns := _q.ctx.Fields
 	if len(columns) == 0 {
 		columns = problemcaserun.Columns
 	}
 	selector := builder.Select(t1.Columns(columns...)...).From(t1)
-	if pcrq.sql != nil {
-		selector = pcrq.sql
+	if _q.sql != nil {
+		selector = _q.sql
 		selector.Select(selector.Columns(columns...)...)
 	}
-	if pcrq.ctx.Unique != nil && *pcrq.ctx.Unique {
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
-	for _, p := range pcrq.predicates {
+	for _, p := range _q.predicates {
 		p(selector)
 	}
-	for _, p := range pcrq.order {
+	for _, p := range _q.order {
 		p(selector)
 	}
-	if offset := pcrq.ctx.Offset; offset != nil {
+	if offset := _q.ctx.Offset; offset != nil {
 		// limit is mandatory for offset clause. We start
 		// with default value, and override it below if needed.
 		selector.Offset(*offset).Limit(math.MaxInt32)
 	}
-	if limit := pcrq.ctx.Limit; limit != nil {
+	if limit := _q.ctx.Limit; limit != nil {
 		selector.Limit(*limit)
 	}
 	return selector
@@ -443,41 +443,41 @@ type ProblemCaseRunGroupBy struct {
 }
 
 // Aggregate adds the given aggregation functions to the group-by query.
-func (pcrgb *ProblemCaseRunGroupBy) Aggregate(fns ...AggregateFunc) *ProblemCaseRunGroupBy {
-	pcrgb.fns = append(pcrgb.fns, fns...)
-	return pcrgb
+func (_g *ProblemCaseRunGroupBy) Aggregate(fns ...AggregateFunc) *ProblemCaseRunGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (pcrgb *ProblemCaseRunGroupBy) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, pcrgb.build.ctx, ent.OpQueryGroupBy)
-	if err := pcrgb.build.prepareQuery(ctx); err != nil {
+func (_g *ProblemCaseRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*ProblemCaseRunQuery, *ProblemCaseRunGroupBy](ctx, pcrgb.build, pcrgb, pcrgb.build.inters, v)
+	return scanWithInterceptors[*ProblemCaseRunQuery, *ProblemCaseRunGroupBy](ctx, _g.build, _g, _g.build.inters, v)
 }
 
-func (pcrgb *ProblemCaseRunGroupBy) sqlScan(ctx context.Context, root *ProblemCaseRunQuery, v any) error {
+func (_g *ProblemCaseRunGroupBy) sqlScan(ctx context.Context, root *ProblemCaseRunQuery, v any) error {
 	selector := root.sqlQuery(ctx).Select()
-	aggregation := make([]string, 0, len(pcrgb.fns))
-	for _, fn := range pcrgb.fns {
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
 	if len(selector.SelectedColumns()) == 0 {
-		columns := make([]string, 0, len(*pcrgb.flds)+len(pcrgb.fns))
-		for _, f := range *pcrgb.flds {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
 			columns = append(columns, selector.C(f))
 		}
 		columns = append(columns, aggregation...)
 		selector.Select(columns...)
 	}
-	selector.GroupBy(selector.Columns(*pcrgb.flds...)...)
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
 	if err := selector.Err(); err != nil {
 		return err
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pcrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
@@ -491,27 +491,27 @@ type ProblemCaseRunSelect struct {
 }
 
 // Aggregate adds the given aggregation functions to the selector query.
-func (pcrs *ProblemCaseRunSelect) Aggregate(fns ...AggregateFunc) *ProblemCaseRunSelect {
-	pcrs.fns = append(pcrs.fns, fns...)
-	return pcrs
+func (_s *ProblemCaseRunSelect) Aggregate(fns ...AggregateFunc) *ProblemCaseRunSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
 }
 
 // Scan applies the selector query and scans the result into the given value.
-func (pcrs *ProblemCaseRunSelect) Scan(ctx context.Context, v any) error {
-	ctx = setContextOp(ctx, pcrs.ctx, ent.OpQuerySelect)
-	if err := pcrs.prepareQuery(ctx); err != nil {
+func (_s *ProblemCaseRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
 		return err
 	}
-	return scanWithInterceptors[*ProblemCaseRunQuery, *ProblemCaseRunSelect](ctx, pcrs.ProblemCaseRunQuery, pcrs, pcrs.inters, v)
+	return scanWithInterceptors[*ProblemCaseRunQuery, *ProblemCaseRunSelect](ctx, _s.ProblemCaseRunQuery, _s, _s.inters, v)
 }
 
-func (pcrs *ProblemCaseRunSelect) sqlScan(ctx context.Context, root *ProblemCaseRunQuery, v any) error {
+func (_s *ProblemCaseRunSelect) sqlScan(ctx context.Context, root *ProblemCaseRunQuery, v any) error {
 	selector := root.sqlQuery(ctx)
-	aggregation := make([]string, 0, len(pcrs.fns))
-	for _, fn := range pcrs.fns {
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
 		aggregation = append(aggregation, fn(selector))
 	}
-	switch n := len(*pcrs.selector.flds); {
+	switch n := len(*_s.selector.flds); {
 	case n == 0 && len(aggregation) > 0:
 		selector.Select(aggregation...)
 	case n != 0 && len(aggregation) > 0:
@@ -519,7 +519,7 @@ func (pcrs *ProblemCaseRunSelect) sqlScan(ctx context.Context, root *ProblemCase
 	}
 	rows := &sql.Rows{}
 	query, args := selector.Query()
-	if err := pcrs.driver.Query(ctx, query, args, rows); err != nil {
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
 	defer rows.Close()
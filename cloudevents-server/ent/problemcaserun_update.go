@@ -23,157 +23,171 @@ type ProblemCaseRunUpdate struct {
 }
 
 // Where appends a list predicates to the ProblemCaseRunUpdate builder.
-func (pcru *ProblemCaseRunUpdate) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunUpdate {
-	pcru.mutation.Where(ps...)
-	return pcru
+func (_u *ProblemCaseRunUpdate) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // SetRepo sets the "repo" field.
-func (pcru *ProblemCaseRunUpdate) SetRepo(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetRepo(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetRepo(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetRepo(v)
+	return _u
 }
 
 // SetNillableRepo sets the "repo" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableRepo(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetRepo(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableRepo(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetRepo(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetBranch sets the "branch" field.
-func (pcru *ProblemCaseRunUpdate) SetBranch(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetBranch(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetBranch(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetBranch(v)
+	return _u
 }
 
 // SetNillableBranch sets the "branch" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableBranch(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetBranch(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableBranch(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetBranch(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetSuiteName sets the "suite_name" field.
-func (pcru *ProblemCaseRunUpdate) SetSuiteName(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetSuiteName(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetSuiteName(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetSuiteName(v)
+	return _u
 }
 
 // SetNillableSuiteName sets the "suite_name" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableSuiteName(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetSuiteName(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableSuiteName(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetSuiteName(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetCaseName sets the "case_name" field.
-func (pcru *ProblemCaseRunUpdate) SetCaseName(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetCaseName(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetCaseName(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetCaseName(v)
+	return _u
 }
 
 // SetNillableCaseName sets the "case_name" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableCaseName(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetCaseName(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableCaseName(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetCaseName(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetFlaky sets the "flaky" field.
-func (pcru *ProblemCaseRunUpdate) SetFlaky(b bool) *ProblemCaseRunUpdate {
-	pcru.mutation.SetFlaky(b)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetFlaky(v bool) *ProblemCaseRunUpdate {
+	_u.mutation.SetFlaky(v)
+	return _u
 }
 
 // SetNillableFlaky sets the "flaky" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableFlaky(b *bool) *ProblemCaseRunUpdate {
-	if b != nil {
-		pcru.SetFlaky(*b)
+func (_u *ProblemCaseRunUpdate) SetNillableFlaky(v *bool) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetFlaky(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetTimecostMs sets the "timecost_ms" field.
-func (pcru *ProblemCaseRunUpdate) SetTimecostMs(i int) *ProblemCaseRunUpdate {
-	pcru.mutation.ResetTimecostMs()
-	pcru.mutation.SetTimecostMs(i)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetTimecostMs(v int) *ProblemCaseRunUpdate {
+	_u.mutation.ResetTimecostMs()
+	_u.mutation.SetTimecostMs(v)
+	return _u
 }
 
 // SetNillableTimecostMs sets the "timecost_ms" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableTimecostMs(i *int) *ProblemCaseRunUpdate {
-	if i != nil {
-		pcru.SetTimecostMs(*i)
+func (_u *ProblemCaseRunUpdate) SetNillableTimecostMs(v *int) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetTimecostMs(*v)
 	}
-	return pcru
+	return _u
 }
 
-// AddTimecostMs adds i to the "timecost_ms" field.
-func (pcru *ProblemCaseRunUpdate) AddTimecostMs(i int) *ProblemCaseRunUpdate {
-	pcru.mutation.AddTimecostMs(i)
-	return pcru
+// AddTimecostMs adds value to the "timecost_ms" field.
+func (_u *ProblemCaseRunUpdate) AddTimecostMs(v int) *ProblemCaseRunUpdate {
+	_u.mutation.AddTimecostMs(v)
+	return _u
 }
 
 // SetReportTime sets the "report_time" field.
-func (pcru *ProblemCaseRunUpdate) SetReportTime(t time.Time) *ProblemCaseRunUpdate {
-	pcru.mutation.SetReportTime(t)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetReportTime(v time.Time) *ProblemCaseRunUpdate {
+	_u.mutation.SetReportTime(v)
+	return _u
 }
 
 // SetNillableReportTime sets the "report_time" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableReportTime(t *time.Time) *ProblemCaseRunUpdate {
-	if t != nil {
-		pcru.SetReportTime(*t)
+func (_u *ProblemCaseRunUpdate) SetNillableReportTime(v *time.Time) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetReportTime(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetBuildURL sets the "build_url" field.
-func (pcru *ProblemCaseRunUpdate) SetBuildURL(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetBuildURL(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetBuildURL(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetBuildURL(v)
+	return _u
 }
 
 // SetNillableBuildURL sets the "build_url" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableBuildURL(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetBuildURL(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableBuildURL(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetBuildURL(*v)
 	}
-	return pcru
+	return _u
 }
 
 // SetReason sets the "reason" field.
-func (pcru *ProblemCaseRunUpdate) SetReason(s string) *ProblemCaseRunUpdate {
-	pcru.mutation.SetReason(s)
-	return pcru
+func (_u *ProblemCaseRunUpdate) SetReason(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetReason(v)
+	return _u
 }
 
 // SetNillableReason sets the "reason" field if the given value is not nil.
-func (pcru *ProblemCaseRunUpdate) SetNillableReason(s *string) *ProblemCaseRunUpdate {
-	if s != nil {
-		pcru.SetReason(*s)
+func (_u *ProblemCaseRunUpdate) SetNillableReason(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetReason(*v)
 	}
-	return pcru
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProblemCaseRunUpdate) SetStatus(v string) *ProblemCaseRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProblemCaseRunUpdate) SetNillableStatus(v *string) *ProblemCaseRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
 }
 
 // Mutation returns the ProblemCaseRunMutation object of the builder.
-func (pcru *ProblemCaseRunUpdate) Mutation() *ProblemCaseRunMutation {
-	return pcru.mutation
+func (_u *ProblemCaseRunUpdate) Mutation() *ProblemCaseRunMutation {
+	return _u.mutation
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
-func (pcru *ProblemCaseRunUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, pcru.sqlSave, pcru.mutation, pcru.hooks)
+func (_u *ProblemCaseRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (pcru *ProblemCaseRunUpdate) SaveX(ctx context.Context) int {
-	affected, err := pcru.Save(ctx)
+func (_u *ProblemCaseRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -181,26 +195,26 @@ func (pcru *ProblemCaseRunUpdate) SaveX(ctx context.Context) int {
 }
 
 // Exec executes the query.
-func (pcru *ProblemCaseRunUpdate) Exec(ctx context.Context) error {
-	_, err := pcru.Save(ctx)
+func (_u *ProblemCaseRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcru *ProblemCaseRunUpdate) ExecX(ctx context.Context) {
-	if err := pcru.Exec(ctx); err != nil {
+func (_u *ProblemCaseRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (pcru *ProblemCaseRunUpdate) check() error {
-	if v, ok := pcru.mutation.BuildURL(); ok {
+func (_u *ProblemCaseRunUpdate) check() error {
+	if v, ok := _u.mutation.BuildURL(); ok {
 		if err := problemcaserun.BuildURLValidator(v); err != nil {
 			return &ValidationError{Name: "build_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.build_url": %w`, err)}
 		}
 	}
-	if v, ok := pcru.mutation.Reason(); ok {
+	if v, ok := _u.mutation.Reason(); ok {
 		if err := problemcaserun.ReasonValidator(v); err != nil {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.reason": %w`, err)}
 		}
@@ -208,49 +222,52 @@ func (pcru *ProblemCaseRunUpdate) check() error {
 	return nil
 }
 
-func (pcru *ProblemCaseRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := pcru.check(); err != nil {
-		return n, err
+func (_u *ProblemCaseRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(problemcaserun.Table, problemcaserun.Columns, sqlgraph.NewFieldSpec(problemcaserun.FieldID, field.TypeInt))
-	if ps := pcru.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := pcru.mutation.Repo(); ok {
+	if value, ok := _u.mutation.Repo(); ok {
 		_spec.SetField(problemcaserun.FieldRepo, field.TypeString, value)
 	}
-	if value, ok := pcru.mutation.Branch(); ok {
+	if value, ok := _u.mutation.Branch(); ok {
 		_spec.SetField(problemcaserun.FieldBranch, field.TypeString, value)
 	}
-	if value, ok := pcru.mutation.SuiteName(); ok {
+	if value, ok := _u.mutation.SuiteName(); ok {
 		_spec.SetField(problemcaserun.FieldSuiteName, field.TypeString, value)
 	}
-	if value, ok := pcru.mutation.CaseName(); ok {
+	if value, ok := _u.mutation.CaseName(); ok {
 		_spec.SetField(problemcaserun.FieldCaseName, field.TypeString, value)
 	}
-	if value, ok := pcru.mutation.Flaky(); ok {
+	if value, ok := _u.mutation.Flaky(); ok {
 		_spec.SetField(problemcaserun.FieldFlaky, field.TypeBool, value)
 	}
-	if value, ok := pcru.mutation.TimecostMs(); ok {
+	if value, ok := _u.mutation.TimecostMs(); ok {
 		_spec.SetField(problemcaserun.FieldTimecostMs, field.TypeInt, value)
 	}
-	if value, ok := pcru.mutation.AddedTimecostMs(); ok {
+	if value, ok := _u.mutation.AddedTimecostMs(); ok {
 		_spec.AddField(problemcaserun.FieldTimecostMs, field.TypeInt, value)
 	}
-	if value, ok := pcru.mutation.ReportTime(); ok {
+	if value, ok := _u.mutation.ReportTime(); ok {
 		_spec.SetField(problemcaserun.FieldReportTime, field.TypeTime, value)
 	}
-	if value, ok := pcru.mutation.BuildURL(); ok {
+	if value, ok := _u.mutation.BuildURL(); ok {
 		_spec.SetField(problemcaserun.FieldBuildURL, field.TypeString, value)
 	}
-	if value, ok := pcru.mutation.Reason(); ok {
+	if value, ok := _u.mutation.Reason(); ok {
 		_spec.SetField(problemcaserun.FieldReason, field.TypeString, value)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pcru.driver, _spec); err != nil {
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(problemcaserun.FieldStatus, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{problemcaserun.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -258,8 +275,8 @@ func (pcru *ProblemCaseRunUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		return 0, err
 	}
-	pcru.mutation.done = true
-	return n, nil
+	_u.mutation.done = true
+	return _node, nil
 }
 
 // ProblemCaseRunUpdateOne is the builder for updating a single ProblemCaseRun entity.
@@ -271,164 +288,178 @@ type ProblemCaseRunUpdateOne struct {
 }
 
 // SetRepo sets the "repo" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetRepo(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetRepo(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetRepo(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetRepo(v)
+	return _u
 }
 
 // SetNillableRepo sets the "repo" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableRepo(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetRepo(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableRepo(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetRepo(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetBranch sets the "branch" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetBranch(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetBranch(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetBranch(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetBranch(v)
+	return _u
 }
 
 // SetNillableBranch sets the "branch" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableBranch(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetBranch(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableBranch(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetBranch(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetSuiteName sets the "suite_name" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetSuiteName(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetSuiteName(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetSuiteName(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetSuiteName(v)
+	return _u
 }
 
 // SetNillableSuiteName sets the "suite_name" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableSuiteName(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetSuiteName(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableSuiteName(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetSuiteName(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetCaseName sets the "case_name" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetCaseName(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetCaseName(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetCaseName(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetCaseName(v)
+	return _u
 }
 
 // SetNillableCaseName sets the "case_name" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableCaseName(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetCaseName(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableCaseName(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetCaseName(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetFlaky sets the "flaky" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetFlaky(b bool) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetFlaky(b)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetFlaky(v bool) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetFlaky(v)
+	return _u
 }
 
 // SetNillableFlaky sets the "flaky" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableFlaky(b *bool) *ProblemCaseRunUpdateOne {
-	if b != nil {
-		pcruo.SetFlaky(*b)
+func (_u *ProblemCaseRunUpdateOne) SetNillableFlaky(v *bool) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetFlaky(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetTimecostMs sets the "timecost_ms" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetTimecostMs(i int) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.ResetTimecostMs()
-	pcruo.mutation.SetTimecostMs(i)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetTimecostMs(v int) *ProblemCaseRunUpdateOne {
+	_u.mutation.ResetTimecostMs()
+	_u.mutation.SetTimecostMs(v)
+	return _u
 }
 
 // SetNillableTimecostMs sets the "timecost_ms" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableTimecostMs(i *int) *ProblemCaseRunUpdateOne {
-	if i != nil {
-		pcruo.SetTimecostMs(*i)
+func (_u *ProblemCaseRunUpdateOne) SetNillableTimecostMs(v *int) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetTimecostMs(*v)
 	}
-	return pcruo
+	return _u
 }
 
-// AddTimecostMs adds i to the "timecost_ms" field.
-func (pcruo *ProblemCaseRunUpdateOne) AddTimecostMs(i int) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.AddTimecostMs(i)
-	return pcruo
+// AddTimecostMs adds value to the "timecost_ms" field.
+func (_u *ProblemCaseRunUpdateOne) AddTimecostMs(v int) *ProblemCaseRunUpdateOne {
+	_u.mutation.AddTimecostMs(v)
+	return _u
 }
 
 // SetReportTime sets the "report_time" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetReportTime(t time.Time) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetReportTime(t)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetReportTime(v time.Time) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetReportTime(v)
+	return _u
 }
 
 // SetNillableReportTime sets the "report_time" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableReportTime(t *time.Time) *ProblemCaseRunUpdateOne {
-	if t != nil {
-		pcruo.SetReportTime(*t)
+func (_u *ProblemCaseRunUpdateOne) SetNillableReportTime(v *time.Time) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetReportTime(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetBuildURL sets the "build_url" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetBuildURL(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetBuildURL(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetBuildURL(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetBuildURL(v)
+	return _u
 }
 
 // SetNillableBuildURL sets the "build_url" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableBuildURL(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetBuildURL(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableBuildURL(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetBuildURL(*v)
 	}
-	return pcruo
+	return _u
 }
 
 // SetReason sets the "reason" field.
-func (pcruo *ProblemCaseRunUpdateOne) SetReason(s string) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.SetReason(s)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) SetReason(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
 }
 
 // SetNillableReason sets the "reason" field if the given value is not nil.
-func (pcruo *ProblemCaseRunUpdateOne) SetNillableReason(s *string) *ProblemCaseRunUpdateOne {
-	if s != nil {
-		pcruo.SetReason(*s)
+func (_u *ProblemCaseRunUpdateOne) SetNillableReason(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
 	}
-	return pcruo
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ProblemCaseRunUpdateOne) SetStatus(v string) *ProblemCaseRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ProblemCaseRunUpdateOne) SetNillableStatus(v *string) *ProblemCaseRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
 }
 
 // Mutation returns the ProblemCaseRunMutation object of the builder.
-func (pcruo *ProblemCaseRunUpdateOne) Mutation() *ProblemCaseRunMutation {
-	return pcruo.mutation
+func (_u *ProblemCaseRunUpdateOne) Mutation() *ProblemCaseRunMutation {
+	return _u.mutation
 }
 
 // Where appends a list predicates to the ProblemCaseRunUpdate builder.
-func (pcruo *ProblemCaseRunUpdateOne) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunUpdateOne {
-	pcruo.mutation.Where(ps...)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) Where(ps ...predicate.ProblemCaseRun) *ProblemCaseRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
 }
 
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
-func (pcruo *ProblemCaseRunUpdateOne) Select(field string, fields ...string) *ProblemCaseRunUpdateOne {
-	pcruo.fields = append([]string{field}, fields...)
-	return pcruo
+func (_u *ProblemCaseRunUpdateOne) Select(field string, fields ...string) *ProblemCaseRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
 }
 
 // Save executes the query and returns the updated ProblemCaseRun entity.
-func (pcruo *ProblemCaseRunUpdateOne) Save(ctx context.Context) (*ProblemCaseRun, error) {
-	return withHooks(ctx, pcruo.sqlSave, pcruo.mutation, pcruo.hooks)
+func (_u *ProblemCaseRunUpdateOne) Save(ctx context.Context) (*ProblemCaseRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
 // SaveX is like Save, but panics if an error occurs.
-func (pcruo *ProblemCaseRunUpdateOne) SaveX(ctx context.Context) *ProblemCaseRun {
-	node, err := pcruo.Save(ctx)
+func (_u *ProblemCaseRunUpdateOne) SaveX(ctx context.Context) *ProblemCaseRun {
+	node, err := _u.Save(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -436,26 +467,26 @@ func (pcruo *ProblemCaseRunUpdateOne) SaveX(ctx context.Context) *ProblemCaseRun
 }
 
 // Exec executes the query on the entity.
-func (pcruo *ProblemCaseRunUpdateOne) Exec(ctx context.Context) error {
-	_, err := pcruo.Save(ctx)
+func (_u *ProblemCaseRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
 	return err
 }
 
 // ExecX is like Exec, but panics if an error occurs.
-func (pcruo *ProblemCaseRunUpdateOne) ExecX(ctx context.Context) {
-	if err := pcruo.Exec(ctx); err != nil {
+func (_u *ProblemCaseRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
 		panic(err)
 	}
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (pcruo *ProblemCaseRunUpdateOne) check() error {
-	if v, ok := pcruo.mutation.BuildURL(); ok {
+func (_u *ProblemCaseRunUpdateOne) check() error {
+	if v, ok := _u.mutation.BuildURL(); ok {
 		if err := problemcaserun.BuildURLValidator(v); err != nil {
 			return &ValidationError{Name: "build_url", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.build_url": %w`, err)}
 		}
 	}
-	if v, ok := pcruo.mutation.Reason(); ok {
+	if v, ok := _u.mutation.Reason(); ok {
 		if err := problemcaserun.ReasonValidator(v); err != nil {
 			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "ProblemCaseRun.reason": %w`, err)}
 		}
@@ -463,17 +494,17 @@ func (pcruo *ProblemCaseRunUpdateOne) check() error {
 	return nil
 }
 
-func (pcruo *ProblemCaseRunUpdateOne) sqlSave(ctx context.Context) (_node *ProblemCaseRun, err error) {
-	if err := pcruo.check(); err != nil {
+func (_u *ProblemCaseRunUpdateOne) sqlSave(ctx context.Context) (_node *ProblemCaseRun, err error) {
+	if err := _u.check(); err != nil {
 		return _node, err
 	}
 	_spec := sqlgraph.NewUpdateSpec(problemcaserun.Table, problemcaserun.Columns, sqlgraph.NewFieldSpec(problemcaserun.FieldID, field.TypeInt))
-	id, ok := pcruo.mutation.ID()
+	id, ok := _u.mutation.ID()
 	if !ok {
 		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProblemCaseRun.id" for update`)}
 	}
 	_spec.Node.ID.Value = id
-	if fields := pcruo.fields; len(fields) > 0 {
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, problemcaserun.FieldID)
 		for _, f := range fields {
@@ -485,47 +516,50 @@ func (pcruo *ProblemCaseRunUpdateOne) sqlSave(ctx context.Context) (_node *Probl
 			}
 		}
 	}
-	if ps := pcruo.mutation.predicates; len(ps) > 0 {
+	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
 		}
 	}
-	if value, ok := pcruo.mutation.Repo(); ok {
+	if value, ok := _u.mutation.Repo(); ok {
 		_spec.SetField(problemcaserun.FieldRepo, field.TypeString, value)
 	}
-	if value, ok := pcruo.mutation.Branch(); ok {
+	if value, ok := _u.mutation.Branch(); ok {
 		_spec.SetField(problemcaserun.FieldBranch, field.TypeString, value)
 	}
-	if value, ok := pcruo.mutation.SuiteName(); ok {
+	if value, ok := _u.mutation.SuiteName(); ok {
 		_spec.SetField(problemcaserun.FieldSuiteName, field.TypeString, value)
 	}
-	if value, ok := pcruo.mutation.CaseName(); ok {
+	if value, ok := _u.mutation.CaseName(); ok {
 		_spec.SetField(problemcaserun.FieldCaseName, field.TypeString, value)
 	}
-	if value, ok := pcruo.mutation.Flaky(); ok {
+	if value, ok := _u.mutation.Flaky(); ok {
 		_spec.SetField(problemcaserun.FieldFlaky, field.TypeBool, value)
 	}
-	if value, ok := pcruo.mutation.TimecostMs(); ok {
+	if value, ok := _u.mutation.TimecostMs(); ok {
 		_spec.SetField(problemcaserun.FieldTimecostMs, field.TypeInt, value)
 	}
-	if value, ok := pcruo.mutation.AddedTimecostMs(); ok {
+	if value, ok := _u.mutation.AddedTimecostMs(); ok {
 		_spec.AddField(problemcaserun.FieldTimecostMs, field.TypeInt, value)
 	}
-	if value, ok := pcruo.mutation.ReportTime(); ok {
+	if value, ok := _u.mutation.ReportTime(); ok {
 		_spec.SetField(problemcaserun.FieldReportTime, field.TypeTime, value)
 	}
-	if value, ok := pcruo.mutation.BuildURL(); ok {
+	if value, ok := _u.mutation.BuildURL(); ok {
 		_spec.SetField(problemcaserun.FieldBuildURL, field.TypeString, value)
 	}
-	if value, ok := pcruo.mutation.Reason(); ok {
+	if value, ok := _u.mutation.Reason(); ok {
 		_spec.SetField(problemcaserun.FieldReason, field.TypeString, value)
 	}
-	_node = &ProblemCaseRun{config: pcruo.config}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(problemcaserun.FieldStatus, field.TypeString, value)
+	}
+	_node = &ProblemCaseRun{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
-	if err = sqlgraph.UpdateNode(ctx, pcruo.driver, _spec); err != nil {
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{problemcaserun.Label}
 		} else if sqlgraph.IsConstraintError(err) {
@@ -533,6 +567,6 @@ func (pcruo *ProblemCaseRunUpdateOne) sqlSave(ctx context.Context) (_node *Probl
 		}
 		return nil, err
 	}
-	pcruo.mutation.done = true
+	_u.mutation.done = true
 	return _node, nil
 }
@@ -3,14 +3,86 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/schema"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	archivedeventFields := schema.ArchivedEvent{}.Fields()
+	_ = archivedeventFields
+	// archivedeventDescEventID is the schema descriptor for event_id field.
+	archivedeventDescEventID := archivedeventFields[0].Descriptor()
+	// archivedevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	archivedevent.EventIDValidator = archivedeventDescEventID.Validators[0].(func(string) error)
+	// archivedeventDescType is the schema descriptor for type field.
+	archivedeventDescType := archivedeventFields[1].Descriptor()
+	// archivedevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	archivedevent.TypeValidator = archivedeventDescType.Validators[0].(func(string) error)
+	// archivedeventDescSource is the schema descriptor for source field.
+	archivedeventDescSource := archivedeventFields[2].Descriptor()
+	// archivedevent.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	archivedevent.SourceValidator = archivedeventDescSource.Validators[0].(func(string) error)
+	// archivedeventDescSubject is the schema descriptor for subject field.
+	archivedeventDescSubject := archivedeventFields[3].Descriptor()
+	// archivedevent.DefaultSubject holds the default value on creation for the subject field.
+	archivedevent.DefaultSubject = archivedeventDescSubject.Default.(string)
+	// archivedevent.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	archivedevent.SubjectValidator = archivedeventDescSubject.Validators[0].(func(string) error)
+	// archivedeventDescTopic is the schema descriptor for topic field.
+	archivedeventDescTopic := archivedeventFields[5].Descriptor()
+	// archivedevent.DefaultTopic holds the default value on creation for the topic field.
+	archivedevent.DefaultTopic = archivedeventDescTopic.Default.(string)
+	// archivedevent.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	archivedevent.TopicValidator = archivedeventDescTopic.Validators[0].(func(string) error)
+	// archivedeventDescDataContentType is the schema descriptor for data_content_type field.
+	archivedeventDescDataContentType := archivedeventFields[6].Descriptor()
+	// archivedevent.DefaultDataContentType holds the default value on creation for the data_content_type field.
+	archivedevent.DefaultDataContentType = archivedeventDescDataContentType.Default.(string)
+	// archivedevent.DataContentTypeValidator is a validator for the "data_content_type" field. It is called by the builders before save.
+	archivedevent.DataContentTypeValidator = archivedeventDescDataContentType.Validators[0].(func(string) error)
+	// archivedeventDescTruncated is the schema descriptor for truncated field.
+	archivedeventDescTruncated := archivedeventFields[9].Descriptor()
+	// archivedevent.DefaultTruncated holds the default value on creation for the truncated field.
+	archivedevent.DefaultTruncated = archivedeventDescTruncated.Default.(bool)
+	larkruncardFields := schema.LarkRunCard{}.Fields()
+	_ = larkruncardFields
+	// larkruncardDescCreatedAt is the schema descriptor for created_at field.
+	larkruncardDescCreatedAt := larkruncardFields[4].Descriptor()
+	// larkruncard.DefaultCreatedAt holds the default value on creation for the created_at field.
+	larkruncard.DefaultCreatedAt = larkruncardDescCreatedAt.Default.(func() time.Time)
+	// larkruncardDescUpdatedAt is the schema descriptor for updated_at field.
+	larkruncardDescUpdatedAt := larkruncardFields[5].Descriptor()
+	// larkruncard.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	larkruncard.DefaultUpdatedAt = larkruncardDescUpdatedAt.Default.(func() time.Time)
+	// larkruncard.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	larkruncard.UpdateDefaultUpdatedAt = larkruncardDescUpdatedAt.UpdateDefault.(func() time.Time)
+	problemcaseissueFields := schema.ProblemCaseIssue{}.Fields()
+	_ = problemcaseissueFields
+	// problemcaseissueDescIssueURL is the schema descriptor for issue_url field.
+	problemcaseissueDescIssueURL := problemcaseissueFields[5].Descriptor()
+	// problemcaseissue.IssueURLValidator is a validator for the "issue_url" field. It is called by the builders before save.
+	problemcaseissue.IssueURLValidator = problemcaseissueDescIssueURL.Validators[0].(func(string) error)
+	// problemcaseissueDescCreatedAt is the schema descriptor for created_at field.
+	problemcaseissueDescCreatedAt := problemcaseissueFields[6].Descriptor()
+	// problemcaseissue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	problemcaseissue.DefaultCreatedAt = problemcaseissueDescCreatedAt.Default.(func() time.Time)
+	// problemcaseissueDescUpdatedAt is the schema descriptor for updated_at field.
+	problemcaseissueDescUpdatedAt := problemcaseissueFields[7].Descriptor()
+	// problemcaseissue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	problemcaseissue.DefaultUpdatedAt = problemcaseissueDescUpdatedAt.Default.(func() time.Time)
+	// problemcaseissue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	problemcaseissue.UpdateDefaultUpdatedAt = problemcaseissueDescUpdatedAt.UpdateDefault.(func() time.Time)
 	problemcaserunFields := schema.ProblemCaseRun{}.Fields()
 	_ = problemcaserunFields
 	// problemcaserunDescFlaky is the schema descriptor for flaky field.
@@ -25,4 +97,84 @@ func init() {
 	problemcaserunDescReason := problemcaserunFields[8].Descriptor()
 	// problemcaserun.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
 	problemcaserun.ReasonValidator = problemcaserunDescReason.Validators[0].(func(string) error)
+	// problemcaserunDescStatus is the schema descriptor for status field.
+	problemcaserunDescStatus := problemcaserunFields[9].Descriptor()
+	// problemcaserun.DefaultStatus holds the default value on creation for the status field.
+	problemcaserun.DefaultStatus = problemcaserunDescStatus.Default.(string)
+	tektonrunFields := schema.TektonRun{}.Fields()
+	_ = tektonrunFields
+	// tektonrunDescPipeline is the schema descriptor for pipeline field.
+	tektonrunDescPipeline := tektonrunFields[4].Descriptor()
+	// tektonrun.DefaultPipeline holds the default value on creation for the pipeline field.
+	tektonrun.DefaultPipeline = tektonrunDescPipeline.Default.(string)
+	// tektonrunDescPipelineRun is the schema descriptor for pipeline_run field.
+	tektonrunDescPipelineRun := tektonrunFields[5].Descriptor()
+	// tektonrun.DefaultPipelineRun holds the default value on creation for the pipeline_run field.
+	tektonrun.DefaultPipelineRun = tektonrunDescPipelineRun.Default.(string)
+	// tektonrunDescTriggerUser is the schema descriptor for trigger_user field.
+	tektonrunDescTriggerUser := tektonrunFields[6].Descriptor()
+	// tektonrun.DefaultTriggerUser holds the default value on creation for the trigger_user field.
+	tektonrun.DefaultTriggerUser = tektonrunDescTriggerUser.Default.(string)
+	// tektonrunDescReason is the schema descriptor for reason field.
+	tektonrunDescReason := tektonrunFields[9].Descriptor()
+	// tektonrun.DefaultReason holds the default value on creation for the reason field.
+	tektonrun.DefaultReason = tektonrunDescReason.Default.(string)
+	// tektonrunDescDurationMs is the schema descriptor for duration_ms field.
+	tektonrunDescDurationMs := tektonrunFields[13].Descriptor()
+	// tektonrun.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	tektonrun.DefaultDurationMs = tektonrunDescDurationMs.Default.(int)
+	// tektonrunDescCreatedAt is the schema descriptor for created_at field.
+	tektonrunDescCreatedAt := tektonrunFields[14].Descriptor()
+	// tektonrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tektonrun.DefaultCreatedAt = tektonrunDescCreatedAt.Default.(func() time.Time)
+	// tektonrunDescUpdatedAt is the schema descriptor for updated_at field.
+	tektonrunDescUpdatedAt := tektonrunFields[15].Descriptor()
+	// tektonrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tektonrun.DefaultUpdatedAt = tektonrunDescUpdatedAt.Default.(func() time.Time)
+	// tektonrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tektonrun.UpdateDefaultUpdatedAt = tektonrunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tektonrunfailureFields := schema.TektonRunFailure{}.Fields()
+	_ = tektonrunfailureFields
+	// tektonrunfailureDescPipeline is the schema descriptor for pipeline field.
+	tektonrunfailureDescPipeline := tektonrunfailureFields[4].Descriptor()
+	// tektonrunfailure.DefaultPipeline holds the default value on creation for the pipeline field.
+	tektonrunfailure.DefaultPipeline = tektonrunfailureDescPipeline.Default.(string)
+	// tektonrunfailureDescTask is the schema descriptor for task field.
+	tektonrunfailureDescTask := tektonrunfailureFields[6].Descriptor()
+	// tektonrunfailure.DefaultTask holds the default value on creation for the task field.
+	tektonrunfailure.DefaultTask = tektonrunfailureDescTask.Default.(string)
+	// tektonrunfailureDescStep is the schema descriptor for step field.
+	tektonrunfailureDescStep := tektonrunfailureFields[7].Descriptor()
+	// tektonrunfailure.DefaultStep holds the default value on creation for the step field.
+	tektonrunfailure.DefaultStep = tektonrunfailureDescStep.Default.(string)
+	// tektonrunfailureDescReason is the schema descriptor for reason field.
+	tektonrunfailureDescReason := tektonrunfailureFields[8].Descriptor()
+	// tektonrunfailure.DefaultReason holds the default value on creation for the reason field.
+	tektonrunfailure.DefaultReason = tektonrunfailureDescReason.Default.(string)
+	// tektonrunfailureDescCreatedAt is the schema descriptor for created_at field.
+	tektonrunfailureDescCreatedAt := tektonrunfailureFields[10].Descriptor()
+	// tektonrunfailure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tektonrunfailure.DefaultCreatedAt = tektonrunfailureDescCreatedAt.Default.(func() time.Time)
+	tektonsubscriptionFields := schema.TektonSubscription{}.Fields()
+	_ = tektonsubscriptionFields
+	// tektonsubscriptionDescNamespace is the schema descriptor for namespace field.
+	tektonsubscriptionDescNamespace := tektonsubscriptionFields[1].Descriptor()
+	// tektonsubscription.DefaultNamespace holds the default value on creation for the namespace field.
+	tektonsubscription.DefaultNamespace = tektonsubscriptionDescNamespace.Default.(string)
+	// tektonsubscriptionDescPipeline is the schema descriptor for pipeline field.
+	tektonsubscriptionDescPipeline := tektonsubscriptionFields[2].Descriptor()
+	// tektonsubscription.DefaultPipeline holds the default value on creation for the pipeline field.
+	tektonsubscription.DefaultPipeline = tektonsubscriptionDescPipeline.Default.(string)
+	// tektonsubscriptionDescLabelSelector is the schema descriptor for label_selector field.
+	tektonsubscriptionDescLabelSelector := tektonsubscriptionFields[3].Descriptor()
+	// tektonsubscription.DefaultLabelSelector holds the default value on creation for the label_selector field.
+	tektonsubscription.DefaultLabelSelector = tektonsubscriptionDescLabelSelector.Default.(string)
+	// tektonsubscriptionDescCreatedBy is the schema descriptor for created_by field.
+	tektonsubscriptionDescCreatedBy := tektonsubscriptionFields[5].Descriptor()
+	// tektonsubscription.DefaultCreatedBy holds the default value on creation for the created_by field.
+	tektonsubscription.DefaultCreatedBy = tektonsubscriptionDescCreatedBy.Default.(string)
+	// tektonsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	tektonsubscriptionDescCreatedAt := tektonsubscriptionFields[6].Descriptor()
+	// tektonsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tektonsubscription.DefaultCreatedAt = tektonsubscriptionDescCreatedAt.Default.(func() time.Time)
 }
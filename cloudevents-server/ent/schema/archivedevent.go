@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArchivedEvent holds the schema definition for the ArchivedEvent entity.
+type ArchivedEvent struct {
+	ent.Schema
+}
+
+// Fields of the ArchivedEvent.
+func (ArchivedEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").MaxLen(255).Comment("cloud event id"),
+		field.String("type").MaxLen(255).Comment("cloud event type"),
+		field.String("source").MaxLen(1024).Comment("cloud event source"),
+		field.String("subject").MaxLen(1024).Default("").Comment("cloud event subject"),
+		field.Time("time").Comment("cloud event time, the received time when the event has none"),
+		field.String("topic").MaxLen(255).Default("").Comment("resolved kafka topic, empty when the event is ignored"),
+		field.String("data_content_type").MaxLen(255).Default("").Comment("content type of the payload"),
+		field.JSON("extensions", map[string]string{}).Optional().Comment("cloud event extension attributes"),
+		field.Bytes("payload").Optional().Comment("cloud event data, may be truncated"),
+		field.Bool("truncated").Default(false).Comment("is the payload truncated?"),
+		field.Time("received_at").Comment("received timestamp"),
+	}
+}
+
+// Indexes of the ArchivedEvent.
+func (ArchivedEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id"),
+		index.Fields("type", "time"),
+		index.Fields("time"),
+		index.Fields("received_at"),
+	}
+}
+
+// Edges of the ArchivedEvent.
+func (ArchivedEvent) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LarkRunCard holds the schema definition for the LarkRunCard entity.
+type LarkRunCard struct {
+	ent.Schema
+}
+
+// Fields of the LarkRunCard.
+func (LarkRunCard) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("run_uid").Comment("uid of the tekton run"),
+		field.String("receiver").Comment("lark receiver of the card"),
+		field.String("message_id").Comment("lark message id of the card"),
+		field.String("event_type").Comment("type of the last event updated the card"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the LarkRunCard, one card is sent to a receiver for a run.
+func (LarkRunCard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_uid", "receiver").Unique(),
+	}
+}
+
+// Edges of the LarkRunCard.
+func (LarkRunCard) Edges() []ent.Edge {
+	return nil
+}
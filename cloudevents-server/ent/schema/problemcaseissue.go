@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProblemCaseIssue holds the schema definition for the ProblemCaseIssue entity.
+type ProblemCaseIssue struct {
+	ent.Schema
+}
+
+// Fields of the ProblemCaseIssue.
+func (ProblemCaseIssue) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("repo").Comment("repo full name of the test case"),
+		field.String("suite_name").Comment("suite name, target name in bazel."),
+		field.String("case_name").Comment("case name, may be TextXxx.TestYyy format."),
+		field.String("issue_repo").Comment("repo full name of the issue"),
+		field.Int("issue_number").Comment("github issue number"),
+		field.String("issue_url").MaxLen(1024).Comment("github issue html url"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProblemCaseIssue, one issue is tracked for a case.
+func (ProblemCaseIssue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("repo", "suite_name", "case_name").Unique(),
+	}
+}
+
+// Edges of the ProblemCaseIssue.
+func (ProblemCaseIssue) Edges() []ent.Edge {
+	return nil
+}
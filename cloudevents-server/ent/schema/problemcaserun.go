@@ -3,6 +3,7 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // ProblemCaseRun holds the schema definition for the ProblemCaseRun entity.
@@ -22,6 +23,17 @@ func (ProblemCaseRun) Fields() []ent.Field {
 		field.Time("report_time").Comment("report unit timestamp"),
 		field.String("build_url").MaxLen(1024).Comment("CI build url"),
 		field.String("reason").MaxLen(32).Comment("failure reason"),
+		field.String("status").Default("").Comment("run status: passed, failed, skipped or flaky, empty for the legacy records"),
+	}
+}
+
+// Indexes of the ProblemCaseRun, for the flaky and slow cases analytics.
+func (ProblemCaseRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("repo", "branch", "report_time"),
+		// without the branch, the key of four varchar(255) columns is over the
+		// 3072 bytes limit of MySQL and TiDB.
+		index.Fields("repo", "suite_name", "case_name", "report_time"),
 	}
 }
 
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TektonRun holds the schema definition for the TektonRun entity.
+type TektonRun struct {
+	ent.Schema
+}
+
+// Fields of the TektonRun.
+func (TektonRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("run_uid").Comment("uid of the tekton run"),
+		field.String("kind").Comment("kind of the run: pipelinerun or taskrun"),
+		field.String("namespace"),
+		field.String("name").Comment("name of the run"),
+		field.String("pipeline").Default("").Comment("name of the pipeline or the task of the run"),
+		field.String("pipeline_run").Default("").Comment("name of the pipeline run which the task run belongs to"),
+		field.String("trigger_user").Default(""),
+		field.JSON("params", map[string]string{}).Optional().Comment("recorded params of the run"),
+		field.String("status").Comment("status of the run: running, succeeded, failed or cancelled"),
+		field.String("reason").Default("").Comment("reason of the succeeded condition"),
+		field.Strings("failed_tasks").Optional().Comment("pipeline task names of the failed task runs"),
+		field.Time("start_time").Optional().Nillable(),
+		field.Time("end_time").Optional().Nillable(),
+		field.Int("duration_ms").Default(0).Comment("duration of the finished run in milliseconds"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the TektonRun, a run is recorded once and updated by its events.
+func (TektonRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_uid").Unique(),
+		index.Fields("kind", "namespace", "pipeline", "start_time"),
+		index.Fields("kind", "start_time"),
+	}
+}
+
+// Edges of the TektonRun.
+func (TektonRun) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TektonRunFailure holds the schema definition for the TektonRunFailure entity.
+type TektonRunFailure struct {
+	ent.Schema
+}
+
+// Fields of the TektonRunFailure.
+func (TektonRunFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("run_uid").Comment("uid of the tekton run"),
+		field.String("kind").Comment("kind of the run: pipelinerun or taskrun"),
+		field.String("namespace"),
+		field.String("name").Comment("name of the run"),
+		field.String("pipeline").Default("").Comment("name of the pipeline or the task of the run"),
+		field.String("category").Comment("classified category of the failure"),
+		field.String("task").Default("").Comment("pipeline task name of the failure"),
+		field.String("step").Default("").Comment("step name of the failure"),
+		field.String("reason").Default("").Comment("step termination or condition reason of the failure"),
+		field.Time("failed_at"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TektonRunFailure, a run is recorded once.
+func (TektonRunFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_uid").Unique(),
+		index.Fields("category", "failed_at"),
+		index.Fields("namespace", "pipeline", "failed_at"),
+	}
+}
+
+// Edges of the TektonRunFailure.
+func (TektonRunFailure) Edges() []ent.Edge {
+	return nil
+}
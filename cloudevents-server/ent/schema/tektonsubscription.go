@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TektonSubscription holds the schema definition for the TektonSubscription entity.
+type TektonSubscription struct {
+	ent.Schema
+}
+
+// Fields of the TektonSubscription.
+func (TektonSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("receiver").Comment("lark receiver: email, open id or chat id"),
+		field.String("namespace").Default("").Comment("namespace of the runs, empty for all"),
+		field.String("pipeline").Default("").Comment("glob pattern of the pipeline name, empty for all"),
+		field.String("label_selector").Default("").Comment("kubernetes label selector of the runs, empty for all"),
+		field.Strings("event_types").Optional().Comment("glob patterns of the event types, empty for all"),
+		field.String("created_by").Default("").Comment("who created the subscription"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Indexes of the TektonSubscription.
+func (TektonSubscription) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("receiver"),
+	}
+}
+
+// Edges of the TektonSubscription.
+func (TektonSubscription) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+)
+
+// TektonRun is the model entity for the TektonRun schema.
+type TektonRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// uid of the tekton run
+	RunUID string `json:"run_uid,omitempty"`
+	// kind of the run: pipelinerun or taskrun
+	Kind string `json:"kind,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// name of the run
+	Name string `json:"name,omitempty"`
+	// name of the pipeline or the task of the run
+	Pipeline string `json:"pipeline,omitempty"`
+	// name of the pipeline run which the task run belongs to
+	PipelineRun string `json:"pipeline_run,omitempty"`
+	// TriggerUser holds the value of the "trigger_user" field.
+	TriggerUser string `json:"trigger_user,omitempty"`
+	// recorded params of the run
+	Params map[string]string `json:"params,omitempty"`
+	// status of the run: running, succeeded, failed or cancelled
+	Status string `json:"status,omitempty"`
+	// reason of the succeeded condition
+	Reason string `json:"reason,omitempty"`
+	// pipeline task names of the failed task runs
+	FailedTasks []string `json:"failed_tasks,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime *time.Time `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime *time.Time `json:"end_time,omitempty"`
+	// duration of the finished run in milliseconds
+	DurationMs int `json:"duration_ms,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TektonRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tektonrun.FieldParams, tektonrun.FieldFailedTasks:
+			values[i] = new([]byte)
+		case tektonrun.FieldID, tektonrun.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case tektonrun.FieldRunUID, tektonrun.FieldKind, tektonrun.FieldNamespace, tektonrun.FieldName, tektonrun.FieldPipeline, tektonrun.FieldPipelineRun, tektonrun.FieldTriggerUser, tektonrun.FieldStatus, tektonrun.FieldReason:
+			values[i] = new(sql.NullString)
+		case tektonrun.FieldStartTime, tektonrun.FieldEndTime, tektonrun.FieldCreatedAt, tektonrun.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TektonRun fields.
+func (_m *TektonRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tektonrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tektonrun.FieldRunUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_uid", values[i])
+			} else if value.Valid {
+				_m.RunUID = value.String
+			}
+		case tektonrun.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case tektonrun.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case tektonrun.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tektonrun.FieldPipeline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline", values[i])
+			} else if value.Valid {
+				_m.Pipeline = value.String
+			}
+		case tektonrun.FieldPipelineRun:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline_run", values[i])
+			} else if value.Valid {
+				_m.PipelineRun = value.String
+			}
+		case tektonrun.FieldTriggerUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_user", values[i])
+			} else if value.Valid {
+				_m.TriggerUser = value.String
+			}
+		case tektonrun.FieldParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Params); err != nil {
+					return fmt.Errorf("unmarshal field params: %w", err)
+				}
+			}
+		case tektonrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case tektonrun.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case tektonrun.FieldFailedTasks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_tasks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FailedTasks); err != nil {
+					return fmt.Errorf("unmarshal field failed_tasks: %w", err)
+				}
+			}
+		case tektonrun.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = new(time.Time)
+				*_m.StartTime = value.Time
+			}
+		case tektonrun.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = new(time.Time)
+				*_m.EndTime = value.Time
+			}
+		case tektonrun.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = int(value.Int64)
+			}
+		case tektonrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tektonrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TektonRun.
+// This includes values selected through modifiers, order, etc.
+func (_m *TektonRun) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TektonRun.
+// Note that you need to call TektonRun.Unwrap() before calling this method if this TektonRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TektonRun) Update() *TektonRunUpdateOne {
+	return NewTektonRunClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TektonRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TektonRun) Unwrap() *TektonRun {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TektonRun is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TektonRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("TektonRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_uid=")
+	builder.WriteString(_m.RunUID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline=")
+	builder.WriteString(_m.Pipeline)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline_run=")
+	builder.WriteString(_m.PipelineRun)
+	builder.WriteString(", ")
+	builder.WriteString("trigger_user=")
+	builder.WriteString(_m.TriggerUser)
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Params))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("failed_tasks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedTasks))
+	builder.WriteString(", ")
+	if v := _m.StartTime; v != nil {
+		builder.WriteString("start_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndTime; v != nil {
+		builder.WriteString("end_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TektonRuns is a parsable slice of TektonRun.
+type TektonRuns []*TektonRun
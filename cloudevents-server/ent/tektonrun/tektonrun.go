@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tektonrun type in the database.
+	Label = "tekton_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunUID holds the string denoting the run_uid field in the database.
+	FieldRunUID = "run_uid"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPipeline holds the string denoting the pipeline field in the database.
+	FieldPipeline = "pipeline"
+	// FieldPipelineRun holds the string denoting the pipeline_run field in the database.
+	FieldPipelineRun = "pipeline_run"
+	// FieldTriggerUser holds the string denoting the trigger_user field in the database.
+	FieldTriggerUser = "trigger_user"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldFailedTasks holds the string denoting the failed_tasks field in the database.
+	FieldFailedTasks = "failed_tasks"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the tektonrun in the database.
+	Table = "tekton_runs"
+)
+
+// Columns holds all SQL columns for tektonrun fields.
+var Columns = []string{
+	FieldID,
+	FieldRunUID,
+	FieldKind,
+	FieldNamespace,
+	FieldName,
+	FieldPipeline,
+	FieldPipelineRun,
+	FieldTriggerUser,
+	FieldParams,
+	FieldStatus,
+	FieldReason,
+	FieldFailedTasks,
+	FieldStartTime,
+	FieldEndTime,
+	FieldDurationMs,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPipeline holds the default value on creation for the "pipeline" field.
+	DefaultPipeline string
+	// DefaultPipelineRun holds the default value on creation for the "pipeline_run" field.
+	DefaultPipelineRun string
+	// DefaultTriggerUser holds the default value on creation for the "trigger_user" field.
+	DefaultTriggerUser string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultDurationMs holds the default value on creation for the "duration_ms" field.
+	DefaultDurationMs int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TektonRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunUID orders the results by the run_uid field.
+func ByRunUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunUID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPipeline orders the results by the pipeline field.
+func ByPipeline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipeline, opts...).ToFunc()
+}
+
+// ByPipelineRun orders the results by the pipeline_run field.
+func ByPipelineRun(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipelineRun, opts...).ToFunc()
+}
+
+// ByTriggerUser orders the results by the trigger_user field.
+func ByTriggerUser(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerUser, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
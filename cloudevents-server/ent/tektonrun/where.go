@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldID, id))
+}
+
+// RunUID applies equality check predicate on the "run_uid" field. It's identical to RunUIDEQ.
+func RunUID(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldRunUID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldKind, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldName, v))
+}
+
+// Pipeline applies equality check predicate on the "pipeline" field. It's identical to PipelineEQ.
+func Pipeline(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldPipeline, v))
+}
+
+// PipelineRun applies equality check predicate on the "pipeline_run" field. It's identical to PipelineRunEQ.
+func PipelineRun(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldPipelineRun, v))
+}
+
+// TriggerUser applies equality check predicate on the "trigger_user" field. It's identical to TriggerUserEQ.
+func TriggerUser(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldTriggerUser, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldReason, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RunUIDEQ applies the EQ predicate on the "run_uid" field.
+func RunUIDEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldRunUID, v))
+}
+
+// RunUIDNEQ applies the NEQ predicate on the "run_uid" field.
+func RunUIDNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldRunUID, v))
+}
+
+// RunUIDIn applies the In predicate on the "run_uid" field.
+func RunUIDIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldRunUID, vs...))
+}
+
+// RunUIDNotIn applies the NotIn predicate on the "run_uid" field.
+func RunUIDNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldRunUID, vs...))
+}
+
+// RunUIDGT applies the GT predicate on the "run_uid" field.
+func RunUIDGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldRunUID, v))
+}
+
+// RunUIDGTE applies the GTE predicate on the "run_uid" field.
+func RunUIDGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldRunUID, v))
+}
+
+// RunUIDLT applies the LT predicate on the "run_uid" field.
+func RunUIDLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldRunUID, v))
+}
+
+// RunUIDLTE applies the LTE predicate on the "run_uid" field.
+func RunUIDLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldRunUID, v))
+}
+
+// RunUIDContains applies the Contains predicate on the "run_uid" field.
+func RunUIDContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldRunUID, v))
+}
+
+// RunUIDHasPrefix applies the HasPrefix predicate on the "run_uid" field.
+func RunUIDHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldRunUID, v))
+}
+
+// RunUIDHasSuffix applies the HasSuffix predicate on the "run_uid" field.
+func RunUIDHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldRunUID, v))
+}
+
+// RunUIDEqualFold applies the EqualFold predicate on the "run_uid" field.
+func RunUIDEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldRunUID, v))
+}
+
+// RunUIDContainsFold applies the ContainsFold predicate on the "run_uid" field.
+func RunUIDContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldRunUID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldKind, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldName, v))
+}
+
+// PipelineEQ applies the EQ predicate on the "pipeline" field.
+func PipelineEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldPipeline, v))
+}
+
+// PipelineNEQ applies the NEQ predicate on the "pipeline" field.
+func PipelineNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldPipeline, v))
+}
+
+// PipelineIn applies the In predicate on the "pipeline" field.
+func PipelineIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldPipeline, vs...))
+}
+
+// PipelineNotIn applies the NotIn predicate on the "pipeline" field.
+func PipelineNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldPipeline, vs...))
+}
+
+// PipelineGT applies the GT predicate on the "pipeline" field.
+func PipelineGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldPipeline, v))
+}
+
+// PipelineGTE applies the GTE predicate on the "pipeline" field.
+func PipelineGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldPipeline, v))
+}
+
+// PipelineLT applies the LT predicate on the "pipeline" field.
+func PipelineLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldPipeline, v))
+}
+
+// PipelineLTE applies the LTE predicate on the "pipeline" field.
+func PipelineLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldPipeline, v))
+}
+
+// PipelineContains applies the Contains predicate on the "pipeline" field.
+func PipelineContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldPipeline, v))
+}
+
+// PipelineHasPrefix applies the HasPrefix predicate on the "pipeline" field.
+func PipelineHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldPipeline, v))
+}
+
+// PipelineHasSuffix applies the HasSuffix predicate on the "pipeline" field.
+func PipelineHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldPipeline, v))
+}
+
+// PipelineEqualFold applies the EqualFold predicate on the "pipeline" field.
+func PipelineEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldPipeline, v))
+}
+
+// PipelineContainsFold applies the ContainsFold predicate on the "pipeline" field.
+func PipelineContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldPipeline, v))
+}
+
+// PipelineRunEQ applies the EQ predicate on the "pipeline_run" field.
+func PipelineRunEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldPipelineRun, v))
+}
+
+// PipelineRunNEQ applies the NEQ predicate on the "pipeline_run" field.
+func PipelineRunNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldPipelineRun, v))
+}
+
+// PipelineRunIn applies the In predicate on the "pipeline_run" field.
+func PipelineRunIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldPipelineRun, vs...))
+}
+
+// PipelineRunNotIn applies the NotIn predicate on the "pipeline_run" field.
+func PipelineRunNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldPipelineRun, vs...))
+}
+
+// PipelineRunGT applies the GT predicate on the "pipeline_run" field.
+func PipelineRunGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldPipelineRun, v))
+}
+
+// PipelineRunGTE applies the GTE predicate on the "pipeline_run" field.
+func PipelineRunGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldPipelineRun, v))
+}
+
+// PipelineRunLT applies the LT predicate on the "pipeline_run" field.
+func PipelineRunLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldPipelineRun, v))
+}
+
+// PipelineRunLTE applies the LTE predicate on the "pipeline_run" field.
+func PipelineRunLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldPipelineRun, v))
+}
+
+// PipelineRunContains applies the Contains predicate on the "pipeline_run" field.
+func PipelineRunContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldPipelineRun, v))
+}
+
+// PipelineRunHasPrefix applies the HasPrefix predicate on the "pipeline_run" field.
+func PipelineRunHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldPipelineRun, v))
+}
+
+// PipelineRunHasSuffix applies the HasSuffix predicate on the "pipeline_run" field.
+func PipelineRunHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldPipelineRun, v))
+}
+
+// PipelineRunEqualFold applies the EqualFold predicate on the "pipeline_run" field.
+func PipelineRunEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldPipelineRun, v))
+}
+
+// PipelineRunContainsFold applies the ContainsFold predicate on the "pipeline_run" field.
+func PipelineRunContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldPipelineRun, v))
+}
+
+// TriggerUserEQ applies the EQ predicate on the "trigger_user" field.
+func TriggerUserEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldTriggerUser, v))
+}
+
+// TriggerUserNEQ applies the NEQ predicate on the "trigger_user" field.
+func TriggerUserNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldTriggerUser, v))
+}
+
+// TriggerUserIn applies the In predicate on the "trigger_user" field.
+func TriggerUserIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldTriggerUser, vs...))
+}
+
+// TriggerUserNotIn applies the NotIn predicate on the "trigger_user" field.
+func TriggerUserNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldTriggerUser, vs...))
+}
+
+// TriggerUserGT applies the GT predicate on the "trigger_user" field.
+func TriggerUserGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldTriggerUser, v))
+}
+
+// TriggerUserGTE applies the GTE predicate on the "trigger_user" field.
+func TriggerUserGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldTriggerUser, v))
+}
+
+// TriggerUserLT applies the LT predicate on the "trigger_user" field.
+func TriggerUserLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldTriggerUser, v))
+}
+
+// TriggerUserLTE applies the LTE predicate on the "trigger_user" field.
+func TriggerUserLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldTriggerUser, v))
+}
+
+// TriggerUserContains applies the Contains predicate on the "trigger_user" field.
+func TriggerUserContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldTriggerUser, v))
+}
+
+// TriggerUserHasPrefix applies the HasPrefix predicate on the "trigger_user" field.
+func TriggerUserHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldTriggerUser, v))
+}
+
+// TriggerUserHasSuffix applies the HasSuffix predicate on the "trigger_user" field.
+func TriggerUserHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldTriggerUser, v))
+}
+
+// TriggerUserEqualFold applies the EqualFold predicate on the "trigger_user" field.
+func TriggerUserEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldTriggerUser, v))
+}
+
+// TriggerUserContainsFold applies the ContainsFold predicate on the "trigger_user" field.
+func TriggerUserContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldTriggerUser, v))
+}
+
+// ParamsIsNil applies the IsNil predicate on the "params" field.
+func ParamsIsNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIsNull(FieldParams))
+}
+
+// ParamsNotNil applies the NotNil predicate on the "params" field.
+func ParamsNotNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotNull(FieldParams))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldContainsFold(FieldReason, v))
+}
+
+// FailedTasksIsNil applies the IsNil predicate on the "failed_tasks" field.
+func FailedTasksIsNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIsNull(FieldFailedTasks))
+}
+
+// FailedTasksNotNil applies the NotNil predicate on the "failed_tasks" field.
+func FailedTasksNotNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotNull(FieldFailedTasks))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldStartTime, v))
+}
+
+// StartTimeIsNil applies the IsNil predicate on the "start_time" field.
+func StartTimeIsNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIsNull(FieldStartTime))
+}
+
+// StartTimeNotNil applies the NotNil predicate on the "start_time" field.
+func StartTimeNotNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotNull(FieldStartTime))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldEndTime, v))
+}
+
+// EndTimeIsNil applies the IsNil predicate on the "end_time" field.
+func EndTimeIsNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIsNull(FieldEndTime))
+}
+
+// EndTimeNotNil applies the NotNil predicate on the "end_time" field.
+func EndTimeNotNil() predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotNull(FieldEndTime))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TektonRun {
+	return predicate.TektonRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TektonRun) predicate.TektonRun {
+	return predicate.TektonRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TektonRun) predicate.TektonRun {
+	return predicate.TektonRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TektonRun) predicate.TektonRun {
+	return predicate.TektonRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,473 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+)
+
+// TektonRunCreate is the builder for creating a TektonRun entity.
+type TektonRunCreate struct {
+	config
+	mutation *TektonRunMutation
+	hooks    []Hook
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_c *TektonRunCreate) SetRunUID(v string) *TektonRunCreate {
+	_c.mutation.SetRunUID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *TektonRunCreate) SetKind(v string) *TektonRunCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *TektonRunCreate) SetNamespace(v string) *TektonRunCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TektonRunCreate) SetName(v string) *TektonRunCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_c *TektonRunCreate) SetPipeline(v string) *TektonRunCreate {
+	_c.mutation.SetPipeline(v)
+	return _c
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillablePipeline(v *string) *TektonRunCreate {
+	if v != nil {
+		_c.SetPipeline(*v)
+	}
+	return _c
+}
+
+// SetPipelineRun sets the "pipeline_run" field.
+func (_c *TektonRunCreate) SetPipelineRun(v string) *TektonRunCreate {
+	_c.mutation.SetPipelineRun(v)
+	return _c
+}
+
+// SetNillablePipelineRun sets the "pipeline_run" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillablePipelineRun(v *string) *TektonRunCreate {
+	if v != nil {
+		_c.SetPipelineRun(*v)
+	}
+	return _c
+}
+
+// SetTriggerUser sets the "trigger_user" field.
+func (_c *TektonRunCreate) SetTriggerUser(v string) *TektonRunCreate {
+	_c.mutation.SetTriggerUser(v)
+	return _c
+}
+
+// SetNillableTriggerUser sets the "trigger_user" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableTriggerUser(v *string) *TektonRunCreate {
+	if v != nil {
+		_c.SetTriggerUser(*v)
+	}
+	return _c
+}
+
+// SetParams sets the "params" field.
+func (_c *TektonRunCreate) SetParams(v map[string]string) *TektonRunCreate {
+	_c.mutation.SetParams(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TektonRunCreate) SetStatus(v string) *TektonRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *TektonRunCreate) SetReason(v string) *TektonRunCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableReason(v *string) *TektonRunCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFailedTasks sets the "failed_tasks" field.
+func (_c *TektonRunCreate) SetFailedTasks(v []string) *TektonRunCreate {
+	_c.mutation.SetFailedTasks(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *TektonRunCreate) SetStartTime(v time.Time) *TektonRunCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableStartTime(v *time.Time) *TektonRunCreate {
+	if v != nil {
+		_c.SetStartTime(*v)
+	}
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *TektonRunCreate) SetEndTime(v time.Time) *TektonRunCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableEndTime(v *time.Time) *TektonRunCreate {
+	if v != nil {
+		_c.SetEndTime(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *TektonRunCreate) SetDurationMs(v int) *TektonRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableDurationMs(v *int) *TektonRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TektonRunCreate) SetCreatedAt(v time.Time) *TektonRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableCreatedAt(v *time.Time) *TektonRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TektonRunCreate) SetUpdatedAt(v time.Time) *TektonRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TektonRunCreate) SetNillableUpdatedAt(v *time.Time) *TektonRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TektonRunMutation object of the builder.
+func (_c *TektonRunCreate) Mutation() *TektonRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TektonRun in the database.
+func (_c *TektonRunCreate) Save(ctx context.Context) (*TektonRun, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TektonRunCreate) SaveX(ctx context.Context) *TektonRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TektonRunCreate) defaults() {
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		v := tektonrun.DefaultPipeline
+		_c.mutation.SetPipeline(v)
+	}
+	if _, ok := _c.mutation.PipelineRun(); !ok {
+		v := tektonrun.DefaultPipelineRun
+		_c.mutation.SetPipelineRun(v)
+	}
+	if _, ok := _c.mutation.TriggerUser(); !ok {
+		v := tektonrun.DefaultTriggerUser
+		_c.mutation.SetTriggerUser(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := tektonrun.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := tektonrun.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tektonrun.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tektonrun.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TektonRunCreate) check() error {
+	if _, ok := _c.mutation.RunUID(); !ok {
+		return &ValidationError{Name: "run_uid", err: errors.New(`ent: missing required field "TektonRun.run_uid"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TektonRun.kind"`)}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "TektonRun.namespace"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TektonRun.name"`)}
+	}
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		return &ValidationError{Name: "pipeline", err: errors.New(`ent: missing required field "TektonRun.pipeline"`)}
+	}
+	if _, ok := _c.mutation.PipelineRun(); !ok {
+		return &ValidationError{Name: "pipeline_run", err: errors.New(`ent: missing required field "TektonRun.pipeline_run"`)}
+	}
+	if _, ok := _c.mutation.TriggerUser(); !ok {
+		return &ValidationError{Name: "trigger_user", err: errors.New(`ent: missing required field "TektonRun.trigger_user"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TektonRun.status"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "TektonRun.reason"`)}
+	}
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "TektonRun.duration_ms"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TektonRun.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TektonRun.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TektonRunCreate) sqlSave(ctx context.Context) (*TektonRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TektonRunCreate) createSpec() (*TektonRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TektonRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tektonrun.Table, sqlgraph.NewFieldSpec(tektonrun.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RunUID(); ok {
+		_spec.SetField(tektonrun.FieldRunUID, field.TypeString, value)
+		_node.RunUID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(tektonrun.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(tektonrun.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tektonrun.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrun.FieldPipeline, field.TypeString, value)
+		_node.Pipeline = value
+	}
+	if value, ok := _c.mutation.PipelineRun(); ok {
+		_spec.SetField(tektonrun.FieldPipelineRun, field.TypeString, value)
+		_node.PipelineRun = value
+	}
+	if value, ok := _c.mutation.TriggerUser(); ok {
+		_spec.SetField(tektonrun.FieldTriggerUser, field.TypeString, value)
+		_node.TriggerUser = value
+	}
+	if value, ok := _c.mutation.Params(); ok {
+		_spec.SetField(tektonrun.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tektonrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(tektonrun.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.FailedTasks(); ok {
+		_spec.SetField(tektonrun.FieldFailedTasks, field.TypeJSON, value)
+		_node.FailedTasks = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(tektonrun.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = &value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(tektonrun.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = &value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(tektonrun.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tektonrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tektonrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TektonRunCreateBulk is the builder for creating many TektonRun entities in bulk.
+type TektonRunCreateBulk struct {
+	config
+	err      error
+	builders []*TektonRunCreate
+}
+
+// Save creates the TektonRun entities in the database.
+func (_c *TektonRunCreateBulk) Save(ctx context.Context) ([]*TektonRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TektonRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TektonRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TektonRunCreateBulk) SaveX(ctx context.Context) []*TektonRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+)
+
+// TektonRunUpdate is the builder for updating TektonRun entities.
+type TektonRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TektonRunMutation
+}
+
+// Where appends a list predicates to the TektonRunUpdate builder.
+func (_u *TektonRunUpdate) Where(ps ...predicate.TektonRun) *TektonRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *TektonRunUpdate) SetRunUID(v string) *TektonRunUpdate {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableRunUID(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TektonRunUpdate) SetKind(v string) *TektonRunUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableKind(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonRunUpdate) SetNamespace(v string) *TektonRunUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableNamespace(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TektonRunUpdate) SetName(v string) *TektonRunUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableName(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonRunUpdate) SetPipeline(v string) *TektonRunUpdate {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillablePipeline(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetPipelineRun sets the "pipeline_run" field.
+func (_u *TektonRunUpdate) SetPipelineRun(v string) *TektonRunUpdate {
+	_u.mutation.SetPipelineRun(v)
+	return _u
+}
+
+// SetNillablePipelineRun sets the "pipeline_run" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillablePipelineRun(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetPipelineRun(*v)
+	}
+	return _u
+}
+
+// SetTriggerUser sets the "trigger_user" field.
+func (_u *TektonRunUpdate) SetTriggerUser(v string) *TektonRunUpdate {
+	_u.mutation.SetTriggerUser(v)
+	return _u
+}
+
+// SetNillableTriggerUser sets the "trigger_user" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableTriggerUser(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetTriggerUser(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *TektonRunUpdate) SetParams(v map[string]string) *TektonRunUpdate {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *TektonRunUpdate) ClearParams() *TektonRunUpdate {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TektonRunUpdate) SetStatus(v string) *TektonRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableStatus(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TektonRunUpdate) SetReason(v string) *TektonRunUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableReason(v *string) *TektonRunUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedTasks sets the "failed_tasks" field.
+func (_u *TektonRunUpdate) SetFailedTasks(v []string) *TektonRunUpdate {
+	_u.mutation.SetFailedTasks(v)
+	return _u
+}
+
+// AppendFailedTasks appends value to the "failed_tasks" field.
+func (_u *TektonRunUpdate) AppendFailedTasks(v []string) *TektonRunUpdate {
+	_u.mutation.AppendFailedTasks(v)
+	return _u
+}
+
+// ClearFailedTasks clears the value of the "failed_tasks" field.
+func (_u *TektonRunUpdate) ClearFailedTasks() *TektonRunUpdate {
+	_u.mutation.ClearFailedTasks()
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *TektonRunUpdate) SetStartTime(v time.Time) *TektonRunUpdate {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableStartTime(v *time.Time) *TektonRunUpdate {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (_u *TektonRunUpdate) ClearStartTime() *TektonRunUpdate {
+	_u.mutation.ClearStartTime()
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *TektonRunUpdate) SetEndTime(v time.Time) *TektonRunUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableEndTime(v *time.Time) *TektonRunUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *TektonRunUpdate) ClearEndTime() *TektonRunUpdate {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TektonRunUpdate) SetDurationMs(v int) *TektonRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TektonRunUpdate) SetNillableDurationMs(v *int) *TektonRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TektonRunUpdate) AddDurationMs(v int) *TektonRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TektonRunUpdate) SetUpdatedAt(v time.Time) *TektonRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TektonRunMutation object of the builder.
+func (_u *TektonRunUpdate) Mutation() *TektonRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TektonRunUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TektonRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TektonRunUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tektonrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TektonRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonrun.Table, tektonrun.Columns, sqlgraph.NewFieldSpec(tektonrun.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(tektonrun.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tektonrun.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonrun.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tektonrun.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrun.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PipelineRun(); ok {
+		_spec.SetField(tektonrun.FieldPipelineRun, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TriggerUser(); ok {
+		_spec.SetField(tektonrun.FieldTriggerUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(tektonrun.FieldParams, field.TypeJSON, value)
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(tektonrun.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tektonrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(tektonrun.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedTasks(); ok {
+		_spec.SetField(tektonrun.FieldFailedTasks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFailedTasks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tektonrun.FieldFailedTasks, value)
+		})
+	}
+	if _u.mutation.FailedTasksCleared() {
+		_spec.ClearField(tektonrun.FieldFailedTasks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(tektonrun.FieldStartTime, field.TypeTime, value)
+	}
+	if _u.mutation.StartTimeCleared() {
+		_spec.ClearField(tektonrun.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(tektonrun.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(tektonrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(tektonrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(tektonrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tektonrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TektonRunUpdateOne is the builder for updating a single TektonRun entity.
+type TektonRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TektonRunMutation
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *TektonRunUpdateOne) SetRunUID(v string) *TektonRunUpdateOne {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableRunUID(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TektonRunUpdateOne) SetKind(v string) *TektonRunUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableKind(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonRunUpdateOne) SetNamespace(v string) *TektonRunUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableNamespace(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TektonRunUpdateOne) SetName(v string) *TektonRunUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableName(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonRunUpdateOne) SetPipeline(v string) *TektonRunUpdateOne {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillablePipeline(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetPipelineRun sets the "pipeline_run" field.
+func (_u *TektonRunUpdateOne) SetPipelineRun(v string) *TektonRunUpdateOne {
+	_u.mutation.SetPipelineRun(v)
+	return _u
+}
+
+// SetNillablePipelineRun sets the "pipeline_run" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillablePipelineRun(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetPipelineRun(*v)
+	}
+	return _u
+}
+
+// SetTriggerUser sets the "trigger_user" field.
+func (_u *TektonRunUpdateOne) SetTriggerUser(v string) *TektonRunUpdateOne {
+	_u.mutation.SetTriggerUser(v)
+	return _u
+}
+
+// SetNillableTriggerUser sets the "trigger_user" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableTriggerUser(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetTriggerUser(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *TektonRunUpdateOne) SetParams(v map[string]string) *TektonRunUpdateOne {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// ClearParams clears the value of the "params" field.
+func (_u *TektonRunUpdateOne) ClearParams() *TektonRunUpdateOne {
+	_u.mutation.ClearParams()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TektonRunUpdateOne) SetStatus(v string) *TektonRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableStatus(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TektonRunUpdateOne) SetReason(v string) *TektonRunUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableReason(v *string) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedTasks sets the "failed_tasks" field.
+func (_u *TektonRunUpdateOne) SetFailedTasks(v []string) *TektonRunUpdateOne {
+	_u.mutation.SetFailedTasks(v)
+	return _u
+}
+
+// AppendFailedTasks appends value to the "failed_tasks" field.
+func (_u *TektonRunUpdateOne) AppendFailedTasks(v []string) *TektonRunUpdateOne {
+	_u.mutation.AppendFailedTasks(v)
+	return _u
+}
+
+// ClearFailedTasks clears the value of the "failed_tasks" field.
+func (_u *TektonRunUpdateOne) ClearFailedTasks() *TektonRunUpdateOne {
+	_u.mutation.ClearFailedTasks()
+	return _u
+}
+
+// SetStartTime sets the "start_time" field.
+func (_u *TektonRunUpdateOne) SetStartTime(v time.Time) *TektonRunUpdateOne {
+	_u.mutation.SetStartTime(v)
+	return _u
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableStartTime(v *time.Time) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetStartTime(*v)
+	}
+	return _u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (_u *TektonRunUpdateOne) ClearStartTime() *TektonRunUpdateOne {
+	_u.mutation.ClearStartTime()
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *TektonRunUpdateOne) SetEndTime(v time.Time) *TektonRunUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableEndTime(v *time.Time) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (_u *TektonRunUpdateOne) ClearEndTime() *TektonRunUpdateOne {
+	_u.mutation.ClearEndTime()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TektonRunUpdateOne) SetDurationMs(v int) *TektonRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TektonRunUpdateOne) SetNillableDurationMs(v *int) *TektonRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TektonRunUpdateOne) AddDurationMs(v int) *TektonRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TektonRunUpdateOne) SetUpdatedAt(v time.Time) *TektonRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TektonRunMutation object of the builder.
+func (_u *TektonRunUpdateOne) Mutation() *TektonRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TektonRunUpdate builder.
+func (_u *TektonRunUpdateOne) Where(ps ...predicate.TektonRun) *TektonRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TektonRunUpdateOne) Select(field string, fields ...string) *TektonRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TektonRun entity.
+func (_u *TektonRunUpdateOne) Save(ctx context.Context) (*TektonRun, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonRunUpdateOne) SaveX(ctx context.Context) *TektonRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TektonRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TektonRunUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tektonrun.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TektonRunUpdateOne) sqlSave(ctx context.Context) (_node *TektonRun, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonrun.Table, tektonrun.Columns, sqlgraph.NewFieldSpec(tektonrun.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TektonRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tektonrun.FieldID)
+		for _, f := range fields {
+			if !tektonrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tektonrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(tektonrun.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tektonrun.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonrun.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tektonrun.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrun.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PipelineRun(); ok {
+		_spec.SetField(tektonrun.FieldPipelineRun, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TriggerUser(); ok {
+		_spec.SetField(tektonrun.FieldTriggerUser, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(tektonrun.FieldParams, field.TypeJSON, value)
+	}
+	if _u.mutation.ParamsCleared() {
+		_spec.ClearField(tektonrun.FieldParams, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tektonrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(tektonrun.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedTasks(); ok {
+		_spec.SetField(tektonrun.FieldFailedTasks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFailedTasks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tektonrun.FieldFailedTasks, value)
+		})
+	}
+	if _u.mutation.FailedTasksCleared() {
+		_spec.ClearField(tektonrun.FieldFailedTasks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StartTime(); ok {
+		_spec.SetField(tektonrun.FieldStartTime, field.TypeTime, value)
+	}
+	if _u.mutation.StartTimeCleared() {
+		_spec.ClearField(tektonrun.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(tektonrun.FieldEndTime, field.TypeTime, value)
+	}
+	if _u.mutation.EndTimeCleared() {
+		_spec.ClearField(tektonrun.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(tektonrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(tektonrun.FieldDurationMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tektonrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TektonRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+)
+
+// TektonRunFailure is the model entity for the TektonRunFailure schema.
+type TektonRunFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// uid of the tekton run
+	RunUID string `json:"run_uid,omitempty"`
+	// kind of the run: pipelinerun or taskrun
+	Kind string `json:"kind,omitempty"`
+	// Namespace holds the value of the "namespace" field.
+	Namespace string `json:"namespace,omitempty"`
+	// name of the run
+	Name string `json:"name,omitempty"`
+	// name of the pipeline or the task of the run
+	Pipeline string `json:"pipeline,omitempty"`
+	// classified category of the failure
+	Category string `json:"category,omitempty"`
+	// pipeline task name of the failure
+	Task string `json:"task,omitempty"`
+	// step name of the failure
+	Step string `json:"step,omitempty"`
+	// step termination or condition reason of the failure
+	Reason string `json:"reason,omitempty"`
+	// FailedAt holds the value of the "failed_at" field.
+	FailedAt time.Time `json:"failed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TektonRunFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tektonrunfailure.FieldID:
+			values[i] = new(sql.NullInt64)
+		case tektonrunfailure.FieldRunUID, tektonrunfailure.FieldKind, tektonrunfailure.FieldNamespace, tektonrunfailure.FieldName, tektonrunfailure.FieldPipeline, tektonrunfailure.FieldCategory, tektonrunfailure.FieldTask, tektonrunfailure.FieldStep, tektonrunfailure.FieldReason:
+			values[i] = new(sql.NullString)
+		case tektonrunfailure.FieldFailedAt, tektonrunfailure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TektonRunFailure fields.
+func (_m *TektonRunFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tektonrunfailure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tektonrunfailure.FieldRunUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_uid", values[i])
+			} else if value.Valid {
+				_m.RunUID = value.String
+			}
+		case tektonrunfailure.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case tektonrunfailure.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case tektonrunfailure.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tektonrunfailure.FieldPipeline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline", values[i])
+			} else if value.Valid {
+				_m.Pipeline = value.String
+			}
+		case tektonrunfailure.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case tektonrunfailure.FieldTask:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task", values[i])
+			} else if value.Valid {
+				_m.Task = value.String
+			}
+		case tektonrunfailure.FieldStep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step", values[i])
+			} else if value.Valid {
+				_m.Step = value.String
+			}
+		case tektonrunfailure.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case tektonrunfailure.FieldFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_at", values[i])
+			} else if value.Valid {
+				_m.FailedAt = value.Time
+			}
+		case tektonrunfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TektonRunFailure.
+// This includes values selected through modifiers, order, etc.
+func (_m *TektonRunFailure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TektonRunFailure.
+// Note that you need to call TektonRunFailure.Unwrap() before calling this method if this TektonRunFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TektonRunFailure) Update() *TektonRunFailureUpdateOne {
+	return NewTektonRunFailureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TektonRunFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TektonRunFailure) Unwrap() *TektonRunFailure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TektonRunFailure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TektonRunFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("TektonRunFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("run_uid=")
+	builder.WriteString(_m.RunUID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline=")
+	builder.WriteString(_m.Pipeline)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("task=")
+	builder.WriteString(_m.Task)
+	builder.WriteString(", ")
+	builder.WriteString("step=")
+	builder.WriteString(_m.Step)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("failed_at=")
+	builder.WriteString(_m.FailedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TektonRunFailures is a parsable slice of TektonRunFailure.
+type TektonRunFailures []*TektonRunFailure
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonrunfailure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tektonrunfailure type in the database.
+	Label = "tekton_run_failure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunUID holds the string denoting the run_uid field in the database.
+	FieldRunUID = "run_uid"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPipeline holds the string denoting the pipeline field in the database.
+	FieldPipeline = "pipeline"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldTask holds the string denoting the task field in the database.
+	FieldTask = "task"
+	// FieldStep holds the string denoting the step field in the database.
+	FieldStep = "step"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldFailedAt holds the string denoting the failed_at field in the database.
+	FieldFailedAt = "failed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the tektonrunfailure in the database.
+	Table = "tekton_run_failures"
+)
+
+// Columns holds all SQL columns for tektonrunfailure fields.
+var Columns = []string{
+	FieldID,
+	FieldRunUID,
+	FieldKind,
+	FieldNamespace,
+	FieldName,
+	FieldPipeline,
+	FieldCategory,
+	FieldTask,
+	FieldStep,
+	FieldReason,
+	FieldFailedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPipeline holds the default value on creation for the "pipeline" field.
+	DefaultPipeline string
+	// DefaultTask holds the default value on creation for the "task" field.
+	DefaultTask string
+	// DefaultStep holds the default value on creation for the "step" field.
+	DefaultStep string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TektonRunFailure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunUID orders the results by the run_uid field.
+func ByRunUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunUID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPipeline orders the results by the pipeline field.
+func ByPipeline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipeline, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByTask orders the results by the task field.
+func ByTask(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTask, opts...).ToFunc()
+}
+
+// ByStep orders the results by the step field.
+func ByStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStep, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByFailedAt orders the results by the failed_at field.
+func ByFailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
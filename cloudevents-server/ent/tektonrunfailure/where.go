@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonrunfailure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldID, id))
+}
+
+// RunUID applies equality check predicate on the "run_uid" field. It's identical to RunUIDEQ.
+func RunUID(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldRunUID, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldKind, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldName, v))
+}
+
+// Pipeline applies equality check predicate on the "pipeline" field. It's identical to PipelineEQ.
+func Pipeline(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldPipeline, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldCategory, v))
+}
+
+// Task applies equality check predicate on the "task" field. It's identical to TaskEQ.
+func Task(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldTask, v))
+}
+
+// Step applies equality check predicate on the "step" field. It's identical to StepEQ.
+func Step(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldStep, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldReason, v))
+}
+
+// FailedAt applies equality check predicate on the "failed_at" field. It's identical to FailedAtEQ.
+func FailedAt(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RunUIDEQ applies the EQ predicate on the "run_uid" field.
+func RunUIDEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldRunUID, v))
+}
+
+// RunUIDNEQ applies the NEQ predicate on the "run_uid" field.
+func RunUIDNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldRunUID, v))
+}
+
+// RunUIDIn applies the In predicate on the "run_uid" field.
+func RunUIDIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldRunUID, vs...))
+}
+
+// RunUIDNotIn applies the NotIn predicate on the "run_uid" field.
+func RunUIDNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldRunUID, vs...))
+}
+
+// RunUIDGT applies the GT predicate on the "run_uid" field.
+func RunUIDGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldRunUID, v))
+}
+
+// RunUIDGTE applies the GTE predicate on the "run_uid" field.
+func RunUIDGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldRunUID, v))
+}
+
+// RunUIDLT applies the LT predicate on the "run_uid" field.
+func RunUIDLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldRunUID, v))
+}
+
+// RunUIDLTE applies the LTE predicate on the "run_uid" field.
+func RunUIDLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldRunUID, v))
+}
+
+// RunUIDContains applies the Contains predicate on the "run_uid" field.
+func RunUIDContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldRunUID, v))
+}
+
+// RunUIDHasPrefix applies the HasPrefix predicate on the "run_uid" field.
+func RunUIDHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldRunUID, v))
+}
+
+// RunUIDHasSuffix applies the HasSuffix predicate on the "run_uid" field.
+func RunUIDHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldRunUID, v))
+}
+
+// RunUIDEqualFold applies the EqualFold predicate on the "run_uid" field.
+func RunUIDEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldRunUID, v))
+}
+
+// RunUIDContainsFold applies the ContainsFold predicate on the "run_uid" field.
+func RunUIDContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldRunUID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldKind, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldName, v))
+}
+
+// PipelineEQ applies the EQ predicate on the "pipeline" field.
+func PipelineEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldPipeline, v))
+}
+
+// PipelineNEQ applies the NEQ predicate on the "pipeline" field.
+func PipelineNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldPipeline, v))
+}
+
+// PipelineIn applies the In predicate on the "pipeline" field.
+func PipelineIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldPipeline, vs...))
+}
+
+// PipelineNotIn applies the NotIn predicate on the "pipeline" field.
+func PipelineNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldPipeline, vs...))
+}
+
+// PipelineGT applies the GT predicate on the "pipeline" field.
+func PipelineGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldPipeline, v))
+}
+
+// PipelineGTE applies the GTE predicate on the "pipeline" field.
+func PipelineGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldPipeline, v))
+}
+
+// PipelineLT applies the LT predicate on the "pipeline" field.
+func PipelineLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldPipeline, v))
+}
+
+// PipelineLTE applies the LTE predicate on the "pipeline" field.
+func PipelineLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldPipeline, v))
+}
+
+// PipelineContains applies the Contains predicate on the "pipeline" field.
+func PipelineContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldPipeline, v))
+}
+
+// PipelineHasPrefix applies the HasPrefix predicate on the "pipeline" field.
+func PipelineHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldPipeline, v))
+}
+
+// PipelineHasSuffix applies the HasSuffix predicate on the "pipeline" field.
+func PipelineHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldPipeline, v))
+}
+
+// PipelineEqualFold applies the EqualFold predicate on the "pipeline" field.
+func PipelineEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldPipeline, v))
+}
+
+// PipelineContainsFold applies the ContainsFold predicate on the "pipeline" field.
+func PipelineContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldPipeline, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// TaskEQ applies the EQ predicate on the "task" field.
+func TaskEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldTask, v))
+}
+
+// TaskNEQ applies the NEQ predicate on the "task" field.
+func TaskNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldTask, v))
+}
+
+// TaskIn applies the In predicate on the "task" field.
+func TaskIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldTask, vs...))
+}
+
+// TaskNotIn applies the NotIn predicate on the "task" field.
+func TaskNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldTask, vs...))
+}
+
+// TaskGT applies the GT predicate on the "task" field.
+func TaskGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldTask, v))
+}
+
+// TaskGTE applies the GTE predicate on the "task" field.
+func TaskGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldTask, v))
+}
+
+// TaskLT applies the LT predicate on the "task" field.
+func TaskLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldTask, v))
+}
+
+// TaskLTE applies the LTE predicate on the "task" field.
+func TaskLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldTask, v))
+}
+
+// TaskContains applies the Contains predicate on the "task" field.
+func TaskContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldTask, v))
+}
+
+// TaskHasPrefix applies the HasPrefix predicate on the "task" field.
+func TaskHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldTask, v))
+}
+
+// TaskHasSuffix applies the HasSuffix predicate on the "task" field.
+func TaskHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldTask, v))
+}
+
+// TaskEqualFold applies the EqualFold predicate on the "task" field.
+func TaskEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldTask, v))
+}
+
+// TaskContainsFold applies the ContainsFold predicate on the "task" field.
+func TaskContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldTask, v))
+}
+
+// StepEQ applies the EQ predicate on the "step" field.
+func StepEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldStep, v))
+}
+
+// StepNEQ applies the NEQ predicate on the "step" field.
+func StepNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldStep, v))
+}
+
+// StepIn applies the In predicate on the "step" field.
+func StepIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldStep, vs...))
+}
+
+// StepNotIn applies the NotIn predicate on the "step" field.
+func StepNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldStep, vs...))
+}
+
+// StepGT applies the GT predicate on the "step" field.
+func StepGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldStep, v))
+}
+
+// StepGTE applies the GTE predicate on the "step" field.
+func StepGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldStep, v))
+}
+
+// StepLT applies the LT predicate on the "step" field.
+func StepLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldStep, v))
+}
+
+// StepLTE applies the LTE predicate on the "step" field.
+func StepLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldStep, v))
+}
+
+// StepContains applies the Contains predicate on the "step" field.
+func StepContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldStep, v))
+}
+
+// StepHasPrefix applies the HasPrefix predicate on the "step" field.
+func StepHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldStep, v))
+}
+
+// StepHasSuffix applies the HasSuffix predicate on the "step" field.
+func StepHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldStep, v))
+}
+
+// StepEqualFold applies the EqualFold predicate on the "step" field.
+func StepEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldStep, v))
+}
+
+// StepContainsFold applies the ContainsFold predicate on the "step" field.
+func StepContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldStep, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldContainsFold(FieldReason, v))
+}
+
+// FailedAtEQ applies the EQ predicate on the "failed_at" field.
+func FailedAtEQ(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldFailedAt, v))
+}
+
+// FailedAtNEQ applies the NEQ predicate on the "failed_at" field.
+func FailedAtNEQ(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldFailedAt, v))
+}
+
+// FailedAtIn applies the In predicate on the "failed_at" field.
+func FailedAtIn(vs ...time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldFailedAt, vs...))
+}
+
+// FailedAtNotIn applies the NotIn predicate on the "failed_at" field.
+func FailedAtNotIn(vs ...time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldFailedAt, vs...))
+}
+
+// FailedAtGT applies the GT predicate on the "failed_at" field.
+func FailedAtGT(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldFailedAt, v))
+}
+
+// FailedAtGTE applies the GTE predicate on the "failed_at" field.
+func FailedAtGTE(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldFailedAt, v))
+}
+
+// FailedAtLT applies the LT predicate on the "failed_at" field.
+func FailedAtLT(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldFailedAt, v))
+}
+
+// FailedAtLTE applies the LTE predicate on the "failed_at" field.
+func FailedAtLTE(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldFailedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TektonRunFailure) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TektonRunFailure) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TektonRunFailure) predicate.TektonRunFailure {
+	return predicate.TektonRunFailure(sql.NotPredicates(p))
+}
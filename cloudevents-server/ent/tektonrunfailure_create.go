@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+)
+
+// TektonRunFailureCreate is the builder for creating a TektonRunFailure entity.
+type TektonRunFailureCreate struct {
+	config
+	mutation *TektonRunFailureMutation
+	hooks    []Hook
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_c *TektonRunFailureCreate) SetRunUID(v string) *TektonRunFailureCreate {
+	_c.mutation.SetRunUID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *TektonRunFailureCreate) SetKind(v string) *TektonRunFailureCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *TektonRunFailureCreate) SetNamespace(v string) *TektonRunFailureCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TektonRunFailureCreate) SetName(v string) *TektonRunFailureCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_c *TektonRunFailureCreate) SetPipeline(v string) *TektonRunFailureCreate {
+	_c.mutation.SetPipeline(v)
+	return _c
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_c *TektonRunFailureCreate) SetNillablePipeline(v *string) *TektonRunFailureCreate {
+	if v != nil {
+		_c.SetPipeline(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *TektonRunFailureCreate) SetCategory(v string) *TektonRunFailureCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetTask sets the "task" field.
+func (_c *TektonRunFailureCreate) SetTask(v string) *TektonRunFailureCreate {
+	_c.mutation.SetTask(v)
+	return _c
+}
+
+// SetNillableTask sets the "task" field if the given value is not nil.
+func (_c *TektonRunFailureCreate) SetNillableTask(v *string) *TektonRunFailureCreate {
+	if v != nil {
+		_c.SetTask(*v)
+	}
+	return _c
+}
+
+// SetStep sets the "step" field.
+func (_c *TektonRunFailureCreate) SetStep(v string) *TektonRunFailureCreate {
+	_c.mutation.SetStep(v)
+	return _c
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_c *TektonRunFailureCreate) SetNillableStep(v *string) *TektonRunFailureCreate {
+	if v != nil {
+		_c.SetStep(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *TektonRunFailureCreate) SetReason(v string) *TektonRunFailureCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *TektonRunFailureCreate) SetNillableReason(v *string) *TektonRunFailureCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_c *TektonRunFailureCreate) SetFailedAt(v time.Time) *TektonRunFailureCreate {
+	_c.mutation.SetFailedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TektonRunFailureCreate) SetCreatedAt(v time.Time) *TektonRunFailureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TektonRunFailureCreate) SetNillableCreatedAt(v *time.Time) *TektonRunFailureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TektonRunFailureMutation object of the builder.
+func (_c *TektonRunFailureCreate) Mutation() *TektonRunFailureMutation {
+	return _c.mutation
+}
+
+// Save creates the TektonRunFailure in the database.
+func (_c *TektonRunFailureCreate) Save(ctx context.Context) (*TektonRunFailure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TektonRunFailureCreate) SaveX(ctx context.Context) *TektonRunFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonRunFailureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonRunFailureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TektonRunFailureCreate) defaults() {
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		v := tektonrunfailure.DefaultPipeline
+		_c.mutation.SetPipeline(v)
+	}
+	if _, ok := _c.mutation.Task(); !ok {
+		v := tektonrunfailure.DefaultTask
+		_c.mutation.SetTask(v)
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		v := tektonrunfailure.DefaultStep
+		_c.mutation.SetStep(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := tektonrunfailure.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tektonrunfailure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TektonRunFailureCreate) check() error {
+	if _, ok := _c.mutation.RunUID(); !ok {
+		return &ValidationError{Name: "run_uid", err: errors.New(`ent: missing required field "TektonRunFailure.run_uid"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "TektonRunFailure.kind"`)}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "TektonRunFailure.namespace"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TektonRunFailure.name"`)}
+	}
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		return &ValidationError{Name: "pipeline", err: errors.New(`ent: missing required field "TektonRunFailure.pipeline"`)}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "TektonRunFailure.category"`)}
+	}
+	if _, ok := _c.mutation.Task(); !ok {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required field "TektonRunFailure.task"`)}
+	}
+	if _, ok := _c.mutation.Step(); !ok {
+		return &ValidationError{Name: "step", err: errors.New(`ent: missing required field "TektonRunFailure.step"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "TektonRunFailure.reason"`)}
+	}
+	if _, ok := _c.mutation.FailedAt(); !ok {
+		return &ValidationError{Name: "failed_at", err: errors.New(`ent: missing required field "TektonRunFailure.failed_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TektonRunFailure.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TektonRunFailureCreate) sqlSave(ctx context.Context) (*TektonRunFailure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TektonRunFailureCreate) createSpec() (*TektonRunFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TektonRunFailure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tektonrunfailure.Table, sqlgraph.NewFieldSpec(tektonrunfailure.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.RunUID(); ok {
+		_spec.SetField(tektonrunfailure.FieldRunUID, field.TypeString, value)
+		_node.RunUID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(tektonrunfailure.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(tektonrunfailure.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tektonrunfailure.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrunfailure.FieldPipeline, field.TypeString, value)
+		_node.Pipeline = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(tektonrunfailure.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Task(); ok {
+		_spec.SetField(tektonrunfailure.FieldTask, field.TypeString, value)
+		_node.Task = value
+	}
+	if value, ok := _c.mutation.Step(); ok {
+		_spec.SetField(tektonrunfailure.FieldStep, field.TypeString, value)
+		_node.Step = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(tektonrunfailure.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.FailedAt(); ok {
+		_spec.SetField(tektonrunfailure.FieldFailedAt, field.TypeTime, value)
+		_node.FailedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tektonrunfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TektonRunFailureCreateBulk is the builder for creating many TektonRunFailure entities in bulk.
+type TektonRunFailureCreateBulk struct {
+	config
+	err      error
+	builders []*TektonRunFailureCreate
+}
+
+// Save creates the TektonRunFailure entities in the database.
+func (_c *TektonRunFailureCreateBulk) Save(ctx context.Context) ([]*TektonRunFailure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TektonRunFailure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TektonRunFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TektonRunFailureCreateBulk) SaveX(ctx context.Context) []*TektonRunFailure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonRunFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonRunFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
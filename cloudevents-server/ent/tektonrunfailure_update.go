@@ -0,0 +1,516 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrunfailure"
+)
+
+// TektonRunFailureUpdate is the builder for updating TektonRunFailure entities.
+type TektonRunFailureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TektonRunFailureMutation
+}
+
+// Where appends a list predicates to the TektonRunFailureUpdate builder.
+func (_u *TektonRunFailureUpdate) Where(ps ...predicate.TektonRunFailure) *TektonRunFailureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *TektonRunFailureUpdate) SetRunUID(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableRunUID(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TektonRunFailureUpdate) SetKind(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableKind(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonRunFailureUpdate) SetNamespace(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableNamespace(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TektonRunFailureUpdate) SetName(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableName(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonRunFailureUpdate) SetPipeline(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillablePipeline(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *TektonRunFailureUpdate) SetCategory(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableCategory(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" field.
+func (_u *TektonRunFailureUpdate) SetTask(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetTask(v)
+	return _u
+}
+
+// SetNillableTask sets the "task" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableTask(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetTask(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *TektonRunFailureUpdate) SetStep(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableStep(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TektonRunFailureUpdate) SetReason(v string) *TektonRunFailureUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableReason(v *string) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *TektonRunFailureUpdate) SetFailedAt(v time.Time) *TektonRunFailureUpdate {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *TektonRunFailureUpdate) SetNillableFailedAt(v *time.Time) *TektonRunFailureUpdate {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TektonRunFailureMutation object of the builder.
+func (_u *TektonRunFailureUpdate) Mutation() *TektonRunFailureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TektonRunFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonRunFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TektonRunFailureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonRunFailureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TektonRunFailureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonrunfailure.Table, tektonrunfailure.Columns, sqlgraph.NewFieldSpec(tektonrunfailure.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(tektonrunfailure.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tektonrunfailure.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonrunfailure.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tektonrunfailure.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrunfailure.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(tektonrunfailure.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Task(); ok {
+		_spec.SetField(tektonrunfailure.FieldTask, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(tektonrunfailure.FieldStep, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(tektonrunfailure.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(tektonrunfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonrunfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TektonRunFailureUpdateOne is the builder for updating a single TektonRunFailure entity.
+type TektonRunFailureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TektonRunFailureMutation
+}
+
+// SetRunUID sets the "run_uid" field.
+func (_u *TektonRunFailureUpdateOne) SetRunUID(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetRunUID(v)
+	return _u
+}
+
+// SetNillableRunUID sets the "run_uid" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableRunUID(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetRunUID(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TektonRunFailureUpdateOne) SetKind(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableKind(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonRunFailureUpdateOne) SetNamespace(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableNamespace(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TektonRunFailureUpdateOne) SetName(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableName(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonRunFailureUpdateOne) SetPipeline(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillablePipeline(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *TektonRunFailureUpdateOne) SetCategory(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableCategory(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetTask sets the "task" field.
+func (_u *TektonRunFailureUpdateOne) SetTask(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetTask(v)
+	return _u
+}
+
+// SetNillableTask sets the "task" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableTask(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetTask(*v)
+	}
+	return _u
+}
+
+// SetStep sets the "step" field.
+func (_u *TektonRunFailureUpdateOne) SetStep(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetStep(v)
+	return _u
+}
+
+// SetNillableStep sets the "step" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableStep(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetStep(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *TektonRunFailureUpdateOne) SetReason(v string) *TektonRunFailureUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableReason(v *string) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetFailedAt sets the "failed_at" field.
+func (_u *TektonRunFailureUpdateOne) SetFailedAt(v time.Time) *TektonRunFailureUpdateOne {
+	_u.mutation.SetFailedAt(v)
+	return _u
+}
+
+// SetNillableFailedAt sets the "failed_at" field if the given value is not nil.
+func (_u *TektonRunFailureUpdateOne) SetNillableFailedAt(v *time.Time) *TektonRunFailureUpdateOne {
+	if v != nil {
+		_u.SetFailedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TektonRunFailureMutation object of the builder.
+func (_u *TektonRunFailureUpdateOne) Mutation() *TektonRunFailureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TektonRunFailureUpdate builder.
+func (_u *TektonRunFailureUpdateOne) Where(ps ...predicate.TektonRunFailure) *TektonRunFailureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TektonRunFailureUpdateOne) Select(field string, fields ...string) *TektonRunFailureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TektonRunFailure entity.
+func (_u *TektonRunFailureUpdateOne) Save(ctx context.Context) (*TektonRunFailure, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonRunFailureUpdateOne) SaveX(ctx context.Context) *TektonRunFailure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TektonRunFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonRunFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TektonRunFailureUpdateOne) sqlSave(ctx context.Context) (_node *TektonRunFailure, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonrunfailure.Table, tektonrunfailure.Columns, sqlgraph.NewFieldSpec(tektonrunfailure.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TektonRunFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tektonrunfailure.FieldID)
+		for _, f := range fields {
+			if !tektonrunfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tektonrunfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.RunUID(); ok {
+		_spec.SetField(tektonrunfailure.FieldRunUID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tektonrunfailure.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonrunfailure.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tektonrunfailure.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonrunfailure.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(tektonrunfailure.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Task(); ok {
+		_spec.SetField(tektonrunfailure.FieldTask, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Step(); ok {
+		_spec.SetField(tektonrunfailure.FieldStep, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(tektonrunfailure.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FailedAt(); ok {
+		_spec.SetField(tektonrunfailure.FieldFailedAt, field.TypeTime, value)
+	}
+	_node = &TektonRunFailure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonrunfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
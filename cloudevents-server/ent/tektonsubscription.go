@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
+)
+
+// TektonSubscription is the model entity for the TektonSubscription schema.
+type TektonSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// lark receiver: email, open id or chat id
+	Receiver string `json:"receiver,omitempty"`
+	// namespace of the runs, empty for all
+	Namespace string `json:"namespace,omitempty"`
+	// glob pattern of the pipeline name, empty for all
+	Pipeline string `json:"pipeline,omitempty"`
+	// kubernetes label selector of the runs, empty for all
+	LabelSelector string `json:"label_selector,omitempty"`
+	// glob patterns of the event types, empty for all
+	EventTypes []string `json:"event_types,omitempty"`
+	// who created the subscription
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TektonSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tektonsubscription.FieldEventTypes:
+			values[i] = new([]byte)
+		case tektonsubscription.FieldID:
+			values[i] = new(sql.NullInt64)
+		case tektonsubscription.FieldReceiver, tektonsubscription.FieldNamespace, tektonsubscription.FieldPipeline, tektonsubscription.FieldLabelSelector, tektonsubscription.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case tektonsubscription.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TektonSubscription fields.
+func (_m *TektonSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tektonsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tektonsubscription.FieldReceiver:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receiver", values[i])
+			} else if value.Valid {
+				_m.Receiver = value.String
+			}
+		case tektonsubscription.FieldNamespace:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace", values[i])
+			} else if value.Valid {
+				_m.Namespace = value.String
+			}
+		case tektonsubscription.FieldPipeline:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pipeline", values[i])
+			} else if value.Valid {
+				_m.Pipeline = value.String
+			}
+		case tektonsubscription.FieldLabelSelector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label_selector", values[i])
+			} else if value.Valid {
+				_m.LabelSelector = value.String
+			}
+		case tektonsubscription.FieldEventTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EventTypes); err != nil {
+					return fmt.Errorf("unmarshal field event_types: %w", err)
+				}
+			}
+		case tektonsubscription.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case tektonsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TektonSubscription.
+// This includes values selected through modifiers, order, etc.
+func (_m *TektonSubscription) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TektonSubscription.
+// Note that you need to call TektonSubscription.Unwrap() before calling this method if this TektonSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TektonSubscription) Update() *TektonSubscriptionUpdateOne {
+	return NewTektonSubscriptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TektonSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TektonSubscription) Unwrap() *TektonSubscription {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TektonSubscription is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TektonSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("TektonSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("receiver=")
+	builder.WriteString(_m.Receiver)
+	builder.WriteString(", ")
+	builder.WriteString("namespace=")
+	builder.WriteString(_m.Namespace)
+	builder.WriteString(", ")
+	builder.WriteString("pipeline=")
+	builder.WriteString(_m.Pipeline)
+	builder.WriteString(", ")
+	builder.WriteString("label_selector=")
+	builder.WriteString(_m.LabelSelector)
+	builder.WriteString(", ")
+	builder.WriteString("event_types=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventTypes))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TektonSubscriptions is a parsable slice of TektonSubscription.
+type TektonSubscriptions []*TektonSubscription
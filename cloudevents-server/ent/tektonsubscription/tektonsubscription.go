@@ -0,0 +1,105 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tektonsubscription type in the database.
+	Label = "tekton_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReceiver holds the string denoting the receiver field in the database.
+	FieldReceiver = "receiver"
+	// FieldNamespace holds the string denoting the namespace field in the database.
+	FieldNamespace = "namespace"
+	// FieldPipeline holds the string denoting the pipeline field in the database.
+	FieldPipeline = "pipeline"
+	// FieldLabelSelector holds the string denoting the label_selector field in the database.
+	FieldLabelSelector = "label_selector"
+	// FieldEventTypes holds the string denoting the event_types field in the database.
+	FieldEventTypes = "event_types"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the tektonsubscription in the database.
+	Table = "tekton_subscriptions"
+)
+
+// Columns holds all SQL columns for tektonsubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldReceiver,
+	FieldNamespace,
+	FieldPipeline,
+	FieldLabelSelector,
+	FieldEventTypes,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNamespace holds the default value on creation for the "namespace" field.
+	DefaultNamespace string
+	// DefaultPipeline holds the default value on creation for the "pipeline" field.
+	DefaultPipeline string
+	// DefaultLabelSelector holds the default value on creation for the "label_selector" field.
+	DefaultLabelSelector string
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TektonSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReceiver orders the results by the receiver field.
+func ByReceiver(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiver, opts...).ToFunc()
+}
+
+// ByNamespace orders the results by the namespace field.
+func ByNamespace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespace, opts...).ToFunc()
+}
+
+// ByPipeline orders the results by the pipeline field.
+func ByPipeline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPipeline, opts...).ToFunc()
+}
+
+// ByLabelSelector orders the results by the label_selector field.
+func ByLabelSelector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLabelSelector, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tektonsubscription
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// Receiver applies equality check predicate on the "receiver" field. It's identical to ReceiverEQ.
+func Receiver(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldReceiver, v))
+}
+
+// Namespace applies equality check predicate on the "namespace" field. It's identical to NamespaceEQ.
+func Namespace(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldNamespace, v))
+}
+
+// Pipeline applies equality check predicate on the "pipeline" field. It's identical to PipelineEQ.
+func Pipeline(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldPipeline, v))
+}
+
+// LabelSelector applies equality check predicate on the "label_selector" field. It's identical to LabelSelectorEQ.
+func LabelSelector(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldLabelSelector, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReceiverEQ applies the EQ predicate on the "receiver" field.
+func ReceiverEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldReceiver, v))
+}
+
+// ReceiverNEQ applies the NEQ predicate on the "receiver" field.
+func ReceiverNEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldReceiver, v))
+}
+
+// ReceiverIn applies the In predicate on the "receiver" field.
+func ReceiverIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldReceiver, vs...))
+}
+
+// ReceiverNotIn applies the NotIn predicate on the "receiver" field.
+func ReceiverNotIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldReceiver, vs...))
+}
+
+// ReceiverGT applies the GT predicate on the "receiver" field.
+func ReceiverGT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldReceiver, v))
+}
+
+// ReceiverGTE applies the GTE predicate on the "receiver" field.
+func ReceiverGTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldReceiver, v))
+}
+
+// ReceiverLT applies the LT predicate on the "receiver" field.
+func ReceiverLT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldReceiver, v))
+}
+
+// ReceiverLTE applies the LTE predicate on the "receiver" field.
+func ReceiverLTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldReceiver, v))
+}
+
+// ReceiverContains applies the Contains predicate on the "receiver" field.
+func ReceiverContains(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContains(FieldReceiver, v))
+}
+
+// ReceiverHasPrefix applies the HasPrefix predicate on the "receiver" field.
+func ReceiverHasPrefix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasPrefix(FieldReceiver, v))
+}
+
+// ReceiverHasSuffix applies the HasSuffix predicate on the "receiver" field.
+func ReceiverHasSuffix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasSuffix(FieldReceiver, v))
+}
+
+// ReceiverEqualFold applies the EqualFold predicate on the "receiver" field.
+func ReceiverEqualFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEqualFold(FieldReceiver, v))
+}
+
+// ReceiverContainsFold applies the ContainsFold predicate on the "receiver" field.
+func ReceiverContainsFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContainsFold(FieldReceiver, v))
+}
+
+// NamespaceEQ applies the EQ predicate on the "namespace" field.
+func NamespaceEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldNamespace, v))
+}
+
+// NamespaceNEQ applies the NEQ predicate on the "namespace" field.
+func NamespaceNEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldNamespace, v))
+}
+
+// NamespaceIn applies the In predicate on the "namespace" field.
+func NamespaceIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldNamespace, vs...))
+}
+
+// NamespaceNotIn applies the NotIn predicate on the "namespace" field.
+func NamespaceNotIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldNamespace, vs...))
+}
+
+// NamespaceGT applies the GT predicate on the "namespace" field.
+func NamespaceGT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldNamespace, v))
+}
+
+// NamespaceGTE applies the GTE predicate on the "namespace" field.
+func NamespaceGTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldNamespace, v))
+}
+
+// NamespaceLT applies the LT predicate on the "namespace" field.
+func NamespaceLT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldNamespace, v))
+}
+
+// NamespaceLTE applies the LTE predicate on the "namespace" field.
+func NamespaceLTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldNamespace, v))
+}
+
+// NamespaceContains applies the Contains predicate on the "namespace" field.
+func NamespaceContains(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContains(FieldNamespace, v))
+}
+
+// NamespaceHasPrefix applies the HasPrefix predicate on the "namespace" field.
+func NamespaceHasPrefix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasPrefix(FieldNamespace, v))
+}
+
+// NamespaceHasSuffix applies the HasSuffix predicate on the "namespace" field.
+func NamespaceHasSuffix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasSuffix(FieldNamespace, v))
+}
+
+// NamespaceEqualFold applies the EqualFold predicate on the "namespace" field.
+func NamespaceEqualFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEqualFold(FieldNamespace, v))
+}
+
+// NamespaceContainsFold applies the ContainsFold predicate on the "namespace" field.
+func NamespaceContainsFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContainsFold(FieldNamespace, v))
+}
+
+// PipelineEQ applies the EQ predicate on the "pipeline" field.
+func PipelineEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldPipeline, v))
+}
+
+// PipelineNEQ applies the NEQ predicate on the "pipeline" field.
+func PipelineNEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldPipeline, v))
+}
+
+// PipelineIn applies the In predicate on the "pipeline" field.
+func PipelineIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldPipeline, vs...))
+}
+
+// PipelineNotIn applies the NotIn predicate on the "pipeline" field.
+func PipelineNotIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldPipeline, vs...))
+}
+
+// PipelineGT applies the GT predicate on the "pipeline" field.
+func PipelineGT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldPipeline, v))
+}
+
+// PipelineGTE applies the GTE predicate on the "pipeline" field.
+func PipelineGTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldPipeline, v))
+}
+
+// PipelineLT applies the LT predicate on the "pipeline" field.
+func PipelineLT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldPipeline, v))
+}
+
+// PipelineLTE applies the LTE predicate on the "pipeline" field.
+func PipelineLTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldPipeline, v))
+}
+
+// PipelineContains applies the Contains predicate on the "pipeline" field.
+func PipelineContains(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContains(FieldPipeline, v))
+}
+
+// PipelineHasPrefix applies the HasPrefix predicate on the "pipeline" field.
+func PipelineHasPrefix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasPrefix(FieldPipeline, v))
+}
+
+// PipelineHasSuffix applies the HasSuffix predicate on the "pipeline" field.
+func PipelineHasSuffix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasSuffix(FieldPipeline, v))
+}
+
+// PipelineEqualFold applies the EqualFold predicate on the "pipeline" field.
+func PipelineEqualFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEqualFold(FieldPipeline, v))
+}
+
+// PipelineContainsFold applies the ContainsFold predicate on the "pipeline" field.
+func PipelineContainsFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContainsFold(FieldPipeline, v))
+}
+
+// LabelSelectorEQ applies the EQ predicate on the "label_selector" field.
+func LabelSelectorEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldLabelSelector, v))
+}
+
+// LabelSelectorNEQ applies the NEQ predicate on the "label_selector" field.
+func LabelSelectorNEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldLabelSelector, v))
+}
+
+// LabelSelectorIn applies the In predicate on the "label_selector" field.
+func LabelSelectorIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldLabelSelector, vs...))
+}
+
+// LabelSelectorNotIn applies the NotIn predicate on the "label_selector" field.
+func LabelSelectorNotIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldLabelSelector, vs...))
+}
+
+// LabelSelectorGT applies the GT predicate on the "label_selector" field.
+func LabelSelectorGT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldLabelSelector, v))
+}
+
+// LabelSelectorGTE applies the GTE predicate on the "label_selector" field.
+func LabelSelectorGTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldLabelSelector, v))
+}
+
+// LabelSelectorLT applies the LT predicate on the "label_selector" field.
+func LabelSelectorLT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldLabelSelector, v))
+}
+
+// LabelSelectorLTE applies the LTE predicate on the "label_selector" field.
+func LabelSelectorLTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldLabelSelector, v))
+}
+
+// LabelSelectorContains applies the Contains predicate on the "label_selector" field.
+func LabelSelectorContains(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContains(FieldLabelSelector, v))
+}
+
+// LabelSelectorHasPrefix applies the HasPrefix predicate on the "label_selector" field.
+func LabelSelectorHasPrefix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasPrefix(FieldLabelSelector, v))
+}
+
+// LabelSelectorHasSuffix applies the HasSuffix predicate on the "label_selector" field.
+func LabelSelectorHasSuffix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasSuffix(FieldLabelSelector, v))
+}
+
+// LabelSelectorEqualFold applies the EqualFold predicate on the "label_selector" field.
+func LabelSelectorEqualFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEqualFold(FieldLabelSelector, v))
+}
+
+// LabelSelectorContainsFold applies the ContainsFold predicate on the "label_selector" field.
+func LabelSelectorContainsFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContainsFold(FieldLabelSelector, v))
+}
+
+// EventTypesIsNil applies the IsNil predicate on the "event_types" field.
+func EventTypesIsNil() predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIsNull(FieldEventTypes))
+}
+
+// EventTypesNotNil applies the NotNil predicate on the "event_types" field.
+func EventTypesNotNil() predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotNull(FieldEventTypes))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TektonSubscription) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TektonSubscription) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TektonSubscription) predicate.TektonSubscription {
+	return predicate.TektonSubscription(sql.NotPredicates(p))
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
+)
+
+// TektonSubscriptionCreate is the builder for creating a TektonSubscription entity.
+type TektonSubscriptionCreate struct {
+	config
+	mutation *TektonSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetReceiver sets the "receiver" field.
+func (_c *TektonSubscriptionCreate) SetReceiver(v string) *TektonSubscriptionCreate {
+	_c.mutation.SetReceiver(v)
+	return _c
+}
+
+// SetNamespace sets the "namespace" field.
+func (_c *TektonSubscriptionCreate) SetNamespace(v string) *TektonSubscriptionCreate {
+	_c.mutation.SetNamespace(v)
+	return _c
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_c *TektonSubscriptionCreate) SetNillableNamespace(v *string) *TektonSubscriptionCreate {
+	if v != nil {
+		_c.SetNamespace(*v)
+	}
+	return _c
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_c *TektonSubscriptionCreate) SetPipeline(v string) *TektonSubscriptionCreate {
+	_c.mutation.SetPipeline(v)
+	return _c
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_c *TektonSubscriptionCreate) SetNillablePipeline(v *string) *TektonSubscriptionCreate {
+	if v != nil {
+		_c.SetPipeline(*v)
+	}
+	return _c
+}
+
+// SetLabelSelector sets the "label_selector" field.
+func (_c *TektonSubscriptionCreate) SetLabelSelector(v string) *TektonSubscriptionCreate {
+	_c.mutation.SetLabelSelector(v)
+	return _c
+}
+
+// SetNillableLabelSelector sets the "label_selector" field if the given value is not nil.
+func (_c *TektonSubscriptionCreate) SetNillableLabelSelector(v *string) *TektonSubscriptionCreate {
+	if v != nil {
+		_c.SetLabelSelector(*v)
+	}
+	return _c
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_c *TektonSubscriptionCreate) SetEventTypes(v []string) *TektonSubscriptionCreate {
+	_c.mutation.SetEventTypes(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TektonSubscriptionCreate) SetCreatedBy(v string) *TektonSubscriptionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TektonSubscriptionCreate) SetNillableCreatedBy(v *string) *TektonSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TektonSubscriptionCreate) SetCreatedAt(v time.Time) *TektonSubscriptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TektonSubscriptionCreate) SetNillableCreatedAt(v *time.Time) *TektonSubscriptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TektonSubscriptionMutation object of the builder.
+func (_c *TektonSubscriptionCreate) Mutation() *TektonSubscriptionMutation {
+	return _c.mutation
+}
+
+// Save creates the TektonSubscription in the database.
+func (_c *TektonSubscriptionCreate) Save(ctx context.Context) (*TektonSubscription, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TektonSubscriptionCreate) SaveX(ctx context.Context) *TektonSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TektonSubscriptionCreate) defaults() {
+	if _, ok := _c.mutation.Namespace(); !ok {
+		v := tektonsubscription.DefaultNamespace
+		_c.mutation.SetNamespace(v)
+	}
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		v := tektonsubscription.DefaultPipeline
+		_c.mutation.SetPipeline(v)
+	}
+	if _, ok := _c.mutation.LabelSelector(); !ok {
+		v := tektonsubscription.DefaultLabelSelector
+		_c.mutation.SetLabelSelector(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := tektonsubscription.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tektonsubscription.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TektonSubscriptionCreate) check() error {
+	if _, ok := _c.mutation.Receiver(); !ok {
+		return &ValidationError{Name: "receiver", err: errors.New(`ent: missing required field "TektonSubscription.receiver"`)}
+	}
+	if _, ok := _c.mutation.Namespace(); !ok {
+		return &ValidationError{Name: "namespace", err: errors.New(`ent: missing required field "TektonSubscription.namespace"`)}
+	}
+	if _, ok := _c.mutation.Pipeline(); !ok {
+		return &ValidationError{Name: "pipeline", err: errors.New(`ent: missing required field "TektonSubscription.pipeline"`)}
+	}
+	if _, ok := _c.mutation.LabelSelector(); !ok {
+		return &ValidationError{Name: "label_selector", err: errors.New(`ent: missing required field "TektonSubscription.label_selector"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "TektonSubscription.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TektonSubscription.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TektonSubscriptionCreate) sqlSave(ctx context.Context) (*TektonSubscription, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TektonSubscriptionCreate) createSpec() (*TektonSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TektonSubscription{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tektonsubscription.Table, sqlgraph.NewFieldSpec(tektonsubscription.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Receiver(); ok {
+		_spec.SetField(tektonsubscription.FieldReceiver, field.TypeString, value)
+		_node.Receiver = value
+	}
+	if value, ok := _c.mutation.Namespace(); ok {
+		_spec.SetField(tektonsubscription.FieldNamespace, field.TypeString, value)
+		_node.Namespace = value
+	}
+	if value, ok := _c.mutation.Pipeline(); ok {
+		_spec.SetField(tektonsubscription.FieldPipeline, field.TypeString, value)
+		_node.Pipeline = value
+	}
+	if value, ok := _c.mutation.LabelSelector(); ok {
+		_spec.SetField(tektonsubscription.FieldLabelSelector, field.TypeString, value)
+		_node.LabelSelector = value
+	}
+	if value, ok := _c.mutation.EventTypes(); ok {
+		_spec.SetField(tektonsubscription.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(tektonsubscription.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tektonsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TektonSubscriptionCreateBulk is the builder for creating many TektonSubscription entities in bulk.
+type TektonSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*TektonSubscriptionCreate
+}
+
+// Save creates the TektonSubscription entities in the database.
+func (_c *TektonSubscriptionCreateBulk) Save(ctx context.Context) ([]*TektonSubscription, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TektonSubscription, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TektonSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TektonSubscriptionCreateBulk) SaveX(ctx context.Context) []*TektonSubscription {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TektonSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TektonSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
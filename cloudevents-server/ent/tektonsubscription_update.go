@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
+)
+
+// TektonSubscriptionUpdate is the builder for updating TektonSubscription entities.
+type TektonSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TektonSubscriptionMutation
+}
+
+// Where appends a list predicates to the TektonSubscriptionUpdate builder.
+func (_u *TektonSubscriptionUpdate) Where(ps ...predicate.TektonSubscription) *TektonSubscriptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReceiver sets the "receiver" field.
+func (_u *TektonSubscriptionUpdate) SetReceiver(v string) *TektonSubscriptionUpdate {
+	_u.mutation.SetReceiver(v)
+	return _u
+}
+
+// SetNillableReceiver sets the "receiver" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdate) SetNillableReceiver(v *string) *TektonSubscriptionUpdate {
+	if v != nil {
+		_u.SetReceiver(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonSubscriptionUpdate) SetNamespace(v string) *TektonSubscriptionUpdate {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdate) SetNillableNamespace(v *string) *TektonSubscriptionUpdate {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonSubscriptionUpdate) SetPipeline(v string) *TektonSubscriptionUpdate {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdate) SetNillablePipeline(v *string) *TektonSubscriptionUpdate {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetLabelSelector sets the "label_selector" field.
+func (_u *TektonSubscriptionUpdate) SetLabelSelector(v string) *TektonSubscriptionUpdate {
+	_u.mutation.SetLabelSelector(v)
+	return _u
+}
+
+// SetNillableLabelSelector sets the "label_selector" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdate) SetNillableLabelSelector(v *string) *TektonSubscriptionUpdate {
+	if v != nil {
+		_u.SetLabelSelector(*v)
+	}
+	return _u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_u *TektonSubscriptionUpdate) SetEventTypes(v []string) *TektonSubscriptionUpdate {
+	_u.mutation.SetEventTypes(v)
+	return _u
+}
+
+// AppendEventTypes appends value to the "event_types" field.
+func (_u *TektonSubscriptionUpdate) AppendEventTypes(v []string) *TektonSubscriptionUpdate {
+	_u.mutation.AppendEventTypes(v)
+	return _u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (_u *TektonSubscriptionUpdate) ClearEventTypes() *TektonSubscriptionUpdate {
+	_u.mutation.ClearEventTypes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TektonSubscriptionUpdate) SetCreatedBy(v string) *TektonSubscriptionUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdate) SetNillableCreatedBy(v *string) *TektonSubscriptionUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TektonSubscriptionMutation object of the builder.
+func (_u *TektonSubscriptionUpdate) Mutation() *TektonSubscriptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TektonSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TektonSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TektonSubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonsubscription.Table, tektonsubscription.Columns, sqlgraph.NewFieldSpec(tektonsubscription.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Receiver(); ok {
+		_spec.SetField(tektonsubscription.FieldReceiver, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonsubscription.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonsubscription.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LabelSelector(); ok {
+		_spec.SetField(tektonsubscription.FieldLabelSelector, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventTypes(); ok {
+		_spec.SetField(tektonsubscription.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tektonsubscription.FieldEventTypes, value)
+		})
+	}
+	if _u.mutation.EventTypesCleared() {
+		_spec.ClearField(tektonsubscription.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tektonsubscription.FieldCreatedBy, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TektonSubscriptionUpdateOne is the builder for updating a single TektonSubscription entity.
+type TektonSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TektonSubscriptionMutation
+}
+
+// SetReceiver sets the "receiver" field.
+func (_u *TektonSubscriptionUpdateOne) SetReceiver(v string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetReceiver(v)
+	return _u
+}
+
+// SetNillableReceiver sets the "receiver" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdateOne) SetNillableReceiver(v *string) *TektonSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetReceiver(*v)
+	}
+	return _u
+}
+
+// SetNamespace sets the "namespace" field.
+func (_u *TektonSubscriptionUpdateOne) SetNamespace(v string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetNamespace(v)
+	return _u
+}
+
+// SetNillableNamespace sets the "namespace" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdateOne) SetNillableNamespace(v *string) *TektonSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetNamespace(*v)
+	}
+	return _u
+}
+
+// SetPipeline sets the "pipeline" field.
+func (_u *TektonSubscriptionUpdateOne) SetPipeline(v string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetPipeline(v)
+	return _u
+}
+
+// SetNillablePipeline sets the "pipeline" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdateOne) SetNillablePipeline(v *string) *TektonSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetPipeline(*v)
+	}
+	return _u
+}
+
+// SetLabelSelector sets the "label_selector" field.
+func (_u *TektonSubscriptionUpdateOne) SetLabelSelector(v string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetLabelSelector(v)
+	return _u
+}
+
+// SetNillableLabelSelector sets the "label_selector" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdateOne) SetNillableLabelSelector(v *string) *TektonSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetLabelSelector(*v)
+	}
+	return _u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (_u *TektonSubscriptionUpdateOne) SetEventTypes(v []string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetEventTypes(v)
+	return _u
+}
+
+// AppendEventTypes appends value to the "event_types" field.
+func (_u *TektonSubscriptionUpdateOne) AppendEventTypes(v []string) *TektonSubscriptionUpdateOne {
+	_u.mutation.AppendEventTypes(v)
+	return _u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (_u *TektonSubscriptionUpdateOne) ClearEventTypes() *TektonSubscriptionUpdateOne {
+	_u.mutation.ClearEventTypes()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TektonSubscriptionUpdateOne) SetCreatedBy(v string) *TektonSubscriptionUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TektonSubscriptionUpdateOne) SetNillableCreatedBy(v *string) *TektonSubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TektonSubscriptionMutation object of the builder.
+func (_u *TektonSubscriptionUpdateOne) Mutation() *TektonSubscriptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TektonSubscriptionUpdate builder.
+func (_u *TektonSubscriptionUpdateOne) Where(ps ...predicate.TektonSubscription) *TektonSubscriptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TektonSubscriptionUpdateOne) Select(field string, fields ...string) *TektonSubscriptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TektonSubscription entity.
+func (_u *TektonSubscriptionUpdateOne) Save(ctx context.Context) (*TektonSubscription, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TektonSubscriptionUpdateOne) SaveX(ctx context.Context) *TektonSubscription {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TektonSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TektonSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TektonSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *TektonSubscription, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tektonsubscription.Table, tektonsubscription.Columns, sqlgraph.NewFieldSpec(tektonsubscription.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TektonSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tektonsubscription.FieldID)
+		for _, f := range fields {
+			if !tektonsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tektonsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Receiver(); ok {
+		_spec.SetField(tektonsubscription.FieldReceiver, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Namespace(); ok {
+		_spec.SetField(tektonsubscription.FieldNamespace, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pipeline(); ok {
+		_spec.SetField(tektonsubscription.FieldPipeline, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LabelSelector(); ok {
+		_spec.SetField(tektonsubscription.FieldLabelSelector, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EventTypes(); ok {
+		_spec.SetField(tektonsubscription.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tektonsubscription.FieldEventTypes, value)
+		})
+	}
+	if _u.mutation.EventTypesCleared() {
+		_spec.ClearField(tektonsubscription.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tektonsubscription.FieldCreatedBy, field.TypeString, value)
+	}
+	_node = &TektonSubscription{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tektonsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
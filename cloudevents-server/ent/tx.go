@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ArchivedEvent is the client for interacting with the ArchivedEvent builders.
+	ArchivedEvent *ArchivedEventClient
+	// LarkRunCard is the client for interacting with the LarkRunCard builders.
+	LarkRunCard *LarkRunCardClient
+	// ProblemCaseIssue is the client for interacting with the ProblemCaseIssue builders.
+	ProblemCaseIssue *ProblemCaseIssueClient
 	// ProblemCaseRun is the client for interacting with the ProblemCaseRun builders.
 	ProblemCaseRun *ProblemCaseRunClient
+	// TektonRun is the client for interacting with the TektonRun builders.
+	TektonRun *TektonRunClient
+	// TektonRunFailure is the client for interacting with the TektonRunFailure builders.
+	TektonRunFailure *TektonRunFailureClient
+	// TektonSubscription is the client for interacting with the TektonSubscription builders.
+	TektonSubscription *TektonSubscriptionClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ArchivedEvent = NewArchivedEventClient(tx.config)
+	tx.LarkRunCard = NewLarkRunCardClient(tx.config)
+	tx.ProblemCaseIssue = NewProblemCaseIssueClient(tx.config)
 	tx.ProblemCaseRun = NewProblemCaseRunClient(tx.config)
+	tx.TektonRun = NewTektonRunClient(tx.config)
+	tx.TektonRunFailure = NewTektonRunFailureClient(tx.config)
+	tx.TektonSubscription = NewTektonSubscriptionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ProblemCaseRun.QueryXXX(), the query will be executed
+// applies a query, for example: ArchivedEvent.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -46,11 +46,63 @@ func (c *TektonNotification) IsMatched(typ, subject string) bool {
 	return false
 }
 
+// TektonFailureRule classifies the failed runs into the category when any of
+// the regexes matches.
+type TektonFailureRule struct {
+	Category string `yaml:"category" json:"category"`
+	// Reasons are regexes of the step termination or waiting reasons and the
+	// condition reasons, such as "OOMKilled" and "TaskRunTimeout".
+	Reasons []string `yaml:"reasons,omitempty" json:"reasons,omitempty"`
+	// Patterns are regexes of the failed step log tails and the condition messages.
+	Patterns []string `yaml:"patterns,omitempty" json:"patterns,omitempty"`
+}
+
 type Tekton struct {
 	DashboardBaseURL    string               `yaml:"dashboard_base_url,omitempty" json:"dashboard_base_url,omitempty"`
 	Lark                LarkBotApp           `yaml:"lark" json:"lark"`
 	Notifications       []TektonNotification `yaml:"notifications,omitempty" json:"notifications,omitempty"`
 	FailedStepTailLines int                  `yaml:"failed_step_tail_lines,omitempty" json:"failed_step_tail_lines,omitempty"`
+	// Store of the lark message ids, the subscriptions, the run failures and the
+	// run history, one card is updated for a pipeline run when set.
+	Store *Store `yaml:"store,omitempty" json:"store,omitempty"`
+	// HistoryParams are the names of the run params recorded in the run history.
+	HistoryParams []string `yaml:"history_params,omitempty" json:"history_params,omitempty"`
+	// FailureRules are matched in order before the built-in rules to classify
+	// the failed runs.
+	FailureRules []TektonFailureRule `yaml:"failure_rules,omitempty" json:"failure_rules,omitempty"`
+}
+
+// CDEventsNotification notify config for the CDEvents runs, the `EventType`
+// and `PipelineReg` is "AND" logic.
+type CDEventsNotification struct {
+	// EventType of the cloud event, the version suffix is ignored, such as
+	// "dev.cdevents.pipelinerun.finished". Set it to "" or "*" for all types.
+	EventType string `yaml:"event_type,omitempty" json:"event_type,omitempty"`
+	// PipelineReg matches the pipeline name, set it to "" for all pipelines.
+	PipelineReg string   `yaml:"pipeline_reg,omitempty" json:"pipeline_reg,omitempty"`
+	Receivers   []string `yaml:"receivers,omitempty" json:"receivers,omitempty"`
+}
+
+func (c *CDEventsNotification) IsMatched(typ, pipeline string) bool {
+	if c.EventType != "" && c.EventType != "*" && c.EventType != typ {
+		return false
+	}
+	if c.PipelineReg == "" {
+		return true
+	}
+
+	ok, _ := regexp.MatchString(c.PipelineReg, pipeline)
+	return ok
+}
+
+// CDEvents config of the CDEvents runs, such as the Jenkins builds.
+type CDEvents struct {
+	Lark          LarkBotApp             `yaml:"lark" json:"lark"`
+	Notifications []CDEventsNotification `yaml:"notifications,omitempty" json:"notifications,omitempty"`
+	// Store of the run history, the runs are recorded together with the tekton runs when set.
+	Store *Store `yaml:"store,omitempty" json:"store,omitempty"`
+	// Namespace of the runs in the run history, default is "jenkins".
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
 }
 
 type Kafka struct {
@@ -61,13 +113,84 @@ type Kafka struct {
 	Consumer       kafka.Consumer       `yaml:"consumer" json:"consumer"`
 }
 
+// Webhook config of the http webhook sink.
+type Webhook struct {
+	URL string `yaml:"url,omitempty" json:"url,omitempty"`
+	// Mode of the cloudevents http binding: "binary"(default) or "structured".
+	Mode    string            `yaml:"mode,omitempty" json:"mode,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	// Secret to sign the body with HMAC-SHA256, the signature is sent in the
+	// signature header as `sha256=<hex>`.
+	Secret          string `yaml:"secret,omitempty" json:"secret,omitempty"`
+	SignatureHeader string `yaml:"signature_header,omitempty" json:"signature_header,omitempty"`
+	// TimeoutSeconds of every attempt, default is 10 seconds.
+	TimeoutSeconds int          `yaml:"timeout_seconds,omitempty" json:"timeout_seconds,omitempty"`
+	Retry          WebhookRetry `yaml:"retry,omitempty" json:"retry,omitempty"`
+}
+
+// WebhookRetry config of the exponential retry of the webhook sink.
+type WebhookRetry struct {
+	// MaxAttempts including the first one, default is 5.
+	MaxAttempts int `yaml:"max_attempts,omitempty" json:"max_attempts,omitempty"`
+	// InitialIntervalMs before the first retry, doubled after every retry, default is 500ms.
+	InitialIntervalMs int `yaml:"initial_interval_ms,omitempty" json:"initial_interval_ms,omitempty"`
+	// MaxIntervalSeconds between the retries, default is 30 seconds.
+	MaxIntervalSeconds int `yaml:"max_interval_seconds,omitempty" json:"max_interval_seconds,omitempty"`
+	// BudgetSeconds is the total time of the attempts and retries, default is 120 seconds.
+	BudgetSeconds int `yaml:"budget_seconds,omitempty" json:"budget_seconds,omitempty"`
+}
+
 type TiBuild struct {
 	ResultSinkURL  string `yaml:"result_sink_url,omitempty" json:"result_sink_url,omitempty"`
 	TriggerSinkURL string `yaml:"trigger_sink_url,omitempty" json:"trigger_sink_url,omitempty"`
+	// ResultSink and TriggerSink override the urls above when set.
+	ResultSink  *Webhook `yaml:"result_sink,omitempty" json:"result_sink,omitempty"`
+	TriggerSink *Webhook `yaml:"trigger_sink,omitempty" json:"trigger_sink,omitempty"`
 }
 
 type TestCaseRun struct {
 	Store Store `yaml:"store" json:"store"`
+	// FlakyIssue files github issues for the flaky cases when set.
+	FlakyIssue *FlakyIssue `yaml:"flaky_issue,omitempty" json:"flaky_issue,omitempty"`
+}
+
+// FlakyIssue config of filing github issues for the flaky test cases.
+type FlakyIssue struct {
+	GitHubToken string `yaml:"github_token" json:"github_token"`
+	// Repo to file the issues in, in `owner/repo` format, default is the repo of the case.
+	Repo string `yaml:"repo,omitempty" json:"repo,omitempty"`
+	// Labels of the issues, default is `flaky-test`.
+	Labels []string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	// Threshold of the flaky runs of a case in the window to file the issue, default is 3.
+	Threshold int `yaml:"threshold,omitempty" json:"threshold,omitempty"`
+	// WindowDays of counting the flaky runs, default is 7 days.
+	WindowDays int `yaml:"window_days,omitempty" json:"window_days,omitempty"`
+}
+
+// Archive config of the received events archive.
+type Archive struct {
+	Store Store `yaml:"store" json:"store"`
+	// MaxPayloadBytes truncates the archived event data, default is 32KiB.
+	MaxPayloadBytes int `yaml:"max_payload_bytes,omitempty" json:"max_payload_bytes,omitempty"`
+	// RetentionDays of the archived events, default is 30 days.
+	RetentionDays int `yaml:"retention_days,omitempty" json:"retention_days,omitempty"`
+}
+
+// Routing config of the declarative event routing.
+type Routing struct {
+	// RulesFile is the yaml file of the sinks and rules, it is reloaded when changed.
+	RulesFile string `yaml:"rules_file,omitempty" json:"rules_file,omitempty"`
+	// ReloadIntervalSeconds of checking the rules file, default is 30 seconds.
+	ReloadIntervalSeconds int `yaml:"reload_interval_seconds,omitempty" json:"reload_interval_seconds,omitempty"`
+}
+
+// Admin config of the admin endpoints.
+type Admin struct {
+	// Token is required in the `Authorization: Bearer <token>` header.
+	Token string `yaml:"token,omitempty" json:"token,omitempty"`
+	// SubscriptionsToken grants the access to the tekton subscription endpoints
+	// only, such as for the chat bots, besides Token.
+	SubscriptionsToken string `yaml:"subscriptions_token,omitempty" json:"subscriptions_token,omitempty"`
 }
 
 type Config struct {
@@ -76,7 +199,11 @@ type Config struct {
 	// service config fields.
 	TestCaseRun *TestCaseRun `yaml:"test_case_run,omitempty" json:"test_case_run,omitempty"`
 	Tekton      *Tekton      `yaml:"tekton,omitempty" json:"tekton,omitempty"`
+	CDEvents    *CDEvents    `yaml:"cdevents,omitempty" json:"cdevents,omitempty"`
 	TiBuild     *TiBuild     `yaml:"tibuild,omitempty" json:"tibuild,omitempty"`
+	Archive     *Archive     `yaml:"archive,omitempty" json:"archive,omitempty"`
+	Admin       *Admin       `yaml:"admin,omitempty" json:"admin,omitempty"`
+	Routing     *Routing     `yaml:"routing,omitempty" json:"routing,omitempty"`
 }
 
 func (c *Config) LoadFromFile(file string) error {
@@ -0,0 +1,237 @@
+// Package archive implement the archive of the received cloud events.
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/rs/zerolog/log"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/archivedevent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+const (
+	defaultMaxPayloadBytes = 32 * 1024
+	defaultRetentionDays   = 30
+	defaultSearchLimit     = 100
+	maxSearchLimit         = 1000
+	pruneInterval          = time.Hour
+)
+
+// Archive stores the received cloud events for troubleshooting.
+type Archive struct {
+	Storage         *ent.ArchivedEventClient
+	maxPayloadBytes int
+	retention       time.Duration
+}
+
+func New(storage *ent.ArchivedEventClient, cfg config.Archive) *Archive {
+	ret := &Archive{
+		Storage:         storage,
+		maxPayloadBytes: cfg.MaxPayloadBytes,
+		retention:       time.Duration(cfg.RetentionDays) * 24 * time.Hour,
+	}
+	if ret.maxPayloadBytes <= 0 {
+		ret.maxPayloadBytes = defaultMaxPayloadBytes
+	}
+	if ret.retention <= 0 {
+		ret.retention = defaultRetentionDays * 24 * time.Hour
+	}
+
+	return ret
+}
+
+// Save archives the event with the kafka topic it is resolved to, the topic is
+// empty when the event is ignored.
+func (a *Archive) Save(ctx context.Context, event cloudevents.Event, topic string) error {
+	receivedAt := time.Now()
+	eventTime := event.Time()
+	if eventTime.IsZero() {
+		eventTime = receivedAt
+	}
+
+	extensions := make(map[string]string, len(event.Extensions()))
+	for k, v := range event.Extensions() {
+		if s, err := types.ToString(v); err == nil {
+			extensions[k] = s
+		}
+	}
+
+	payload := event.Data()
+	truncated := len(payload) > a.maxPayloadBytes
+	if truncated {
+		payload = payload[:a.maxPayloadBytes]
+	}
+
+	return a.Storage.Create().
+		SetEventID(event.ID()).
+		SetType(event.Type()).
+		SetSource(event.Source()).
+		SetSubject(event.Subject()).
+		SetTime(eventTime).
+		SetTopic(topic).
+		SetDataContentType(event.DataContentType()).
+		SetExtensions(extensions).
+		SetPayload(payload).
+		SetTruncated(truncated).
+		SetReceivedAt(receivedAt).
+		Exec(ctx)
+}
+
+// Query filters the archived events, the zero fields are ignored.
+type Query struct {
+	Type    string
+	Subject string
+	Since   time.Time
+	Until   time.Time
+	Limit   int
+}
+
+// Search returns the archived events matched the query, newest first.
+func (a *Archive) Search(ctx context.Context, q Query) ([]*ent.ArchivedEvent, error) {
+	query := a.Storage.Query()
+	if q.Type != "" {
+		query.Where(archivedevent.Type(q.Type))
+	}
+	if q.Subject != "" {
+		query.Where(archivedevent.SubjectContains(q.Subject))
+	}
+	if !q.Since.IsZero() {
+		query.Where(archivedevent.TimeGTE(q.Since))
+	}
+	if !q.Until.IsZero() {
+		query.Where(archivedevent.TimeLT(q.Until))
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	return query.
+		Order(ent.Desc(archivedevent.FieldTime), ent.Desc(archivedevent.FieldID)).
+		Limit(min(limit, maxSearchLimit)).
+		All(ctx)
+}
+
+// Get returns the latest received event with the id, the source is optional to
+// tell apart the events with the same id.
+func (a *Archive) Get(ctx context.Context, eventID, source string) (*ent.ArchivedEvent, error) {
+	query := a.Storage.Query().Where(archivedevent.EventID(eventID))
+	if source != "" {
+		query.Where(archivedevent.Source(source))
+	}
+
+	return query.Order(ent.Desc(archivedevent.FieldReceivedAt), ent.Desc(archivedevent.FieldID)).First(ctx)
+}
+
+// Prune deletes the events received before the retention period.
+func (a *Archive) Prune(ctx context.Context, now time.Time) (int, error) {
+	return a.Storage.Delete().
+		Where(archivedevent.ReceivedAtLT(now.Add(-a.retention))).
+		Exec(ctx)
+}
+
+// Start runs the retention worker until the context is done.
+func (a *Archive) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(pruneInterval)
+		defer ticker.Stop()
+		for {
+			if n, err := a.Prune(ctx, time.Now()); err != nil {
+				log.Err(err).Msg("failed to prune archived events")
+			} else if n > 0 {
+				log.Info().Int("count", n).Msg("pruned archived events")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// ErrTruncated is returned when restoring the event with truncated payload.
+var ErrTruncated = errors.New("the archived payload is truncated")
+
+// Event restores the archived cloud event.
+func Event(e *ent.ArchivedEvent) (cloudevents.Event, error) {
+	if e.Truncated {
+		return cloudevents.Event{}, ErrTruncated
+	}
+
+	ret := cloudevents.NewEvent()
+	ret.SetID(e.EventID)
+	ret.SetType(e.Type)
+	ret.SetSource(e.Source)
+	ret.SetSubject(e.Subject)
+	ret.SetTime(e.Time)
+	for k, v := range e.Extensions {
+		ret.SetExtension(k, v)
+	}
+	if e.DataContentType != "" {
+		ret.SetDataContentType(e.DataContentType)
+	}
+	// keep the data encoded as received, SetData would mark bytes as base64.
+	if len(e.Payload) > 0 {
+		ret.DataEncoded = e.Payload
+	}
+
+	return ret, ret.Validate()
+}
+
+// Record is the view of an archived event.
+type Record struct {
+	ID              int               `json:"id"`
+	EventID         string            `json:"event_id"`
+	Type            string            `json:"type"`
+	Source          string            `json:"source"`
+	Subject         string            `json:"subject,omitempty"`
+	Time            time.Time         `json:"time"`
+	Topic           string            `json:"topic,omitempty"`
+	DataContentType string            `json:"data_content_type,omitempty"`
+	Extensions      map[string]string `json:"extensions,omitempty"`
+	// Payload is the json data as is, other data are presented as string.
+	Payload    any       `json:"payload,omitempty"`
+	Truncated  bool      `json:"truncated"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+// NewRecord converts the archived event to the view, the payload is omitted
+// unless withPayload.
+func NewRecord(e *ent.ArchivedEvent, withPayload bool) Record {
+	ret := Record{
+		ID:              e.ID,
+		EventID:         e.EventID,
+		Type:            e.Type,
+		Source:          e.Source,
+		Subject:         e.Subject,
+		Time:            e.Time,
+		Topic:           e.Topic,
+		DataContentType: e.DataContentType,
+		Extensions:      e.Extensions,
+		Truncated:       e.Truncated,
+		ReceivedAt:      e.ReceivedAt,
+	}
+	if withPayload && len(e.Payload) > 0 {
+		if !e.Truncated && json.Valid(e.Payload) {
+			ret.Payload = json.RawMessage(e.Payload)
+		} else {
+			ret.Payload = string(e.Payload)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,126 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+func newTestArchive(t *testing.T, cfg config.Archive) *Archive {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	return New(client.ArchivedEvent, cfg)
+}
+
+func newTestEvent(t *testing.T, id, typ, subject string, eventTime time.Time, data any) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("/tekton/pipelineruns")
+	event.SetType(typ)
+	event.SetSubject(subject)
+	event.SetTime(eventTime)
+	event.SetExtension("buildurl", "https://ci.example.com/1")
+	if err := event.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		t.Fatal(err)
+	}
+
+	return event
+}
+
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	ar := newTestArchive(t, config.Archive{MaxPayloadBytes: 64})
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	events := []cloudevents.Event{
+		newTestEvent(t, "1", "dev.tekton.event.pipelinerun.started.v1", "tidb-pr-123", base, map[string]string{"k": "v"}),
+		newTestEvent(t, "2", "dev.tekton.event.pipelinerun.failed.v1", "tidb-pr-123", base.Add(time.Hour), map[string]string{"log": strings.Repeat("x", 100)}),
+		newTestEvent(t, "3", "dev.tekton.event.pipelinerun.failed.v1", "tiflow-pr-456", base.Add(2*time.Hour), map[string]string{"k": "v"}),
+	}
+	for _, e := range events {
+		if err := ar.Save(ctx, e, "tekton-events"); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{name: "all newest first", query: Query{}, want: []string{"3", "2", "1"}},
+		{name: "by type", query: Query{Type: "dev.tekton.event.pipelinerun.failed.v1"}, want: []string{"3", "2"}},
+		{name: "by subject", query: Query{Subject: "tidb-pr"}, want: []string{"2", "1"}},
+		{name: "by time range", query: Query{Since: base.Add(time.Hour), Until: base.Add(2 * time.Hour)}, want: []string{"2"}},
+		{name: "limit", query: Query{Limit: 1}, want: []string{"3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ar.Search(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			var gotIDs []string
+			for _, e := range got {
+				gotIDs = append(gotIDs, e.EventID)
+			}
+			if strings.Join(gotIDs, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Search() = %v, want %v", gotIDs, tt.want)
+			}
+		})
+	}
+
+	t.Run("get", func(t *testing.T) {
+		got, err := ar.Get(ctx, "1", "")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		record := NewRecord(got, true)
+		if record.Topic != "tekton-events" || record.Extensions["buildurl"] != "https://ci.example.com/1" || record.Truncated {
+			t.Errorf("Get() = %+v", record)
+		}
+		if payload, _ := json.Marshal(record.Payload); string(payload) != `{"k":"v"}` {
+			t.Errorf("Get() payload = %s", payload)
+		}
+
+		restored, err := Event(got)
+		if err != nil {
+			t.Fatalf("Event() error = %v", err)
+		}
+		if restored.String() != events[0].String() {
+			t.Errorf("Event() = %s, want %s", restored, events[0])
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		got, err := ar.Get(ctx, "2", "/tekton/pipelineruns")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if !got.Truncated || len(got.Payload) != 64 {
+			t.Errorf("Get() truncated = %v, payload length = %d", got.Truncated, len(got.Payload))
+		}
+		if _, err := Event(got); err != ErrTruncated {
+			t.Errorf("Event() error = %v, want %v", err, ErrTruncated)
+		}
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		n, err := ar.Prune(ctx, time.Now().Add(defaultRetentionDays*24*time.Hour+time.Minute))
+		if err != nil {
+			t.Fatalf("Prune() error = %v", err)
+		}
+		if n != len(events) {
+			t.Errorf("Prune() = %d, want %d", n, len(events))
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package cdevents
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	lark "github.com/larksuite/oapi-sdk-go/v3"
+	larkcard "github.com/larksuite/oapi-sdk-go/v3/card"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/tekton"
+	larkutil "github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/lark"
+)
+
+const defaultNamespace = "jenkins"
+
+var larkCardHeaderTemplates = map[string]string{
+	tekton.RunStatusQueued:    larkcard.TemplateGrey,
+	tekton.RunStatusRunning:   larkcard.TemplateYellow,
+	tekton.RunStatusSucceeded: larkcard.TemplateGreen,
+	tekton.RunStatusFailed:    larkcard.TemplateRed,
+	tekton.RunStatusCancelled: larkcard.TemplateGrey,
+}
+
+var larkCardHeaderEmojis = map[string]string{
+	tekton.RunStatusQueued:    "⌛️",
+	tekton.RunStatusRunning:   "🚀",
+	tekton.RunStatusSucceeded: "✅",
+	tekton.RunStatusFailed:    "❌",
+	tekton.RunStatusCancelled: "⛔️",
+}
+
+// NewHandler creates the handler of the CDEvents pipeline runs and task runs,
+// such as the builds and stages of Jenkins. The runs are recorded in the run
+// history of db when it is not nil.
+func NewHandler(cfg config.CDEvents, db *ent.Client) (handler.EventHandler, error) {
+	ret := &runHandler{
+		CDEvents:   cfg,
+		LarkClient: larkutil.NewClient(cfg.Lark.AppID, cfg.Lark.AppSecret),
+	}
+	if ret.Namespace == "" {
+		ret.Namespace = defaultNamespace
+	}
+	if db != nil {
+		ret.History = tekton.NewRunHistoryRecorder(db)
+	}
+
+	return ret, nil
+}
+
+type runHandler struct {
+	config.CDEvents
+	LarkClient *lark.Client
+	// History records the runs together with the tekton runs when set.
+	History *tekton.RunHistoryRecorder
+}
+
+func (h *runHandler) SupportEventTypes() []string {
+	return EventTypes()
+}
+
+// EventTypes returns the event types of the runs without the version, they
+// match the events of all the spec versions.
+func EventTypes() []string {
+	return []string{
+		EventTypePipelineRunQueued,
+		EventTypePipelineRunStarted,
+		EventTypePipelineRunFinished,
+		EventTypeTaskRunStarted,
+		EventTypeTaskRunFinished,
+	}
+}
+
+func (h *runHandler) Handle(event cloudevents.Event) cloudevents.Result {
+	eventType, data, err := parseEvent(event)
+	if err != nil {
+		return cloudevents.NewReceipt(false, "invalid data: %v", err)
+	}
+
+	handlerLog := log.With().
+		Str("ce-type", event.Type()).
+		Str("ce-id", event.ID()).
+		Logger()
+
+	record := newRunRecord(eventType, data, h.Namespace)
+
+	var saved *ent.TektonRun
+	if h.History != nil {
+		if saved, err = h.History.Save(context.Background(), record); err != nil {
+			handlerLog.Err(err).Str("run-uid", record.UID).Msg("failed to record the run history")
+		}
+	}
+
+	receivers := getReceivers(eventType, record.Pipeline, h.Notifications)
+	if len(receivers) == 0 {
+		return cloudevents.ResultACK
+	}
+
+	handlerLog.Debug().
+		Str("receivers", strings.Join(receivers, ",")).
+		Msg("send notification for the event type.")
+	return tekton.ComposeAndSendLarkMessages(h.LarkClient, receivers, newCardMessageInfos(record, data, saved))
+}
+
+// newRunRecord maps the event onto the run history record, the runs are
+// identified by the source and the subject id.
+func newRunRecord(eventType string, data api.CDEventReader, namespace string) tekton.RunRecord {
+	content := contentOf(data)
+	ret := tekton.RunRecord{
+		UID:       runUID(data.GetSubjectSource(), data.GetSource(), data.GetSubjectId()),
+		Kind:      tekton.RunKindPipelineRun,
+		Namespace: namespace,
+		Name:      data.GetSubjectId(),
+		Pipeline:  content.PipelineName,
+		Reason:    content.Outcome,
+	}
+	if strings.HasPrefix(eventType, "dev.cdevents.taskrun.") {
+		ret.Kind = tekton.RunKindTaskRun
+		ret.Pipeline = content.TaskName
+		ret.PipelineRun = content.PipelineRun
+	}
+
+	timestamp := data.GetTimestamp()
+	switch eventType {
+	case EventTypePipelineRunQueued:
+		ret.Status = tekton.RunStatusQueued
+	case EventTypePipelineRunStarted, EventTypeTaskRunStarted:
+		ret.Status = tekton.RunStatusRunning
+		ret.StartTime = &timestamp
+	case EventTypePipelineRunFinished, EventTypeTaskRunFinished:
+		ret.Status = finishedRunStatus(content.Outcome)
+		ret.EndTime = &timestamp
+	}
+
+	return ret
+}
+
+func runUID(subjectSource, contextSource, id string) string {
+	source := subjectSource
+	if source == "" {
+		source = contextSource
+	}
+	if source == "" {
+		return id
+	}
+	return strings.TrimSuffix(source, "/") + "/" + strings.TrimPrefix(id, "/")
+}
+
+func finishedRunStatus(outcome string) string {
+	switch outcome {
+	case outcomeSuccess:
+		return tekton.RunStatusSucceeded
+	case outcomeCancel:
+		return tekton.RunStatusCancelled
+	case outcomeFailure, outcomeError:
+		return tekton.RunStatusFailed
+	default: // not reported.
+		return tekton.RunStatusFailed
+	}
+}
+
+func getReceivers(eventType, pipeline string, cfgs []config.CDEventsNotification) []string {
+	ret := sets.NewString()
+	for _, cfg := range cfgs {
+		if cfg.IsMatched(eventType, pipeline) {
+			ret.Insert(cfg.Receivers...)
+		}
+	}
+
+	return ret.List()
+}
+
+// newCardMessageInfos composes the card infos of the run, the times are
+// taken from the saved history record when it exists, since the finished
+// events do not carry the start time.
+func newCardMessageInfos(record tekton.RunRecord, data api.CDEventReader, saved *ent.TektonRun) *tekton.CardMessageInfos {
+	content := contentOf(data)
+	ret := &tekton.CardMessageInfos{
+		Title: fmt.Sprintf("%s [%s] %s is %s ",
+			larkCardHeaderEmojis[record.Status], record.Kind, record.Pipeline, record.Status),
+		TitleTemplate: larkCardHeaderTemplates[record.Status],
+		ViewURL:       content.URL,
+		FailedMessage: content.Errors,
+	}
+	if ret.ViewURL == "" {
+		ret.ViewURL = data.GetSource()
+	}
+
+	startTime, endTime := record.StartTime, record.EndTime
+	if saved != nil {
+		startTime, endTime = saved.StartTime, saved.EndTime
+	}
+	if startTime != nil {
+		ret.StartTime = startTime.Format(time.RFC3339)
+	}
+	if endTime != nil {
+		ret.EndTime = endTime.Format(time.RFC3339)
+	}
+	if startTime != nil && endTime != nil {
+		ret.TimeCost = endTime.Sub(*startTime).String()
+	}
+
+	return ret
+}
@@ -0,0 +1,279 @@
+package cdevents
+
+import (
+	"context"
+	_ "embed"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	larkcard "github.com/larksuite/oapi-sdk-go/v3/card"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/tekton"
+)
+
+var (
+	//go:embed testdata/event-pipelinerun.queued.json
+	pipelineRunQueuedEventBytes []byte
+	//go:embed testdata/event-pipelinerun.started.json
+	pipelineRunStartedEventBytes []byte
+	//go:embed testdata/event-pipelinerun.finished-failed.json
+	pipelineRunFailedEventBytes []byte
+	//go:embed testdata/event-pipelinerun.finished-successful.json
+	pipelineRunSuccessfulEventBytes []byte
+	//go:embed testdata/event-taskrun.finished.json
+	taskRunFinishedEventBytes []byte
+)
+
+const failedRunUID = "https://jenkins.example.com/job/pingcap/tidb/ghpr_unit_test/1234/"
+
+func newTestEvent(t *testing.T, data []byte) (cloudevents.Event, api.CDEventReader) {
+	t.Helper()
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+	_, ret, err := parseEvent(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return event, ret
+}
+
+func Test_parseEvent(t *testing.T) {
+	tests := []struct {
+		eventType string
+		want      string
+		wantErr   bool
+	}{
+		{eventType: "dev.cdevents.pipelinerun.finished.0.1.0", want: EventTypePipelineRunFinished},
+		{eventType: "dev.cdevents.pipelinerun.finished.0.2.0", want: EventTypePipelineRunFinished},
+		{eventType: "dev.cdevents.taskrun.started.0.3.0-draft", want: EventTypeTaskRunStarted},
+		{eventType: "dev.cdevents.taskrun.started.1.0.0", wantErr: true},
+		{eventType: "dev.cdevents.artifact.published.0.1.0", wantErr: true},
+		{eventType: "dev.tekton.event.pipelinerun.started.v1", wantErr: true},
+	}
+	for _, tt := range tests {
+		event := cloudevents.NewEvent()
+		event.SetType(tt.eventType)
+		if err := event.SetData(cloudevents.ApplicationJSON, map[string]any{}); err != nil {
+			t.Fatal(err)
+		}
+		got, _, err := parseEvent(event)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseEvent(%q) = %q, %v, want %q, error %v", tt.eventType, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func Test_runHandler_SupportEventTypes(t *testing.T) {
+	got := new(runHandler).SupportEventTypes()
+	for _, typ := range []string{
+		"dev.cdevents.pipelinerun.queued",
+		"dev.cdevents.pipelinerun.finished",
+		"dev.cdevents.taskrun.finished",
+	} {
+		if !slices.Contains(got, typ) {
+			t.Errorf("SupportEventTypes() does not contain %q", typ)
+		}
+	}
+}
+
+func Test_newRunRecord(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want tekton.RunRecord
+	}{
+		{
+			name: "queued pipeline run",
+			data: pipelineRunQueuedEventBytes,
+			want: tekton.RunRecord{
+				UID:       failedRunUID,
+				Kind:      tekton.RunKindPipelineRun,
+				Namespace: "jenkins",
+				Name:      "job/pingcap/tidb/ghpr_unit_test/1234/",
+				Pipeline:  "pingcap/tidb/ghpr_unit_test",
+				Status:    tekton.RunStatusQueued,
+			},
+		},
+		{
+			name: "failed pipeline run",
+			data: pipelineRunFailedEventBytes,
+			want: tekton.RunRecord{
+				UID:       failedRunUID,
+				Kind:      tekton.RunKindPipelineRun,
+				Namespace: "jenkins",
+				Name:      "job/pingcap/tidb/ghpr_unit_test/1234/",
+				Pipeline:  "pingcap/tidb/ghpr_unit_test",
+				Status:    tekton.RunStatusFailed,
+				Reason:    outcomeFailure,
+			},
+		},
+		{
+			name: "successful pipeline run",
+			data: pipelineRunSuccessfulEventBytes,
+			want: tekton.RunRecord{
+				UID:       "https://jenkins.example.com/job/pingcap/tidb/ghpr_unit_test/1235/",
+				Kind:      tekton.RunKindPipelineRun,
+				Namespace: "jenkins",
+				Name:      "job/pingcap/tidb/ghpr_unit_test/1235/",
+				Pipeline:  "pingcap/tidb/ghpr_unit_test",
+				Status:    tekton.RunStatusSucceeded,
+				Reason:    outcomeSuccess,
+			},
+		},
+		{
+			name: "failed task run",
+			data: taskRunFinishedEventBytes,
+			want: tekton.RunRecord{
+				UID:         "https://jenkins.example.com/job/pingcap/tidb/ghpr_unit_test/1234/stage/unit-test",
+				Kind:        tekton.RunKindTaskRun,
+				Namespace:   "jenkins",
+				Name:        "job/pingcap/tidb/ghpr_unit_test/1234/stage/unit-test",
+				Pipeline:    "unit-test",
+				PipelineRun: "job/pingcap/tidb/ghpr_unit_test/1234/",
+				Status:      tekton.RunStatusFailed,
+				Reason:      outcomeFailure,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, data := newTestEvent(t, tt.data)
+			eventType, _, err := parseEvent(event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := newRunRecord(eventType, data, "jenkins")
+			// times are checked in the history test.
+			got.StartTime, got.EndTime = nil, nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newRunRecord() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_finishedRunStatus(t *testing.T) {
+	tests := map[string]string{
+		outcomeSuccess: tekton.RunStatusSucceeded,
+		outcomeFailure: tekton.RunStatusFailed,
+		outcomeError:   tekton.RunStatusFailed,
+		outcomeCancel:  tekton.RunStatusCancelled,
+		"":             tekton.RunStatusFailed,
+	}
+	for outcome, want := range tests {
+		if got := finishedRunStatus(outcome); got != want {
+			t.Errorf("finishedRunStatus(%q) = %q, want %q", outcome, got, want)
+		}
+	}
+}
+
+func Test_getReceivers(t *testing.T) {
+	cfgs := []config.CDEventsNotification{
+		{EventType: EventTypePipelineRunFinished, PipelineReg: "^pingcap/tidb/", Receivers: []string{"tidb-team"}},
+		{EventType: "*", PipelineReg: "^pingcap/tiflow/", Receivers: []string{"tiflow-team"}},
+		{Receivers: []string{"ci-admin"}},
+	}
+	tests := []struct {
+		eventType string
+		pipeline  string
+		want      []string
+	}{
+		{EventTypePipelineRunFinished, "pingcap/tidb/ghpr_unit_test", []string{"ci-admin", "tidb-team"}},
+		{EventTypePipelineRunStarted, "pingcap/tidb/ghpr_unit_test", []string{"ci-admin"}},
+		{EventTypePipelineRunStarted, "pingcap/tiflow/ghpr_verify", []string{"ci-admin", "tiflow-team"}},
+	}
+	for _, tt := range tests {
+		if got := getReceivers(tt.eventType, tt.pipeline, cfgs); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("getReceivers(%q, %q) = %v, want %v", tt.eventType, tt.pipeline, got, tt.want)
+		}
+	}
+}
+
+func Test_runHandler_Handle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	h := &runHandler{
+		CDEvents: config.CDEvents{Namespace: "jenkins"},
+		History:  &tekton.RunHistoryRecorder{Storage: client.TektonRun},
+	}
+	ctx := context.Background()
+
+	for _, data := range [][]byte{
+		pipelineRunQueuedEventBytes,
+		pipelineRunStartedEventBytes,
+		pipelineRunFailedEventBytes,
+		taskRunFinishedEventBytes,
+	} {
+		event, _ := newTestEvent(t, data)
+		if got := h.Handle(event); !cloudevents.IsACK(got) {
+			t.Fatalf("Handle(%s) = %v", event.Type(), got)
+		}
+	}
+
+	failed, err := client.TektonRun.Query().Where(tektonrun.RunUIDEQ(failedRunUID)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Status != tekton.RunStatusFailed || failed.Namespace != "jenkins" || failed.Pipeline != "pingcap/tidb/ghpr_unit_test" {
+		t.Errorf("unexpected failed run: %+v", failed)
+	}
+	if failed.StartTime == nil || failed.EndTime == nil || failed.DurationMs != 30*60*1000+30*1000 {
+		t.Errorf("unexpected times of failed run: start=%v, end=%v, duration=%d", failed.StartTime, failed.EndTime, failed.DurationMs)
+	}
+	if n := client.TektonRun.Query().Where(tektonrun.KindEQ(tekton.RunKindTaskRun)).CountX(ctx); n != 1 {
+		t.Errorf("recorded task runs = %d, want 1", n)
+	}
+
+	// the delayed started event does not revert the finished run.
+	event, _ := newTestEvent(t, pipelineRunStartedEventBytes)
+	h.Handle(event)
+	if got := client.TektonRun.Query().Where(tektonrun.RunUIDEQ(failedRunUID)).OnlyX(ctx).Status; got != tekton.RunStatusFailed {
+		t.Errorf("status after delayed started event = %q, want %q", got, tekton.RunStatusFailed)
+	}
+}
+
+func Test_newCardMessageInfos(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	recorder := &tekton.RunHistoryRecorder{Storage: client.TektonRun}
+	ctx := context.Background()
+
+	_, started := newTestEvent(t, pipelineRunStartedEventBytes)
+	if _, err := recorder.Save(ctx, newRunRecord(EventTypePipelineRunStarted, started, "jenkins")); err != nil {
+		t.Fatal(err)
+	}
+	_, failed := newTestEvent(t, pipelineRunFailedEventBytes)
+	record := newRunRecord(EventTypePipelineRunFinished, failed, "jenkins")
+	saved, err := recorder.Save(ctx, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := newCardMessageInfos(record, failed, saved)
+	want := &tekton.CardMessageInfos{
+		Title:         "❌ [pipelinerun] pingcap/tidb/ghpr_unit_test is failed ",
+		TitleTemplate: larkcard.TemplateRed,
+		ViewURL:       "https://jenkins.example.com/job/pingcap/job/tidb/job/ghpr_unit_test/1234/",
+		StartTime:     "2024-03-01T08:01:00Z",
+		EndTime:       "2024-03-01T08:31:30Z",
+		TimeCost:      "30m30s",
+		FailedMessage: "script returned exit code 2",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newCardMessageInfos() = %+v, want %+v", got, want)
+	}
+
+	// the start time is unknown without the history.
+	if got := newCardMessageInfos(record, failed, nil); got.StartTime != "" || got.TimeCost != "" || got.EndTime != want.EndTime {
+		t.Errorf("newCardMessageInfos() without history = %+v", got)
+	}
+}
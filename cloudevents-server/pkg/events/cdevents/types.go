@@ -0,0 +1,102 @@
+package cdevents
+
+import (
+	"fmt"
+
+	"github.com/cdevents/sdk-go/pkg/api"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// Event types of the CDEvents runs without the version, such as
+// "dev.cdevents.pipelinerun.finished" of "dev.cdevents.pipelinerun.finished.0.1.0".
+//
+// Spec: https://github.com/cdevents/spec/blob/main/core.md
+var (
+	EventTypePipelineRunQueued   = api.PipelineRunQueuedEventTypeV0_2_0.UnversionedString()
+	EventTypePipelineRunStarted  = api.PipelineRunStartedEventTypeV0_2_0.UnversionedString()
+	EventTypePipelineRunFinished = api.PipelineRunFinishedEventTypeV0_2_0.UnversionedString()
+	EventTypeTaskRunStarted      = api.TaskRunStartedEventTypeV0_2_0.UnversionedString()
+	EventTypeTaskRunFinished     = api.TaskRunFinishedEventTypeV0_2_0.UnversionedString()
+)
+
+// newEvents creates the SDK events of the run event types, the events of the
+// older spec versions are decoded by the latest ones of the same major version.
+var newEvents = map[string]func() api.CDEventReader{
+	EventTypePipelineRunQueued:   func() api.CDEventReader { return new(api.PipelineRunQueuedEventV0_2_0) },
+	EventTypePipelineRunStarted:  func() api.CDEventReader { return new(api.PipelineRunStartedEventV0_2_0) },
+	EventTypePipelineRunFinished: func() api.CDEventReader { return new(api.PipelineRunFinishedEventV0_2_0) },
+	EventTypeTaskRunStarted:      func() api.CDEventReader { return new(api.TaskRunStartedEventV0_2_0) },
+	EventTypeTaskRunFinished:     func() api.CDEventReader { return new(api.TaskRunFinishedEventV0_2_0) },
+}
+
+// outcomes of the finished runs.
+const (
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+	outcomeError   = "error"
+	outcomeCancel  = "cancel"
+)
+
+// parseEvent decodes the data of the run event, it returns the event type
+// without the version.
+func parseEvent(event cloudevents.Event) (string, api.CDEventReader, error) {
+	typ, err := api.ParseType(event.Type())
+	if err != nil {
+		return "", nil, err
+	}
+
+	eventType := typ.UnversionedString()
+	newEvent, ok := newEvents[eventType]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported event type %s", event.Type())
+	}
+	data := newEvent()
+	if !typ.IsCompatible(data.GetType()) {
+		return "", nil, fmt.Errorf("unsupported version %s of event type %s", typ.Version, eventType)
+	}
+	if err := event.DataAs(data); err != nil {
+		return "", nil, err
+	}
+
+	return eventType, data, nil
+}
+
+// subjectContent is the content of the `pipelineRun` or `taskRun` subject,
+// `TaskName` and `PipelineRun` are set for the task runs only.
+type subjectContent struct {
+	PipelineName string
+	TaskName     string
+	PipelineRun  string
+	URL          string
+	Outcome      string
+	Errors       string
+}
+
+func contentOf(data api.CDEventReader) subjectContent {
+	switch e := data.(type) {
+	case *api.PipelineRunQueuedEventV0_2_0:
+		c := e.Subject.Content
+		return subjectContent{PipelineName: c.PipelineName, URL: c.Url}
+	case *api.PipelineRunStartedEventV0_2_0:
+		c := e.Subject.Content
+		return subjectContent{PipelineName: c.PipelineName, URL: c.Url}
+	case *api.PipelineRunFinishedEventV0_2_0:
+		c := e.Subject.Content
+		return subjectContent{PipelineName: c.PipelineName, URL: c.Url, Outcome: c.Outcome, Errors: c.Errors}
+	case *api.TaskRunStartedEventV0_2_0:
+		c := e.Subject.Content
+		return subjectContent{TaskName: c.TaskName, PipelineRun: referenceID(c.PipelineRun), URL: c.Url}
+	case *api.TaskRunFinishedEventV0_2_0:
+		c := e.Subject.Content
+		return subjectContent{TaskName: c.TaskName, PipelineRun: referenceID(c.PipelineRun), URL: c.Url, Outcome: c.Outcome, Errors: c.Errors}
+	default:
+		return subjectContent{}
+	}
+}
+
+func referenceID(ref *api.Reference) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Id
+}
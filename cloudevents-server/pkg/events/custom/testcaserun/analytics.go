@@ -0,0 +1,349 @@
+package testcaserun
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/stats"
+)
+
+// ErrInvalidQuery is returned when the required query parameters are missing.
+var ErrInvalidQuery = errors.New("invalid query")
+
+// Analytics queries the flaky and slow test cases from the problem case runs.
+//
+// The bazel reports carry the flaky and long time runs only, so the flaky rates
+// are computed over the builds which reported the cases of the repo.
+type Analytics struct {
+	Storage *ent.ProblemCaseRunClient
+}
+
+func NewAnalytics(db *ent.Client) *Analytics {
+	return &Analytics{Storage: db.ProblemCaseRun}
+}
+
+// AnalyticsQuery filters the problem case runs in the window.
+type AnalyticsQuery struct {
+	Repo   string `form:"repo" json:"repo"`
+	Branch string `form:"branch" json:"branch,omitempty"` // all branches when empty.
+	Suite  string `form:"suite" json:"suite,omitempty"`
+	Case   string `form:"case" json:"case,omitempty"`
+	stats.Window
+}
+
+func (q *AnalyticsQuery) normalize() error {
+	if q.Repo == "" {
+		return fmt.Errorf("%w: repo is required", ErrInvalidQuery)
+	}
+	q.Window.Normalize()
+
+	return nil
+}
+
+// predicates of the repo, branch and the time range.
+func (q AnalyticsQuery) predicates(since, until time.Time) []predicate.ProblemCaseRun {
+	ret := []predicate.ProblemCaseRun{
+		problemcaserun.RepoEQ(q.Repo),
+		problemcaserun.ReportTimeGTE(since),
+		problemcaserun.ReportTimeLT(until),
+	}
+	if q.Branch != "" {
+		ret = append(ret, problemcaserun.BranchEQ(q.Branch))
+	}
+	return ret
+}
+
+// FlakyCase is the flaky statistics of a test case.
+type FlakyCase struct {
+	Suite      string  `json:"suite"`
+	Case       string  `json:"case"`
+	FlakyCount int     `json:"flaky_count"`
+	FlakyRate  float64 `json:"flaky_rate"`
+}
+
+// TopFlaky returns the cases flaky most often, the flaky rate is the count
+// divided by the reported builds in the window.
+func (a *Analytics) TopFlaky(ctx context.Context, q AnalyticsQuery) ([]FlakyCase, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+
+	ret, err := a.flakyCases(ctx, q, q.Since(), q.Until)
+	if err != nil {
+		return nil, err
+	}
+	builds, err := a.countBuilds(ctx, q, q.Since(), q.Until)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ret {
+		ret[i].FlakyRate = stats.Rate(ret[i].FlakyCount, builds)
+	}
+	if len(ret) > q.Limit {
+		ret = ret[:q.Limit]
+	}
+
+	return ret, nil
+}
+
+// NewFlaky returns the cases flaky in the window but not in the previous
+// window of the same days, such as this week compared with the last week.
+func (a *Analytics) NewFlaky(ctx context.Context, q AnalyticsQuery) ([]FlakyCase, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+
+	current, err := a.flakyCases(ctx, q, q.Since(), q.Until)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := a.flakyCases(ctx, q, q.Since().AddDate(0, 0, -q.Days), q.Since())
+	if err != nil {
+		return nil, err
+	}
+	builds, err := a.countBuilds(ctx, q, q.Since(), q.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[[2]string]bool, len(previous))
+	for _, c := range previous {
+		seen[[2]string{c.Suite, c.Case}] = true
+	}
+	var ret []FlakyCase
+	for _, c := range current {
+		if seen[[2]string{c.Suite, c.Case}] {
+			continue
+		}
+		c.FlakyRate = stats.Rate(c.FlakyCount, builds)
+		ret = append(ret, c)
+		if len(ret) >= q.Limit {
+			break
+		}
+	}
+
+	return ret, nil
+}
+
+// flakyCases returns the flaky cases in the time range, sorted by the flaky
+// count in descending order.
+func (a *Analytics) flakyCases(ctx context.Context, q AnalyticsQuery, since, until time.Time) ([]FlakyCase, error) {
+	var rows []struct {
+		SuiteName string `json:"suite_name"`
+		CaseName  string `json:"case_name"`
+		Count     int    `json:"count"`
+	}
+	err := a.Storage.Query().
+		Where(q.predicates(since, until)...).
+		Where(problemcaserun.FlakyEQ(true)).
+		GroupBy(problemcaserun.FieldSuiteName, problemcaserun.FieldCaseName).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]FlakyCase, 0, len(rows))
+	for _, r := range rows {
+		ret = append(ret, FlakyCase{Suite: r.SuiteName, Case: r.CaseName, FlakyCount: r.Count})
+	}
+	slices.SortFunc(ret, func(a, b FlakyCase) int {
+		return cmp.Or(
+			cmp.Compare(b.FlakyCount, a.FlakyCount),
+			cmp.Compare(a.Suite, b.Suite),
+			cmp.Compare(a.Case, b.Case),
+		)
+	})
+
+	return ret, nil
+}
+
+// countBuilds returns the number of the builds reported in the time range.
+func (a *Analytics) countBuilds(ctx context.Context, q AnalyticsQuery, since, until time.Time) (int, error) {
+	return a.Storage.Query().
+		Where(q.predicates(since, until)...).
+		Unique(true).
+		Select(problemcaserun.FieldBuildURL).
+		Count(ctx)
+}
+
+// reportedBuilds returns the report time of the builds reported in the time
+// range, all the records of one report share the build url and report time.
+func (a *Analytics) reportedBuilds(ctx context.Context, q AnalyticsQuery, since, until time.Time) ([]time.Time, error) {
+	var rows []struct {
+		BuildURL   string    `json:"build_url"`
+		ReportTime time.Time `json:"report_time"`
+	}
+	err := a.Storage.Query().
+		Where(q.predicates(since, until)...).
+		Unique(true).
+		Select(problemcaserun.FieldBuildURL, problemcaserun.FieldReportTime).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]time.Time, 0, len(rows))
+	for _, r := range rows {
+		ret = append(ret, r.ReportTime)
+	}
+	return ret, nil
+}
+
+// TrendPoint is the flaky statistics of a case in a day.
+type TrendPoint struct {
+	Date       string  `json:"date"`
+	Builds     int     `json:"builds"`
+	FlakyCount int     `json:"flaky_count"`
+	FlakyRate  float64 `json:"flaky_rate"`
+}
+
+// FlakyTrend returns the daily flaky rate of the case in the window.
+func (a *Analytics) FlakyTrend(ctx context.Context, q AnalyticsQuery) ([]TrendPoint, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+	if q.Suite == "" || q.Case == "" {
+		return nil, fmt.Errorf("%w: suite and case are required", ErrInvalidQuery)
+	}
+
+	since := q.Since()
+	dates := q.Dates()
+	ret := make([]TrendPoint, 0, len(dates))
+	index := make(map[string]int, len(dates))
+	for _, date := range dates {
+		index[date] = len(ret)
+		ret = append(ret, TrendPoint{Date: date})
+	}
+
+	builds, err := a.reportedBuilds(ctx, q, since, q.Until)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range builds {
+		if i, ok := index[t.UTC().Format(stats.DateLayout)]; ok {
+			ret[i].Builds++
+		}
+	}
+
+	flakyRuns, err := a.Storage.Query().
+		Where(q.predicates(since, q.Until)...).
+		Where(
+			problemcaserun.SuiteNameEQ(q.Suite),
+			problemcaserun.CaseNameEQ(q.Case),
+			problemcaserun.FlakyEQ(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range flakyRuns {
+		if i, ok := index[r.ReportTime.UTC().Format(stats.DateLayout)]; ok {
+			ret[i].FlakyCount++
+		}
+	}
+	for i := range ret {
+		ret[i].FlakyRate = stats.Rate(ret[i].FlakyCount, ret[i].Builds)
+	}
+
+	return ret, nil
+}
+
+// slowestCandidatesFactor is the number of the cases with the longest
+// timecosts loaded for every returned slowest case, the cases ranked by the P90
+// timecost are mostly among them.
+const slowestCandidatesFactor = 5
+
+const maxTimecostColumn = "max_timecost_ms"
+
+// SlowCase is the timecost statistics of a test case.
+type SlowCase struct {
+	Suite string `json:"suite"`
+	Case  string `json:"case"`
+	Runs  int    `json:"runs"`
+	stats.Durations
+}
+
+// Slowest returns the long time cases sorted by the P90 timecost in descending
+// order, the failed, skipped and not finished runs are excluded.
+func (a *Analytics) Slowest(ctx context.Context, q AnalyticsQuery) ([]SlowCase, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+
+	// the percentiles are computed in go, so only the runs of the cases with the
+	// longest timecosts are loaded.
+	runs := a.Storage.Query().
+		Where(q.predicates(q.Since(), q.Until)...).
+		Where(problemcaserun.FlakyEQ(false), problemcaserun.StatusNotIn(statusFailed, statusSkipped), problemcaserun.TimecostMsGT(0))
+	var candidates []struct {
+		SuiteName     string `json:"suite_name"`
+		CaseName      string `json:"case_name"`
+		MaxTimecostMs int    `json:"max_timecost_ms"`
+	}
+	err := runs.Clone().
+		Order(func(s *sql.Selector) {
+			s.OrderBy(sql.Desc(maxTimecostColumn), s.C(problemcaserun.FieldSuiteName), s.C(problemcaserun.FieldCaseName))
+		}).
+		Limit(q.Limit*slowestCandidatesFactor).
+		GroupBy(problemcaserun.FieldSuiteName, problemcaserun.FieldCaseName).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(sql.Max(s.C(problemcaserun.FieldTimecostMs)), maxTimecostColumn)
+		}).
+		Scan(ctx, &candidates)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return []SlowCase{}, nil
+	}
+
+	cases := make([]predicate.ProblemCaseRun, 0, len(candidates))
+	for _, c := range candidates {
+		cases = append(cases, problemcaserun.And(problemcaserun.SuiteNameEQ(c.SuiteName), problemcaserun.CaseNameEQ(c.CaseName)))
+	}
+	var rows []struct {
+		SuiteName  string `json:"suite_name"`
+		CaseName   string `json:"case_name"`
+		TimecostMs int    `json:"timecost_ms"`
+	}
+	err = runs.
+		Where(problemcaserun.Or(cases...)).
+		Select(problemcaserun.FieldSuiteName, problemcaserun.FieldCaseName, problemcaserun.FieldTimecostMs).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	timecosts := make(map[[2]string][]int)
+	for _, r := range rows {
+		key := [2]string{r.SuiteName, r.CaseName}
+		timecosts[key] = append(timecosts[key], r.TimecostMs)
+	}
+	ret := make([]SlowCase, 0, len(timecosts))
+	for key, values := range timecosts {
+		ret = append(ret, SlowCase{Suite: key[0], Case: key[1], Runs: len(values), Durations: stats.NewDurations(values)})
+	}
+	slices.SortFunc(ret, func(a, b SlowCase) int {
+		return cmp.Or(
+			cmp.Compare(b.P90Ms, a.P90Ms),
+			cmp.Compare(b.MaxMs, a.MaxMs),
+			cmp.Compare(a.Suite, b.Suite),
+			cmp.Compare(a.Case, b.Case),
+		)
+	})
+	if len(ret) > q.Limit {
+		ret = ret[:q.Limit]
+	}
+
+	return ret, nil
+}
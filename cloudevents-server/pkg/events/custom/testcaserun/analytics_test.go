@@ -0,0 +1,148 @@
+package testcaserun
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/stats"
+)
+
+func newTestAnalytics(t *testing.T) *Analytics {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	return &Analytics{Storage: client.ProblemCaseRun}
+}
+
+type testRun struct {
+	build    string
+	daysAgo  int
+	suite    string
+	name     string
+	flaky    bool
+	timecost int
+}
+
+func TestAnalytics(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAnalytics(t)
+	until := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	runs := []testRun{
+		// last week.
+		{build: "10", daysAgo: 10, suite: "//pkg/a", name: "TestOld", flaky: true},
+		{build: "10", daysAgo: 10, suite: "//pkg/a", name: "TestA", flaky: true},
+		// this week.
+		{build: "1", daysAgo: 3, suite: "//pkg/a", name: "TestA", flaky: true},
+		{build: "1", daysAgo: 3, suite: "//pkg/b", name: "TestSlow", timecost: 1000},
+		{build: "2", daysAgo: 1, suite: "//pkg/a", name: "TestA", flaky: true},
+		{build: "2", daysAgo: 1, suite: "//pkg/b", name: "TestB", flaky: true},
+		{build: "2", daysAgo: 1, suite: "//pkg/b", name: "TestSlow", timecost: 3000},
+		{build: "3", daysAgo: 1, suite: "//pkg/b", name: "TestSlow", timecost: 2000},
+		{build: "3", daysAgo: 1, suite: "//pkg/c", name: "TestFast", timecost: 500},
+		{build: "3", daysAgo: 1, suite: "//pkg/c", name: "TestNotFinished", timecost: -1000},
+	}
+	for _, r := range runs {
+		a.Storage.Create().
+			SetRepo("pingcap/tidb").
+			SetBranch("master").
+			SetSuiteName(r.suite).
+			SetCaseName(r.name).
+			SetFlaky(r.flaky).
+			SetTimecostMs(r.timecost).
+			SetReportTime(until.AddDate(0, 0, -r.daysAgo).Add(time.Duration(len(r.build)) * time.Minute)).
+			SetBuildURL("https://ci.example.com/" + r.build).
+			SetReason(reasonNA).
+			SaveX(ctx)
+	}
+	// other branches are excluded.
+	a.Storage.Create().
+		SetRepo("pingcap/tidb").SetBranch("release-8.5").SetSuiteName("//pkg/b").SetCaseName("TestB").
+		SetFlaky(true).SetTimecostMs(0).SetReportTime(until.AddDate(0, 0, -1)).
+		SetBuildURL("https://ci.example.com/4").SetReason(reasonNA).
+		SaveX(ctx)
+
+	q := AnalyticsQuery{Repo: "pingcap/tidb", Branch: "master", Window: stats.Window{Until: until}}
+
+	t.Run("top flaky", func(t *testing.T) {
+		got, err := a.TopFlaky(ctx, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []FlakyCase{
+			{Suite: "//pkg/a", Case: "TestA", FlakyCount: 2, FlakyRate: 0.6667},
+			{Suite: "//pkg/b", Case: "TestB", FlakyCount: 1, FlakyRate: 0.3333},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("TopFlaky() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("new flaky", func(t *testing.T) {
+		got, err := a.NewFlaky(ctx, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []FlakyCase{{Suite: "//pkg/b", Case: "TestB", FlakyCount: 1, FlakyRate: 0.3333}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("NewFlaky() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("flaky trend", func(t *testing.T) {
+		trendQuery := q
+		trendQuery.Suite, trendQuery.Case, trendQuery.Days = "//pkg/a", "TestA", 4
+		got, err := a.FlakyTrend(ctx, trendQuery)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []TrendPoint{
+			{Date: "2024-05-11"},
+			{Date: "2024-05-12", Builds: 1, FlakyCount: 1, FlakyRate: 1},
+			{Date: "2024-05-13"},
+			{Date: "2024-05-14", Builds: 2, FlakyCount: 1, FlakyRate: 0.5},
+			{Date: "2024-05-15"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("FlakyTrend() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("slowest", func(t *testing.T) {
+		got, err := a.Slowest(ctx, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []SlowCase{
+			{Suite: "//pkg/b", Case: "TestSlow", Runs: 3, Durations: stats.Durations{P50Ms: 2000, P90Ms: 3000, P99Ms: 3000, MaxMs: 3000}},
+			{Suite: "//pkg/c", Case: "TestFast", Runs: 1, Durations: stats.Durations{P50Ms: 500, P90Ms: 500, P99Ms: 500, MaxMs: 500}},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Slowest() = %v, want %v", got, want)
+		}
+
+		limited := q
+		limited.Limit = 1
+		got, err = a.Slowest(ctx, limited)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 1 || got[0].Case != "TestSlow" {
+			t.Errorf("Slowest() with limit 1 = %v, want TestSlow only", got)
+		}
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		if _, err := a.FlakyTrend(ctx, AnalyticsQuery{Repo: "pingcap/tidb"}); err == nil {
+			t.Errorf("FlakyTrend() without case, want error")
+		}
+		if _, err := a.TopFlaky(ctx, AnalyticsQuery{}); err == nil {
+			t.Errorf("TopFlaky() without repo, want error")
+		}
+	})
+}
@@ -3,35 +3,64 @@ package testcaserun
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/rs/zerolog/log"
 )
 
+// insertBatchSize bounds the rows of an insert statement, the reports may have
+// thousands of cases, which exceed the placeholder limit of a single statement.
+const insertBatchSize = 1000
+
 type Handler struct {
-	Storage *ent.ProblemCaseRunClient
+	Client *ent.Client
+	// Issues files the github issues for the flaky cases, optional.
+	Issues *IssueFiler
 }
 
-func NewHandler(cfg config.Store) (*Handler, error) {
-	dbClient, err := newStoreClient(cfg)
-	if err != nil {
-		return nil, err
+func NewHandler(cfg config.TestCaseRun, db *ent.Client) *Handler {
+	ret := &Handler{
+		Client: db,
+	}
+	if cfg.FlakyIssue != nil {
+		ret.Issues = NewIssueFiler(*cfg.FlakyIssue, db)
 	}
 
-	return &Handler{Storage: dbClient.ProblemCaseRun}, nil
+	return ret
 }
 
 func (h *Handler) SupportEventTypes() []string {
-	return []string{EventTypeTestCaseRunReport}
+	return []string{
+		EventTypeTestCaseRunReport,
+		EventTypeTestCaseRunReportJUnit,
+		EventTypeTestCaseRunReportGoTest,
+	}
 }
 
 // Handle for test case run events
 func (h *Handler) Handle(event cloudevents.Event) cloudevents.Result {
-	caseData := make(map[string]ProblemCasesFromBazel)
-	if err := event.DataAs(&caseData); err != nil {
+	var (
+		results []CaseResult
+		err     error
+	)
+	switch event.Type() {
+	case EventTypeTestCaseRunReportJUnit:
+		results, err = parseJUnitReport(event.Data())
+	case EventTypeTestCaseRunReportGoTest:
+		results, err = parseGoTestReport(event.Data())
+	default:
+		// the bazel reports carry the flaky and long time cases only.
+		caseData := make(map[string]ProblemCasesFromBazel)
+		err = event.DataAs(&caseData)
+		results = newBazelCaseResults(caseData)
+	}
+	if err != nil {
 		return cloudevents.NewReceipt(false, "invalid data: %v", err)
 	}
 
@@ -40,51 +69,87 @@ func (h *Handler) Handle(event cloudevents.Event) cloudevents.Result {
 	branch, _ := types.ToString(event.Extensions()["branch"])
 
 	// Insert records
-	if err := h.addRecords(context.Background(), caseData, repo, branch, buildURL); err != nil {
-		return cloudevents.NewReceipt(true, "insert database records failed: %v", err)
+	reportTime := time.Now()
+	if err := h.addRecords(context.Background(), results, repo, branch, buildURL, reportTime); err != nil {
+		return cloudevents.NewReceipt(false, "insert database records failed: %v", err)
+	}
+
+	// the records are saved, failures of filing issues should not redeliver the event.
+	if h.Issues != nil {
+		if err := h.Issues.File(context.Background(), newFlakyCases(results, repo), buildURL, reportTime); err != nil {
+			log.Err(err).Str("ce-id", event.ID()).Str("repo", repo).Msg("failed to file issues for flaky cases")
+		}
 	}
 
 	return cloudevents.ResultACK
 }
 
-func (h *Handler) addRecords(ctx context.Context, caseData map[string]ProblemCasesFromBazel, repo, branch, buildURL string) error {
-	reportTime := time.Now()
-
-	var recordBuilders []*ent.ProblemCaseRunCreate
+func newBazelCaseResults(caseData map[string]ProblemCasesFromBazel) []CaseResult {
+	var ret []CaseResult
 	for target, caseResults := range caseData {
 		for _, tc := range caseResults.NewFlaky {
+			ret = append(ret, CaseResult{Suite: target, Case: tc.Name, Status: statusFlaky, Reason: tc.Reason})
+		}
+
+		for tc, timecost := range caseResults.LongTime {
+			result := CaseResult{
+				Suite:    target,
+				Case:     tc,
+				Status:   statusPassed,
+				Duration: time.Duration(timecost * float64(time.Second)),
+				Reason:   reasonNA,
+			}
+			if timecost < 0 {
+				result.Status, result.Reason = statusFailed, reasonNotFinished
+			}
+			ret = append(ret, result)
+		}
+	}
+	return ret
+}
+
+func newFlakyCases(results []CaseResult, repo string) []flakyCase {
+	var ret []flakyCase
+	for _, r := range results {
+		if r.Status == statusFlaky {
+			ret = append(ret, flakyCase{Repo: repo, Suite: r.Suite, Case: r.Case})
+		}
+	}
+	return ret
+}
+
+// addRecords inserts the records of the report in batches in one transaction,
+// so a report is either recorded completely or not at all.
+func (h *Handler) addRecords(ctx context.Context, results []CaseResult, repo, branch, buildURL string, reportTime time.Time) error {
+	tx, err := h.Client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(results, insertBatchSize) {
+		recordBuilders := make([]*ent.ProblemCaseRunCreate, 0, len(batch))
+		for _, r := range batch {
 			recordBuilders = append(recordBuilders,
-				h.Storage.Create().
+				tx.ProblemCaseRun.Create().
 					SetRepo(repo).
 					SetBranch(branch).
-					SetSuiteName(target).
-					SetCaseName(tc.Name).
+					SetSuiteName(r.Suite).
+					SetCaseName(r.Case).
 					SetBuildURL(buildURL).
-					SetTimecostMs(0).
+					SetTimecostMs(int(r.Duration.Milliseconds())).
 					SetReportTime(reportTime).
-					SetFlaky(true).
-					SetReason(tc.Reason),
+					SetFlaky(r.Status == statusFlaky).
+					SetStatus(r.Status).
+					SetReason(r.Reason),
 			)
 		}
-
-		for tc, timecost := range caseResults.LongTime {
-			entry := h.Storage.Create().
-				SetRepo(repo).
-				SetBranch(branch).
-				SetSuiteName(target).
-				SetCaseName(tc).
-				SetBuildURL(buildURL).
-				SetTimecostMs(int(timecost * 1000)).
-				SetReportTime(reportTime).
-				SetReason(reasonNA).
-				SetFlaky(false)
-			if timecost < 0 {
-				entry.SetReason(reasonNotFinished)
+		if err := tx.ProblemCaseRun.CreateBulk(recordBuilders...).Exec(ctx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: rolling back: %v", err, rerr)
 			}
-
-			recordBuilders = append(recordBuilders, entry)
+			return err
 		}
 	}
 
-	return h.Storage.CreateBulk(recordBuilders...).Exec(ctx)
+	return tx.Commit()
 }
@@ -0,0 +1,212 @@
+package testcaserun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaseissue"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+const (
+	defaultFlakyIssueLabel      = "flaky-test"
+	defaultFlakyIssueThreshold  = 3
+	defaultFlakyIssueWindowDays = 7
+	maxIssueRuns                = 20
+)
+
+// IssueFiler files a github issue for the case flaky more than the threshold
+// times in the window, and comments on the issue when it is still open rather
+// than filing duplicates.
+type IssueFiler struct {
+	Runs   *ent.ProblemCaseRunClient
+	Issues *ent.ProblemCaseIssueClient
+	GitHub *github.Client
+
+	repo      string
+	labels    []string
+	threshold int
+	window    time.Duration
+
+	mu sync.Mutex // serializes the filing to avoid duplicated issues.
+}
+
+func NewIssueFiler(cfg config.FlakyIssue, db *ent.Client) *IssueFiler {
+	return newIssueFiler(cfg, db, github.NewClient(nil).WithAuthToken(cfg.GitHubToken))
+}
+
+func newIssueFiler(cfg config.FlakyIssue, db *ent.Client, gc *github.Client) *IssueFiler {
+	ret := &IssueFiler{
+		Runs:      db.ProblemCaseRun,
+		Issues:    db.ProblemCaseIssue,
+		GitHub:    gc,
+		repo:      cfg.Repo,
+		labels:    cfg.Labels,
+		threshold: cfg.Threshold,
+		window:    time.Duration(cfg.WindowDays) * 24 * time.Hour,
+	}
+	if len(ret.labels) == 0 {
+		ret.labels = []string{defaultFlakyIssueLabel}
+	}
+	if ret.threshold <= 0 {
+		ret.threshold = defaultFlakyIssueThreshold
+	}
+	if ret.window <= 0 {
+		ret.window = defaultFlakyIssueWindowDays * 24 * time.Hour
+	}
+
+	return ret
+}
+
+// flakyCase identifies a test case of the repo.
+type flakyCase struct {
+	Repo  string
+	Suite string
+	Case  string
+}
+
+// File files or comments on the issues of the flaky cases of the report.
+func (f *IssueFiler) File(ctx context.Context, cases []flakyCase, buildURL string, reportTime time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var errs []error
+	for _, c := range cases {
+		if err := f.fileCase(ctx, c, buildURL, reportTime); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", c.Suite, c.Case, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (f *IssueFiler) fileCase(ctx context.Context, c flakyCase, buildURL string, reportTime time.Time) error {
+	runs, err := f.Runs.Query().
+		Where(
+			problemcaserun.RepoEQ(c.Repo),
+			problemcaserun.SuiteNameEQ(c.Suite),
+			problemcaserun.CaseNameEQ(c.Case),
+			problemcaserun.FlakyEQ(true),
+			problemcaserun.ReportTimeGT(reportTime.Add(-f.window)),
+			problemcaserun.ReportTimeLTE(reportTime),
+		).
+		Order(ent.Desc(problemcaserun.FieldReportTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	if len(runs) < f.threshold {
+		return nil
+	}
+
+	record, err := f.Issues.Query().
+		Where(
+			problemcaseissue.RepoEQ(c.Repo),
+			problemcaseissue.SuiteNameEQ(c.Suite),
+			problemcaseissue.CaseNameEQ(c.Case),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	if record != nil {
+		owner, name, err := splitRepo(record.IssueRepo)
+		if err != nil {
+			return err
+		}
+		issue, _, err := f.GitHub.Issues.Get(ctx, owner, name, record.IssueNumber)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %s#%d: %w", record.IssueRepo, record.IssueNumber, err)
+		}
+		// file a new issue when it flakes again after the issue is closed.
+		if issue.GetState() == "open" {
+			comment := &github.IssueComment{Body: github.Ptr(f.commentBody(runs, buildURL))}
+			if _, _, err := f.GitHub.Issues.CreateComment(ctx, owner, name, record.IssueNumber, comment); err != nil {
+				return fmt.Errorf("failed to comment on issue %s#%d: %w", record.IssueRepo, record.IssueNumber, err)
+			}
+			return nil
+		}
+	}
+
+	issueRepo := f.repo
+	if issueRepo == "" {
+		issueRepo = c.Repo
+	}
+	owner, name, err := splitRepo(issueRepo)
+	if err != nil {
+		return err
+	}
+	issue, _, err := f.GitHub.Issues.Create(ctx, owner, name, &github.IssueRequest{
+		Title:  github.Ptr(fmt.Sprintf("Flaky test: %s in %s", c.Case, c.Suite)),
+		Body:   github.Ptr(f.issueBody(c, runs)),
+		Labels: &f.labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create issue in %s: %w", issueRepo, err)
+	}
+
+	if record != nil {
+		return record.Update().
+			SetIssueRepo(issueRepo).
+			SetIssueNumber(issue.GetNumber()).
+			SetIssueURL(issue.GetHTMLURL()).
+			Exec(ctx)
+	}
+	return f.Issues.Create().
+		SetRepo(c.Repo).
+		SetSuiteName(c.Suite).
+		SetCaseName(c.Case).
+		SetIssueRepo(issueRepo).
+		SetIssueNumber(issue.GetNumber()).
+		SetIssueURL(issue.GetHTMLURL()).
+		Exec(ctx)
+}
+
+func (f *IssueFiler) issueBody(c flakyCase, runs []*ent.ProblemCaseRun) string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "The test case is flaky %d times in the last %d days.\n\n", len(runs), f.windowDays())
+	fmt.Fprintf(b, "- Repo: %s\n- Suite: `%s`\n- Case: `%s`\n\n", c.Repo, c.Suite, c.Case)
+	writeRunsTable(b, runs)
+
+	return b.String()
+}
+
+func (f *IssueFiler) commentBody(runs []*ent.ProblemCaseRun, buildURL string) string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "Flaky again in %s, %d times in the last %d days.\n\n", buildURL, len(runs), f.windowDays())
+	writeRunsTable(b, runs)
+
+	return b.String()
+}
+
+func (f *IssueFiler) windowDays() int {
+	return int(f.window / (24 * time.Hour))
+}
+
+// writeRunsTable writes the latest runs in markdown table.
+func writeRunsTable(b *strings.Builder, runs []*ent.ProblemCaseRun) {
+	b.WriteString("| Time | Branch | Build | Reason |\n| ---- | ------ | ----- | ------ |\n")
+	for _, r := range runs[:min(len(runs), maxIssueRuns)] {
+		fmt.Fprintf(b, "| %s | %s | %s | %s |\n", r.ReportTime.UTC().Format(time.RFC3339), r.Branch, r.BuildURL, r.Reason)
+	}
+	if len(runs) > maxIssueRuns {
+		fmt.Fprintf(b, "\nand %d more runs.\n", len(runs)-maxIssueRuns)
+	}
+}
+
+func splitRepo(fullName string) (string, string, error) {
+	owner, name, ok := strings.Cut(fullName, "/")
+	if !ok || owner == "" || name == "" {
+		return "", "", fmt.Errorf("invalid repo full name: %q", fullName)
+	}
+	return owner, name, nil
+}
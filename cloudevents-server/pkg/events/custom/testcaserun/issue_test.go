@@ -0,0 +1,175 @@
+package testcaserun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-github/v68/github"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+// fakeGitHub serves the issues api of the github.
+type fakeGitHub struct {
+	mu       sync.Mutex
+	issues   map[int]*github.Issue
+	comments map[int][]string
+}
+
+func newFakeGitHub(t *testing.T) (*fakeGitHub, *github.Client) {
+	f := &fakeGitHub{issues: make(map[int]*github.Issue), comments: make(map[int][]string)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/pingcap/tidb/issues", func(w http.ResponseWriter, r *http.Request) {
+		var req github.IssueRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		number := len(f.issues) + 1
+		issue := &github.Issue{
+			Number:  github.Ptr(number),
+			State:   github.Ptr("open"),
+			Title:   req.Title,
+			Body:    req.Body,
+			HTMLURL: github.Ptr(fmt.Sprintf("https://github.com/pingcap/tidb/issues/%d", number)),
+		}
+		for _, l := range req.GetLabels() {
+			issue.Labels = append(issue.Labels, &github.Label{Name: github.Ptr(l)})
+		}
+		f.issues[number] = issue
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(issue)
+	})
+	mux.HandleFunc("GET /repos/pingcap/tidb/issues/{number}", func(w http.ResponseWriter, r *http.Request) {
+		number, _ := strconv.Atoi(r.PathValue("number"))
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		issue, ok := f.issues[number]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(issue)
+	})
+	mux.HandleFunc("POST /repos/pingcap/tidb/issues/{number}/comments", func(w http.ResponseWriter, r *http.Request) {
+		number, _ := strconv.Atoi(r.PathValue("number"))
+		var comment github.IssueComment
+		if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.comments[number] = append(f.comments[number], comment.GetBody())
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(comment)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	gc := github.NewClient(nil)
+	gc.BaseURL, _ = url.Parse(server.URL + "/")
+	return f, gc
+}
+
+func newTestReportEvent(t *testing.T, id, buildURL string, flakyCases ...string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("test")
+	event.SetType(EventTypeTestCaseRunReport)
+	event.SetExtension("repo", "pingcap/tidb")
+	event.SetExtension("branch", "master")
+	event.SetExtension("buildurl", buildURL)
+
+	var data ProblemCasesFromBazel
+	for _, c := range flakyCases {
+		data.NewFlaky = append(data.NewFlaky, flaky{Name: c, Reason: "timeout"})
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, map[string]ProblemCasesFromBazel{"//pkg/executor:executor_test": data}); err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func TestHandler_fileFlakyIssues(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	gh, gc := newFakeGitHub(t)
+
+	h := &Handler{
+		Client: client,
+		Issues: newIssueFiler(config.FlakyIssue{Threshold: 2}, client, gc),
+	}
+	report := func(id string, cases ...string) {
+		t.Helper()
+		if result := h.Handle(newTestReportEvent(t, id, "https://ci.example.com/"+id, cases...)); !cloudevents.IsACK(result) {
+			t.Fatalf("Handle() = %v, want ACK", result)
+		}
+	}
+
+	// below the threshold.
+	report("1", "TestA")
+	if len(gh.issues) != 0 {
+		t.Fatalf("issues = %d, want none below the threshold", len(gh.issues))
+	}
+
+	// crosses the threshold.
+	report("2", "TestA")
+	if len(gh.issues) != 1 {
+		t.Fatalf("issues = %d, want 1", len(gh.issues))
+	}
+	issue := gh.issues[1]
+	if got := issue.Labels[0].GetName(); got != defaultFlakyIssueLabel {
+		t.Errorf("label = %s, want %s", got, defaultFlakyIssueLabel)
+	}
+	for _, want := range []string{"https://ci.example.com/1", "https://ci.example.com/2", "timeout", "TestA"} {
+		if !strings.Contains(issue.GetTitle()+issue.GetBody(), want) {
+			t.Errorf("issue missing %q:\n%s\n%s", want, issue.GetTitle(), issue.GetBody())
+		}
+	}
+	record, err := client.ProblemCaseIssue.Query().Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.IssueNumber != 1 || record.CaseName != "TestA" {
+		t.Errorf("record = %v, want issue 1 of TestA", record)
+	}
+
+	// comments on the open issue instead of filing duplicates.
+	report("3", "TestA")
+	if len(gh.issues) != 1 || len(gh.comments[1]) != 1 {
+		t.Fatalf("issues = %d, comments = %d, want 1 issue with 1 comment", len(gh.issues), len(gh.comments[1]))
+	}
+	if !strings.Contains(gh.comments[1][0], "https://ci.example.com/3") {
+		t.Errorf("comment missing the build url:\n%s", gh.comments[1][0])
+	}
+
+	// files a new issue after the issue is closed.
+	gh.issues[1].State = github.Ptr("closed")
+	report("4", "TestA")
+	if len(gh.issues) != 2 {
+		t.Fatalf("issues = %d, want 2", len(gh.issues))
+	}
+	record, err = client.ProblemCaseIssue.Query().Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.IssueNumber != 2 {
+		t.Errorf("recorded issue = %d, want 2", record.IssueNumber)
+	}
+}
@@ -0,0 +1,249 @@
+package testcaserun
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CaseResult is the result of a test case in the report, the retried runs of
+// the case are merged.
+type CaseResult struct {
+	Suite    string
+	Case     string
+	Status   string
+	Duration time.Duration
+	Reason   string
+}
+
+// caseRun is a single run of a test case.
+type caseRun struct {
+	suite    string
+	name     string
+	status   string
+	duration time.Duration
+	reason   string
+}
+
+// mergeCaseRuns merges the runs of the same case, the case failed and passed
+// in the retries is flaky. The results keep the order of the first runs.
+func mergeCaseRuns(runs []caseRun) []CaseResult {
+	var ret []CaseResult
+	index := make(map[[2]string]int)
+	statuses := make(map[[2]string]map[string]bool)
+	for _, r := range runs {
+		key := [2]string{r.suite, r.name}
+		i, ok := index[key]
+		if !ok {
+			i = len(ret)
+			index[key] = i
+			statuses[key] = make(map[string]bool)
+			ret = append(ret, CaseResult{Suite: r.suite, Case: r.name})
+		}
+		statuses[key][r.status] = true
+		ret[i].Duration = max(ret[i].Duration, r.duration)
+		if r.reason != "" {
+			ret[i].Reason = r.reason
+		}
+	}
+
+	for i := range ret {
+		seen := statuses[[2]string{ret[i].Suite, ret[i].Case}]
+		switch {
+		case seen[statusFailed] && seen[statusPassed]:
+			ret[i].Status = statusFlaky
+		case seen[statusFailed]:
+			ret[i].Status = statusFailed
+		case seen[statusPassed]:
+			ret[i].Status = statusPassed
+		default:
+			ret[i].Status = statusSkipped
+		}
+		if ret[i].Reason == "" {
+			ret[i].Reason = reasonNA
+		}
+		ret[i].Reason = truncateReason(ret[i].Reason)
+	}
+
+	return ret
+}
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name   string           `xml:"name,attr"`
+	Cases  []junitTestCase  `xml:"testcase"`
+	Suites []junitTestSuite `xml:"testsuite"` // nested suites.
+}
+
+type junitTestCase struct {
+	ClassName string `xml:"classname,attr"`
+	Name      string `xml:"name,attr"`
+	Time      string `xml:"time,attr"`
+
+	Failure *junitResult `xml:"failure"`
+	Error   *junitResult `xml:"error"`
+	Skipped *junitResult `xml:"skipped"`
+	// flaky runs reported by the maven surefire reruns.
+	FlakyFailures []junitResult `xml:"flakyFailure"`
+	FlakyErrors   []junitResult `xml:"flakyError"`
+}
+
+type junitResult struct {
+	Type    string `xml:"type,attr"`
+	Message string `xml:"message,attr"`
+}
+
+func (r *junitResult) reason(fallback string) string {
+	if r.Type != "" {
+		return r.Type
+	}
+	return fallback
+}
+
+// parseJUnitReport parses the JUnit XML report, the root element can be
+// `testsuites` or `testsuite`.
+func parseJUnitReport(data []byte) ([]CaseResult, error) {
+	var root junitTestSuites
+	data = bytes.TrimSpace(data)
+	if bytes.Contains(data[:min(len(data), 512)], []byte("<testsuites")) {
+		if err := xml.Unmarshal(data, &root); err != nil {
+			return nil, fmt.Errorf("invalid junit report: %w", err)
+		}
+	} else {
+		var suite junitTestSuite
+		if err := xml.Unmarshal(data, &suite); err != nil {
+			return nil, fmt.Errorf("invalid junit report: %w", err)
+		}
+		root.Suites = []junitTestSuite{suite}
+	}
+
+	var runs []caseRun
+	var walk func(suites []junitTestSuite)
+	walk = func(suites []junitTestSuite) {
+		for _, s := range suites {
+			for _, tc := range s.Cases {
+				runs = append(runs, newJUnitCaseRuns(s.Name, tc)...)
+			}
+			walk(s.Suites)
+		}
+	}
+	walk(root.Suites)
+
+	return mergeCaseRuns(runs), nil
+}
+
+func newJUnitCaseRuns(suiteName string, tc junitTestCase) []caseRun {
+	run := caseRun{suite: suiteName, name: tc.Name, status: statusPassed}
+	if run.suite == "" {
+		run.suite = tc.ClassName
+	}
+	if seconds, err := strconv.ParseFloat(strings.ReplaceAll(tc.Time, ",", ""), 64); err == nil {
+		run.duration = time.Duration(seconds * float64(time.Second))
+	}
+
+	switch {
+	case tc.Failure != nil:
+		run.status, run.reason = statusFailed, tc.Failure.reason("failure")
+	case tc.Error != nil:
+		run.status, run.reason = statusFailed, tc.Error.reason("error")
+	case tc.Skipped != nil:
+		run.status = statusSkipped
+	}
+
+	// the case passed in the reruns after the flaky failures.
+	ret := []caseRun{run}
+	for _, f := range slices.Concat(tc.FlakyFailures, tc.FlakyErrors) {
+		ret = append(ret, caseRun{suite: run.suite, name: run.name, status: statusFailed, reason: f.reason("failure")})
+	}
+	return ret
+}
+
+// goTestEvent is the event of `go test -json` output.
+type goTestEvent struct {
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// parseGoTestReport parses the `go test -json` output, the outputs of the
+// reruns can be concatenated in the report.
+func parseGoTestReport(data []byte) ([]CaseResult, error) {
+	var runs []caseRun
+	reasons := make(map[[2]string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 || text[0] != '{' {
+			continue // such as the build outputs.
+		}
+		var e goTestEvent
+		if err := json.Unmarshal(text, &e); err != nil {
+			return nil, fmt.Errorf("invalid go test json at line %d: %w", line, err)
+		}
+		if e.Test == "" {
+			continue
+		}
+
+		key := [2]string{e.Package, e.Test}
+		switch e.Action {
+		case "output":
+			if reason := goTestFailureReason(e.Output); reason != "" {
+				reasons[key] = reason
+			}
+		case "pass", "fail", "skip":
+			run := caseRun{
+				suite:    e.Package,
+				name:     e.Test,
+				status:   map[string]string{"pass": statusPassed, "fail": statusFailed, "skip": statusSkipped}[e.Action],
+				duration: time.Duration(e.Elapsed * float64(time.Second)),
+			}
+			if run.status == statusFailed {
+				run.reason = reasons[key]
+				if run.reason == "" {
+					run.reason = "fail"
+				}
+			}
+			delete(reasons, key)
+			runs = append(runs, run)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid go test json: %w", err)
+	}
+
+	return mergeCaseRuns(runs), nil
+}
+
+func goTestFailureReason(output string) string {
+	switch {
+	case strings.HasPrefix(output, "panic: test timed out"):
+		return "timeout"
+	case strings.HasPrefix(output, "panic:"):
+		return "panic"
+	case strings.Contains(output, "WARNING: DATA RACE"):
+		return "data race"
+	default:
+		return ""
+	}
+}
+
+func truncateReason(reason string) string {
+	const maxLen = 32 // max length of the reason field.
+	if len(reason) <= maxLen {
+		return reason
+	}
+	return strings.ToValidUTF8(reason[:maxLen], "")
+}
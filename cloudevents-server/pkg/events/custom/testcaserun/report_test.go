@@ -0,0 +1,160 @@
+package testcaserun
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/problemcaserun"
+)
+
+const testJUnitReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="github.com/tikv/pd/server" tests="5">
+    <testcase classname="github.com/tikv/pd/server" name="TestPass" time="1.5"></testcase>
+    <testcase classname="github.com/tikv/pd/server" name="TestFail" time="2">
+      <failure type="AssertionError" message="not equal"></failure>
+    </testcase>
+    <testcase classname="github.com/tikv/pd/server" name="TestSkip" time="0">
+      <skipped message="short mode"></skipped>
+    </testcase>
+    <testcase classname="github.com/tikv/pd/server" name="TestRetried" time="3">
+      <error message="boom"></error>
+    </testcase>
+    <testcase classname="github.com/tikv/pd/server" name="TestRetried" time="4"></testcase>
+  </testsuite>
+  <testsuite name="org.tikv.SurefireTest">
+    <testcase classname="org.tikv.SurefireTest" name="testRerun" time="0.5">
+      <flakyFailure type="java.net.SocketTimeoutException" message="timeout"></flakyFailure>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+const testGoTestReport = `{"Action":"run","Package":"github.com/pingcap/tiflow/cdc","Test":"TestPass"}
+{"Action":"pass","Package":"github.com/pingcap/tiflow/cdc","Test":"TestPass","Elapsed":1.5}
+{"Action":"run","Package":"github.com/pingcap/tiflow/cdc","Test":"TestRetried"}
+{"Action":"output","Package":"github.com/pingcap/tiflow/cdc","Test":"TestRetried","Output":"panic: runtime error\n"}
+{"Action":"fail","Package":"github.com/pingcap/tiflow/cdc","Test":"TestRetried","Elapsed":2}
+{"Action":"skip","Package":"github.com/pingcap/tiflow/cdc","Test":"TestSkip","Elapsed":0}
+{"Action":"fail","Package":"github.com/pingcap/tiflow/cdc","Test":"TestFail","Elapsed":0.25}
+{"Action":"fail","Package":"github.com/pingcap/tiflow/cdc","Elapsed":4}
+FAIL github.com/pingcap/tiflow/cdc
+{"Action":"run","Package":"github.com/pingcap/tiflow/cdc","Test":"TestRetried"}
+{"Action":"pass","Package":"github.com/pingcap/tiflow/cdc","Test":"TestRetried","Elapsed":1}
+`
+
+func TestParseReports(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func([]byte) ([]CaseResult, error)
+		data  string
+		want  []CaseResult
+	}{
+		{
+			name:  "junit",
+			parse: parseJUnitReport,
+			data:  testJUnitReport,
+			want: []CaseResult{
+				{Suite: "github.com/tikv/pd/server", Case: "TestPass", Status: statusPassed, Duration: 1500 * time.Millisecond, Reason: reasonNA},
+				{Suite: "github.com/tikv/pd/server", Case: "TestFail", Status: statusFailed, Duration: 2 * time.Second, Reason: "AssertionError"},
+				{Suite: "github.com/tikv/pd/server", Case: "TestSkip", Status: statusSkipped, Reason: reasonNA},
+				{Suite: "github.com/tikv/pd/server", Case: "TestRetried", Status: statusFlaky, Duration: 4 * time.Second, Reason: "error"},
+				{Suite: "org.tikv.SurefireTest", Case: "testRerun", Status: statusFlaky, Duration: 500 * time.Millisecond, Reason: "java.net.SocketTimeoutException"},
+			},
+		},
+		{
+			name:  "junit single testsuite",
+			parse: parseJUnitReport,
+			data:  `<testsuite name="s"><testcase name="TestA" time="1"/></testsuite>`,
+			want:  []CaseResult{{Suite: "s", Case: "TestA", Status: statusPassed, Duration: time.Second, Reason: reasonNA}},
+		},
+		{
+			name:  "go test json",
+			parse: parseGoTestReport,
+			data:  testGoTestReport,
+			want: []CaseResult{
+				{Suite: "github.com/pingcap/tiflow/cdc", Case: "TestPass", Status: statusPassed, Duration: 1500 * time.Millisecond, Reason: reasonNA},
+				{Suite: "github.com/pingcap/tiflow/cdc", Case: "TestRetried", Status: statusFlaky, Duration: 2 * time.Second, Reason: "panic"},
+				{Suite: "github.com/pingcap/tiflow/cdc", Case: "TestSkip", Status: statusSkipped, Reason: reasonNA},
+				{Suite: "github.com/pingcap/tiflow/cdc", Case: "TestFail", Status: statusFailed, Duration: 250 * time.Millisecond, Reason: "fail"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("parse error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandler_Handle_reports(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	h := &Handler{Client: client}
+
+	for _, tc := range []struct {
+		eventType   string
+		contentType string
+		data        string
+	}{
+		{EventTypeTestCaseRunReportJUnit, "application/xml", testJUnitReport},
+		{EventTypeTestCaseRunReportGoTest, "application/x-ndjson", testGoTestReport},
+	} {
+		event := cloudevents.NewEvent()
+		event.SetID(tc.eventType)
+		event.SetSource("test")
+		event.SetType(tc.eventType)
+		event.SetExtension("repo", "tikv/pd")
+		event.SetExtension("branch", "master")
+		if err := event.SetData(tc.contentType, []byte(tc.data)); err != nil {
+			t.Fatal(err)
+		}
+		if result := h.Handle(event); !cloudevents.IsACK(result) {
+			t.Fatalf("Handle(%s) = %v, want ACK", tc.eventType, result)
+		}
+	}
+
+	// all the cases are recorded with their status.
+	ctx := context.Background()
+	counts := map[string]int{}
+	for _, r := range client.ProblemCaseRun.Query().Order(problemcaserun.ByID()).AllX(ctx) {
+		counts[r.Status]++
+		if r.Flaky != (r.Status == statusFlaky) {
+			t.Errorf("record %s flaky = %v, status = %s", r.CaseName, r.Flaky, r.Status)
+		}
+	}
+	want := map[string]int{statusPassed: 2, statusFailed: 2, statusFlaky: 3, statusSkipped: 2}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("recorded statuses = %v, want %v", counts, want)
+	}
+}
+
+func TestHandler_addRecords_batches(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	h := &Handler{Client: client}
+	ctx := context.Background()
+
+	results := make([]CaseResult, 2*insertBatchSize+1)
+	for i := range results {
+		results[i] = CaseResult{Suite: "pkg", Case: fmt.Sprintf("TestCase%d", i), Status: statusPassed}
+	}
+	if err := h.addRecords(ctx, results, "tikv/pd", "master", "https://ci.example.com/1", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if n := client.ProblemCaseRun.Query().CountX(ctx); n != len(results) {
+		t.Errorf("recorded cases = %d, want %d", n, len(results))
+	}
+}
@@ -2,6 +2,15 @@ package testcaserun
 
 const (
 	EventTypeTestCaseRunReport = "test-case-run-report"
+	// EventTypeTestCaseRunReportJUnit is the report with JUnit XML data.
+	EventTypeTestCaseRunReportJUnit = "test-case-run-report-junit"
+	// EventTypeTestCaseRunReportGoTest is the report with `go test -json` output data.
+	EventTypeTestCaseRunReportGoTest = "test-case-run-report-gotest"
+
+	statusPassed  = "passed"
+	statusFailed  = "failed"
+	statusSkipped = "skipped"
+	statusFlaky   = "flaky"
 
 	reasonNotFinished = "not_finished"
 	reasonUnknown     = "unknown"
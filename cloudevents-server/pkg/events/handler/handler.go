@@ -1,11 +1,16 @@
 package handler
 
 import (
+	"slices"
+
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
 
+// EventTypeAny is the event type to register handlers for all events.
+const EventTypeAny = "*"
+
 // CompositeEventHandler is a public struct that composes multiple event handlers.
 type CompositeEventHandler struct {
 	handleMap map[string][]EventHandler
@@ -23,8 +28,12 @@ func (h *CompositeEventHandler) SupportEventTypes() []string {
 
 // Handle handles the given event.
 func (h *CompositeEventHandler) Handle(event cloudevents.Event) cloudevents.Result {
-	handlers, ok := h.handleMap[event.Type()]
-	if !ok {
+	handlers := h.handleMap[event.Type()]
+	if unversioned, ok := unversionedCDEventType(event.Type()); ok {
+		handlers = slices.Concat(handlers, h.handleMap[unversioned])
+	}
+	handlers = slices.Concat(handlers, h.handleMap[EventTypeAny])
+	if len(handlers) == 0 {
 		log.Warn().Str("ce-type", event.Type()).Msg("no handlers registered for the event")
 		return cloudevents.NewReceipt(false, "no handlers registered for the event")
 	}
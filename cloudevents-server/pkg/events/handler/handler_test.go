@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+type typesHandler struct {
+	types   []string
+	handled int
+}
+
+func (h *typesHandler) SupportEventTypes() []string { return h.types }
+
+func (h *typesHandler) Handle(cloudevents.Event) cloudevents.Result {
+	h.handled++
+	return cloudevents.ResultACK
+}
+
+func TestCompositeEventHandler_Handle(t *testing.T) {
+	versioned := &typesHandler{types: []string{"dev.cdevents.pipelinerun.finished.0.1.0"}}
+	unversioned := &typesHandler{types: []string{"dev.cdevents.pipelinerun.finished"}}
+	any := &typesHandler{types: []string{EventTypeAny}}
+	h := new(CompositeEventHandler).AddHandlers(versioned, unversioned, any)
+
+	for _, eventType := range []string{
+		"dev.cdevents.pipelinerun.finished.0.1.0",
+		"dev.cdevents.pipelinerun.finished.0.2.0",
+		"dev.cdevents.pipelinerun.started.0.2.0",
+	} {
+		event := cloudevents.NewEvent()
+		event.SetType(eventType)
+		if got := h.Handle(event); !cloudevents.IsACK(got) {
+			t.Fatalf("Handle(%s) = %v", eventType, got)
+		}
+	}
+
+	if versioned.handled != 1 || unversioned.handled != 2 || any.handled != 3 {
+		t.Errorf("handled events: versioned = %d, unversioned = %d, any = %d, want 1, 2, 3",
+			versioned.handled, unversioned.handled, any.handled)
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"strings"
 
+	"github.com/cdevents/sdk-go/pkg/api"
 	"github.com/rs/zerolog/log"
 )
 
@@ -13,10 +14,32 @@ func (eb *EventProducer) resolveTopic(eventType string) (string, bool) {
 		return topic, false
 	}
 
-	if strings.HasPrefix(eventType, jenkinsCloudEventTypePrefix) {
+	if strings.HasPrefix(eventType, jenkinsCloudEventTypePrefix) && !eb.forwardCDEvent(eventType) {
 		return "", true
 	}
 
 	log.Debug().Str("event-type", eventType).Msg("No topic found for event type, using default topic")
 	return eb.unknowEventTopic, false
 }
+
+func (eb *EventProducer) forwardCDEvent(eventType string) bool {
+	if eb.cdeventTypes[eventType] {
+		return true
+	}
+	unversioned, ok := unversionedCDEventType(eventType)
+	return ok && eb.cdeventTypes[unversioned]
+}
+
+// unversionedCDEventType returns the CDEvents type without the version, such as
+// "dev.cdevents.pipelinerun.finished" of "dev.cdevents.pipelinerun.finished.0.2.0",
+// ok is false for the other events.
+func unversionedCDEventType(eventType string) (string, bool) {
+	if !strings.HasPrefix(eventType, jenkinsCloudEventTypePrefix) {
+		return "", false
+	}
+	typ, err := api.ParseType(eventType)
+	if err != nil {
+		return "", false
+	}
+	return typ.UnversionedString(), true
+}
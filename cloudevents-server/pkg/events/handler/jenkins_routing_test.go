@@ -72,3 +72,52 @@ func TestHandleCloudEventIgnoresUnsupportedJenkinsCDEvent(t *testing.T) {
 		t.Fatalf("HandleCloudEvent() result = %v, want ACK", result)
 	}
 }
+
+func TestResolveTopicForwardsCDEvents(t *testing.T) {
+	producer := (&EventProducer{
+		unknowEventTopic: "cloudevents-default-channel",
+		topicMapping: map[string]string{
+			"dev.cdevents.pipelinerun.finished.0.1.0": "jenkins-event",
+		},
+	}).ForwardCDEvents("dev.cdevents.taskrun.finished.0.1.0", "dev.cdevents.pipelinerun.started")
+
+	tests := []struct {
+		name       string
+		eventType  string
+		wantTopic  string
+		wantIgnore bool
+	}{
+		{
+			name:      "mapped event keeps the mapped topic",
+			eventType: "dev.cdevents.pipelinerun.finished.0.1.0",
+			wantTopic: "jenkins-event",
+		},
+		{
+			name:      "forwarded event falls back to default topic",
+			eventType: "dev.cdevents.taskrun.finished.0.1.0",
+			wantTopic: "cloudevents-default-channel",
+		},
+		{
+			name:      "event of an unversioned type is forwarded in all the versions",
+			eventType: "dev.cdevents.pipelinerun.started.0.3.0",
+			wantTopic: "cloudevents-default-channel",
+		},
+		{
+			name:       "not forwarded event is still ignored",
+			eventType:  "dev.cdevents.artifact.published.0.1.0",
+			wantIgnore: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTopic, gotIgnore := producer.resolveTopic(tt.eventType)
+			if gotTopic != tt.wantTopic {
+				t.Fatalf("resolveTopic() topic = %q, want %q", gotTopic, tt.wantTopic)
+			}
+			if gotIgnore != tt.wantIgnore {
+				t.Fatalf("resolveTopic() ignore = %v, want %v", gotIgnore, tt.wantIgnore)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/rs/zerolog/log"
 	kafka "github.com/segmentio/kafka-go"
 
@@ -13,7 +15,8 @@ import (
 	skakfa "github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/kafka"
 )
 
-func NewEventProducer(cfg config.Kafka) (*EventProducer, error) {
+// NewEventProducer creates the producer, the archive is optional.
+func NewEventProducer(cfg config.Kafka, archive EventArchiver) (*EventProducer, error) {
 	writer, err := skakfa.NewWriter(cfg.Authentication, cfg.Brokers, "", cfg.ClientID)
 	if err != nil {
 		return nil, err
@@ -23,6 +26,7 @@ func NewEventProducer(cfg config.Kafka) (*EventProducer, error) {
 		writer:           writer,
 		unknowEventTopic: cfg.Producer.DefaultTopic,
 		topicMapping:     cfg.Producer.TopicMapping,
+		archive:          archive,
 	}, nil
 }
 
@@ -67,20 +71,51 @@ type EventProducer struct {
 	writer           *kafka.Writer
 	unknowEventTopic string
 	topicMapping     map[string]string // Map event type to Kafka topic
+	archive          EventArchiver     // optional
+	cdeventTypes     map[string]bool   // unmapped CDEvents types sent to the default topic.
+}
+
+// ForwardCDEvents sends the unmapped CDEvents of the types to the default topic
+// instead of ignoring them, it is used when a handler of the types is enabled.
+// The types without the version match the events of all the versions.
+func (eb *EventProducer) ForwardCDEvents(eventTypes ...string) *EventProducer {
+	if eb.cdeventTypes == nil {
+		eb.cdeventTypes = make(map[string]bool)
+	}
+	for _, t := range eventTypes {
+		eb.cdeventTypes[t] = true
+	}
+	return eb
 }
 
 func (eb *EventProducer) HandleCloudEvent(ctx context.Context, event cloudevents.Event) cloudevents.Result {
 	eventType := event.Type()
 	topic, ignore := eb.resolveTopic(eventType)
+	eb.archiveEvent(ctx, event, topic)
 	if ignore {
 		log.Debug().Str("event-type", eventType).Str("ce-id", event.ID()).Msg("Ignoring unsupported Jenkins CDEvent type")
 		return cloudevents.ResultACK
 	}
 
+	if err := eb.Publish(ctx, topic, event); err != nil {
+		return err
+	}
+
+	return cloudevents.ResultACK
+}
+
+// ResolveTopic returns the topic of the event type, ignore is true when the
+// event should not be published.
+func (eb *EventProducer) ResolveTopic(eventType string) (topic string, ignore bool) {
+	return eb.resolveTopic(eventType)
+}
+
+// Publish writes the event to the topic.
+func (eb *EventProducer) Publish(ctx context.Context, topic string, event cloudevents.Event) error {
 	cloudEventBytes, err := event.MarshalJSON()
 	if err != nil {
 		log.Err(err).Msg("error marshalling Cloud Event")
-		return cloudevents.ResultNACK
+		return err
 	}
 
 	message := kafka.Message{
@@ -100,7 +135,19 @@ func (eb *EventProducer) HandleCloudEvent(ctx context.Context, event cloudevents
 	log.Debug().Str("topic", topic).Str("ce-id", event.ID()).
 		Dur("duration", time.Since(startTime)).
 		Msg("message written to Kafka")
-	return cloudevents.ResultACK
+	return nil
+}
+
+// archiveEvent archives the event when the archive is enabled, failures are
+// only logged and do not fail the ingestion.
+func (eb *EventProducer) archiveEvent(ctx context.Context, event cloudevents.Event, topic string) {
+	if eb.archive == nil {
+		return
+	}
+
+	if err := eb.archive.Save(ctx, event, topic); err != nil {
+		log.Err(err).Str("ce-id", event.ID()).Str("ce-type", event.Type()).Msg("error archiving event")
+	}
 }
 
 type EventConsumerGroup map[string]*EventConsumer
@@ -160,14 +207,33 @@ func (ec *EventConsumer) Start(ctx context.Context, wg *sync.WaitGroup) {
 
 				result := ec.handler.Handle(event)
 				if !cloudevents.IsACK(result) {
-					log.Error().Err(err).Msg("error handling event")
-					ec.writer.WriteMessages(ctx, kafka.Message{Topic: ec.faultTopic, Key: msg.Key, Value: msg.Value})
+					log.Error().Err(result).Msg("error handling event")
+					ec.writer.WriteMessages(ctx, kafka.Message{Topic: ec.faultTopic, Key: msg.Key, Value: msg.Value, Headers: deadLetterHeaders(result)})
 				}
 			}
 		}
 	}()
 }
 
+// Headers of the dead letter messages.
+const (
+	DeadLetterHeaderError      = "ce-error"
+	DeadLetterHeaderHTTPStatus = "ce-http-status"
+)
+
+// deadLetterHeaders records the failure in the dead letter message, including
+// the last http status when the event was sent to a http sink.
+func deadLetterHeaders(result cloudevents.Result) []kafka.Header {
+	ret := []kafka.Header{{Key: DeadLetterHeaderError, Value: []byte(result.Error())}}
+
+	var httpResult *cehttp.Result
+	if cloudevents.ResultAs(result, &httpResult) {
+		ret = append(ret, kafka.Header{Key: DeadLetterHeaderHTTPStatus, Value: []byte(strconv.Itoa(httpResult.StatusCode))})
+	}
+
+	return ret
+}
+
 func (ec *EventConsumer) Close() {
 	if ec.reader != nil {
 		ec.reader.Close()
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	pkgerrors "github.com/pkg/errors"
+)
+
+func TestDeadLetterHeaders(t *testing.T) {
+	webhookErr := cehttp.NewResult(http.StatusBadRequest, "webhook responded %s", "400 Bad Request")
+	tests := []struct {
+		name       string
+		result     cloudevents.Result
+		wantStatus string
+	}{
+		{name: "http result", result: webhookErr, wantStatus: "400"},
+		{
+			name:       "wrapped by composite handlers",
+			result:     pkgerrors.Wrap(cloudevents.NewReceipt(false, "%w", errors.Join(errors.New("sink a"), webhookErr)), "another handler failed"),
+			wantStatus: "400",
+		},
+		{name: "other result", result: cloudevents.NewReceipt(false, "no handlers registered for the event")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := make(map[string]string)
+			for _, h := range deadLetterHeaders(tt.result) {
+				headers[h.Key] = string(h.Value)
+			}
+			if headers[DeadLetterHeaderError] != tt.result.Error() {
+				t.Errorf("error header = %q, want %q", headers[DeadLetterHeaderError], tt.result.Error())
+			}
+			if headers[DeadLetterHeaderHTTPStatus] != tt.wantStatus {
+				t.Errorf("http status header = %q, want %q", headers[DeadLetterHeaderHTTPStatus], tt.wantStatus)
+			}
+		})
+	}
+}
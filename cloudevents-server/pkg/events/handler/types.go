@@ -1,6 +1,10 @@
 package handler
 
-import cloudevents "github.com/cloudevents/sdk-go/v2"
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
 
 // EventHandler is an interface that defines the Handle method.
 type EventHandler interface {
@@ -19,6 +23,13 @@ type EventHandler interface {
 	// * func(context.Context, event.Event) (*event.Event, protocol.Result)
 	// Handle handles the given event.
 	Handle(event cloudevents.Event) cloudevents.Result
-	// SupportEventTypes returns a list of supported event types.
+	// SupportEventTypes returns a list of supported event types, the CDEvents
+	// types without the version match the events of all the versions.
 	SupportEventTypes() []string
 }
+
+// EventArchiver archives the received events.
+type EventArchiver interface {
+	// Save archives the event with the resolved topic, the topic is empty when the event is ignored.
+	Save(ctx context.Context, event cloudevents.Event, topic string) error
+}
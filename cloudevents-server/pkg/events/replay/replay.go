@@ -0,0 +1,198 @@
+// Package replay implement replaying of the archived or dead letter events.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/time/rate"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+)
+
+// Sources of the replayed events.
+const (
+	SourceArchive = "archive"
+	SourceDLQ     = "dlq"
+)
+
+// Targets of the replayed events.
+const (
+	// TargetHandler handles the events directly with the registered handlers.
+	TargetHandler = "handler"
+	// TargetTopic publishes the events back onto the kafka topics.
+	TargetTopic = "topic"
+)
+
+// Status of the replayed events.
+const (
+	StatusReplayed    = "replayed"
+	StatusWouldReplay = "would_replay"
+	StatusSkipped     = "skipped"
+	StatusNotFound    = "not_found"
+	StatusFailed      = "failed"
+)
+
+const (
+	defaultRate = 5
+	maxEvents   = 1000
+)
+
+// ErrInvalidRequest is returned when the replay request is invalid.
+var ErrInvalidRequest = errors.New("invalid replay request")
+
+// Request to replay the events.
+type Request struct {
+	Source string   `yaml:"source,omitempty" json:"source,omitempty"`
+	Target string   `yaml:"target,omitempty" json:"target,omitempty"`
+	IDs    []string `yaml:"ids,omitempty" json:"ids,omitempty"`
+	DryRun bool     `yaml:"dry_run,omitempty" json:"dry_run,omitempty"`
+	// Rate limits the replayed events per second, default is 5.
+	Rate float64 `yaml:"rate,omitempty" json:"rate,omitempty"`
+}
+
+// Result of a replayed event.
+type Result struct {
+	ID     string `json:"id"`
+	Type   string `json:"type,omitempty"`
+	Topic  string `json:"topic,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Source loads the events to replay.
+type Source interface {
+	// Fetch returns the events with the ids, the missing ones are absent in the
+	// returned map.
+	Fetch(ctx context.Context, ids []string) (map[string]Item, error)
+}
+
+// Item is an event to replay.
+type Item struct {
+	Event cloudevents.Event
+	// Topic is the original topic of the event, empty if unknown.
+	Topic string
+	// Err is set when the event is found but can not be replayed.
+	Err error
+}
+
+// Publisher publishes the events onto the kafka topics.
+type Publisher interface {
+	ResolveTopic(eventType string) (topic string, ignore bool)
+	Publish(ctx context.Context, topic string, event cloudevents.Event) error
+}
+
+// Replayer replays the events from the sources to the handler or the topics.
+type Replayer struct {
+	sources   map[string]Source
+	handler   handler.EventHandler
+	publisher Publisher
+}
+
+func NewReplayer(hd handler.EventHandler, publisher Publisher) *Replayer {
+	return &Replayer{sources: make(map[string]Source), handler: hd, publisher: publisher}
+}
+
+// AddSource registers the source with the name.
+func (r *Replayer) AddSource(name string, source Source) *Replayer {
+	r.sources[name] = source
+	return r
+}
+
+// Replay replays the requested events in order, it stops when the context is
+// done.
+func (r *Replayer) Replay(ctx context.Context, req Request) ([]Result, error) {
+	source, ok := r.sources[req.Source]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported source: %q", ErrInvalidRequest, req.Source)
+	}
+	switch {
+	case req.Target != TargetHandler && req.Target != TargetTopic:
+		return nil, fmt.Errorf("%w: unsupported target: %q", ErrInvalidRequest, req.Target)
+	case req.Target == TargetHandler && r.handler == nil:
+		return nil, fmt.Errorf("%w: no handlers to replay to", ErrInvalidRequest)
+	case req.Target == TargetTopic && r.publisher == nil:
+		return nil, fmt.Errorf("%w: no publisher to replay to", ErrInvalidRequest)
+	case len(req.IDs) == 0:
+		return nil, fmt.Errorf("%w: no event ids", ErrInvalidRequest)
+	case len(req.IDs) > maxEvents:
+		return nil, fmt.Errorf("%w: too many event ids, at most %d", ErrInvalidRequest, maxEvents)
+	}
+
+	items, err := source.Fetch(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsPerSecond := req.Rate
+	if eventsPerSecond <= 0 {
+		eventsPerSecond = defaultRate
+	}
+	limiter := rate.NewLimiter(rate.Limit(eventsPerSecond), 1)
+
+	results := make([]Result, 0, len(req.IDs))
+	for _, id := range req.IDs {
+		item, ok := items[id]
+		if !ok {
+			results = append(results, Result{ID: id, Status: StatusNotFound})
+			continue
+		}
+		if item.Err != nil {
+			results = append(results, Result{ID: id, Status: StatusFailed, Error: item.Err.Error()})
+			continue
+		}
+
+		if !req.DryRun {
+			if err := limiter.Wait(ctx); err != nil {
+				return results, err
+			}
+		}
+		result := r.replay(ctx, req, item)
+		result.ID = id
+		log.Info().Str("ce-id", id).Str("target", req.Target).Str("status", result.Status).Str("error", result.Error).Msg("replayed event")
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *Replayer) replay(ctx context.Context, req Request, item Item) Result {
+	ret := Result{Type: item.Event.Type(), Status: StatusReplayed}
+	if req.DryRun {
+		ret.Status = StatusWouldReplay
+	}
+
+	switch req.Target {
+	case TargetHandler:
+		if req.DryRun {
+			return ret
+		}
+		if result := r.handler.Handle(item.Event); !cloudevents.IsACK(result) {
+			ret.Status = StatusFailed
+			ret.Error = result.Error()
+		}
+	case TargetTopic:
+		ret.Topic = item.Topic
+		if ret.Topic == "" {
+			topic, ignore := r.publisher.ResolveTopic(item.Event.Type())
+			if ignore {
+				ret.Status = StatusSkipped
+				ret.Error = "no topic for the event type"
+				return ret
+			}
+			ret.Topic = topic
+		}
+		if req.DryRun {
+			return ret
+		}
+		if err := r.publisher.Publish(ctx, ret.Topic, item.Event); err != nil {
+			ret.Status = StatusFailed
+			ret.Error = err.Error()
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,148 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+type fakeSource map[string]Item
+
+func (s fakeSource) Fetch(_ context.Context, ids []string) (map[string]Item, error) {
+	ret := make(map[string]Item)
+	for _, id := range ids {
+		if item, ok := s[id]; ok {
+			ret[id] = item
+		}
+	}
+	return ret, nil
+}
+
+type fakeHandler struct {
+	handled []string
+}
+
+func (h *fakeHandler) SupportEventTypes() []string { return nil }
+
+func (h *fakeHandler) Handle(event cloudevents.Event) cloudevents.Result {
+	h.handled = append(h.handled, event.ID())
+	if event.Type() == "bad" {
+		return cloudevents.NewReceipt(false, "bad event")
+	}
+	return cloudevents.ResultACK
+}
+
+type fakePublisher struct {
+	published []string
+}
+
+func (p *fakePublisher) ResolveTopic(eventType string) (string, bool) {
+	if eventType == "dev.cdevents.unknown" {
+		return "", true
+	}
+	return "default-topic", false
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, event cloudevents.Event) error {
+	p.published = append(p.published, topic+"/"+event.ID())
+	return nil
+}
+
+type failingSource struct{}
+
+func (failingSource) Fetch(context.Context, []string) (map[string]Item, error) {
+	return nil, context.DeadlineExceeded
+}
+
+func newEvent(id, typ string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("test")
+	event.SetType(typ)
+	return event
+}
+
+func TestReplayer_Replay(t *testing.T) {
+	source := fakeSource{
+		"1": {Event: newEvent("1", "dev.tekton.event.pipelinerun.failed.v1"), Topic: "tekton-topic"},
+		"2": {Event: newEvent("2", "bad")},
+		"3": {Event: newEvent("3", "dev.cdevents.unknown")},
+		"4": {Err: errors.New("the archived payload is truncated")},
+	}
+
+	tests := []struct {
+		name          string
+		req           Request
+		wantStatus    []string
+		wantHandled   []string
+		wantPublished []string
+		wantErr       error
+	}{
+		{
+			name:        "to handler",
+			req:         Request{Source: SourceArchive, Target: TargetHandler, IDs: []string{"1", "2", "4", "5"}, Rate: 1000},
+			wantStatus:  []string{StatusReplayed, StatusFailed, StatusFailed, StatusNotFound},
+			wantHandled: []string{"1", "2"},
+		},
+		{
+			name:          "to topic",
+			req:           Request{Source: SourceArchive, Target: TargetTopic, IDs: []string{"1", "2", "3"}, Rate: 1000},
+			wantStatus:    []string{StatusReplayed, StatusReplayed, StatusSkipped},
+			wantPublished: []string{"tekton-topic/1", "default-topic/2"},
+		},
+		{
+			name:       "dry run",
+			req:        Request{Source: SourceArchive, Target: TargetTopic, IDs: []string{"1", "2"}, DryRun: true},
+			wantStatus: []string{StatusWouldReplay, StatusWouldReplay},
+		},
+		{
+			name:    "unknown source",
+			req:     Request{Source: SourceDLQ, Target: TargetHandler, IDs: []string{"1"}},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "unknown target",
+			req:     Request{Source: SourceArchive, Target: "lark", IDs: []string{"1"}},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "no ids",
+			req:     Request{Source: SourceArchive, Target: TargetHandler},
+			wantErr: ErrInvalidRequest,
+		},
+		{
+			name:    "fetch failed",
+			req:     Request{Source: "broken", Target: TargetHandler, IDs: []string{"1"}},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hd := &fakeHandler{}
+			publisher := &fakePublisher{}
+			r := NewReplayer(hd, publisher).AddSource(SourceArchive, source).AddSource("broken", failingSource{})
+
+			results, err := r.Replay(context.Background(), tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Replay() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var gotStatus []string
+			for _, r := range results {
+				gotStatus = append(gotStatus, r.Status)
+			}
+			if !reflect.DeepEqual(gotStatus, tt.wantStatus) {
+				t.Errorf("Replay() status = %v, want %v", gotStatus, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(hd.handled, tt.wantHandled) {
+				t.Errorf("Replay() handled = %v, want %v", hd.handled, tt.wantHandled)
+			}
+			if !reflect.DeepEqual(publisher.published, tt.wantPublished) {
+				t.Errorf("Replay() published = %v, want %v", publisher.published, tt.wantPublished)
+			}
+		})
+	}
+}
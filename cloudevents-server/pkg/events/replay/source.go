@@ -0,0 +1,125 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/rs/zerolog/log"
+	kafka "github.com/segmentio/kafka-go"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/archive"
+	skakfa "github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/kafka"
+)
+
+// ArchiveSource loads the events from the event archive.
+type ArchiveSource struct {
+	Archive *archive.Archive
+}
+
+func (s *ArchiveSource) Fetch(ctx context.Context, ids []string) (map[string]Item, error) {
+	ret := make(map[string]Item)
+	for _, id := range ids {
+		record, err := s.Archive.Get(ctx, id, "")
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := archive.Event(record)
+		ret[id] = Item{Event: event, Topic: record.Topic, Err: err}
+	}
+
+	return ret, nil
+}
+
+// defaultScanTimeout bounds the scan of the dead letter topic, the end offset
+// may never be read, such as the one of a transaction marker.
+const defaultScanTimeout = 2 * time.Minute
+
+// DLQSource loads the events by scanning the dead letter topic, the message key
+// is the event id.
+type DLQSource struct {
+	Kafka config.Kafka
+	// ScanTimeout bounds the scan of the topic, default is 2 minutes.
+	ScanTimeout time.Duration
+}
+
+func (s *DLQSource) Fetch(ctx context.Context, ids []string) (map[string]Item, error) {
+	timeout := s.ScanTimeout
+	if timeout <= 0 {
+		timeout = defaultScanTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	readers, err := skakfa.NewPartitionReaders(ctx, s.Kafka.Authentication, s.Kafka.Brokers, s.Kafka.Consumer.DeadLetterTopic, s.Kafka.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the dead letter topic: %w", err)
+	}
+
+	var (
+		mu       sync.Mutex
+		ret      = make(map[string]Item)
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for _, reader := range readers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer reader.Close()
+
+			err := scanPartition(ctx, reader, func(msg kafka.Message) {
+				id := string(msg.Key)
+				if !wanted[id] {
+					return
+				}
+
+				var event cloudevents.Event
+				err := event.UnmarshalJSON(msg.Value)
+				mu.Lock()
+				// the later dead letter wins.
+				ret[id] = Item{Event: event, Err: err}
+				mu.Unlock()
+			})
+			if err != nil {
+				errOnce.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+	if errors.Is(firstErr, context.DeadlineExceeded) {
+		return ret, fmt.Errorf("scanning the dead letter topic timed out after %s: %w", timeout, firstErr)
+	}
+
+	return ret, firstErr
+}
+
+// scanPartition reads the partition from the first offset to the end offset.
+func scanPartition(ctx context.Context, reader skakfa.PartitionReader, fn func(kafka.Message)) error {
+	for {
+		msg, err := reader.ReadMessage(ctx)
+		if err != nil {
+			return err
+		}
+		fn(msg)
+		if msg.Offset+1 >= reader.EndOffset {
+			log.Debug().Str("topic", msg.Topic).Int("partition", msg.Partition).Int64("offset", msg.Offset).Msg("dead letter partition scanned")
+			return nil
+		}
+	}
+}
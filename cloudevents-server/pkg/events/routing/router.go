@@ -0,0 +1,190 @@
+// Package routing implement the declarative routing of the events to sinks.
+package routing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/goccy/go-yaml"
+	"github.com/rs/zerolog/log"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+)
+
+const defaultReloadInterval = 30 * time.Second
+
+// Router routes the events to the sinks by the rules of the routing table, the
+// table is reloaded when the rules file changes.
+type Router struct {
+	file           string
+	reloadInterval time.Duration
+	publisher      Publisher
+	recorder       handler.EventArchiver
+
+	table   atomic.Pointer[compiledTable]
+	content []byte // content of the loaded rules file.
+}
+
+type compiledTable struct {
+	rules []*compiledRule
+	sinks map[string]Sink
+}
+
+// NewRouter creates the router and loads the rules file, the publisher and the
+// recorder are optional and needed by the kafka and db sinks.
+func NewRouter(cfg config.Routing, publisher Publisher, recorder handler.EventArchiver) (*Router, error) {
+	ret := &Router{
+		file:           cfg.RulesFile,
+		reloadInterval: time.Duration(cfg.ReloadIntervalSeconds) * time.Second,
+		publisher:      publisher,
+		recorder:       recorder,
+	}
+	if ret.reloadInterval <= 0 {
+		ret.reloadInterval = defaultReloadInterval
+	}
+	if _, err := ret.Reload(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// Reload loads the rules file when it changed, the current table is kept when
+// the file is invalid.
+func (r *Router) Reload() (bool, error) {
+	content, err := os.ReadFile(r.file)
+	if err != nil {
+		return false, fmt.Errorf("failed to read rules file: %w", err)
+	}
+	if r.table.Load() != nil && bytes.Equal(content, r.content) {
+		return false, nil
+	}
+
+	var table Table
+	if err := yaml.Unmarshal(content, &table); err != nil {
+		return false, fmt.Errorf("failed to parse rules file: %w", err)
+	}
+	compiled, err := r.compile(table)
+	if err != nil {
+		return false, err
+	}
+
+	r.table.Store(compiled)
+	r.content = content
+	return true, nil
+}
+
+func (r *Router) compile(table Table) (*compiledTable, error) {
+	ret := &compiledTable{sinks: make(map[string]Sink)}
+	for _, s := range table.Sinks {
+		if _, ok := ret.sinks[s.Name]; ok || s.Name == "" {
+			return nil, fmt.Errorf("sink name %q is empty or duplicated", s.Name)
+		}
+		sink, err := newSink(s, r.publisher, r.recorder)
+		if err != nil {
+			return nil, fmt.Errorf("sink %s: %w", s.Name, err)
+		}
+		ret.sinks[s.Name] = sink
+	}
+
+	for _, rule := range table.Rules {
+		compiled, err := compileRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if len(rule.Sinks) == 0 {
+			return nil, fmt.Errorf("rule %s: no sinks", rule.Name)
+		}
+		for _, name := range rule.Sinks {
+			if _, ok := ret.sinks[name]; !ok {
+				return nil, fmt.Errorf("rule %s: unknown sink %q", rule.Name, name)
+			}
+		}
+		ret.rules = append(ret.rules, compiled)
+	}
+
+	return ret, nil
+}
+
+// Start runs the reload worker until the context is done.
+func (r *Router) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.reloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if reloaded, err := r.Reload(); err != nil {
+					log.Err(err).Str("file", r.file).Msg("failed to reload routing rules, keep the current ones")
+				} else if reloaded {
+					log.Info().Str("file", r.file).Msg("routing rules reloaded")
+				}
+			}
+		}
+	}()
+}
+
+// SupportEventTypes returns the any type, the rules decide which events are routed.
+func (r *Router) SupportEventTypes() []string {
+	return []string{handler.EventTypeAny}
+}
+
+// Handle sends the event to the sinks of all the matched rules, every sink is
+// sent once at most. The rules failed to evaluate are treated as not matched.
+func (r *Router) Handle(event cloudevents.Event) cloudevents.Result {
+	table := r.table.Load()
+
+	var (
+		sinks []string
+		seen  = make(map[string]bool)
+		errs  []error
+	)
+	for _, rule := range table.rules {
+		matched, err := rule.match(event)
+		if err != nil {
+			// such as missing keys of the event, `has()` macro can guard them.
+			log.Warn().Err(err).Str("ce-id", event.ID()).Str("ce-type", event.Type()).Msg("rule is not matched for the evaluation error")
+			continue
+		}
+		if !matched {
+			continue
+		}
+		for _, name := range rule.sinks {
+			if !seen[name] {
+				seen[name] = true
+				sinks = append(sinks, name)
+			}
+		}
+	}
+
+	for _, name := range sinks {
+		sink := table.sinks[name]
+		ctx, cancel := context.WithTimeout(context.Background(), sendTimeout(sink))
+		err := sink.Send(ctx, event)
+		cancel()
+		if err != nil {
+			log.Err(err).Str("sink", name).Str("ce-id", event.ID()).Str("ce-type", event.Type()).Msg("failed to send event to sink")
+			errs = append(errs, fmt.Errorf("sink %s: %w", name, err))
+			continue
+		}
+		log.Debug().Str("sink", name).Str("ce-id", event.ID()).Str("ce-type", event.Type()).Msg("event sent to sink")
+	}
+
+	if len(errs) > 0 {
+		return cloudevents.NewReceipt(false, "%w", errors.Join(errs...))
+	}
+	return cloudevents.ResultACK
+}
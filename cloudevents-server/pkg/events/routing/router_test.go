@@ -0,0 +1,197 @@
+package routing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+type fakePublisher struct {
+	published []string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, event cloudevents.Event) error {
+	p.published = append(p.published, topic+"/"+event.ID())
+	return nil
+}
+
+type fakeRecorder struct {
+	saved []string
+}
+
+func (r *fakeRecorder) Save(_ context.Context, event cloudevents.Event, _ string) error {
+	r.saved = append(r.saved, event.ID())
+	return nil
+}
+
+const testRules = `
+sinks:
+  - name: tekton-topic
+    type: kafka
+    topic: tekton
+  - name: failures-topic
+    type: kafka
+    topic: failures
+  - name: recorder
+    type: db
+rules:
+  - name: all tekton pipeline runs
+    types: ["dev.tekton.event.pipelinerun.*"]
+    sinks: [tekton-topic]
+  - name: tidb failures
+    types: ["*.failed.v1"]
+    subject: ^tidb-
+    extensions:
+      repo: ^pingcap/
+    sinks: [failures-topic, tekton-topic]
+  - name: release branches
+    cel: event.extensions.branch.startsWith("release-") && event.data.retries > 1
+    sinks: [recorder]
+`
+
+func writeRules(t *testing.T, file, content string) {
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newEvent(id, typ, subject string, extensions map[string]string, data map[string]any) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("test")
+	event.SetType(typ)
+	event.SetSubject(subject)
+	for k, v := range extensions {
+		event.SetExtension(k, v)
+	}
+	if data != nil {
+		_ = event.SetData(cloudevents.ApplicationJSON, data)
+	}
+	return event
+}
+
+func TestRouter_Handle(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "rules.yaml")
+	writeRules(t, file, testRules)
+
+	publisher := &fakePublisher{}
+	recorder := &fakeRecorder{}
+	router, err := NewRouter(config.Routing{RulesFile: file}, publisher, recorder)
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		event         cloudevents.Event
+		wantPublished []string
+		wantSaved     []string
+	}{
+		{
+			name:          "type glob",
+			event:         newEvent("1", "dev.tekton.event.pipelinerun.started.v1", "tiflow-pr", nil, nil),
+			wantPublished: []string{"tekton/1"},
+		},
+		{
+			name:          "subject and extensions, sinks deduplicated",
+			event:         newEvent("2", "dev.tekton.event.pipelinerun.failed.v1", "tidb-pr", map[string]string{"repo": "pingcap/tidb"}, nil),
+			wantPublished: []string{"failures/2", "tekton/2"},
+		},
+		{
+			name:  "extension not matched",
+			event: newEvent("3", "dev.tekton.event.taskrun.failed.v1", "tidb-pr", map[string]string{"repo": "tikv/tikv"}, nil),
+		},
+		{
+			name:      "cel",
+			event:     newEvent("4", "test-case-run-report", "", map[string]string{"branch": "release-8.5"}, map[string]any{"retries": 2}),
+			wantSaved: []string{"4"},
+		},
+		{
+			name:  "cel not matched",
+			event: newEvent("5", "test-case-run-report", "", map[string]string{"branch": "release-8.5"}, map[string]any{"retries": 1}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher.published, recorder.saved = nil, nil
+			if result := router.Handle(tt.event); !cloudevents.IsACK(result) {
+				t.Errorf("Handle() = %v, want ACK", result)
+			}
+
+			sort.Strings(publisher.published)
+			if !reflect.DeepEqual(publisher.published, tt.wantPublished) {
+				t.Errorf("published = %v, want %v", publisher.published, tt.wantPublished)
+			}
+			if !reflect.DeepEqual(recorder.saved, tt.wantSaved) {
+				t.Errorf("saved = %v, want %v", recorder.saved, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestRouter_Reload(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "rules.yaml")
+	writeRules(t, file, testRules)
+
+	publisher := &fakePublisher{}
+	router, err := NewRouter(config.Routing{RulesFile: file}, publisher, &fakeRecorder{})
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	if reloaded, err := router.Reload(); reloaded || err != nil {
+		t.Errorf("Reload() without change = %v, %v, want false, nil", reloaded, err)
+	}
+
+	writeRules(t, file, `
+sinks:
+  - name: all
+    type: kafka
+    topic: all
+rules:
+  - name: everything
+    sinks: [all]
+`)
+	if reloaded, err := router.Reload(); !reloaded || err != nil {
+		t.Fatalf("Reload() = %v, %v, want true, nil", reloaded, err)
+	}
+
+	writeRules(t, file, `
+rules:
+  - name: broken
+    sinks: [missing]
+`)
+	if _, err := router.Reload(); err == nil {
+		t.Errorf("Reload() with unknown sink, want error")
+	}
+
+	// the last valid rules are kept.
+	router.Handle(newEvent("1", "any", "", nil, nil))
+	if want := []string{"all/1"}; !reflect.DeepEqual(publisher.published, want) {
+		t.Errorf("published = %v, want %v", publisher.published, want)
+	}
+}
+
+func Test_sendTimeout(t *testing.T) {
+	httpSink, err := newSink(SinkConfig{Type: SinkTypeHTTP, Webhook: config.Webhook{
+		URL:            "http://localhost/events",
+		TimeoutSeconds: 5,
+		Retry:          config.WebhookRetry{BudgetSeconds: 300},
+	}}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sendTimeout(httpSink), 305*time.Second; got != want {
+		t.Errorf("sendTimeout(http) = %v, want %v", got, want)
+	}
+	if got := sendTimeout(&kafkaSink{topic: "events"}); got != sinkTimeout {
+		t.Errorf("sendTimeout(kafka) = %v, want %v", got, sinkTimeout)
+	}
+}
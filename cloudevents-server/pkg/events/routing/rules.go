@@ -0,0 +1,173 @@
+package routing
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/cel-go/cel"
+)
+
+// Table is the routing table of the rules file.
+type Table struct {
+	Sinks []SinkConfig `yaml:"sinks,omitempty" json:"sinks,omitempty"`
+	Rules []Rule       `yaml:"rules,omitempty" json:"rules,omitempty"`
+}
+
+// Rule routes the matched events to the sinks, the conditions are "AND" logic
+// and the empty ones are ignored. All the matched rules are applied.
+type Rule struct {
+	Name string `yaml:"name" json:"name"`
+	// Types are glob patterns of the event type, such as `dev.tekton.event.pipelinerun.*`.
+	Types []string `yaml:"types,omitempty" json:"types,omitempty"`
+	// Source regex of the event source.
+	Source string `yaml:"source,omitempty" json:"source,omitempty"`
+	// Subject regex of the event subject.
+	Subject string `yaml:"subject,omitempty" json:"subject,omitempty"`
+	// Extensions regexes of the event extension attributes, the missing
+	// attributes are not matched.
+	Extensions map[string]string `yaml:"extensions,omitempty" json:"extensions,omitempty"`
+	// CEL expression over the `event` variable, it should return a bool.
+	CEL string `yaml:"cel,omitempty" json:"cel,omitempty"`
+	// Sinks are the names of the sinks to route to.
+	Sinks []string `yaml:"sinks" json:"sinks"`
+}
+
+type compiledRule struct {
+	name       string
+	types      []string
+	source     *regexp.Regexp
+	subject    *regexp.Regexp
+	extensions map[string]*regexp.Regexp
+	program    cel.Program
+	sinks      []string
+}
+
+var celEnv *cel.Env
+
+func init() {
+	env, err := cel.NewEnv(cel.Variable("event", cel.MapType(cel.StringType, cel.DynType)))
+	if err != nil {
+		panic(err)
+	}
+	celEnv = env
+}
+
+func compileRule(r Rule) (*compiledRule, error) {
+	ret := &compiledRule{name: r.Name, types: r.Types, sinks: r.Sinks}
+	for _, t := range r.Types {
+		if _, err := path.Match(t, ""); err != nil {
+			return nil, fmt.Errorf("invalid type pattern %q: %w", t, err)
+		}
+	}
+
+	var err error
+	if r.Source != "" {
+		if ret.source, err = regexp.Compile(r.Source); err != nil {
+			return nil, fmt.Errorf("invalid source regex: %w", err)
+		}
+	}
+	if r.Subject != "" {
+		if ret.subject, err = regexp.Compile(r.Subject); err != nil {
+			return nil, fmt.Errorf("invalid subject regex: %w", err)
+		}
+	}
+	if len(r.Extensions) > 0 {
+		ret.extensions = make(map[string]*regexp.Regexp)
+		for k, v := range r.Extensions {
+			if ret.extensions[k], err = regexp.Compile(v); err != nil {
+				return nil, fmt.Errorf("invalid regex of extension %q: %w", k, err)
+			}
+		}
+	}
+	if r.CEL != "" {
+		ast, issues := celEnv.Compile(r.CEL)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("invalid cel expression: %w", issues.Err())
+		}
+		if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+			return nil, fmt.Errorf("cel expression should return bool, got %s", ast.OutputType())
+		}
+		if ret.program, err = celEnv.Program(ast); err != nil {
+			return nil, fmt.Errorf("invalid cel expression: %w", err)
+		}
+	}
+
+	return ret, nil
+}
+
+func (r *compiledRule) match(event cloudevents.Event) (bool, error) {
+	if len(r.types) > 0 && !matchAnyGlob(r.types, event.Type()) {
+		return false, nil
+	}
+	if r.source != nil && !r.source.MatchString(event.Source()) {
+		return false, nil
+	}
+	if r.subject != nil && !r.subject.MatchString(event.Subject()) {
+		return false, nil
+	}
+	for k, re := range r.extensions {
+		v, ok := event.Extensions()[k]
+		if !ok {
+			return false, nil
+		}
+		s, err := types.ToString(v)
+		if err != nil || !re.MatchString(s) {
+			return false, nil
+		}
+	}
+	if r.program == nil {
+		return true, nil
+	}
+
+	out, _, err := r.program.Eval(map[string]any{"event": celEventVars(event)})
+	if err != nil {
+		return false, fmt.Errorf("rule %s: failed to evaluate cel expression: %w", r.name, err)
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %s: cel expression returned %T, want bool", r.name, out.Value())
+	}
+
+	return matched, nil
+}
+
+func matchAnyGlob(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, s); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// celEventVars returns the `event` variable of the cel expressions, the json
+// data is decoded as `event.data`.
+func celEventVars(event cloudevents.Event) map[string]any {
+	extensions := make(map[string]any, len(event.Extensions()))
+	for k, v := range event.Extensions() {
+		if s, err := types.ToString(v); err == nil {
+			extensions[k] = s
+		}
+	}
+
+	ret := map[string]any{
+		"id":              event.ID(),
+		"type":            event.Type(),
+		"source":          event.Source(),
+		"subject":         event.Subject(),
+		"time":            event.Time(),
+		"datacontenttype": event.DataContentType(),
+		"extensions":      extensions,
+	}
+	var data any
+	if err := json.Unmarshal(event.Data(), &data); err == nil {
+		ret["data"] = data
+	}
+
+	return ret
+}
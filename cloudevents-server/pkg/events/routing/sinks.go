@@ -0,0 +1,185 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	larksdk "github.com/larksuite/oapi-sdk-go/v3"
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/webhook"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/lark"
+)
+
+// Sink types.
+const (
+	SinkTypeLark  = "lark"
+	SinkTypeHTTP  = "http"
+	SinkTypeKafka = "kafka"
+	SinkTypeDB    = "db"
+)
+
+const (
+	sinkTimeout             = 30 * time.Second
+	defaultLarkTextTemplate = `[{{ .Type }}] {{ .Subject }}
+source: {{ .Source }}
+id: {{ .ID }}`
+)
+
+// SinkConfig config of the named sink.
+type SinkConfig struct {
+	Name string `yaml:"name" json:"name"`
+	// Type of the sink: lark, http, kafka or db.
+	Type string `yaml:"type" json:"type"`
+
+	// Lark bot app to send text messages to the receivers.
+	Lark      config.LarkBotApp `yaml:"lark,omitempty" json:"lark,omitempty"`
+	Receivers []string          `yaml:"receivers,omitempty" json:"receivers,omitempty"`
+	// Template is the go template of the lark text message, the event is the data.
+	Template string `yaml:"template,omitempty" json:"template,omitempty"`
+
+	// Webhook to forward the events to in cloudevents http binding.
+	config.Webhook `yaml:",inline" json:",inline"`
+
+	// Topic of kafka to publish the events to.
+	Topic string `yaml:"topic,omitempty" json:"topic,omitempty"`
+}
+
+// Sink receives the routed events.
+type Sink interface {
+	Send(ctx context.Context, event cloudevents.Event) error
+}
+
+// sendTimeout returns the timeout of sending an event to the sink, the sinks
+// retrying in their own budget, such as the webhook sink, are given the budget.
+func sendTimeout(sink Sink) time.Duration {
+	if s, ok := sink.(interface{ Timeout() time.Duration }); ok {
+		return max(s.Timeout(), sinkTimeout)
+	}
+	return sinkTimeout
+}
+
+// Publisher publishes the events onto the kafka topics.
+type Publisher interface {
+	Publish(ctx context.Context, topic string, event cloudevents.Event) error
+}
+
+func newSink(cfg SinkConfig, publisher Publisher, recorder handler.EventArchiver) (Sink, error) {
+	switch cfg.Type {
+	case SinkTypeLark:
+		return newLarkSink(cfg)
+	case SinkTypeHTTP:
+		return webhook.New(cfg.Webhook)
+	case SinkTypeKafka:
+		if cfg.Topic == "" {
+			return nil, fmt.Errorf("topic is required")
+		}
+		if publisher == nil {
+			return nil, fmt.Errorf("kafka is not configured")
+		}
+		return &kafkaSink{topic: cfg.Topic, publisher: publisher}, nil
+	case SinkTypeDB:
+		if recorder == nil {
+			return nil, fmt.Errorf("the event archive is not configured")
+		}
+		return &dbSink{recorder: recorder}, nil
+	default:
+		return nil, fmt.Errorf("unsupported sink type: %q", cfg.Type)
+	}
+}
+
+type larkSink struct {
+	client    *larksdk.Client
+	receivers []string
+	tmpl      *template.Template
+}
+
+func newLarkSink(cfg SinkConfig) (*larkSink, error) {
+	if len(cfg.Receivers) == 0 {
+		return nil, fmt.Errorf("receivers are required")
+	}
+	text := cfg.Template
+	if text == "" {
+		text = defaultLarkTextTemplate
+	}
+	tmpl, err := template.New("lark").Funcs(sprig.FuncMap()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+
+	return &larkSink{
+		client:    lark.NewClient(cfg.Lark.AppID, cfg.Lark.AppSecret),
+		receivers: cfg.Receivers,
+		tmpl:      tmpl,
+	}, nil
+}
+
+func (s *larkSink) Send(ctx context.Context, event cloudevents.Event) error {
+	text := new(bytes.Buffer)
+	if err := s.tmpl.Execute(text, event); err != nil {
+		return fmt.Errorf("failed to render the lark message: %w", err)
+	}
+	content, err := json.Marshal(map[string]string{"text": text.String()})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range s.receivers {
+		req := larkim.NewCreateMessageReqBuilder().
+			ReceiveIdType(lark.ReceiverIDType(r)).
+			Body(larkim.NewCreateMessageReqBodyBuilder().
+				MsgType(larkim.MsgTypeText).
+				ReceiveId(r).
+				Content(string(content)).
+				// dedup the messages of the same event.
+				Uuid(messageUUID(event, r)).
+				Build()).
+			Build()
+		resp, err := s.client.Im.Message.Create(ctx, req)
+		if err != nil {
+			return fmt.Errorf("send lark message failed: %w", err)
+		}
+		if !resp.Success() {
+			return fmt.Errorf("send lark message failed: %s", resp.Msg)
+		}
+	}
+
+	return nil
+}
+
+func messageUUID(event cloudevents.Event, receiver string) string {
+	h := sha1.New()
+	io.WriteString(h, event.Source())
+	io.WriteString(h, event.ID())
+	io.WriteString(h, receiver)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type kafkaSink struct {
+	topic     string
+	publisher Publisher
+}
+
+func (s *kafkaSink) Send(ctx context.Context, event cloudevents.Event) error {
+	return s.publisher.Publish(ctx, s.topic, event)
+}
+
+type dbSink struct {
+	recorder handler.EventArchiver
+}
+
+func (s *dbSink) Send(ctx context.Context, event cloudevents.Event) error {
+	return s.recorder.Save(ctx, event, "")
+}
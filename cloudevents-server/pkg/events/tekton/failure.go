@@ -0,0 +1,259 @@
+package tekton
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+const failureCategoryUnknown = "unknown"
+
+// defaultFailureRules are matched after the configured rules, the infra
+// failures are matched before the code failures.
+var defaultFailureRules = []config.TektonFailureRule{
+	{
+		Category: "oom-killed",
+		Reasons:  []string{`^OOMKilled$`},
+		Patterns: []string{`(?i)out of memory|oom-?killed`},
+	},
+	{
+		Category: "registry-auth",
+		Patterns: []string{`(?i)unauthorized: authentication required|denied: requested access to the resource is denied|no basic auth credentials|pull access denied|401 unauthorized`},
+	},
+	{
+		Category: "image-pull",
+		Reasons:  []string{`^(ErrImagePull|ImagePullBackOff|InvalidImageName)$`},
+		Patterns: []string{`(?i)failed to pull (the )?image|back-off pulling image|manifest unknown`},
+	},
+	{
+		Category: "timeout",
+		Reasons:  []string{`^(TaskRunTimeout|PipelineRunTimeout)$`},
+		Patterns: []string{`failed to finish within`},
+	},
+	{
+		Category: "network-timeout",
+		Patterns: []string{`(?i)i/o timeout|connection (timed out|refused|reset by peer)|tls handshake timeout|temporary failure in name resolution|could not resolve host`},
+	},
+	{
+		Category: "compile-error",
+		Patterns: []string{`\[build failed\]|(?i)compilation (failed|terminated)|error: could not compile|COMPILATION ERROR|(?m)^\S+\.go:\d+:\d+: `},
+	},
+	{
+		Category: "test-failure",
+		Patterns: []string{`(?m)^--- FAIL: |(?m)^FAIL\s|(?i)\btests? failed\b|\d+ failing|Tests run: .*, Failures: [1-9]`},
+	},
+}
+
+type failureRule struct {
+	category string
+	reasons  []*regexp.Regexp
+	patterns []*regexp.Regexp
+}
+
+func (r *failureRule) matches(s failureSignal) bool {
+	for _, re := range r.reasons {
+		if s.Reason != "" && re.MatchString(s.Reason) {
+			return true
+		}
+	}
+	for _, re := range r.patterns {
+		if s.Text != "" && re.MatchString(s.Text) {
+			return true
+		}
+	}
+	return false
+}
+
+// failureClassifier classifies the failed runs by the rules, the first rule
+// matched any signal of the run wins.
+type failureClassifier struct {
+	rules []failureRule
+}
+
+func newFailureClassifier(cfgs []config.TektonFailureRule) (*failureClassifier, error) {
+	ret := new(failureClassifier)
+	for _, cfg := range slices.Concat(cfgs, defaultFailureRules) {
+		if cfg.Category == "" {
+			return nil, fmt.Errorf("category of the failure rule is required")
+		}
+		rule := failureRule{category: cfg.Category}
+		for _, expr := range cfg.Reasons {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid reason regex of failure rule %q: %w", cfg.Category, err)
+			}
+			rule.reasons = append(rule.reasons, re)
+		}
+		for _, expr := range cfg.Patterns {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern regex of failure rule %q: %w", cfg.Category, err)
+			}
+			rule.patterns = append(rule.patterns, re)
+		}
+		ret.rules = append(ret.rules, rule)
+	}
+
+	return ret, nil
+}
+
+// failureSignal is a hint of the failure, from a failed step or the condition
+// of a failed task or run.
+type failureSignal struct {
+	Task   string
+	Step   string
+	Reason string // termination or waiting reason of the step, or the condition reason.
+	Text   string // log tail of the step, or the condition message.
+}
+
+// runFailure is the classified failure of a run.
+type runFailure struct {
+	Category string
+	Task     string
+	Step     string
+	Reason   string
+}
+
+func (c *failureClassifier) classify(signals []failureSignal) runFailure {
+	for _, rule := range c.rules {
+		for _, s := range signals {
+			if rule.matches(s) {
+				return runFailure{Category: rule.category, Task: s.Task, Step: s.Step, Reason: s.Reason}
+			}
+		}
+	}
+
+	ret := runFailure{Category: failureCategoryUnknown}
+	if len(signals) > 0 {
+		ret.Task, ret.Step, ret.Reason = signals[0].Task, signals[0].Step, signals[0].Reason
+	}
+	return ret
+}
+
+// pipelineRunFailureSignals returns the signals of the failed tasks ordered by
+// the task names, with the log tails in the infos, then the run condition.
+func pipelineRunFailureSignals(run *v1beta1.PipelineRun, infos *CardMessageInfos) []failureSignal {
+	var ret []failureSignal
+	taskRuns := make([]*v1beta1.PipelineRunTaskRunStatus, 0, len(run.Status.TaskRuns))
+	for _, tr := range run.Status.TaskRuns {
+		if tr.Status != nil && !tr.Status.GetCondition(apis.ConditionSucceeded).IsTrue() {
+			taskRuns = append(taskRuns, tr)
+		}
+	}
+	slices.SortFunc(taskRuns, func(a, b *v1beta1.PipelineRunTaskRunStatus) int {
+		return cmp.Compare(a.PipelineTaskName, b.PipelineTaskName)
+	})
+	for _, tr := range taskRuns {
+		ret = append(ret, taskRunFailureSignals(tr.PipelineTaskName, tr.Status, infos.FailedTasks[tr.PipelineTaskName])...)
+	}
+
+	return append(ret, conditionSignal("", run.Status.GetCondition(apis.ConditionSucceeded)))
+}
+
+// taskRunFailureSignals returns the signals of the failed and waiting steps,
+// then the task run condition.
+func taskRunFailureSignals(task string, status *v1beta1.TaskRunStatus, steps []stepInfo) []failureSignal {
+	var ret []failureSignal
+	for _, s := range steps {
+		if s.Terminated != nil && s.Terminated.Reason != "Completed" {
+			ret = append(ret, failureSignal{Task: task, Step: s.Name, Reason: s.Terminated.Reason, Text: s.Logs})
+		}
+	}
+	for _, s := range status.Steps {
+		if s.Waiting != nil {
+			ret = append(ret, waitingSignal(task, s.Name, s.Waiting))
+		}
+	}
+
+	return append(ret, conditionSignal(task, status.GetCondition(apis.ConditionSucceeded)))
+}
+
+func waitingSignal(task, step string, state *corev1.ContainerStateWaiting) failureSignal {
+	return failureSignal{Task: task, Step: step, Reason: state.Reason, Text: state.Message}
+}
+
+func conditionSignal(task string, cond *apis.Condition) failureSignal {
+	if cond == nil {
+		return failureSignal{Task: task}
+	}
+	return failureSignal{Task: task, Reason: cond.Reason, Text: cond.Message}
+}
+
+// failureRecorder stores the classified failures of the runs for the trend
+// reports.
+type failureRecorder struct {
+	Storage *ent.TektonRunFailureClient
+}
+
+// record saves the failure of the run once, the redelivered events are skipped.
+func (r *failureRecorder) record(ctx context.Context, meta runMeta, failure runFailure) error {
+	err := r.Storage.Create().
+		SetRunUID(meta.UID).
+		SetKind(meta.Kind).
+		SetNamespace(meta.Namespace).
+		SetName(meta.Name).
+		SetPipeline(meta.Pipeline).
+		SetCategory(failure.Category).
+		SetTask(failure.Task).
+		SetStep(failure.Step).
+		SetReason(failure.Reason).
+		SetFailedAt(meta.FailedAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
+
+// runMeta identifies the failed run.
+type runMeta struct {
+	UID       string
+	Kind      string
+	Namespace string
+	Name      string
+	Pipeline  string // name of the pipeline or the task of the run.
+	FailedAt  time.Time
+}
+
+func pipelineRunMeta(run *v1beta1.PipelineRun) runMeta {
+	return runMeta{
+		UID:       string(run.UID),
+		Kind:      RunKindPipelineRun,
+		Namespace: run.Namespace,
+		Name:      run.Name,
+		Pipeline:  pipelineName(run),
+		FailedAt:  failedAt(run.Status.CompletionTime),
+	}
+}
+
+func taskRunMeta(run *v1beta1.TaskRun) runMeta {
+	ret := runMeta{
+		UID:       string(run.UID),
+		Kind:      RunKindTaskRun,
+		Namespace: run.Namespace,
+		Name:      run.Name,
+		FailedAt:  failedAt(run.Status.CompletionTime),
+	}
+	if run.Spec.TaskRef != nil {
+		ret.Pipeline = run.Spec.TaskRef.Name
+	}
+	return ret
+}
+
+func failedAt(completionTime *metav1.Time) time.Time {
+	if completionTime == nil {
+		return time.Now()
+	}
+	return completionTime.Time
+}
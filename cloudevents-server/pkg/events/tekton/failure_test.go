@@ -0,0 +1,139 @@
+package tekton
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	_ "github.com/mattn/go-sqlite3"
+	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+func Test_failureClassifier_classify(t *testing.T) {
+	classifier, err := newFailureClassifier([]config.TektonFailureRule{
+		{Category: "disk-full", Patterns: []string{`(?i)no space left on device`}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		signals []failureSignal
+		want    runFailure
+	}{
+		{
+			name:    "oom killed",
+			signals: []failureSignal{{Task: "build", Step: "compile", Reason: "OOMKilled"}},
+			want:    runFailure{Category: "oom-killed", Task: "build", Step: "compile", Reason: "OOMKilled"},
+		},
+		{
+			name:    "image pull",
+			signals: []failureSignal{{Task: "test", Step: "unit", Reason: "ImagePullBackOff", Text: `Back-off pulling image "hub.pingcap.net/foo:bar"`}},
+			want:    runFailure{Category: "image-pull", Task: "test", Step: "unit", Reason: "ImagePullBackOff"},
+		},
+		{
+			name:    "registry auth before image pull",
+			signals: []failureSignal{{Task: "push", Reason: "Failed", Text: "failed to pull image: unauthorized: authentication required"}},
+			want:    runFailure{Category: "registry-auth", Task: "push", Reason: "Failed"},
+		},
+		{
+			name: "infra failure before code failure",
+			signals: []failureSignal{
+				{Task: "test", Step: "unit", Reason: "Error", Text: "--- FAIL: TestFoo (0.01s)\nFAIL\tgithub.com/pingcap/tidb/pkg/foo"},
+				{Task: "download", Step: "fetch", Reason: "Error", Text: "dial tcp 10.0.0.1:443: i/o timeout"},
+			},
+			want: runFailure{Category: "network-timeout", Task: "download", Step: "fetch", Reason: "Error"},
+		},
+		{
+			name:    "compile error",
+			signals: []failureSignal{{Task: "build", Step: "make", Reason: "Error", Text: "pkg/foo/bar.go:12:3: undefined: baz\nmake: *** [build] Error 1"}},
+			want:    runFailure{Category: "compile-error", Task: "build", Step: "make", Reason: "Error"},
+		},
+		{
+			name:    "test failure",
+			signals: []failureSignal{{Task: "test", Step: "unit", Reason: "Error", Text: "--- FAIL: TestFoo (0.01s)"}},
+			want:    runFailure{Category: "test-failure", Task: "test", Step: "unit", Reason: "Error"},
+		},
+		{
+			name:    "timeout",
+			signals: []failureSignal{{Reason: "PipelineRunTimeout", Text: `PipelineRun "foo" failed to finish within "1h0m0s"`}},
+			want:    runFailure{Category: "timeout", Reason: "PipelineRunTimeout"},
+		},
+		{
+			name:    "configured rule first",
+			signals: []failureSignal{{Task: "build", Step: "make", Reason: "Error", Text: "write /tmp/foo: no space left on device\n--- FAIL: TestFoo"}},
+			want:    runFailure{Category: "disk-full", Task: "build", Step: "make", Reason: "Error"},
+		},
+		{
+			name:    "unknown",
+			signals: []failureSignal{{Task: "build", Step: "make", Reason: "Error", Text: "exit status 2"}},
+			want:    runFailure{Category: failureCategoryUnknown, Task: "build", Step: "make", Reason: "Error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifier.classify(tt.signals); got != tt.want {
+				t.Errorf("classify() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newFailureClassifier_invalid(t *testing.T) {
+	for _, rules := range [][]config.TektonFailureRule{
+		{{Patterns: []string{"foo"}}},
+		{{Category: "foo", Reasons: []string{"("}}},
+		{{Category: "foo", Patterns: []string{"["}}},
+	} {
+		if _, err := newFailureClassifier(rules); err == nil {
+			t.Errorf("newFailureClassifier(%+v) want error", rules)
+		}
+	}
+}
+
+func Test_failureRecorder_record(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(pipelineRunFailedEventBytes); err != nil {
+		t.Fatal(err)
+	}
+	data := new(tektoncloudevent.TektonCloudEventData)
+	if err := event.DataAs(data); err != nil {
+		t.Fatal(err)
+	}
+
+	classifier, _ := newFailureClassifier(nil)
+	infos := &CardMessageInfos{FailedTasks: getFailedTasks(data.PipelineRun, func(_, _ string) string {
+		return "pkg/foo/bar.go:12:3: undefined: baz"
+	})}
+	failure := classifier.classify(pipelineRunFailureSignals(data.PipelineRun, infos))
+	if failure.Category != "compile-error" || failure.Task == "" || failure.Step == "" {
+		t.Fatalf("classify() = %+v, want compile error of a step", failure)
+	}
+
+	recorder := &failureRecorder{Storage: client.TektonRunFailure}
+	ctx := context.Background()
+	// the redelivered event is recorded once.
+	for range 2 {
+		if err := recorder.record(ctx, pipelineRunMeta(data.PipelineRun), failure); err != nil {
+			t.Fatalf("record() error = %v", err)
+		}
+	}
+	records, err := client.TektonRunFailure.Query().All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	r := records[0]
+	if r.RunUID != string(data.PipelineRun.UID) || r.Kind != RunKindPipelineRun || r.Category != "compile-error" || r.Pipeline == "" {
+		t.Errorf("record = %+v", r)
+	}
+}
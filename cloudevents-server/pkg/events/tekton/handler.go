@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/lark"
@@ -28,12 +29,27 @@ const (
 	eventContextAnnotationInnerKeyUser = "user"
 )
 
-func NewHandler(cfg config.Tekton) (handler.EventHandler, error) {
+// NewHandler creates the handler of the tekton runs, db stores the cards,
+// subscriptions, failures and run history, and it is nil without the store.
+func NewHandler(cfg config.Tekton, db *ent.Client) (handler.EventHandler, error) {
+	classifier, err := newFailureClassifier(cfg.FailureRules)
+	if err != nil {
+		return nil, err
+	}
+
 	larkClient := lark.NewClient(cfg.Lark.AppID, cfg.Lark.AppSecret)
-	ret := new(handler.CompositeEventHandler).AddHandlers(
-		&pipelineRunHandler{LarkClient: larkClient, Tekton: cfg},
-		&taskRunHandler{LarkClient: larkClient, Tekton: cfg},
-	)
+	prHandler := &pipelineRunHandler{LarkClient: larkClient, Tekton: cfg, Classifier: classifier}
+	trHandler := &taskRunHandler{LarkClient: larkClient, Tekton: cfg, Classifier: classifier}
+	ret := new(handler.CompositeEventHandler)
+	if db != nil {
+		prHandler.Cards = &larkCardUpdater{LarkClient: larkClient, Storage: db.LarkRunCard}
+		prHandler.Subscriptions = &SubscriptionStore{Storage: db.TektonSubscription}
+		prHandler.Failures = &failureRecorder{Storage: db.TektonRunFailure}
+		trHandler.Failures = prHandler.Failures
+		ret.AddHandlers(&runHistoryHandler{Recorder: &RunHistoryRecorder{Storage: db.TektonRun}, Params: cfg.HistoryParams})
+	}
+
+	ret.AddHandlers(prHandler, trHandler)
 
 	return ret, nil
 }
@@ -84,13 +100,13 @@ func newDetailURL(etype, source, baseURL string) string {
 	return fmt.Sprintf("%s/#/namespaces/%s/%s/%s", baseURL, runNamespace, runType, runName)
 }
 
-func extractLarkInfosFromEvent(event cloudevents.Event, baseURL string, tailLogLines int) (*cardMessageInfos, error) {
+func extractLarkInfosFromEvent(event cloudevents.Event, baseURL string, tailLogLines int) (*CardMessageInfos, error) {
 	var data tektoncloudevent.TektonCloudEventData
 	if err := event.DataAs(&data); err != nil {
 		return nil, err
 	}
 
-	ret := cardMessageInfos{
+	ret := CardMessageInfos{
 		Title:         newLarkTitle(event.Type(), event.Subject()),
 		TitleTemplate: larkCardHeaderTemplates[tektoncloudevent.TektonEventType(event.Type())],
 		ViewURL:       newDetailURL(event.Type(), event.Source(), baseURL),
@@ -131,7 +147,7 @@ func extractLarkInfosFromEvent(event cloudevents.Event, baseURL string, tailLogL
 	return &ret, nil
 }
 
-func fillInfosWithCustomRun(data *v1beta1.CustomRun, ret *cardMessageInfos) bool {
+func fillInfosWithCustomRun(data *v1beta1.CustomRun, ret *CardMessageInfos) bool {
 	fillTimeFileds(ret, data.Status.StartTime, data.Status.CompletionTime)
 
 	for _, p := range data.Spec.Params {
@@ -147,7 +163,7 @@ func fillInfosWithCustomRun(data *v1beta1.CustomRun, ret *cardMessageInfos) bool
 	return true
 }
 
-func fillInfosWithTaskRun(data *v1beta1.TaskRun, ret *cardMessageInfos) bool {
+func fillInfosWithTaskRun(data *v1beta1.TaskRun, ret *CardMessageInfos) bool {
 	fillTimeFileds(ret, data.Status.StartTime, data.Status.CompletionTime)
 
 	for _, p := range data.Spec.Params {
@@ -174,7 +190,7 @@ func fillInfosWithTaskRun(data *v1beta1.TaskRun, ret *cardMessageInfos) bool {
 	return true
 }
 
-func fillInfosWithPipelineRun(data *v1beta1.PipelineRun, ret *cardMessageInfos) bool {
+func fillInfosWithPipelineRun(data *v1beta1.PipelineRun, ret *CardMessageInfos) bool {
 	fillTimeFileds(ret, data.Status.StartTime, data.Status.CompletionTime)
 
 	for _, p := range data.Spec.Params {
@@ -270,7 +286,7 @@ func getStepLog(baseURL, ns, podName, containerName string, tailLines int) (stri
 	return string(body), nil
 }
 
-func fillTimeFileds(ret *cardMessageInfos, startTime, endTime *metav1.Time) {
+func fillTimeFileds(ret *CardMessageInfos, startTime, endTime *metav1.Time) {
 	if startTime != nil {
 		ret.StartTime = startTime.Format(time.RFC3339)
 	}
@@ -1,12 +1,15 @@
 package tekton
 
 import (
+	"context"
 	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	lark "github.com/larksuite/oapi-sdk-go/v3"
 	"github.com/rs/zerolog/log"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
 )
@@ -18,6 +21,15 @@ const (
 type pipelineRunHandler struct {
 	config.Tekton
 	LarkClient *lark.Client
+	// Cards updates one lark card for a run when set, else a new message is
+	// sent for every started, failed and succeeded event.
+	Cards *larkCardUpdater
+	// Subscriptions adds the subscribed receivers when set.
+	Subscriptions *SubscriptionStore
+	// Classifier classifies the failed runs when set.
+	Classifier *failureClassifier
+	// Failures records the classified failures when set.
+	Failures *failureRecorder
 }
 
 type AnnotationsGetter interface {
@@ -45,18 +57,21 @@ func (h *pipelineRunHandler) Handle(event cloudevents.Event) cloudevents.Result
 		Str("ce-id", event.ID()).
 		Logger()
 
-	switch tektoncloudevent.TektonEventType(event.Type()) {
+	eventType := tektoncloudevent.TektonEventType(event.Type())
+	if eventType == tektoncloudevent.PipelineRunRunningEventV1 && h.Cards == nil {
+		handlerLog.Debug().Msg("skip notifing for the event type.")
+		return cloudevents.ResultACK
+	}
+
+	switch eventType {
 	case tektoncloudevent.PipelineRunStartedEventV1,
+		tektoncloudevent.PipelineRunRunningEventV1,
 		tektoncloudevent.PipelineRunFailedEventV1,
 		tektoncloudevent.PipelineRunSuccessfulEventV1:
-		var receivers []string
-		// send notify to the trigger user if it's existed, else send to the receivers configurated by type.
-		if receiver := getTriggerUser(data.PipelineRun); receiver != "" {
-			receivers = []string{receiver}
-		} else {
-			receivers = getReceivers(event, h.Notifications)
-		}
-		if len(receivers) == 0 {
+		receivers := h.getReceivers(event, data.PipelineRun)
+		// the failures are recorded for the trend reports even no one is notified.
+		recordFailure := eventType == tektoncloudevent.PipelineRunFailedEventV1 && h.Failures != nil && data.PipelineRun != nil
+		if len(receivers) == 0 && !recordFailure {
 			return cloudevents.ResultACK
 		}
 
@@ -68,13 +83,49 @@ func (h *pipelineRunHandler) Handle(event cloudevents.Event) cloudevents.Result
 				Msg("failed to extract lark infos from event")
 			return cloudevents.ResultNACK
 		}
+		if infos != nil && eventType == tektoncloudevent.PipelineRunFailedEventV1 && data.PipelineRun != nil && h.Classifier != nil {
+			failure := h.Classifier.classify(pipelineRunFailureSignals(data.PipelineRun, infos))
+			infos.FailureCategory = failure.Category
+			if h.Failures != nil {
+				if err := h.Failures.record(context.Background(), pipelineRunMeta(data.PipelineRun), failure); err != nil {
+					handlerLog.Err(err).Msg("failed to record the failure of the run")
+				}
+			}
+		}
+		if len(receivers) == 0 {
+			return cloudevents.ResultACK
+		}
 
 		handlerLog.Debug().
 			Str("receivers", strings.Join(receivers, ",")).
 			Msg("send notification for the event type.")
-		return composeAndSendLarkMessages(h.LarkClient, receivers, infos)
+		if h.Cards != nil && data.PipelineRun != nil {
+			return h.Cards.sendOrUpdate(context.Background(), string(data.PipelineRun.UID), event.Type(), receivers, infos)
+		}
+		return ComposeAndSendLarkMessages(h.LarkClient, receivers, infos)
 	default:
 		handlerLog.Debug().Msg("skip notifing for the event type.")
 		return cloudevents.ResultACK
 	}
 }
+
+// getReceivers returns the trigger user if it's existed, else the receivers
+// configurated by type, together with the subscribed receivers.
+func (h *pipelineRunHandler) getReceivers(event cloudevents.Event, run *v1beta1.PipelineRun) []string {
+	var receivers []string
+	if receiver := getTriggerUser(run); receiver != "" {
+		receivers = []string{receiver}
+	} else {
+		receivers = getReceivers(event, h.Notifications)
+	}
+	if h.Subscriptions == nil || run == nil {
+		return receivers
+	}
+
+	subscribed, err := h.Subscriptions.Receivers(context.Background(), event.Type(), run)
+	if err != nil {
+		log.Err(err).Str("ce-id", event.ID()).Msg("failed to get the subscribed receivers")
+		return receivers
+	}
+	return sets.NewString(receivers...).Insert(subscribed...).List()
+}
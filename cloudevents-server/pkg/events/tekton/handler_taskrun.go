@@ -1,6 +1,7 @@
 package tekton
 
 import (
+	"context"
 	"strings"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -15,6 +16,10 @@ import (
 type taskRunHandler struct {
 	config.Tekton
 	LarkClient *lark.Client
+	// Classifier classifies the failed runs when set.
+	Classifier *failureClassifier
+	// Failures records the classified failures when set.
+	Failures *failureRecorder
 }
 
 func (h *taskRunHandler) SupportEventTypes() []string {
@@ -52,7 +57,7 @@ func (h *taskRunHandler) Handle(event cloudevents.Event) cloudevents.Result {
 		} else {
 			receivers = getReceivers(event, h.Notifications)
 		}
-		if len(receivers) == 0 {
+		if len(receivers) == 0 && h.Failures == nil {
 			return cloudevents.ResultACK
 		}
 
@@ -63,11 +68,23 @@ func (h *taskRunHandler) Handle(event cloudevents.Event) cloudevents.Result {
 				Msg("failed to extract lark infos")
 			return err
 		}
+		if infos != nil && h.Classifier != nil {
+			failure := h.Classifier.classify(taskRunFailureSignals("", &data.TaskRun.Status, infos.StepStatuses))
+			infos.FailureCategory = failure.Category
+			if h.Failures != nil {
+				if err := h.Failures.record(context.Background(), taskRunMeta(data.TaskRun), failure); err != nil {
+					handlerLog.Err(err).Msg("failed to record the failure of the run")
+				}
+			}
+		}
+		if len(receivers) == 0 {
+			return cloudevents.ResultACK
+		}
 
 		handlerLog.Debug().
 			Str("receivers", strings.Join(receivers, ",")).
 			Msg("send notification for the event type.")
-		return composeAndSendLarkMessages(h.LarkClient, receivers, infos)
+		return ComposeAndSendLarkMessages(h.LarkClient, receivers, infos)
 	}
 
 	handlerLog.Debug().Msg("skip notifing for the event type.")
@@ -0,0 +1,258 @@
+package tekton
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+)
+
+// kinds and statuses of the recorded runs.
+const (
+	RunKindPipelineRun = "pipelinerun"
+	RunKindTaskRun     = "taskrun"
+
+	RunStatusQueued    = "queued"
+	RunStatusRunning   = "running"
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+	RunStatusCancelled = "cancelled"
+)
+
+// cancelledReasons are the condition reasons of the cancelled runs.
+var cancelledReasons = []string{"Cancelled", "CancelledRunFinally", "StoppedRunFinally", "TaskRunCancelled"}
+
+// runHistoryHandler records the status and timing of the pipeline runs and
+// task runs.
+type runHistoryHandler struct {
+	Recorder *RunHistoryRecorder
+	// Params are the names of the params to record.
+	Params []string
+}
+
+func (h *runHistoryHandler) SupportEventTypes() []string {
+	return []string{
+		string(tektoncloudevent.PipelineRunStartedEventV1),
+		string(tektoncloudevent.PipelineRunRunningEventV1),
+		string(tektoncloudevent.PipelineRunSuccessfulEventV1),
+		string(tektoncloudevent.PipelineRunFailedEventV1),
+		string(tektoncloudevent.TaskRunStartedEventV1),
+		string(tektoncloudevent.TaskRunRunningEventV1),
+		string(tektoncloudevent.TaskRunSuccessfulEventV1),
+		string(tektoncloudevent.TaskRunFailedEventV1),
+	}
+}
+
+func (h *runHistoryHandler) Handle(event cloudevents.Event) cloudevents.Result {
+	data := new(tektoncloudevent.TektonCloudEventData)
+	if err := event.DataAs(&data); err != nil {
+		return cloudevents.NewReceipt(false, "invalid data: %v", err)
+	}
+
+	var record RunRecord
+	switch {
+	case data.PipelineRun != nil:
+		record = newPipelineRunRecord(data.PipelineRun, h.Params)
+	case data.TaskRun != nil:
+		record = newTaskRunRecord(data.TaskRun, h.Params)
+	default:
+		return cloudevents.ResultACK
+	}
+	record.Status = runStatus(event.Type(), record.Reason)
+
+	if _, err := h.Recorder.Save(context.Background(), record); err != nil {
+		log.Err(err).Str("ce-id", event.ID()).Str("run-uid", record.UID).Msg("failed to record the run history")
+		return cloudevents.NewReceipt(true, "record run history failed: %v", err)
+	}
+	return cloudevents.ResultACK
+}
+
+// RunHistoryRecorder records the runs in the run history, it is shared with
+// the handlers of the other CI systems.
+type RunHistoryRecorder struct {
+	Storage *ent.TektonRunClient
+}
+
+func NewRunHistoryRecorder(db *ent.Client) *RunHistoryRecorder {
+	return &RunHistoryRecorder{Storage: db.TektonRun}
+}
+
+// Save creates or updates the record of the run and returns the saved one.
+// The finished record is not updated by the delayed events of the queued or
+// running run, and the duration is filled from the recorded start time when
+// the finished event carries the end time only.
+func (r *RunHistoryRecorder) Save(ctx context.Context, record RunRecord) (*ent.TektonRun, error) {
+	existed, err := r.Storage.Query().Where(tektonrun.RunUIDEQ(record.UID)).Only(ctx)
+	if ent.IsNotFound(err) {
+		var created *ent.TektonRun
+		created, err = r.Storage.Create().
+			SetRunUID(record.UID).
+			SetKind(record.Kind).
+			SetNamespace(record.Namespace).
+			SetName(record.Name).
+			SetPipeline(record.Pipeline).
+			SetPipelineRun(record.PipelineRun).
+			SetTriggerUser(record.TriggerUser).
+			SetParams(record.Params).
+			SetStatus(record.Status).
+			SetReason(record.Reason).
+			SetFailedTasks(record.FailedTasks).
+			SetNillableStartTime(record.StartTime).
+			SetNillableEndTime(record.EndTime).
+			SetDurationMs(record.DurationMs).
+			Save(ctx)
+		if !ent.IsConstraintError(err) {
+			return created, err
+		}
+		// created by the concurrent event.
+		existed, err = r.Storage.Query().Where(tektonrun.RunUIDEQ(record.UID)).Only(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isFinishedRunStatus(existed.Status) && !isFinishedRunStatus(record.Status) {
+		return existed, nil
+	}
+	if record.DurationMs == 0 && record.StartTime == nil && record.EndTime != nil && existed.StartTime != nil {
+		record.DurationMs = int(record.EndTime.Sub(*existed.StartTime).Milliseconds())
+	}
+
+	update := existed.Update().
+		SetStatus(record.Status).
+		SetReason(record.Reason).
+		SetFailedTasks(record.FailedTasks).
+		SetNillableStartTime(record.StartTime).
+		SetNillableEndTime(record.EndTime).
+		SetDurationMs(record.DurationMs)
+	if record.TriggerUser != "" {
+		update.SetTriggerUser(record.TriggerUser)
+	}
+	if len(record.Params) > 0 {
+		update.SetParams(record.Params)
+	}
+	return update.Save(ctx)
+}
+
+func isFinishedRunStatus(status string) bool {
+	switch status {
+	case RunStatusSucceeded, RunStatusFailed, RunStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// RunRecord is the history record of a run.
+type RunRecord struct {
+	UID         string
+	Kind        string
+	Namespace   string
+	Name        string
+	Pipeline    string
+	PipelineRun string
+	TriggerUser string
+	Params      map[string]string
+	Status      string
+	Reason      string
+	FailedTasks []string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	DurationMs  int
+}
+
+func newPipelineRunRecord(run *v1beta1.PipelineRun, params []string) RunRecord {
+	ret := RunRecord{
+		UID:         string(run.UID),
+		Kind:        RunKindPipelineRun,
+		Namespace:   run.Namespace,
+		Name:        run.Name,
+		Pipeline:    pipelineName(run),
+		TriggerUser: getTriggerUser(run),
+		Params:      recordedParams(run.Spec.Params, params),
+	}
+	if cond := run.Status.GetCondition(apis.ConditionSucceeded); cond != nil {
+		ret.Reason = cond.Reason
+	}
+	fillRecordTimes(&ret, run.Status.StartTime, run.Status.CompletionTime)
+	for _, tr := range run.Status.TaskRuns {
+		if tr.Status != nil && tr.Status.GetCondition(apis.ConditionSucceeded).IsFalse() {
+			ret.FailedTasks = append(ret.FailedTasks, tr.PipelineTaskName)
+		}
+	}
+	slices.Sort(ret.FailedTasks)
+
+	return ret
+}
+
+func newTaskRunRecord(run *v1beta1.TaskRun, params []string) RunRecord {
+	ret := RunRecord{
+		UID:         string(run.UID),
+		Kind:        RunKindTaskRun,
+		Namespace:   run.Namespace,
+		Name:        run.Name,
+		PipelineRun: run.Labels[pipeline.PipelineRunLabelKey],
+		TriggerUser: getTriggerUser(run),
+		Params:      recordedParams(run.Spec.Params, params),
+	}
+	if run.Spec.TaskRef != nil {
+		ret.Pipeline = run.Spec.TaskRef.Name
+	}
+	if cond := run.Status.GetCondition(apis.ConditionSucceeded); cond != nil {
+		ret.Reason = cond.Reason
+	}
+	fillRecordTimes(&ret, run.Status.StartTime, run.Status.CompletionTime)
+
+	return ret
+}
+
+func fillRecordTimes(r *RunRecord, startTime, endTime *metav1.Time) {
+	if startTime != nil {
+		r.StartTime = &startTime.Time
+	}
+	if endTime != nil {
+		r.EndTime = &endTime.Time
+	}
+	if startTime != nil && endTime != nil {
+		r.DurationMs = int(endTime.Sub(startTime.Time).Milliseconds())
+	}
+}
+
+func recordedParams(runParams v1beta1.Params, names []string) map[string]string {
+	ret := make(map[string]string)
+	for _, p := range runParams {
+		if !slices.Contains(names, p.Name) {
+			continue
+		}
+		if p.Value.Type == v1beta1.ParamTypeString {
+			ret[p.Name] = p.Value.StringVal
+		} else {
+			v, _ := p.Value.MarshalJSON()
+			ret[p.Name] = string(v)
+		}
+	}
+	return ret
+}
+
+func runStatus(eventType, reason string) string {
+	switch {
+	case strings.HasSuffix(eventType, ".successful.v1"):
+		return RunStatusSucceeded
+	case strings.HasSuffix(eventType, ".failed.v1") && slices.Contains(cancelledReasons, reason):
+		return RunStatusCancelled
+	case strings.HasSuffix(eventType, ".failed.v1"):
+		return RunStatusFailed
+	default:
+		return RunStatusRunning
+	}
+}
@@ -0,0 +1,185 @@
+package tekton
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/predicate"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/stats"
+)
+
+// ErrInvalidHistoryQuery is returned when the query parameters are invalid.
+var ErrInvalidHistoryQuery = errors.New("invalid history query")
+
+// RunHistory queries the duration and success rate statistics of the runs
+// recorded in the run history.
+type RunHistory struct {
+	Storage *ent.TektonRunClient
+}
+
+func NewRunHistory(db *ent.Client) *RunHistory {
+	return &RunHistory{Storage: db.TektonRun}
+}
+
+// HistoryQuery filters the runs started in the window.
+type HistoryQuery struct {
+	Kind      string `form:"kind" json:"kind,omitempty"` // pipelinerun(default) or taskrun.
+	Namespace string `form:"namespace" json:"namespace,omitempty"`
+	Pipeline  string `form:"pipeline" json:"pipeline,omitempty"` // pipeline or task name.
+	stats.Window
+}
+
+func (q *HistoryQuery) normalize() error {
+	switch q.Kind {
+	case "":
+		q.Kind = RunKindPipelineRun
+	case RunKindPipelineRun, RunKindTaskRun:
+	default:
+		return fmt.Errorf("%w: kind must be %s or %s", ErrInvalidHistoryQuery, RunKindPipelineRun, RunKindTaskRun)
+	}
+	q.Window.Normalize()
+
+	return nil
+}
+
+func (q HistoryQuery) predicates() []predicate.TektonRun {
+	ret := []predicate.TektonRun{
+		tektonrun.KindEQ(q.Kind),
+		tektonrun.StartTimeGTE(q.Since()),
+		tektonrun.StartTimeLT(q.Until),
+		tektonrun.StatusIn(RunStatusSucceeded, RunStatusFailed, RunStatusCancelled),
+	}
+	if q.Namespace != "" {
+		ret = append(ret, tektonrun.NamespaceEQ(q.Namespace))
+	}
+	if q.Pipeline != "" {
+		ret = append(ret, tektonrun.PipelineEQ(q.Pipeline))
+	}
+	return ret
+}
+
+// finishedRuns returns the finished runs started in the window.
+func (h *RunHistory) finishedRuns(ctx context.Context, q HistoryQuery) ([]*ent.TektonRun, error) {
+	return h.Storage.Query().
+		Where(q.predicates()...).
+		Select(
+			tektonrun.FieldNamespace,
+			tektonrun.FieldPipeline,
+			tektonrun.FieldStatus,
+			tektonrun.FieldStartTime,
+			tektonrun.FieldDurationMs,
+		).
+		All(ctx)
+}
+
+// RunStats is the statistics of the finished runs, the success rate excludes
+// the cancelled runs and the durations are of the succeeded runs.
+type RunStats struct {
+	Runs        int     `json:"runs"`
+	Succeeded   int     `json:"succeeded"`
+	Failed      int     `json:"failed"`
+	Cancelled   int     `json:"cancelled"`
+	SuccessRate float64 `json:"success_rate"`
+	stats.Durations
+}
+
+// PipelineStats is the run statistics of a pipeline or a task.
+type PipelineStats struct {
+	Namespace string `json:"namespace"`
+	Pipeline  string `json:"pipeline"`
+	RunStats
+}
+
+// Stats returns the statistics of the pipelines, sorted by the runs in
+// descending order.
+func (h *RunHistory) Stats(ctx context.Context, q HistoryQuery) ([]PipelineStats, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+
+	runs, err := h.finishedRuns(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[[2]string][]*ent.TektonRun)
+	for _, r := range runs {
+		key := [2]string{r.Namespace, r.Pipeline}
+		groups[key] = append(groups[key], r)
+	}
+	ret := make([]PipelineStats, 0, len(groups))
+	for key, group := range groups {
+		ret = append(ret, PipelineStats{Namespace: key[0], Pipeline: key[1], RunStats: newRunStats(group)})
+	}
+	slices.SortFunc(ret, func(a, b PipelineStats) int {
+		return cmp.Or(
+			cmp.Compare(b.Runs, a.Runs),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Pipeline, b.Pipeline),
+		)
+	})
+	if len(ret) > q.Limit {
+		ret = ret[:q.Limit]
+	}
+
+	return ret, nil
+}
+
+// RunTrendPoint is the run statistics of a pipeline in a day.
+type RunTrendPoint struct {
+	Date string `json:"date"`
+	RunStats
+}
+
+// Trend returns the daily statistics of the pipeline in the window.
+func (h *RunHistory) Trend(ctx context.Context, q HistoryQuery) ([]RunTrendPoint, error) {
+	if err := q.normalize(); err != nil {
+		return nil, err
+	}
+	if q.Pipeline == "" {
+		return nil, fmt.Errorf("%w: pipeline is required", ErrInvalidHistoryQuery)
+	}
+
+	runs, err := h.finishedRuns(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	daily := make(map[string][]*ent.TektonRun)
+	for _, r := range runs {
+		date := r.StartTime.UTC().Format(stats.DateLayout)
+		daily[date] = append(daily[date], r)
+	}
+	var ret []RunTrendPoint
+	for _, date := range q.Dates() {
+		ret = append(ret, RunTrendPoint{Date: date, RunStats: newRunStats(daily[date])})
+	}
+
+	return ret, nil
+}
+
+func newRunStats(runs []*ent.TektonRun) RunStats {
+	var ret RunStats
+	var durations []int
+	for _, r := range runs {
+		ret.Runs++
+		switch r.Status {
+		case RunStatusSucceeded:
+			ret.Succeeded++
+			durations = append(durations, r.DurationMs)
+		case RunStatusFailed:
+			ret.Failed++
+		case RunStatusCancelled:
+			ret.Cancelled++
+		}
+	}
+	ret.SuccessRate = stats.Rate(ret.Succeeded, ret.Succeeded+ret.Failed)
+	ret.Durations = stats.NewDurations(durations)
+
+	return ret
+}
@@ -0,0 +1,142 @@
+package tekton
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonrun"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/stats"
+)
+
+func Test_runHistoryHandler_Handle(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	h := &runHistoryHandler{Recorder: &RunHistoryRecorder{Storage: client.TektonRun}, Params: []string{"component", "git-ref"}}
+	ctx := context.Background()
+
+	for _, data := range [][]byte{
+		pipelineRunStartedEventBytes,
+		pipelineRunFailedEventBytes,
+		pipelineRunSuccessfulEventBytes,
+		pipelineRunRunningEventBytes,
+		standaloneTaskRunFailedEventBytes,
+	} {
+		event := cloudevents.NewEvent()
+		if err := event.UnmarshalJSON(data); err != nil {
+			t.Fatal(err)
+		}
+		if got := h.Handle(event); !cloudevents.IsACK(got) {
+			t.Fatalf("Handle(%s) = %v", event.Type(), got)
+		}
+	}
+
+	failed, err := client.TektonRun.Query().Where(tektonrun.RunUIDEQ("e4821549-eddb-4c78-a686-519c6f173a06")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Kind != RunKindPipelineRun || failed.Status != RunStatusFailed || failed.Pipeline != "pingcap-build-package" ||
+		failed.DurationMs != int((4*time.Minute+52*time.Second).Milliseconds()) ||
+		!reflect.DeepEqual(failed.FailedTasks, []string{"build-binaries"}) ||
+		failed.Params["component"] == "" || len(failed.Params) != 2 {
+		t.Errorf("failed pipeline run record = %+v", failed)
+	}
+
+	running, err := client.TektonRun.Query().Where(tektonrun.RunUIDEQ("1b0e81f9-ccfa-445e-a455-6c12f13646ee")).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if running.Status != RunStatusRunning || running.StartTime == nil || running.EndTime != nil {
+		t.Errorf("running pipeline run record = %+v", running)
+	}
+
+	taskRun, err := client.TektonRun.Query().Where(tektonrun.KindEQ(RunKindTaskRun)).Only(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if taskRun.Status != RunStatusFailed || taskRun.DurationMs == 0 {
+		t.Errorf("task run record = %+v", taskRun)
+	}
+
+	// the delayed running event does not update the finished run.
+	if _, err := h.Recorder.Save(ctx, RunRecord{UID: failed.RunUID, Kind: RunKindPipelineRun, Status: RunStatusRunning}); err != nil {
+		t.Fatal(err)
+	}
+	if got := client.TektonRun.GetX(ctx, failed.ID); got.Status != RunStatusFailed || got.DurationMs != failed.DurationMs {
+		t.Errorf("record after the delayed running event = %+v", got)
+	}
+}
+
+func TestRunHistory(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	history := &RunHistory{Storage: client.TektonRun}
+	ctx := context.Background()
+
+	until := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	day1 := until.AddDate(0, 0, -2).Add(time.Hour)
+	day2 := until.AddDate(0, 0, -1).Add(time.Hour)
+	for i, r := range []struct {
+		pipeline string
+		status   string
+		start    time.Time
+		duration int
+	}{
+		{"build", RunStatusSucceeded, day1, 1000},
+		{"build", RunStatusSucceeded, day1, 3000},
+		{"build", RunStatusFailed, day1, 500},
+		{"build", RunStatusSucceeded, day2, 2000},
+		{"build", RunStatusCancelled, day2, 100},
+		{"build", RunStatusRunning, day2, 0},
+		{"test", RunStatusFailed, day2, 100},
+		{"build", RunStatusSucceeded, until.AddDate(0, 0, -30), 9000}, // out of the window.
+	} {
+		client.TektonRun.Create().
+			SetRunUID(string(rune('a' + i))).
+			SetKind(RunKindPipelineRun).
+			SetNamespace("ee-cd").
+			SetName(r.pipeline + "-run").
+			SetPipeline(r.pipeline).
+			SetStatus(r.status).
+			SetStartTime(r.start).
+			SetDurationMs(r.duration).
+			ExecX(ctx)
+	}
+
+	got, err := history.Stats(ctx, HistoryQuery{Window: stats.Window{Until: until}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStats := []PipelineStats{
+		{Namespace: "ee-cd", Pipeline: "build", RunStats: RunStats{Runs: 5, Succeeded: 3, Failed: 1, Cancelled: 1, SuccessRate: 0.75, Durations: stats.Durations{P50Ms: 2000, P90Ms: 3000, P99Ms: 3000, MaxMs: 3000}}},
+		{Namespace: "ee-cd", Pipeline: "test", RunStats: RunStats{Runs: 1, Failed: 1}},
+	}
+	if !reflect.DeepEqual(got, wantStats) {
+		t.Errorf("Stats() = %+v, want %+v", got, wantStats)
+	}
+
+	trend, err := history.Trend(ctx, HistoryQuery{Pipeline: "build", Window: stats.Window{Days: 2, Until: until}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTrend := []RunTrendPoint{
+		{Date: "2024-01-08", RunStats: RunStats{Runs: 3, Succeeded: 2, Failed: 1, SuccessRate: 0.6667, Durations: stats.Durations{P50Ms: 1000, P90Ms: 3000, P99Ms: 3000, MaxMs: 3000}}},
+		{Date: "2024-01-09", RunStats: RunStats{Runs: 2, Succeeded: 1, Cancelled: 1, SuccessRate: 1, Durations: stats.Durations{P50Ms: 2000, P90Ms: 2000, P99Ms: 2000, MaxMs: 2000}}},
+		{Date: "2024-01-10"},
+	}
+	if !reflect.DeepEqual(trend, wantTrend) {
+		t.Errorf("Trend() = %+v, want %+v", trend, wantTrend)
+	}
+
+	if _, err := history.Stats(ctx, HistoryQuery{Kind: "customrun"}); err == nil {
+		t.Error("Stats() of unknown kind want error")
+	}
+	if _, err := history.Trend(ctx, HistoryQuery{}); err == nil {
+		t.Error("Trend() without pipeline want error")
+	}
+}
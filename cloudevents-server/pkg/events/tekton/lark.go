@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"regexp"
 	"strings"
 	"text/template"
 
@@ -20,41 +19,16 @@ import (
 	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
 	"github.com/rs/zerolog/log"
 
+	larkutil "github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/lark"
+
 	_ "embed"
 )
 
 //go:embed lark_templates/tekton-run-notify.yaml.tmpl
 var larkTemplateBytes string
 
-// receiver formats:
-// - Open ID: ou_......
-// - Union ID: on_......
-// - Chat ID: oc_......
-// - Email: some email address.
-// - User ID: I do not know
-var (
-	reLarkOpenID  = regexp.MustCompile(`^ou_\w+`)
-	reLarkUnionID = regexp.MustCompile(`^on_\w+`)
-	reLarkChatID  = regexp.MustCompile(`^oc_\w+`)
-	reLarkEmail   = regexp.MustCompile(`^\S+@\S+\.\S+$`)
-)
-
-func getReceiverIDType(id string) string {
-	switch {
-	case reLarkOpenID.MatchString(id):
-		return larkim.ReceiveIdTypeOpenId
-	case reLarkUnionID.MatchString(id):
-		return larkim.ReceiveIdTypeUnionId
-	case reLarkChatID.MatchString(id):
-		return larkim.ReceiveIdTypeChatId
-	case reLarkEmail.MatchString(id):
-		return larkim.ReceiveIdTypeEmail
-	default:
-		return larkim.ReceiveIdTypeUserId
-	}
-}
-
-func composeAndSendLarkMessages(client *lark.Client, receivers []string, infos *cardMessageInfos) protocol.Result {
+// ComposeAndSendLarkMessages sends a new lark card of the infos to every receiver.
+func ComposeAndSendLarkMessages(client *lark.Client, receivers []string, infos *CardMessageInfos) protocol.Result {
 	createMsgReqs, err := composeLarkMessages(receivers, infos)
 	if err != nil {
 		log.Err(err).Stack().Any("infos", infos).Msg("compose lark message failed")
@@ -72,25 +46,31 @@ func composeAndSendLarkMessages(client *lark.Client, receivers []string, infos *
 }
 
 func sendLarkMessage(client *lark.Client, createMsgReq *larkim.CreateMessageReq) error {
-	resp, err := client.Im.Message.Create(context.Background(), createMsgReq)
+	_, err := createLarkMessage(context.Background(), client, createMsgReq)
+	return err
+}
+
+// createLarkMessage sends the message and returns the message id.
+func createLarkMessage(ctx context.Context, client *lark.Client, createMsgReq *larkim.CreateMessageReq) (string, error) {
+	resp, err := client.Im.Message.Create(ctx, createMsgReq)
 	if err != nil {
 		log.Err(err).Stack().Msg("send lark message failed")
-		return cloudevents.NewReceipt(true, "send lark message failed: %v", err)
+		return "", cloudevents.NewReceipt(true, "send lark message failed: %v", err)
 	}
 
 	if !resp.Success() {
 		log.Error().Msg(string(resp.RawBody))
-		return cloudevents.ResultNACK
+		return "", cloudevents.ResultNACK
 	}
 
 	log.Info().
 		Str("request-id", resp.RequestId()).
 		Str("message-id", *resp.Data.MessageId).
 		Msg("send lark message successfully.")
-	return nil
+	return *resp.Data.MessageId, nil
 }
 
-func composeLarkMessages(receivers []string, infos *cardMessageInfos) ([]*larkim.CreateMessageReq, error) {
+func composeLarkMessages(receivers []string, infos *CardMessageInfos) ([]*larkim.CreateMessageReq, error) {
 	if infos == nil {
 		return nil, nil
 	}
@@ -110,7 +90,7 @@ func composeLarkMessages(receivers []string, infos *cardMessageInfos) ([]*larkim
 
 func newMessageReq(receiver string, messageRawStr string) *larkim.CreateMessageReq {
 	return larkim.NewCreateMessageReqBuilder().
-		ReceiveIdType(getReceiverIDType(receiver)).
+		ReceiveIdType(larkutil.ReceiverIDType(receiver)).
 		Body(larkim.NewCreateMessageReqBodyBuilder().
 			MsgType(larkim.MsgTypeInteractive).
 			ReceiveId(receiver).
@@ -120,7 +100,7 @@ func newMessageReq(receiver string, messageRawStr string) *larkim.CreateMessageR
 		Build()
 }
 
-func newLarkCardWithGoTemplate(infos *cardMessageInfos) (string, error) {
+func newLarkCardWithGoTemplate(infos *CardMessageInfos) (string, error) {
 	tmpl, err := template.New("lark").Funcs(sprig.FuncMap()).Parse(larkTemplateBytes)
 	if err != nil {
 		return "", err
@@ -0,0 +1,100 @@
+package tekton
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	lark "github.com/larksuite/oapi-sdk-go/v3"
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+	"github.com/rs/zerolog/log"
+	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/larkruncard"
+)
+
+// larkCardUpdater sends one lark card to a receiver for a run, and patches the
+// card as the run moves from started to running to succeeded or failed.
+type larkCardUpdater struct {
+	LarkClient *lark.Client
+	Storage    *ent.LarkRunCardClient
+}
+
+// isFinalEventType returns true for the events of the finished runs.
+func isFinalEventType(eventType string) bool {
+	switch tektoncloudevent.TektonEventType(eventType) {
+	case tektoncloudevent.PipelineRunFailedEventV1,
+		tektoncloudevent.PipelineRunSuccessfulEventV1:
+		return true
+	default:
+		return false
+	}
+}
+
+func (u *larkCardUpdater) sendOrUpdate(ctx context.Context, runUID, eventType string, receivers []string, infos *CardMessageInfos) protocol.Result {
+	if infos == nil {
+		return cloudevents.ResultACK
+	}
+
+	content, err := newLarkCardWithGoTemplate(infos)
+	if err != nil {
+		log.Err(err).Stack().Any("infos", infos).Msg("compose lark message failed")
+		return cloudevents.NewReceipt(true, "compose lark message failed: %v", err)
+	}
+
+	for _, r := range receivers {
+		if err := u.sendOrUpdateOne(ctx, runUID, eventType, r, content); err != nil {
+			return err
+		}
+	}
+
+	return cloudevents.ResultACK
+}
+
+func (u *larkCardUpdater) sendOrUpdateOne(ctx context.Context, runUID, eventType, receiver, content string) error {
+	card, err := u.Storage.Query().
+		Where(larkruncard.RunUIDEQ(runUID), larkruncard.ReceiverEQ(receiver)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return cloudevents.NewReceipt(true, "query lark card failed: %v", err)
+	}
+
+	cardLog := log.With().Str("run-uid", runUID).Str("receiver", receiver).Str("ce-type", eventType).Logger()
+	if card != nil {
+		// the events may be delivered out of order, the finished card is kept.
+		if isFinalEventType(card.EventType) && !isFinalEventType(eventType) {
+			cardLog.Debug().Str("card-type", card.EventType).Msg("skip updating the card of the finished run.")
+			return nil
+		}
+
+		req := larkim.NewPatchMessageReqBuilder().
+			MessageId(card.MessageID).
+			Body(larkim.NewPatchMessageReqBodyBuilder().Content(content).Build()).
+			Build()
+		resp, err := u.LarkClient.Im.Message.Patch(ctx, req)
+		switch {
+		case err != nil:
+			cardLog.Err(err).Str("message-id", card.MessageID).Msg("update lark card failed, will send a new one.")
+		case !resp.Success():
+			cardLog.Warn().Int("code", resp.Code).Str("msg", resp.Msg).Str("message-id", card.MessageID).Msg("update lark card failed, will send a new one.")
+		default:
+			cardLog.Debug().Str("message-id", card.MessageID).Msg("lark card updated.")
+			return card.Update().SetEventType(eventType).Exec(ctx)
+		}
+	}
+
+	messageID, err := createLarkMessage(ctx, u.LarkClient, newMessageReq(receiver, content))
+	if err != nil {
+		return err
+	}
+	if card != nil {
+		return card.Update().SetMessageID(messageID).SetEventType(eventType).Exec(ctx)
+	}
+	return u.Storage.Create().
+		SetRunUID(runUID).
+		SetReceiver(receiver).
+		SetMessageID(messageID).
+		SetEventType(eventType).
+		Exec(ctx)
+}
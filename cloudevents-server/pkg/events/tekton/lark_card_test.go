@@ -0,0 +1,99 @@
+package tekton
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	lark "github.com/larksuite/oapi-sdk-go/v3"
+	_ "github.com/mattn/go-sqlite3"
+	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+)
+
+// fakeLark serves the message api of the lark open platform.
+type fakeLark struct {
+	mu         sync.Mutex
+	created    []string // ids of the created messages.
+	patched    []string // ids of the patched messages.
+	patchFails bool
+}
+
+func newFakeLark(t *testing.T) (*fakeLark, *lark.Client) {
+	f := new(fakeLark)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /open-apis/auth/v3/tenant_access_token/internal", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"code":0,"tenant_access_token":"t-test","expire":7200}`)
+	})
+	mux.HandleFunc("POST /open-apis/im/v1/messages", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		id := fmt.Sprintf("om_%d", len(f.created)+1)
+		f.created = append(f.created, id)
+		_ = json.NewEncoder(w).Encode(map[string]any{"code": 0, "data": map[string]any{"message_id": id}})
+	})
+	mux.HandleFunc("PATCH /open-apis/im/v1/messages/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.patchFails {
+			fmt.Fprint(w, `{"code":230011,"msg":"The message was withdrawn."}`)
+			return
+		}
+		f.patched = append(f.patched, r.PathValue("id"))
+		fmt.Fprint(w, `{"code":0}`)
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return f, lark.NewClient("app-id", "app-secret", lark.WithOpenBaseUrl(server.URL), lark.WithEnableTokenCache(false))
+}
+
+func Test_larkCardUpdater_sendOrUpdate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	fake, larkClient := newFakeLark(t)
+	u := &larkCardUpdater{LarkClient: larkClient, Storage: client.LarkRunCard}
+
+	ctx := context.Background()
+	send := func(eventType tektoncloudevent.TektonEventType) {
+		t.Helper()
+		infos := &CardMessageInfos{Title: newLarkTitle(string(eventType), "test-run")}
+		if result := u.sendOrUpdate(ctx, "run-uid", string(eventType), []string{receiver}, infos); !cloudevents.IsACK(result) {
+			t.Fatalf("sendOrUpdate(%s) = %v, want ACK", eventType, result)
+		}
+	}
+
+	send(tektoncloudevent.PipelineRunStartedEventV1)
+	send(tektoncloudevent.PipelineRunRunningEventV1)
+	send(tektoncloudevent.PipelineRunFailedEventV1)
+	// the delayed running event should not update the finished card.
+	send(tektoncloudevent.PipelineRunRunningEventV1)
+	if len(fake.created) != 1 || len(fake.patched) != 2 {
+		t.Fatalf("created = %v, patched = %v, want 1 created and 2 patched", fake.created, fake.patched)
+	}
+
+	card := client.LarkRunCard.Query().OnlyX(ctx)
+	if card.MessageID != "om_1" || card.EventType != string(tektoncloudevent.PipelineRunFailedEventV1) {
+		t.Errorf("card = %v, want message om_1 with failed event type", card)
+	}
+
+	// a new card is sent when the card can not be updated.
+	fake.patchFails = true
+	send(tektoncloudevent.PipelineRunSuccessfulEventV1)
+	if len(fake.created) != 2 {
+		t.Fatalf("created = %v, want a new card", fake.created)
+	}
+	if card := client.LarkRunCard.Query().OnlyX(ctx); card.MessageID != "om_2" {
+		t.Errorf("card message id = %s, want om_2", card.MessageID)
+	}
+}
@@ -1,6 +1,7 @@
 package tekton
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
@@ -10,12 +11,12 @@ import (
 func Test_newLarkCardWithGoTemplate(t *testing.T) {
 	tests := []struct {
 		name    string
-		infos   *cardMessageInfos
+		infos   *CardMessageInfos
 		wantErr bool
 	}{
 		{
 			name: "step log contains control characters",
-			infos: &cardMessageInfos{
+			infos: &CardMessageInfos{
 				Title: "title",
 				FailedTasks: map[string][]stepInfo{
 					"hello": {
@@ -42,6 +43,10 @@ func Test_newLarkCardWithGoTemplate(t *testing.T) {
 				t.Errorf("newLarkCardWithGoTemplate() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			// only the shared cards can be updated by the later events.
+			if !tt.wantErr && !strings.Contains(got, `"update_multi":true`) {
+				t.Errorf("newLarkCardWithGoTemplate() = %s, want a shared card", got)
+			}
 		})
 	}
 }
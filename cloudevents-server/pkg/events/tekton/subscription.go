@@ -0,0 +1,185 @@
+package tekton
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/tektonsubscription"
+)
+
+var (
+	// ErrInvalidSubscription is returned when the subscription is invalid.
+	ErrInvalidSubscription = errors.New("invalid subscription")
+	// ErrSubscriptionNotFound is returned when the subscription to delete is not found.
+	ErrSubscriptionNotFound = errors.New("subscription not found")
+)
+
+const pipelineLabelKey = "tekton.dev/pipeline"
+
+// Subscription subscribes the tekton runs to notify the receiver.
+type Subscription struct {
+	ID            int      `json:"id,omitempty"`
+	Receiver      string   `json:"receiver"`
+	Namespace     string   `json:"namespace,omitempty"`
+	Pipeline      string   `json:"pipeline,omitempty"`       // glob pattern of the pipeline name.
+	LabelSelector string   `json:"label_selector,omitempty"` // kubernetes label selector.
+	EventTypes    []string `json:"event_types,omitempty"`    // glob patterns of the event types.
+	CreatedBy     string   `json:"created_by,omitempty"`
+}
+
+func newSubscription(s *ent.TektonSubscription) Subscription {
+	return Subscription{
+		ID:            s.ID,
+		Receiver:      s.Receiver,
+		Namespace:     s.Namespace,
+		Pipeline:      s.Pipeline,
+		LabelSelector: s.LabelSelector,
+		EventTypes:    s.EventTypes,
+		CreatedBy:     s.CreatedBy,
+	}
+}
+
+// Validate checks the receiver and the patterns of the subscription.
+func (s Subscription) Validate() error {
+	if strings.TrimSpace(s.Receiver) == "" {
+		return fmt.Errorf("%w: receiver is required", ErrInvalidSubscription)
+	}
+	if _, err := path.Match(s.Pipeline, ""); err != nil {
+		return fmt.Errorf("%w: pipeline pattern: %v", ErrInvalidSubscription, err)
+	}
+	if _, err := labels.Parse(s.LabelSelector); err != nil {
+		return fmt.Errorf("%w: label selector: %v", ErrInvalidSubscription, err)
+	}
+	for _, t := range s.EventTypes {
+		if _, err := path.Match(t, ""); err != nil {
+			return fmt.Errorf("%w: event type pattern %q: %v", ErrInvalidSubscription, t, err)
+		}
+	}
+	return nil
+}
+
+// matches returns true when the event of the pipeline run is subscribed.
+func (s Subscription) matches(eventType string, run *v1beta1.PipelineRun) bool {
+	if s.Namespace != "" && s.Namespace != run.Namespace {
+		return false
+	}
+	if s.Pipeline != "" {
+		if ok, _ := path.Match(s.Pipeline, pipelineName(run)); !ok {
+			return false
+		}
+	}
+	if s.LabelSelector != "" {
+		selector, err := labels.Parse(s.LabelSelector)
+		if err != nil || !selector.Matches(labels.Set(run.Labels)) {
+			return false
+		}
+	}
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range s.EventTypes {
+		if ok, _ := path.Match(t, eventType); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func pipelineName(run *v1beta1.PipelineRun) string {
+	if name := run.Labels[pipelineLabelKey]; name != "" {
+		return name
+	}
+	if run.Spec.PipelineRef != nil {
+		return run.Spec.PipelineRef.Name
+	}
+	return ""
+}
+
+// SubscriptionStore stores the subscriptions of the tekton runs.
+type SubscriptionStore struct {
+	Storage *ent.TektonSubscriptionClient
+}
+
+func NewSubscriptionStore(db *ent.Client) *SubscriptionStore {
+	return &SubscriptionStore{Storage: db.TektonSubscription}
+}
+
+// Create saves the subscription and returns it with the id.
+func (s *SubscriptionStore) Create(ctx context.Context, sub Subscription) (Subscription, error) {
+	if err := sub.Validate(); err != nil {
+		return Subscription{}, err
+	}
+
+	created, err := s.Storage.Create().
+		SetReceiver(strings.TrimSpace(sub.Receiver)).
+		SetNamespace(sub.Namespace).
+		SetPipeline(sub.Pipeline).
+		SetLabelSelector(sub.LabelSelector).
+		SetEventTypes(sub.EventTypes).
+		SetCreatedBy(sub.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	return newSubscription(created), nil
+}
+
+// List returns the subscriptions of the receiver, all the subscriptions when
+// the receiver is empty.
+func (s *SubscriptionStore) List(ctx context.Context, receiver string) ([]Subscription, error) {
+	query := s.Storage.Query().Order(ent.Asc(tektonsubscription.FieldID))
+	if receiver != "" {
+		query.Where(tektonsubscription.ReceiverEQ(receiver))
+	}
+	subs, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]Subscription, 0, len(subs))
+	for _, sub := range subs {
+		ret = append(ret, newSubscription(sub))
+	}
+	return ret, nil
+}
+
+// Delete deletes the subscription, it must belong to the receiver when the
+// receiver is not empty.
+func (s *SubscriptionStore) Delete(ctx context.Context, id int, receiver string) error {
+	deletion := s.Storage.Delete().Where(tektonsubscription.IDEQ(id))
+	if receiver != "" {
+		deletion.Where(tektonsubscription.ReceiverEQ(receiver))
+	}
+	n, err := deletion.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrSubscriptionNotFound
+	}
+	return nil
+}
+
+// Receivers returns the receivers subscribed the event of the pipeline run.
+func (s *SubscriptionStore) Receivers(ctx context.Context, eventType string, run *v1beta1.PipelineRun) ([]string, error) {
+	subs, err := s.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	for _, sub := range subs {
+		if sub.matches(eventType, run) {
+			ret = append(ret, sub.Receiver)
+		}
+	}
+	return ret, nil
+}
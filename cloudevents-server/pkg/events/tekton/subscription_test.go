@@ -0,0 +1,89 @@
+package tekton
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
+	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/ent/enttest"
+)
+
+func TestSubscriptionStore(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	store := &SubscriptionStore{Storage: client.TektonSubscription}
+	ctx := context.Background()
+
+	for _, sub := range []Subscription{
+		{Receiver: "all@example.com"},
+		{Receiver: "oc_group", Namespace: "ee-cd", Pipeline: "build-*", EventTypes: []string{"*.failed.v1"}},
+		{Receiver: "tidb@example.com", LabelSelector: "repo in (tidb,tikv),!skip-notify"},
+		{Receiver: "other-ns@example.com", Namespace: "other"},
+	} {
+		if _, err := store.Create(ctx, sub); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	for _, invalid := range []Subscription{
+		{},
+		{Receiver: "a@example.com", Pipeline: "[bad"},
+		{Receiver: "a@example.com", LabelSelector: "a in b"},
+	} {
+		if _, err := store.Create(ctx, invalid); !errors.Is(err, ErrInvalidSubscription) {
+			t.Errorf("Create(%+v) error = %v, want invalid", invalid, err)
+		}
+	}
+
+	run := &v1beta1.PipelineRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ee-cd",
+			Name:      "build-tidb-abcde",
+			Labels:    map[string]string{pipelineLabelKey: "build-tidb", "repo": "tidb"},
+		},
+	}
+	tests := []struct {
+		name      string
+		eventType tektoncloudevent.TektonEventType
+		labels    map[string]string
+		want      []string
+	}{
+		{name: "failed", eventType: tektoncloudevent.PipelineRunFailedEventV1, want: []string{"all@example.com", "oc_group", "tidb@example.com"}},
+		{name: "event type not matched", eventType: tektoncloudevent.PipelineRunStartedEventV1, want: []string{"all@example.com", "tidb@example.com"}},
+		{name: "labels not matched", eventType: tektoncloudevent.PipelineRunStartedEventV1, labels: map[string]string{"skip-notify": "true"}, want: []string{"all@example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := run.DeepCopy()
+			for k, v := range tt.labels {
+				r.Labels[k] = v
+			}
+			got, err := store.Receivers(ctx, string(tt.eventType), r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Receivers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	subs, err := store.List(ctx, "oc_group")
+	if err != nil || len(subs) != 1 {
+		t.Fatalf("List() = %v, %v, want 1 subscription", subs, err)
+	}
+	if err := store.Delete(ctx, subs[0].ID, "all@example.com"); !errors.Is(err, ErrSubscriptionNotFound) {
+		t.Errorf("Delete() of others = %v, want not found", err)
+	}
+	if err := store.Delete(ctx, subs[0].ID, "oc_group"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+	if subs, _ := store.List(ctx, ""); len(subs) != 3 {
+		t.Errorf("List() after delete = %d subscriptions, want 3", len(subs))
+	}
+}
@@ -6,19 +6,21 @@ import (
 	"github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
 )
 
-type cardMessageInfos struct {
-	Title         string
-	TitleTemplate string
-	RerunURL      string
-	ViewURL       string
-	StartTime     string
-	EndTime       string
-	TimeCost      string
-	FailedMessage string
-	Params        [][2]string           // key-value pairs.
-	Results       [][2]string           // Key-Value pairs.
-	StepStatuses  []stepInfo            // name-status pairs.
-	FailedTasks   map[string][]stepInfo // task id => step statuses.
+// CardMessageInfos are the infos rendered in the lark card of a run.
+type CardMessageInfos struct {
+	Title           string
+	TitleTemplate   string
+	RerunURL        string
+	ViewURL         string
+	StartTime       string
+	EndTime         string
+	TimeCost        string
+	FailedMessage   string
+	FailureCategory string                // classified category of the failed run.
+	Params          [][2]string           // key-value pairs.
+	Results         [][2]string           // Key-Value pairs.
+	StepStatuses    []stepInfo            // name-status pairs.
+	FailedTasks     map[string][]stepInfo // task id => step statuses.
 }
 
 type stepInfo struct {
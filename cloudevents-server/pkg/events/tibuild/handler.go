@@ -5,21 +5,21 @@ import (
 	"fmt"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
-	"github.com/cloudevents/sdk-go/v2/client"
 	"github.com/rs/zerolog/log"
 	tektoncloudevent "github.com/tektoncd/pipeline/pkg/reconciler/events/cloudevent"
 
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
 	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/handler"
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/events/webhook"
 )
 
-// NewHandler creates a new SinkHandler with the specified sink URL.
+// NewHandler creates a new SinkHandler with the specified sinks.
 func NewHandler(cfg config.TiBuild) (handler.EventHandler, error) {
-	routeToTbHandler, err := newRouteHandler(cfg.ResultSinkURL)
+	routeToTbHandler, err := newRouteHandler(cfg.ResultSink, cfg.ResultSinkURL)
 	if err != nil {
 		return nil, err
 	}
-	routeToTektonHandler, err := newRouteHandler(cfg.TriggerSinkURL)
+	routeToTektonHandler, err := newRouteHandler(cfg.TriggerSink, cfg.TriggerSinkURL)
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +57,10 @@ func (h *resultHandler) SupportEventTypes() []string {
 	}
 }
 
-// routeHandler is an event handler that forward events to target sink URL using the CloudEvents SDK.
+// routeHandler is an event handler that forward events to target sink with the webhook sink.
 type routeHandler struct {
 	sinkURL string // tibuild's sink URL.
-	client  client.Client
+	sink    *webhook.Sink
 }
 
 // Handle routes the given event to the specified sink URL using the CloudEvents SDK.
@@ -70,14 +70,20 @@ func (h *routeHandler) Handle(event cloudevents.Event) cloudevents.Result {
 		Str("ce-type", event.Type()).
 		Any("detail", event).
 		Msg("Send to sinker")
-	return h.client.Send(context.Background(), event)
+	return h.sink.Send(context.Background(), event)
 }
 
-func newRouteHandler(sinkURL string) (*routeHandler, error) {
-	client, err := cloudevents.NewClientHTTP(cloudevents.WithTarget(sinkURL))
+// newRouteHandler creates the handler with the webhook config, or the sink url
+// with the default webhook options when the config is nil.
+func newRouteHandler(cfg *config.Webhook, sinkURL string) (*routeHandler, error) {
+	webhookCfg := config.Webhook{URL: sinkURL}
+	if cfg != nil {
+		webhookCfg = *cfg
+	}
+	sink, err := webhook.New(webhookCfg)
 	if err != nil {
-		return nil, fmt.Errorf("error creating client: %v", err)
+		return nil, fmt.Errorf("error creating sink: %v", err)
 	}
 
-	return &routeHandler{client: client, sinkURL: sinkURL}, nil
+	return &routeHandler{sink: sink, sinkURL: webhookCfg.URL}, nil
 }
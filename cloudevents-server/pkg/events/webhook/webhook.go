@@ -0,0 +1,208 @@
+// Package webhook implement the http webhook sink of the cloud events.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/rs/zerolog/log"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+// Modes of the cloudevents http binding.
+const (
+	ModeBinary     = "binary"
+	ModeStructured = "structured"
+)
+
+const (
+	defaultSignatureHeader = "X-CloudEvents-Signature-256"
+	defaultTimeout         = 10 * time.Second
+	defaultMaxAttempts     = 5
+	defaultInitialInterval = 500 * time.Millisecond
+	defaultMaxInterval     = 30 * time.Second
+	defaultBudget          = 2 * time.Minute
+)
+
+// Sink sends the events to the webhook url, failed attempts are retried with
+// exponential backoff in the retry budget.
+type Sink struct {
+	cfg             config.Webhook
+	client          *http.Client
+	maxAttempts     int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	budget          time.Duration
+}
+
+func New(cfg config.Webhook) (*Sink, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("url is required")
+	}
+	switch cfg.Mode {
+	case "":
+		cfg.Mode = ModeBinary
+	case ModeBinary, ModeStructured:
+	default:
+		return nil, fmt.Errorf("unsupported mode: %q", cfg.Mode)
+	}
+	if cfg.SignatureHeader == "" {
+		cfg.SignatureHeader = defaultSignatureHeader
+	}
+
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ret := &Sink{
+		cfg:             cfg,
+		client:          &http.Client{Timeout: timeout},
+		maxAttempts:     cfg.Retry.MaxAttempts,
+		initialInterval: time.Duration(cfg.Retry.InitialIntervalMs) * time.Millisecond,
+		maxInterval:     time.Duration(cfg.Retry.MaxIntervalSeconds) * time.Second,
+		budget:          time.Duration(cfg.Retry.BudgetSeconds) * time.Second,
+	}
+	if ret.maxAttempts <= 0 {
+		ret.maxAttempts = defaultMaxAttempts
+	}
+	if ret.initialInterval <= 0 {
+		ret.initialInterval = defaultInitialInterval
+	}
+	if ret.maxInterval <= 0 {
+		ret.maxInterval = defaultMaxInterval
+	}
+	if ret.budget <= 0 {
+		ret.budget = defaultBudget
+	}
+
+	return ret, nil
+}
+
+// Timeout returns the longest duration of a send, it is the retry budget plus
+// the timeout of the last attempt.
+func (s *Sink) Timeout() time.Duration {
+	return s.budget + s.client.Timeout
+}
+
+// Send sends the event, the returned error is a cloudevents http result with
+// the last http status when the webhook responded.
+func (s *Sink) Send(ctx context.Context, event cloudevents.Event) error {
+	header, body, err := s.encode(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to encode the event: %w", err)
+	}
+
+	deadline := time.Now().Add(s.budget)
+	interval := s.initialInterval
+	for attempt := 1; ; attempt++ {
+		err := s.send(ctx, header, body)
+		if err == nil {
+			return nil
+		}
+
+		retryable := isRetryable(err)
+		log.Warn().Err(err).
+			Str("url", s.cfg.URL).
+			Str("ce-id", event.ID()).
+			Int("attempt", attempt).
+			Bool("retryable", retryable).
+			Msg("failed to send event to webhook")
+		if !retryable || attempt >= s.maxAttempts || time.Now().Add(interval).After(deadline) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, s.maxInterval)
+	}
+}
+
+// encode encodes the event in the http binding once for all the attempts.
+func (s *Sink) encode(ctx context.Context, event cloudevents.Event) (http.Header, []byte, error) {
+	if s.cfg.Mode == ModeStructured {
+		ctx = binding.WithForceStructured(ctx)
+	} else {
+		ctx = binding.WithForceBinary(ctx)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cehttp.WriteRequest(ctx, binding.ToMessage(&event), req); err != nil {
+		return nil, nil, err
+	}
+	var body []byte
+	if req.Body != nil {
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	if s.cfg.Secret != "" {
+		req.Header.Set(s.cfg.SignatureHeader, Sign(s.cfg.Secret, body))
+	}
+
+	return req.Header, body, nil
+}
+
+func (s *Sink) send(ctx context.Context, header http.Header, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header = header.Clone()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	return cehttp.NewResult(resp.StatusCode, "webhook responded %s: %s", resp.Status, bytes.TrimSpace(respBody))
+}
+
+// isRetryable returns false for the client errors except timeout and rate
+// limiting, the retries will not succeed for them.
+func isRetryable(err error) bool {
+	var result *cehttp.Result
+	if !cloudevents.ResultAs(err, &result) {
+		return true
+	}
+
+	switch result.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return result.StatusCode >= http.StatusInternalServerError
+	}
+}
+
+// Sign returns the HMAC-SHA256 signature of the body in `sha256=<hex>` format.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"github.com/PingCAP-QE/ee-apps/cloudevents-server/pkg/config"
+)
+
+func newTestEvent() cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("1")
+	event.SetSource("test")
+	event.SetType("dev.tekton.event.pipelinerun.failed.v1")
+	_ = event.SetData(cloudevents.ApplicationJSON, map[string]string{"k": "v"})
+	return event
+}
+
+func TestSink_Send(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		statuses     []int // statuses of the attempts, the last one repeats.
+		wantAttempts int32
+		wantStatus   int // status of the returned result, 0 for success.
+	}{
+		{name: "binary", mode: ModeBinary, statuses: []int{http.StatusAccepted}, wantAttempts: 1},
+		{name: "structured", mode: ModeStructured, statuses: []int{http.StatusOK}, wantAttempts: 1},
+		{name: "retry until success", statuses: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, wantAttempts: 3},
+		{name: "retry exhausted", statuses: []int{http.StatusBadGateway}, wantAttempts: 3, wantStatus: http.StatusBadGateway},
+		{name: "permanent failure", statuses: []int{http.StatusBadRequest}, wantAttempts: 1, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(attempts.Add(1))
+				body, _ := io.ReadAll(r.Body)
+				if got, want := r.Header.Get("X-CloudEvents-Signature-256"), Sign("secret", body); got != want {
+					t.Errorf("signature = %s, want %s", got, want)
+				}
+				if r.Header.Get("X-Team") != "ee" {
+					t.Errorf("missing custom header")
+				}
+				switch tt.mode {
+				case ModeStructured:
+					if ct := r.Header.Get("Content-Type"); ct != cloudevents.ApplicationCloudEventsJSON {
+						t.Errorf("content type = %s, want structured", ct)
+					}
+				default:
+					if r.Header.Get("Ce-Id") != "1" {
+						t.Errorf("missing binary mode ce-id header")
+					}
+				}
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			sink, err := New(config.Webhook{
+				URL:     server.URL,
+				Mode:    tt.mode,
+				Headers: map[string]string{"X-Team": "ee"},
+				Secret:  "secret",
+				Retry:   config.WebhookRetry{MaxAttempts: 3, InitialIntervalMs: 1},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = sink.Send(context.Background(), newTestEvent())
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Errorf("Send() error = %v", err)
+				}
+				return
+			}
+			var result *cehttp.Result
+			if !cloudevents.ResultAs(err, &result) || result.StatusCode != tt.wantStatus {
+				t.Errorf("Send() = %v, want http result with status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
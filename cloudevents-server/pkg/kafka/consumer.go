@@ -1,6 +1,9 @@
 package kafka
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/rs/zerolog/log"
 	kafka "github.com/segmentio/kafka-go"
 )
@@ -24,3 +27,65 @@ func NewReader(auth Authentication, brokers []string, topic, consumerGroupID, cl
 
 	return kafka.NewReader(readerConfig), nil
 }
+
+// PartitionReader reads a partition of the topic without consumer group.
+type PartitionReader struct {
+	*kafka.Reader
+	// EndOffset is the offset of the next message when the reader is created.
+	EndOffset int64
+}
+
+// NewPartitionReaders creates readers of all the non-empty partitions of the
+// topic, they read from the first offset.
+func NewPartitionReaders(ctx context.Context, auth Authentication, brokers []string, topic, clientID string) ([]PartitionReader, error) {
+	mechanism, err := GetMechanism(auth)
+	if err != nil {
+		return nil, err
+	}
+	dialer, err := NewDialer(mechanism, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("no kafka brokers")
+	}
+
+	partitions, err := dialer.LookupPartitions(ctx, "tcp", brokers[0], topic)
+	if err != nil {
+		return nil, err
+	}
+
+	var readers []PartitionReader
+	for _, p := range partitions {
+		first, last, err := readOffsets(ctx, dialer, brokers[0], topic, p.ID)
+		if err != nil {
+			for _, r := range readers {
+				r.Close()
+			}
+			return nil, err
+		}
+		if first >= last {
+			continue
+		}
+
+		readerConfig := NewReaderConfig(brokers, []string{topic}, dialer)
+		readerConfig.Partition = p.ID
+		readerConfig.StartOffset = kafka.FirstOffset
+		readerConfig.ErrorLogger = kafka.LoggerFunc(func(msg string, keysAndValues ...any) {
+			log.Error().Msgf(msg, keysAndValues...)
+		})
+		readers = append(readers, PartitionReader{Reader: kafka.NewReader(readerConfig), EndOffset: last})
+	}
+
+	return readers, nil
+}
+
+func readOffsets(ctx context.Context, dialer *kafka.Dialer, broker, topic string, partition int) (int64, int64, error) {
+	conn, err := dialer.DialLeader(ctx, "tcp", broker, topic, partition)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer conn.Close()
+
+	return conn.ReadOffsets()
+}
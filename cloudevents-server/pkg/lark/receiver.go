@@ -0,0 +1,36 @@
+package lark
+
+import (
+	"regexp"
+
+	larkim "github.com/larksuite/oapi-sdk-go/v3/service/im/v1"
+)
+
+// receiver formats:
+// - Open ID: ou_......
+// - Union ID: on_......
+// - Chat ID: oc_......
+// - Email: some email address.
+// - User ID: I do not know
+var (
+	reLarkOpenID  = regexp.MustCompile(`^ou_\w+`)
+	reLarkUnionID = regexp.MustCompile(`^on_\w+`)
+	reLarkChatID  = regexp.MustCompile(`^oc_\w+`)
+	reLarkEmail   = regexp.MustCompile(`^\S+@\S+\.\S+$`)
+)
+
+// ReceiverIDType returns the id type of the message receiver.
+func ReceiverIDType(id string) string {
+	switch {
+	case reLarkOpenID.MatchString(id):
+		return larkim.ReceiveIdTypeOpenId
+	case reLarkUnionID.MatchString(id):
+		return larkim.ReceiveIdTypeUnionId
+	case reLarkChatID.MatchString(id):
+		return larkim.ReceiveIdTypeChatId
+	case reLarkEmail.MatchString(id):
+		return larkim.ReceiveIdTypeEmail
+	default:
+		return larkim.ReceiveIdTypeUserId
+	}
+}
@@ -0,0 +1,94 @@
+// Package stats implement the helpers shared by the statistics queries of the
+// recorded runs, such as the test case runs and the tekton runs.
+package stats
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	defaultDays  = 7
+	maxDays      = 90
+	defaultLimit = 20
+	maxLimit     = 200
+	// DateLayout is the layout of the dates in the daily trends.
+	DateLayout = "2006-01-02"
+)
+
+// Window filters the runs in the days before `Until`, and limits the returned
+// results. It is embedded in the queries bound from the url parameters.
+type Window struct {
+	Days  int       `form:"days" json:"days,omitempty"`
+	Until time.Time `form:"until" time_format:"2006-01-02T15:04:05Z07:00" json:"until,omitempty"`
+	Limit int       `form:"limit" json:"limit,omitempty"`
+}
+
+// Normalize fills the defaults and caps the days and the limit.
+func (w *Window) Normalize() {
+	if w.Days <= 0 {
+		w.Days = defaultDays
+	}
+	w.Days = min(w.Days, maxDays)
+	if w.Until.IsZero() {
+		w.Until = time.Now()
+	}
+	if w.Limit <= 0 {
+		w.Limit = defaultLimit
+	}
+	w.Limit = min(w.Limit, maxLimit)
+}
+
+// Since returns the start of the window.
+func (w Window) Since() time.Time {
+	return w.Until.AddDate(0, 0, -w.Days)
+}
+
+// Dates returns the UTC dates of the daily trend in the window, in order.
+func (w Window) Dates() []string {
+	var ret []string
+	for d := w.Since().UTC(); !d.After(w.Until.UTC()); d = d.AddDate(0, 0, 1) {
+		if date := d.Format(DateLayout); len(ret) == 0 || ret[len(ret)-1] != date {
+			ret = append(ret, date)
+		}
+	}
+	return ret
+}
+
+// Durations is the nearest-rank percentiles of the durations.
+type Durations struct {
+	P50Ms int `json:"p50_ms"`
+	P90Ms int `json:"p90_ms"`
+	P99Ms int `json:"p99_ms"`
+	MaxMs int `json:"max_ms"`
+}
+
+// NewDurations returns the percentiles of the durations, they are sorted in
+// place. It is zero without durations.
+func NewDurations(values []int) Durations {
+	if len(values) == 0 {
+		return Durations{}
+	}
+	slices.Sort(values)
+	return Durations{
+		P50Ms: Percentile(values, 50),
+		P90Ms: Percentile(values, 90),
+		P99Ms: Percentile(values, 99),
+		MaxMs: values[len(values)-1],
+	}
+}
+
+// Percentile returns the nearest-rank percentile of the sorted values.
+func Percentile(sorted []int, p int) int {
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
+
+// Rate returns the ratio rounded to 4 decimals, it is zero when total is zero.
+func Rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)/float64(total)*10000) / 10000
+}
@@ -0,0 +1,53 @@
+package stats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWindow(t *testing.T) {
+	w := Window{Days: 365, Limit: 1000, Until: time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)}
+	w.Normalize()
+	if w.Days != maxDays || w.Limit != maxLimit {
+		t.Errorf("Normalize() = %+v, want the capped days and limit", w)
+	}
+
+	w = Window{Days: 2, Until: time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)}
+	w.Normalize()
+	if w.Limit != defaultLimit {
+		t.Errorf("Normalize() limit = %d, want %d", w.Limit, defaultLimit)
+	}
+	if got, want := w.Since(), time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Since() = %v, want %v", got, want)
+	}
+	if got, want := w.Dates(), []string{"2024-01-08", "2024-01-09", "2024-01-10"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Dates() = %v, want %v", got, want)
+	}
+
+	var empty Window
+	empty.Normalize()
+	if empty.Days != defaultDays || empty.Until.IsZero() {
+		t.Errorf("Normalize() = %+v, want the defaults", empty)
+	}
+}
+
+func TestNewDurations(t *testing.T) {
+	got := NewDurations([]int{3000, 1000, 2000, 4000})
+	want := Durations{P50Ms: 2000, P90Ms: 4000, P99Ms: 4000, MaxMs: 4000}
+	if got != want {
+		t.Errorf("NewDurations() = %+v, want %+v", got, want)
+	}
+	if got := NewDurations(nil); got != (Durations{}) {
+		t.Errorf("NewDurations(nil) = %+v, want zero", got)
+	}
+}
+
+func TestRate(t *testing.T) {
+	if got := Rate(2, 3); got != 0.6667 {
+		t.Errorf("Rate(2, 3) = %v, want 0.6667", got)
+	}
+	if got := Rate(1, 0); got != 0 {
+		t.Errorf("Rate(1, 0) = %v, want 0", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	goa "goa.design/goa/v3/pkg"
 )
@@ -46,6 +47,17 @@ func main() {
 		debug = *verboseF || *vF
 	}
 
+	if flag.Arg(0) == "mirror" {
+		if err := runMirror(context.Background(), addr, time.Duration(timeout)*time.Second, flag.Args()[1:]); err != nil {
+			if err == flag.ErrHelp {
+				os.Exit(0)
+			}
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	var (
 		scheme string
 		host   string
@@ -98,6 +110,7 @@ func main() {
 func usage() {
 	var usageCommands []string
 	usageCommands = append(usageCommands, httpUsageCommands()...)
+	usageCommands = append(usageCommands, "mirror REPOSITORY:TAG")
 	sort.Strings(usageCommands)
 	usageCommands = slices.Compact(usageCommands)
 	fmt.Fprintf(os.Stderr, `%s is a command line client for the dl API.
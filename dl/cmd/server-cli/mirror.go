@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mirrorManifestFile is the name of the manifest written in the mirror directory.
+const mirrorManifestFile = "manifest.json"
+
+// mirrorManifest describes the mirrored files of an OCI artifact.
+type mirrorManifest struct {
+	Repository  string       `json:"repository"`
+	Tag         string       `json:"tag"`
+	ResolvedTag string       `json:"resolvedTag,omitempty"`
+	Platform    string       `json:"platform,omitempty"`
+	Digest      string       `json:"digest"`
+	Files       []mirrorFile `json:"files"`
+}
+
+type mirrorFile struct {
+	File   string `json:"file"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// artifactMetadata is the part of the get-artifact-metadata response used by
+// the mirror command.
+type artifactMetadata struct {
+	Digest string `json:"digest"`
+	Layers []struct {
+		File string `json:"file"`
+		Size int64  `json:"size"`
+	} `json:"layers"`
+}
+
+type mirrorer struct {
+	addr     string
+	client   *http.Client
+	timeout  time.Duration
+	dir      string
+	repo     string
+	ref      string // pinned manifest digest.
+	progress io.Writer
+}
+
+func mirrorUsage() {
+	fmt.Fprintf(os.Stderr, `Mirror the files of an OCI artifact into a local directory.
+
+Usage:
+    %s [-url URL] mirror [flags] REPOSITORY:TAG
+
+The TAG can be a tag selector such as ~v8.5_linux_amd64. The files are saved in
+OUT/REPOSITORY/TAG with a %s listing them, interrupted downloads are resumed
+on the next run and every file is verified against the sha256 checksum.
+
+Flags:
+`, os.Args[0], mirrorManifestFile)
+}
+
+// runMirror runs the mirror subcommand with the arguments after `mirror`.
+func runMirror(ctx context.Context, addr string, timeout time.Duration, args []string) error {
+	fs := flag.NewFlagSet("mirror", flag.ContinueOnError)
+	var (
+		fileRegexF = fs.String("file-regex", "", "regex of the file names to mirror (default all files)")
+		platformF  = fs.String("platform", "", "platform of the artifact when the tag points to an image index, e.g. linux/amd64")
+		outF       = fs.String("out", ".", "output directory")
+		parallelF  = fs.Int("parallel", 4, "number of files downloaded in parallel")
+	)
+	fs.Usage = func() {
+		mirrorUsage()
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("exactly one artifact reference is required")
+	}
+	repo, tag, ok := cutReference(fs.Arg(0))
+	if !ok {
+		return fmt.Errorf("invalid artifact reference %q, want REPOSITORY:TAG", fs.Arg(0))
+	}
+	var pattern *regexp.Regexp
+	if *fileRegexF != "" {
+		var err error
+		if pattern, err = regexp.Compile(*fileRegexF); err != nil {
+			return fmt.Errorf("invalid file regex: %w", err)
+		}
+	}
+	if *parallelF < 1 {
+		return errors.New("parallel must be positive")
+	}
+
+	m := &mirrorer{addr: strings.TrimSuffix(addr, "/"), client: &http.Client{}, timeout: timeout, repo: repo, progress: os.Stderr}
+	manifest, err := m.resolve(ctx, tag, *platformF, pattern)
+	if err != nil {
+		return err
+	}
+	m.dir = filepath.Join(*outF, filepath.FromSlash(repo), manifest.ResolvedTag)
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	if err := m.mirrorFiles(ctx, manifest.Files, *parallelF); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return err
+	}
+	manifestPath := filepath.Join(m.dir, mirrorManifestFile)
+	if err := os.WriteFile(manifestPath, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(m.progress, "mirrored %d files to %s\n", len(manifest.Files), m.dir)
+	return nil
+}
+
+// cutReference splits `repository:tag`, the registry port is not mistaken as
+// the tag.
+func cutReference(ref string) (string, string, bool) {
+	i := strings.LastIndex(ref, ":")
+	if i <= 0 || i == len(ref)-1 || strings.Contains(ref[i+1:], "/") {
+		return "", "", false
+	}
+	return ref[:i], ref[i+1:], true
+}
+
+// resolve resolves the tag and pins the manifest digest, so all the files come
+// from the same artifact even when the tag moves during mirroring.
+func (m *mirrorer) resolve(ctx context.Context, tag, platform string, pattern *regexp.Regexp) (*mirrorManifest, error) {
+	query := url.Values{"tag": {tag}}
+	if platform != "" {
+		query.Set("platform", platform)
+	}
+
+	var metadata artifactMetadata
+	header, err := m.getJSON(ctx, "/oci-metadata/", query, &metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the artifact metadata: %w", err)
+	}
+	m.ref = metadata.Digest
+
+	manifest := &mirrorManifest{Repository: m.repo, Tag: tag, ResolvedTag: tag, Platform: platform, Digest: metadata.Digest}
+	if resolved := header.Get("X-Resolved-Tag"); resolved != "" {
+		manifest.ResolvedTag = resolved
+	}
+	for _, l := range metadata.Layers {
+		if l.File == "" || (pattern != nil && !pattern.MatchString(l.File)) {
+			continue
+		}
+		if !filepath.IsLocal(l.File) {
+			return nil, fmt.Errorf("unsafe file name %q in the artifact", l.File)
+		}
+		manifest.Files = append(manifest.Files, mirrorFile{File: l.File, Size: l.Size})
+	}
+	if len(manifest.Files) == 0 {
+		return nil, errors.New("no files matched in the artifact")
+	}
+
+	return manifest, nil
+}
+
+// mirrorFiles downloads and verifies the files in parallel, the checksums are
+// filled in files.
+func (m *mirrorer) mirrorFiles(ctx context.Context, files []mirrorFile, parallel int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		jobs     = make(chan int)
+	)
+	for range min(parallel, len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				sum, err := m.mirrorFile(ctx, files[i])
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("%s: %w", files[i].File, err)
+						cancel()
+					})
+					continue
+				}
+				files[i].SHA256 = sum
+			}
+		}()
+	}
+
+feed:
+	for i := range files {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return firstErr
+}
+
+// mirrorFile downloads the file unless a verified copy exists, and returns its
+// sha256 checksum. A partial download is resumed when the server supports range
+// requests.
+func (m *mirrorer) mirrorFile(ctx context.Context, f mirrorFile) (string, error) {
+	var want struct {
+		Checksum string `json:"checksum"`
+	}
+	query := url.Values{"tag": {m.ref}, "file": {f.File}, "algorithm": {"sha256"}}
+	if _, err := m.getJSON(ctx, "/oci-file-checksum/", query, &want); err != nil {
+		return "", fmt.Errorf("failed to fetch the checksum: %w", err)
+	}
+
+	target := filepath.Join(m.dir, f.File)
+	if sum, err := fileSHA256(target); err == nil && sum == want.Checksum {
+		fmt.Fprintf(m.progress, "%s: up to date\n", f.File)
+		return sum, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", err
+	}
+	partial := target + ".part"
+	if err := m.download(ctx, f.File, partial); err != nil {
+		return "", err
+	}
+	sum, err := fileSHA256(partial)
+	if err != nil {
+		return "", err
+	}
+	if sum != want.Checksum {
+		// start over next time.
+		os.Remove(partial)
+		return "", fmt.Errorf("checksum mismatch, got sha256 %s, want %s", sum, want.Checksum)
+	}
+	if err := os.Rename(partial, target); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(m.progress, "%s: downloaded\n", f.File)
+	return sum, nil
+}
+
+// download downloads the file to path, continuing from the existing content.
+func (m *mirrorer) download(ctx context.Context, file, path string) error {
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{"tag": {m.ref}, "file": {file}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.url("/oci-file/", query), nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		fmt.Fprintf(m.progress, "%s: resuming from %d bytes\n", file, offset)
+	case http.StatusOK:
+		// the range is not supported, download from the beginning.
+		if err := out.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := out.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// the partial file is complete or broken, the checksum tells.
+		return nil
+	default:
+		return fmt.Errorf("failed to download: %s", resp.Status)
+	}
+
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+func (m *mirrorer) url(path string, query url.Values) string {
+	return m.addr + path + m.repo + "?" + query.Encode()
+}
+
+func (m *mirrorer) getJSON(ctx context.Context, path string, query url.Values, v any) (http.Header, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.url(path, query), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp.Header, json.NewDecoder(resp.Body).Decode(v)
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCutReference(t *testing.T) {
+	tests := []struct {
+		ref      string
+		wantRepo string
+		wantTag  string
+		wantOK   bool
+	}{
+		{ref: "hub.pingcap.net/pingcap/tidb/package:v8.5.0_linux_amd64", wantRepo: "hub.pingcap.net/pingcap/tidb/package", wantTag: "v8.5.0_linux_amd64", wantOK: true},
+		{ref: "localhost:5000/pingcap/tidb:~v8.5", wantRepo: "localhost:5000/pingcap/tidb", wantTag: "~v8.5", wantOK: true},
+		{ref: "localhost:5000/pingcap/tidb", wantOK: false},
+		{ref: "pingcap/tidb:", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			repo, tag, ok := cutReference(tt.ref)
+			if ok != tt.wantOK || repo != tt.wantRepo || tag != tt.wantTag {
+				t.Errorf("cutReference() = (%q, %q, %v), want (%q, %q, %v)", repo, tag, ok, tt.wantRepo, tt.wantTag, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMirror(t *testing.T) {
+	files := map[string]string{
+		"tidb-v8.5.0-linux-amd64.tar.gz": strings.Repeat("tidb", 1024),
+		"pd-v8.5.0-linux-amd64.tar.gz":   strings.Repeat("pd", 1024),
+		"tidb-v8.5.0-linux-amd64.sbom":   "sbom",
+	}
+	var (
+		mu        sync.Mutex
+		downloads []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		qp := r.URL.Query()
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/oci-metadata/"):
+			w.Header().Set("X-Resolved-Tag", "v8.5.0_linux_amd64")
+			var layers []map[string]any
+			for name, content := range files {
+				layers = append(layers, map[string]any{"file": name, "size": len(content)})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"digest": "sha256:abc", "layers": layers})
+		case strings.HasPrefix(r.URL.Path, "/oci-file-checksum/"):
+			sum := sha256.Sum256([]byte(files[qp.Get("file")]))
+			_ = json.NewEncoder(w).Encode(map[string]any{"checksum": hex.EncodeToString(sum[:])})
+		case strings.HasPrefix(r.URL.Path, "/oci-file/"):
+			if qp.Get("tag") != "sha256:abc" {
+				http.Error(w, "not pinned", http.StatusBadRequest)
+				return
+			}
+			content := files[qp.Get("file")]
+			mu.Lock()
+			downloads = append(downloads, qp.Get("file")+" "+r.Header.Get("Range"))
+			mu.Unlock()
+			// served like the ranged blobs of the server.
+			http.ServeContent(w, r, qp.Get("file"), time.Time{}, strings.NewReader(content))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	out := t.TempDir()
+	dir := filepath.Join(out, "hub.pingcap.net", "pingcap", "tidb", "package", "v8.5.0_linux_amd64")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// an interrupted download.
+	if err := os.WriteFile(filepath.Join(dir, "pd-v8.5.0-linux-amd64.tar.gz.part"), []byte(files["pd-v8.5.0-linux-amd64.tar.gz"][:100]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mirrorer{addr: server.URL, client: server.Client(), timeout: time.Minute, repo: "hub.pingcap.net/pingcap/tidb/package", dir: dir, progress: io.Discard}
+	manifest, err := m.resolve(context.Background(), "~v8.5_linux_amd64", "", regexp.MustCompile(`\.tar\.gz$`))
+	if err != nil {
+		t.Fatalf("resolve error: %v", err)
+	}
+	if manifest.ResolvedTag != "v8.5.0_linux_amd64" || len(manifest.Files) != 2 {
+		t.Fatalf("manifest = %+v, want 2 tarballs of v8.5.0_linux_amd64", manifest)
+	}
+
+	for round := range 2 {
+		downloads = nil
+		if err := m.mirrorFiles(context.Background(), manifest.Files, 2); err != nil {
+			t.Fatalf("mirrorFiles error: %v", err)
+		}
+		if round == 0 && (len(downloads) != 2 || !slices.Contains(downloads, "pd-v8.5.0-linux-amd64.tar.gz bytes=100-")) {
+			t.Errorf("downloads = %v, want both tarballs and pd resumed", downloads)
+		}
+		if round == 1 && len(downloads) != 0 {
+			t.Errorf("downloads = %v, want none for verified files", downloads)
+		}
+	}
+	for _, f := range manifest.Files {
+		got, err := os.ReadFile(filepath.Join(dir, f.File))
+		if err != nil || string(got) != files[f.File] {
+			t.Errorf("mirrored %s mismatched: %v", f.File, err)
+		}
+		if f.SHA256 == "" {
+			t.Errorf("checksum of %s is missing", f.File)
+		}
+	}
+}
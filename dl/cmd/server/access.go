@@ -0,0 +1,334 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/minio/minio-go/v7"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/middleware"
+	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/api/googleapi"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// Error types of the failed requests.
+const (
+	errorTypeNotFound     = "not_found"
+	errorTypeUpstreamAuth = "upstream_auth"
+	errorTypeTimeout      = "timeout"
+	errorTypeBadRequest   = "bad_request"
+	errorTypeUpstream     = "upstream"
+	errorTypeInternal     = "internal"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dl_requests_total",
+		Help: "Number of the requests to the storage backends.",
+	}, []string{"backend", "repository", "file", "code"})
+	bytesServedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dl_bytes_served_total",
+		Help: "Bytes of the response bodies served.",
+	}, []string{"backend", "repository", "file"})
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dl_request_duration_seconds",
+		Help:    "Duration of the requests until the response is fully written.",
+		Buckets: []float64{0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 1800},
+	}, []string{"backend", "repository"})
+	errorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "dl_errors_total",
+		Help: "Number of the failed requests by error type.",
+	}, []string{"backend", "type"})
+	activeStreams = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dl_active_streams",
+		Help: "Number of the requests being served.",
+	}, []string{"backend"})
+)
+
+// otherLabel is the metric label value of the repositories and files which are
+// not resolved, they come from the requests and would make the series unbounded.
+const otherLabel = "other"
+
+// accessTarget is the artifact or object a request accesses.
+type accessTarget struct {
+	backend    string // oci, ks3, gcs or s3, empty for other requests.
+	repository string // repository of OCI artifacts, or bucket of objects.
+	file       string
+	entry      string // entry of the tarball file.
+	pattern    bool   // file is a regular expression or a listed prefix.
+}
+
+// metricLabels returns the repository and file labels of the request metrics.
+// Only the successful requests have their targets resolved, the others, the
+// file patterns, listed prefixes and tarball entries are collapsed to otherLabel.
+func (t accessTarget) metricLabels(status int) (repository, file string) {
+	if status >= http.StatusBadRequest {
+		return otherLabel, otherLabel
+	}
+	file = t.file
+	if t.pattern || t.entry != "" {
+		file = otherLabel
+	}
+	return t.repository, file
+}
+
+// parseAccessTarget parses the target of the requests to the storage backends.
+func parseAccessTarget(r *http.Request) accessTarget {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	route, rest, _ := strings.Cut(path, "/")
+	switch {
+	case strings.HasPrefix(route, "oci-"):
+		qp := r.URL.Query()
+		target := accessTarget{backend: "oci", repository: rest, file: qp.Get("file"), entry: qp.Get("entry")}
+		if target.file == "" && qp.Has("file_regex") {
+			target.file, target.pattern = qp.Get("file_regex"), true
+		}
+		return target
+	case route == "s3-obj" || route == "s3-obj-checksum" || route == "s3-objs":
+		bucket, key, _ := strings.Cut(rest, "/")
+		return accessTarget{backend: "ks3", repository: bucket, file: key, pattern: route == "s3-objs" || strings.HasSuffix(key, "/")}
+	case route == "gcs-obj" || route == "gcs-obj-checksum" || route == "gcs-objs":
+		bucket, key, _ := strings.Cut(rest, "/")
+		return accessTarget{backend: "gcs", repository: bucket, file: key, pattern: route == "gcs-objs" || strings.HasSuffix(key, "/")}
+	case route == "s3" || route == "s3-list":
+		segments := strings.SplitN(rest, "/", 3)
+		target := accessTarget{backend: "s3", repository: strings.Join(segments[:min(2, len(segments))], "/")}
+		if len(segments) == 3 {
+			target.file = segments[2]
+			target.pattern = strings.HasSuffix(target.file, "/")
+		}
+		return target
+	default:
+		return accessTarget{}
+	}
+}
+
+// accessRecord collects the details of a request for the access log.
+type accessRecord struct {
+	mu        sync.Mutex
+	errorType string
+	err       error
+}
+
+func (r *accessRecord) error() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *accessRecord) errorTypeOf() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.errorType
+}
+
+type accessRecordKey struct{}
+
+// recordError records the first error of the request in the access record of
+// ctx, if any.
+func recordError(ctx context.Context, err error) {
+	rec, ok := ctx.Value(accessRecordKey{}).(*accessRecord)
+	if !ok || err == nil {
+		return
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.err == nil {
+		rec.err = err
+		rec.errorType = classifyError(err)
+	}
+}
+
+// classifyError returns the error type of the errors from the services and the
+// upstream storages.
+func classifyError(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return errorTypeTimeout
+	}
+
+	status := 0
+	var (
+		registryErr *errcode.ErrorResponse
+		googleErr   *googleapi.Error
+		statusErr   interface{ StatusCode() int }
+	)
+	switch {
+	case errors.Is(err, errdef.ErrNotFound) || errors.Is(err, storage.ErrObjectNotExist) || errors.Is(err, storage.ErrBucketNotExist):
+		return errorTypeNotFound
+	case errors.As(err, &registryErr):
+		status = registryErr.StatusCode
+	case errors.As(err, &googleErr):
+		status = googleErr.Code
+	case errors.As(err, &statusErr):
+		status = statusErr.StatusCode()
+	default:
+		status = minio.ToErrorResponse(err).StatusCode
+	}
+
+	switch {
+	case status == http.StatusNotFound:
+		return errorTypeNotFound
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return errorTypeUpstreamAuth
+	case status >= http.StatusInternalServerError:
+		return errorTypeUpstream
+	case strings.Contains(err.Error(), "not found"):
+		return errorTypeNotFound
+	}
+
+	var serviceErr *goa.ServiceError
+	switch {
+	case errors.As(err, &serviceErr) && serviceErr.Name == "invalid_file_path":
+		return errorTypeNotFound
+	case errors.As(err, &serviceErr) && !serviceErr.Fault && serviceErr.Name != "internal_error":
+		return errorTypeBadRequest
+	default:
+		return errorTypeInternal
+	}
+}
+
+// errorFormatter records the service errors for the access log and formats
+// them as the goa default.
+func errorFormatter(ctx context.Context, err error) goahttp.Statuser {
+	recordError(ctx, err)
+	return goahttp.NewErrorResponse(ctx, err)
+}
+
+// accessResponseWriter counts the written bytes and captures the status code.
+type accessResponseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *accessResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *accessResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// accessMiddleware writes a structured access log for every request, and
+// collects the metrics of the requests to the storage backends.
+func accessMiddleware(accessLogger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			target := parseAccessTarget(r)
+			// the query may carry signatures, only the tag is logged.
+			tag := r.URL.Query().Get("tag")
+
+			rec := &accessRecord{}
+			r = r.WithContext(context.WithValue(r.Context(), accessRecordKey{}, rec))
+			aw := &accessResponseWriter{ResponseWriter: w}
+
+			if target.backend != "" {
+				activeStreams.WithLabelValues(target.backend).Inc()
+				defer activeStreams.WithLabelValues(target.backend).Dec()
+			}
+			next.ServeHTTP(aw, r)
+
+			duration := time.Since(start)
+			status := aw.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			errorType := rec.errorTypeOf()
+			if errorType == "" && status >= http.StatusBadRequest {
+				errorType = statusErrorType(status)
+			}
+
+			if target.backend != "" {
+				code := strconv.Itoa(status)
+				repository, file := target.metricLabels(status)
+				requestsTotal.WithLabelValues(target.backend, repository, file, code).Inc()
+				bytesServedTotal.WithLabelValues(target.backend, repository, file).Add(float64(aw.bytes))
+				requestDuration.WithLabelValues(target.backend, repository).Observe(duration.Seconds())
+				if errorType != "" {
+					errorsTotal.WithLabelValues(target.backend, errorType).Inc()
+				}
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", status),
+				slog.Int64("bytes", aw.bytes),
+				slog.Duration("duration", duration),
+				slog.String("remote", r.RemoteAddr),
+				slog.String("user_agent", r.UserAgent()),
+			}
+			if id, ok := r.Context().Value(middleware.RequestIDKey).(string); ok {
+				attrs = append(attrs, slog.String("request_id", id))
+			}
+			if target.backend != "" {
+				attrs = append(attrs,
+					slog.String("backend", target.backend),
+					slog.String("repository", target.repository),
+					slog.String("file", target.file),
+				)
+			}
+			if target.entry != "" {
+				attrs = append(attrs, slog.String("entry", target.entry))
+			}
+			if tag != "" {
+				attrs = append(attrs, slog.String("tag", tag))
+			}
+			if resolved := w.Header().Get("X-Resolved-Tag"); resolved != "" {
+				attrs = append(attrs, slog.String("resolved_tag", resolved))
+			}
+			if errorType != "" {
+				attrs = append(attrs, slog.String("error_type", errorType))
+			}
+			if err := rec.error(); err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			accessLogger.LogAttrs(r.Context(), slog.LevelInfo, "access", attrs...)
+		})
+	}
+}
+
+// statusErrorType returns the error type of the failed requests without a
+// recorded error, such as the ones rejected by the middlewares.
+func statusErrorType(status int) string {
+	switch status {
+	case http.StatusNotFound:
+		return errorTypeNotFound
+	case http.StatusGatewayTimeout:
+		return errorTypeTimeout
+	case http.StatusBadGateway:
+		return errorTypeUpstream
+	default:
+		if status < http.StatusInternalServerError {
+			return errorTypeBadRequest
+		}
+		return errorTypeInternal
+	}
+}
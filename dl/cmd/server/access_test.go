@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+func TestParseAccessTarget(t *testing.T) {
+	tests := []struct {
+		target string
+		want   accessTarget
+	}{
+		{target: "/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0&file=tidb.tar.gz", want: accessTarget{backend: "oci", repository: "hub.pingcap.net/pingcap/tidb/package", file: "tidb.tar.gz"}},
+		{target: "/oci-file-entry/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0&file=tidb.tar.gz&entry=bin/tidb-server", want: accessTarget{backend: "oci", repository: "hub.pingcap.net/pingcap/tidb/package", file: "tidb.tar.gz", entry: "bin/tidb-server"}},
+		{target: "/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0&file_regex=tidb.*", want: accessTarget{backend: "oci", repository: "hub.pingcap.net/pingcap/tidb/package", file: "tidb.*", pattern: true}},
+		{target: "/s3-obj/my-bucket/builds/tidb.tar.gz", want: accessTarget{backend: "ks3", repository: "my-bucket", file: "builds/tidb.tar.gz"}},
+		{target: "/gcs-obj-checksum/my-bucket/tidb.tar.gz", want: accessTarget{backend: "gcs", repository: "my-bucket", file: "tidb.tar.gz"}},
+		{target: "/s3/minio/my-bucket/builds/tidb.tar.gz", want: accessTarget{backend: "s3", repository: "minio/my-bucket", file: "builds/tidb.tar.gz"}},
+		{target: "/s3-list/minio/my-bucket?prefix=builds/", want: accessTarget{backend: "s3", repository: "minio/my-bucket"}},
+		{target: "/s3/minio/my-bucket/builds/", want: accessTarget{backend: "s3", repository: "minio/my-bucket", file: "builds/", pattern: true}},
+		{target: "/gcs-objs/my-bucket/builds", want: accessTarget{backend: "gcs", repository: "my-bucket", file: "builds", pattern: true}},
+		{target: "/healthz", want: accessTarget{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			if got := parseAccessTarget(httptest.NewRequest(http.MethodGet, tt.target, nil)); got != tt.want {
+				t.Errorf("parseAccessTarget() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessTargetMetricLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   accessTarget
+		status   int
+		wantRepo string
+		wantFile string
+	}{
+		{name: "downloaded", target: accessTarget{backend: "oci", repository: "pingcap/tidb", file: "tidb.tar.gz"}, status: http.StatusOK, wantRepo: "pingcap/tidb", wantFile: "tidb.tar.gz"},
+		{name: "redirected", target: accessTarget{backend: "ks3", repository: "my-bucket", file: "tidb.tar.gz"}, status: http.StatusFound, wantRepo: "my-bucket", wantFile: "tidb.tar.gz"},
+		{name: "not found", target: accessTarget{backend: "oci", repository: "pingcap/missing", file: "tidb.tar.gz"}, status: http.StatusNotFound, wantRepo: otherLabel, wantFile: otherLabel},
+		{name: "file pattern", target: accessTarget{backend: "oci", repository: "pingcap/tidb", file: "tidb.*", pattern: true}, status: http.StatusOK, wantRepo: "pingcap/tidb", wantFile: otherLabel},
+		{name: "tarball entry", target: accessTarget{backend: "oci", repository: "pingcap/tidb", file: "tidb.tar.gz", entry: "bin/tidb-server"}, status: http.StatusOK, wantRepo: "pingcap/tidb", wantFile: otherLabel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, file := tt.target.metricLabels(tt.status)
+			if repo != tt.wantRepo || file != tt.wantFile {
+				t.Errorf("metricLabels() = (%q, %q), want (%q, %q)", repo, file, tt.wantRepo, tt.wantFile)
+			}
+		})
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "registry auth", err: oci.MakeInvalidFilePath(fmt.Errorf("failed to fetch: %w", &errcode.ErrorResponse{StatusCode: http.StatusUnauthorized})), want: errorTypeUpstreamAuth},
+		{name: "registry unavailable", err: &errcode.ErrorResponse{StatusCode: http.StatusServiceUnavailable}, want: errorTypeUpstream},
+		{name: "file not found", err: oci.MakeInvalidFilePath(errors.New("not found file: tidb.tar.gz")), want: errorTypeNotFound},
+		{name: "timeout", err: fmt.Errorf("failed to fetch: %w", context.DeadlineExceeded), want: errorTypeTimeout},
+		{name: "internal", err: errors.New("boom"), want: errorTypeInternal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyError(tt.err); got != tt.want {
+				t.Errorf("classifyError() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccessMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recordError(r.Context(), &errcode.ErrorResponse{StatusCode: http.StatusForbidden})
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("denied"))
+	})
+	handler := accessMiddleware(slog.New(slog.NewJSONHandler(&buf, nil)))(next)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0&file=tidb.tar.gz&signature=secret", nil))
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("invalid access log %q: %v", buf.String(), err)
+	}
+	for key, want := range map[string]any{
+		"backend":    "oci",
+		"repository": "hub.pingcap.net/pingcap/tidb/package",
+		"file":       "tidb.tar.gz",
+		"tag":        "v8.5.0",
+		"status":     float64(http.StatusBadRequest),
+		"bytes":      float64(len("denied")),
+		"error_type": errorTypeUpstreamAuth,
+	} {
+		if entry[key] != want {
+			t.Errorf("access log %s = %v, want %v", key, entry[key], want)
+		}
+	}
+	if bytes.Contains(buf.Bytes(), []byte("secret")) {
+		t.Errorf("access log leaks the signature: %s", buf.String())
+	}
+}
@@ -3,15 +3,19 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"goa.design/clue/health"
 	goahttp "goa.design/goa/v3/http"
 	httpmdlwr "goa.design/goa/v3/http/middleware"
@@ -21,10 +25,16 @@ import (
 	gcssvr "github.com/PingCAP-QE/ee-apps/dl/gen/http/gcs/server"
 	ks3svr "github.com/PingCAP-QE/ee-apps/dl/gen/http/ks3/server"
 	ocisvr "github.com/PingCAP-QE/ee-apps/dl/gen/http/oci/server"
+	s3svr "github.com/PingCAP-QE/ee-apps/dl/gen/http/s3/server"
+	signsvr "github.com/PingCAP-QE/ee-apps/dl/gen/http/sign/server"
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
 	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
 	pkgoci "github.com/PingCAP-QE/ee-apps/dl/pkg/oci"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/signing"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2/registry/remote"
 )
 
@@ -35,7 +45,9 @@ type ociRepoProvider interface {
 
 // handleHTTPServer starts configures and starts a HTTP server on the given
 // URL. It shuts down the server if any error is received in the error channel.
-func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoints, ks3Endpoints *ks3.Endpoints, gcsEndpoints *gcs.Endpoints, wg *sync.WaitGroup, errc chan error, logger *log.Logger, debug bool, ociSvc oci.Service) {
+// When publicHost is set, another server only accepting signed download links
+// is started on it.
+func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoints, ks3Endpoints *ks3.Endpoints, gcsEndpoints *gcs.Endpoints, s3Endpoints *s3.Endpoints, signEndpoints *sign.Endpoints, wg *sync.WaitGroup, errc chan error, logger *log.Logger, debug bool, ociSvc oci.Service, ks3Svc ks3.Service, gcsSvc gcs.Service, signer *signing.Signer, publicHost string) {
 
 	// Setup goa log adapter.
 	var (
@@ -66,20 +78,26 @@ func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoin
 	// the service input and output data structures to HTTP requests and
 	// responses.
 	var (
-		ociServer *ocisvr.Server
-		ks3Server *ks3svr.Server
-		gcsServer *gcssvr.Server
+		ociServer  *ocisvr.Server
+		ks3Server  *ks3svr.Server
+		gcsServer  *gcssvr.Server
+		s3Server   *s3svr.Server
+		signServer *signsvr.Server
 	)
 	{
 		eh := errorHandler(logger)
-		ociServer = ocisvr.New(ociEndpoints, mux, dec, enc, eh, nil)
-		ks3Server = ks3svr.New(ks3Endpoints, mux, dec, enc, eh, nil)
-		gcsServer = gcssvr.New(gcsEndpoints, mux, dec, enc, eh, nil)
+		ociServer = ocisvr.New(ociEndpoints, mux, dec, enc, eh, errorFormatter)
+		ks3Server = ks3svr.New(ks3Endpoints, mux, dec, enc, eh, errorFormatter)
+		gcsServer = gcssvr.New(gcsEndpoints, mux, dec, enc, eh, errorFormatter)
+		s3Server = s3svr.New(s3Endpoints, mux, dec, enc, eh, errorFormatter)
+		signServer = signsvr.New(signEndpoints, mux, dec, enc, eh, errorFormatter)
 		if debug {
 			servers := goahttp.Servers{
 				ociServer,
 				ks3Server,
 				gcsServer,
+				s3Server,
+				signServer,
 			}
 			servers.Use(httpmdlwr.Debug(mux, os.Stdout))
 		}
@@ -88,26 +106,57 @@ func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoin
 	ocisvr.Mount(mux, ociServer)
 	ks3svr.Mount(mux, ks3Server)
 	gcssvr.Mount(mux, gcsServer)
+	s3svr.Mount(mux, s3Server)
+	signsvr.Mount(mux, signServer)
 
 	// ** Mount health check handler **
 	check := health.Handler(health.NewChecker())
 	mux.Handle("GET", "/healthz", check)
 	mux.Handle("GET", "/livez", check)
 
+	// ** Mount Prometheus metrics handler **
+	mux.Handle("GET", "/metrics", promhttp.Handler().ServeHTTP)
+
 	// Wrap the multiplexer with additional middlewares. Middlewares mounted
 	// here apply to all the service endpoints.
 	var handler http.Handler = mux
 	{
+		handler = objectIndexMiddleware("/s3-obj/", 1, ks3ObjectLister(ks3Endpoints), logger)(handler)
+		handler = objectIndexMiddleware("/gcs-obj/", 1, gcsObjectLister(gcsEndpoints), logger)(handler)
+		handler = objectIndexMiddleware("/s3/", 2, s3ObjectLister(s3Endpoints), logger)(handler)
+		if presigner, ok := ks3Svc.(objectPresigner); ok {
+			handler = objectRedirectMiddleware("/s3-obj/", presigner, logger)(handler)
+		}
+		if presigner, ok := gcsSvc.(objectPresigner); ok {
+			handler = objectRedirectMiddleware("/gcs-obj/", presigner, logger)(handler)
+		}
 		if provider, ok := ociSvc.(ociRepoProvider); ok {
+			handler = rangeOCIMiddleware(provider, logger)(handler)
+			handler = ociRedirectMiddleware(provider, logger)(handler)
 			handler = headOCIMiddleware(provider, logger)(handler)
+			handler = tagSelectorMiddleware(provider, logger)(handler)
 		}
-		handler = httpmdlwr.Log(adapter)(handler)
-		handler = httpmdlwr.RequestID()(handler)
 	}
 
+	// The signatures are verified before the tag selectors rewrite the query.
+	var publicHandler http.Handler
+	if signer != nil {
+		publicHandler = signedLinkMiddleware(signer, true, logger)(handler)
+		handler = signedLinkMiddleware(signer, false, logger)(handler)
+	}
+	accessLogger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	handler = httpmdlwr.RequestID()(httpmdlwr.Log(adapter)(accessMiddleware(accessLogger)(handler)))
+
 	// Start HTTP server using default configuration, change the code to
 	// configure the server as required by your service.
-	srv := &http.Server{Addr: u.Host, Handler: handler, ReadHeaderTimeout: time.Second * 60}
+	srvs := []*http.Server{{Addr: u.Host, Handler: handler, ReadHeaderTimeout: time.Second * 60}}
+	if publicHost != "" {
+		if publicHandler == nil {
+			logger.Fatalf("the public listener requires link signing to be configured")
+		}
+		publicHandler = httpmdlwr.RequestID()(httpmdlwr.Log(adapter)(accessMiddleware(accessLogger)(publicHandler)))
+		srvs = append(srvs, &http.Server{Addr: publicHost, Handler: publicHandler, ReadHeaderTimeout: time.Second * 60})
+	}
 	for _, m := range ociServer.Mounts {
 		logger.Printf("HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
 	}
@@ -117,29 +166,37 @@ func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoin
 	for _, m := range gcsServer.Mounts {
 		logger.Printf("HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
 	}
+	for _, m := range s3Server.Mounts {
+		logger.Printf("HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
+	}
+	for _, m := range signServer.Mounts {
+		logger.Printf("HTTP %q mounted on %s %s", m.Method, m.Verb, m.Pattern)
+	}
 
-	(*wg).Add(1)
-	go func() {
-		defer (*wg).Done()
-
-		// Start HTTP server in a separate goroutine.
+	for _, srv := range srvs {
+		(*wg).Add(1)
 		go func() {
-			logger.Printf("HTTP server listening on %q", u.Host)
-			errc <- srv.ListenAndServe()
-		}()
+			defer (*wg).Done()
 
-		<-ctx.Done()
-		logger.Printf("shutting down HTTP server at %q", u.Host)
+			// Start HTTP server in a separate goroutine.
+			go func() {
+				logger.Printf("HTTP server listening on %q", srv.Addr)
+				errc <- srv.ListenAndServe()
+			}()
 
-		// Shutdown gracefully with a 30s timeout.
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
+			<-ctx.Done()
+			logger.Printf("shutting down HTTP server at %q", srv.Addr)
 
-		err := srv.Shutdown(ctx)
-		if err != nil {
-			logger.Printf("failed to shutdown: %v", err)
-		}
-	}()
+			// Shutdown gracefully with a 30s timeout.
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			err := srv.Shutdown(ctx)
+			if err != nil {
+				logger.Printf("failed to shutdown: %v", err)
+			}
+		}()
+	}
 }
 
 // errorHandler returns a function that writes and logs the given error.
@@ -147,6 +204,7 @@ func handleHTTPServer(ctx context.Context, u *url.URL, ociEndpoints *oci.Endpoin
 // to correlate.
 func errorHandler(logger *log.Logger) func(context.Context, http.ResponseWriter, error) {
 	return func(ctx context.Context, w http.ResponseWriter, err error) {
+		recordError(ctx, err)
 		id := ctx.Value(middleware.RequestIDKey).(string)
 		_, _ = w.Write([]byte("[" + id + "] encoding: " + err.Error()))
 		logger.Printf("[%s] ERROR: %s", id, err.Error())
@@ -174,69 +232,322 @@ func headOCIMiddleware(provider ociRepoProvider, logger *log.Logger) func(http.H
 				return
 			}
 
-			qp := r.URL.Query()
-			tag := qp.Get("tag")
-			if tag == "" {
-				http.Error(w, "missing tag query parameter", http.StatusBadRequest)
+			_, targetFile, descriptor, ok := resolveOCIFile(w, r, provider, repository, logger, "HEAD oci-file")
+			if !ok {
 				return
 			}
 
-			file := qp.Get("file")
-			fileRegex := qp.Get("file_regex")
+			w.Header().Set("Content-Disposition", attachment.ContentDisposition(targetFile))
+			w.Header().Set("Content-Length", fmt.Sprintf("%d", descriptor.Size))
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.WriteHeader(http.StatusOK)
+		})
+	}
+}
 
-			repo, err := provider.GetTargetRepo(repository)
+// rangeOCIMiddleware serves GET requests to /oci-file/{*repository} with the
+// Range header from the registry blob with ranged requests, so interrupted
+// downloads are resumed. The blob cache is bypassed, and the whole file is
+// streamed as usual when the registry does not support ranges.
+func rangeOCIMiddleware(provider ociRepoProvider, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			repository := strings.TrimPrefix(r.URL.Path, "/oci-file/")
+			if r.Method != http.MethodGet || r.Header.Get("Range") == "" ||
+				!strings.HasPrefix(r.URL.Path, "/oci-file/") || repository == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			repo, targetFile, descriptor, ok := resolveOCIFile(w, r, provider, repository, logger, "range oci-file")
+			if !ok {
+				return
+			}
+			rc, err := repo.Blobs().Fetch(r.Context(), *descriptor)
 			if err != nil {
-				logger.Printf("HEAD oci-file: getTargetRepo: %v", err)
-				http.Error(w, "failed to resolve repository", http.StatusInternalServerError)
+				logger.Printf("range oci-file: Fetch: %v", err)
+				http.Error(w, "failed to fetch blob", http.StatusBadGateway)
+				return
+			}
+			defer rc.Close()
+			content, ok := rc.(io.ReadSeeker)
+			if !ok {
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			ctx := r.Context()
-			var targetFile string
+			w.Header().Set("Content-Disposition", attachment.ContentDisposition(targetFile))
+			w.Header().Set("Content-Type", "application/octet-stream")
+			http.ServeContent(w, r, targetFile, time.Time{}, content)
+		})
+	}
+}
 
-			if file != "" {
-				targetFile = file
-			} else if fileRegex != "" {
-				pattern, err := regexp.Compile(fileRegex)
-				if err != nil {
-					http.Error(w, "invalid file_regex", http.StatusBadRequest)
-					return
-				}
+// resolveOCIFile resolves the file requested by the `tag`, `platform`, `file`
+// and `file_regex` query parameters to the layer descriptor. The error response
+// is written and ok is false when it fails.
+func resolveOCIFile(w http.ResponseWriter, r *http.Request, provider ociRepoProvider, repository string, logger *log.Logger, logPrefix string) (repo *remote.Repository, targetFile string, descriptor *ocispec.Descriptor, ok bool) {
+	qp := r.URL.Query()
+	tag := qp.Get("tag")
+	if tag == "" {
+		http.Error(w, "missing tag query parameter", http.StatusBadRequest)
+		return nil, "", nil, false
+	}
 
-				files, err := pkgoci.ListFiles(ctx, repo, tag)
-				if err != nil {
-					logger.Printf("HEAD oci-file: ListFiles: %v", err)
-					http.Error(w, "failed to list files", http.StatusInternalServerError)
-					return
-				}
+	file := qp.Get("file")
+	fileRegex := qp.Get("file_regex")
 
-				for _, f := range files {
-					if pattern.MatchString(f) {
-						targetFile = f
-						break
-					}
-				}
+	repo, err := provider.GetTargetRepo(repository)
+	if err != nil {
+		logger.Printf("%s: getTargetRepo: %v", logPrefix, err)
+		http.Error(w, "failed to resolve repository", http.StatusInternalServerError)
+		return nil, "", nil, false
+	}
 
-				if targetFile == "" {
-					http.Error(w, "file not found", http.StatusNotFound)
-					return
-				}
-			} else {
-				http.Error(w, "missing file or file_regex parameter", http.StatusBadRequest)
+	ctx := r.Context()
+	tag, err = pkgoci.ResolveReference(ctx, repo, tag, qp.Get("platform"))
+	if err != nil {
+		logger.Printf("%s: ResolveReference: %v", logPrefix, err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, "", nil, false
+	}
+
+	if file != "" {
+		targetFile = file
+	} else if fileRegex != "" {
+		pattern, err := regexp.Compile(fileRegex)
+		if err != nil {
+			http.Error(w, "invalid file_regex", http.StatusBadRequest)
+			return nil, "", nil, false
+		}
+
+		files, err := pkgoci.ListFiles(ctx, repo, tag)
+		if err != nil {
+			logger.Printf("%s: ListFiles: %v", logPrefix, err)
+			http.Error(w, "failed to list files", http.StatusInternalServerError)
+			return nil, "", nil, false
+		}
+
+		for _, f := range files {
+			if pattern.MatchString(f) {
+				targetFile = f
+				break
+			}
+		}
+
+		if targetFile == "" {
+			http.Error(w, "file not found", http.StatusNotFound)
+			return nil, "", nil, false
+		}
+	} else {
+		http.Error(w, "missing file or file_regex parameter", http.StatusBadRequest)
+		return nil, "", nil, false
+	}
+
+	descriptor, err = pkgoci.FetchFileDescriptor(ctx, repo, tag, targetFile)
+	if err != nil {
+		logger.Printf("%s: FetchFileDescriptor: %v", logPrefix, err)
+		http.Error(w, "file not found", http.StatusNotFound)
+		return nil, "", nil, false
+	}
+
+	return repo, targetFile, descriptor, true
+}
+
+// redirectTTL is the lifetime of the presigned URLs in redirects, clients
+// follow them immediately.
+const redirectTTL = 15 * time.Minute
+
+// redirector is implemented by services which can redirect downloads to the
+// storage backend.
+type redirector interface {
+	RedirectByDefault() bool
+}
+
+// objectPresigner is implemented by storage services which can presign object
+// downloads.
+type objectPresigner interface {
+	redirector
+	PresignObject(ctx context.Context, bucket, key string, ttl time.Duration) (string, error)
+}
+
+// wantRedirect reports whether the download should be redirected, the
+// `redirect` query parameter overrides the default of the backend.
+func wantRedirect(r *http.Request, byDefault bool) (bool, error) {
+	if r.Method != http.MethodGet {
+		return false, nil
+	}
+	value := r.URL.Query().Get("redirect")
+	if value == "" {
+		return byDefault, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+// objectRedirectMiddleware redirects GET requests to `<pathPrefix><bucket>/<key>`
+// to presigned object URLs when redirection is wanted, so the content is not
+// streamed through dl.
+func objectRedirectMiddleware(pathPrefix string, presigner objectPresigner, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, pathPrefix) || strings.HasSuffix(r.URL.Path, "/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			redirect, err := wantRedirect(r, presigner.RedirectByDefault())
+			if err != nil {
+				http.Error(w, "invalid redirect parameter", http.StatusBadRequest)
+				return
+			}
+			bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, pathPrefix), "/")
+			if !redirect || bucket == "" || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			location, err := presigner.PresignObject(r.Context(), bucket, key, redirectTTL)
+			if err != nil {
+				logger.Printf("redirect %s: PresignObject: %v", r.URL.Path, err)
+				http.Error(w, "failed to presign object", http.StatusInternalServerError)
+				return
+			}
+			http.Redirect(w, r, location, http.StatusFound)
+		})
+	}
+}
+
+// ociRedirectMiddleware redirects GET requests to /oci-file/{*repository} to
+// the blob location when redirection is wanted and the registry redirects blob
+// downloads, otherwise the blob fetched for the redirect is streamed.
+func ociRedirectMiddleware(provider ociRepoProvider, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/oci-file/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+			var byDefault bool
+			if rd, ok := provider.(redirector); ok {
+				byDefault = rd.RedirectByDefault()
+			}
+			redirect, err := wantRedirect(r, byDefault)
+			if err != nil {
+				http.Error(w, "invalid redirect parameter", http.StatusBadRequest)
+				return
+			}
+			repository := strings.TrimPrefix(r.URL.Path, "/oci-file/")
+			if !redirect || repository == "" {
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			descriptor, err := pkgoci.FetchFileDescriptor(ctx, repo, tag, targetFile)
+			repo, targetFile, descriptor, ok := resolveOCIFile(w, r, provider, repository, logger, "redirect oci-file")
+			if !ok {
+				return
+			}
+			location, content, err := pkgoci.BlobLocation(r.Context(), repo, *descriptor)
 			if err != nil {
-				logger.Printf("HEAD oci-file: FetchFileDescriptor: %v", err)
-				http.Error(w, "file not found", http.StatusNotFound)
+				logger.Printf("redirect oci-file: BlobLocation: %v", err)
+				http.Error(w, "failed to locate blob", http.StatusBadGateway)
+				return
+			}
+			if location != "" {
+				http.Redirect(w, r, location, http.StatusFound)
+				return
+			}
+			if r.Header.Get("Range") != "" {
+				// served by the range middleware.
+				content.Close()
+				next.ServeHTTP(w, r)
 				return
 			}
 
+			// the registry serves the blob by itself, stream the fetched content.
+			defer content.Close()
 			w.Header().Set("Content-Disposition", attachment.ContentDisposition(targetFile))
 			w.Header().Set("Content-Length", fmt.Sprintf("%d", descriptor.Size))
 			w.Header().Set("Content-Type", "application/octet-stream")
 			w.WriteHeader(http.StatusOK)
+			if _, err := io.Copy(w, content); err != nil {
+				logger.Printf("redirect oci-file: stream blob: %v", err)
+			}
+		})
+	}
+}
+
+// tagSelectorMiddleware resolves tag selectors such as `~v8.5_linux_amd64` in
+// the `tag` query parameter of OCI requests to the highest matched tag, and
+// returns the resolved tag in the X-Resolved-Tag response header. The tags of
+// the repositories are cached for a short time.
+func tagSelectorMiddleware(provider ociRepoProvider, logger *log.Logger) func(http.Handler) http.Handler {
+	tags := pkgoci.NewTagCache(pkgoci.DefaultTagCacheTTL)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, "/oci-") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			qp := r.URL.Query()
+			selector := qp.Get("tag")
+			if !pkgoci.IsTagSelector(selector) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			_, repository, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+			if repository == "" {
+				http.Error(w, "missing repository", http.StatusBadRequest)
+				return
+			}
+
+			repo, err := provider.GetTargetRepo(repository)
+			if err != nil {
+				logger.Printf("tag selector: getTargetRepo: %v", err)
+				http.Error(w, "failed to resolve repository", http.StatusInternalServerError)
+				return
+			}
+
+			tag, err := tags.Resolve(r.Context(), repository, repo, selector)
+			if err != nil {
+				logger.Printf("tag selector: Resolve: %v", err)
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+
+			qp.Set("tag", tag)
+			r.URL.RawQuery = qp.Encode()
+			w.Header().Set("X-Resolved-Tag", tag)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// signedLinkMiddleware verifies the signed download links, the links with an
+// invalid or expired signature are rejected. When required is true, the
+// unsigned requests are rejected too, it's used by the public listener.
+func signedLinkMiddleware(signer *signing.Signer, required bool, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !signing.IsSigned(r.URL) {
+				if required {
+					http.Error(w, "signed link required", http.StatusUnauthorized)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			if err := signer.Verify(r.URL, time.Now()); err != nil {
+				logger.Printf("signed link %s: %v", r.URL.Path, err)
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
 		})
 	}
 }
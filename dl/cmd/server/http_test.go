@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/signing"
+)
+
+func TestSignedLinkMiddleware(t *testing.T) {
+	signer, err := signing.New(&signing.Config{Keys: []signing.Key{{ID: "k1", Secret: "secret"}}})
+	if err != nil {
+		t.Fatalf("signing.New error: %v", err)
+	}
+	link, _, err := signer.Sign(&url.URL{Path: "/s3-obj/bucket/hotfix.tar.gz"}, time.Hour, time.Now())
+	if err != nil {
+		t.Fatalf("Sign error: %v", err)
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	logger := log.New(io.Discard, "", 0)
+	private := signedLinkMiddleware(signer, false, logger)(next)
+	public := signedLinkMiddleware(signer, true, logger)(next)
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		method  string
+		target  string
+		want    int
+	}{
+		{name: "private unsigned", handler: private, method: http.MethodGet, target: "/s3-obj/bucket/hotfix.tar.gz", want: http.StatusTeapot},
+		{name: "private signed", handler: private, method: http.MethodGet, target: link, want: http.StatusTeapot},
+		{name: "private tampered", handler: private, method: http.MethodGet, target: link + "&x=1", want: http.StatusForbidden},
+		{name: "public unsigned", handler: public, method: http.MethodGet, target: "/s3-obj/bucket/hotfix.tar.gz", want: http.StatusUnauthorized},
+		{name: "public signed", handler: public, method: http.MethodHead, target: link, want: http.StatusTeapot},
+		{name: "public signed post", handler: public, method: http.MethodPost, target: link, want: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+type fakePresigner struct {
+	redirect bool
+}
+
+func (p *fakePresigner) RedirectByDefault() bool { return p.redirect }
+
+func (p *fakePresigner) PresignObject(_ context.Context, bucket, key string, _ time.Duration) (string, error) {
+	return "https://storage.example.com/" + bucket + "/" + key + "?sig=abc", nil
+}
+
+func TestObjectRedirectMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	logger := log.New(io.Discard, "", 0)
+
+	tests := []struct {
+		name      string
+		byDefault bool
+		method    string
+		target    string
+		want      int
+	}{
+		{name: "streamed by default", method: http.MethodGet, target: "/s3-obj/bucket/tidb.tar.gz", want: http.StatusTeapot},
+		{name: "redirect requested", method: http.MethodGet, target: "/s3-obj/bucket/tidb.tar.gz?redirect=true", want: http.StatusFound},
+		{name: "redirected by default", byDefault: true, method: http.MethodGet, target: "/s3-obj/bucket/tidb.tar.gz", want: http.StatusFound},
+		{name: "redirect opted out", byDefault: true, method: http.MethodGet, target: "/s3-obj/bucket/tidb.tar.gz?redirect=false", want: http.StatusTeapot},
+		{name: "head is not redirected", byDefault: true, method: http.MethodHead, target: "/s3-obj/bucket/tidb.tar.gz", want: http.StatusTeapot},
+		{name: "index is not redirected", byDefault: true, method: http.MethodGet, target: "/s3-obj/bucket/builds/", want: http.StatusTeapot},
+		{name: "invalid parameter", method: http.MethodGet, target: "/s3-obj/bucket/tidb.tar.gz?redirect=maybe", want: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := objectRedirectMiddleware("/s3-obj/", &fakePresigner{redirect: tt.byDefault}, logger)(next)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if rec.Code == http.StatusFound && rec.Header().Get("Location") != "https://storage.example.com/bucket/tidb.tar.gz?sig=abc" {
+				t.Errorf("Location = %q", rec.Header().Get("Location"))
+			}
+		})
+	}
+}
+
+type fakeRepoProvider struct{ repo *remote.Repository }
+
+func (p *fakeRepoProvider) GetTargetRepo(string) (*remote.Repository, error) { return p.repo, nil }
+
+func TestRangeOCIMiddleware(t *testing.T) {
+	data := strings.Repeat("tidb", 1024)
+	layer := content.NewDescriptorFromBytes("application/gzip", []byte(data))
+	layer.Annotations = map[string]string{ocispec.AnnotationTitle: "tidb.tar.gz"}
+	manifest, err := json.Marshal(ocispec.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ocispec.MediaTypeImageManifest,
+		Config:    ocispec.DescriptorEmptyJSON,
+		Layers:    []ocispec.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifestDigest := digest.FromBytes(manifest)
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/pingcap/tidb/package/manifests/v8.5.0", "/v2/pingcap/tidb/package/manifests/" + manifestDigest.String():
+			w.Header().Set("Content-Type", ocispec.MediaTypeImageManifest)
+			w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(manifest))
+		case "/v2/pingcap/tidb/package/blobs/" + layer.Digest.String():
+			http.ServeContent(w, r, "", time.Time{}, strings.NewReader(data))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+
+	repo, err := remote.NewRepository(strings.TrimPrefix(registry.URL, "http://") + "/pingcap/tidb/package")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo.PlainHTTP = true
+	repo.Client = &auth.Client{Client: registry.Client()}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "next")
+	})
+	handler := rangeOCIMiddleware(&fakeRepoProvider{repo: repo}, log.New(io.Discard, "", 0))(next)
+
+	tests := []struct {
+		name       string
+		rangeValue string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "resumed", rangeValue: "bytes=100-", wantStatus: http.StatusPartialContent, wantBody: data[100:]},
+		{name: "not satisfiable", rangeValue: "bytes=4096-", wantStatus: http.StatusRequestedRangeNotSatisfiable},
+		{name: "without range", wantStatus: http.StatusOK, wantBody: "next"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/oci-file/pingcap/tidb/package?tag=v8.5.0&file=tidb.tar.gz", nil)
+			if tt.rangeValue != "" {
+				req.Header.Set("Range", tt.rangeValue)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %.20q..., want %.20q...", rec.Body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
+	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+)
+
+// objectIndex is the view model of the object index page.
+type objectIndex struct {
+	Path          string
+	Parent        string
+	Prefixes      []objectIndexEntry
+	Objects       []objectIndexEntry
+	NextPageToken string
+}
+
+type objectIndexEntry struct {
+	Name         string
+	Link         string
+	Size         int64
+	LastModified string
+}
+
+// objectLister lists one page of objects and common prefixes under prefix, root
+// locates the bucket, such as `<bucket>` or `<endpoint>/<bucket>`.
+type objectLister func(ctx context.Context, root, prefix, pageToken string) (*objectIndex, error)
+
+var objectIndexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Index of {{.Path}}</title></head>
+<body>
+<h1>Index of {{.Path}}</h1>
+<table>
+<tr><th align="left">Name</th><th align="right">Size</th><th align="left">Last modified</th></tr>
+{{- if .Parent}}
+<tr><td><a href="{{.Parent}}">../</a></td><td></td><td></td></tr>
+{{- end}}
+{{- range .Prefixes}}
+<tr><td><a href="{{.Link}}">{{.Name}}</a></td><td align="right">-</td><td></td></tr>
+{{- end}}
+{{- range .Objects}}
+<tr><td><a href="{{.Link}}">{{.Name}}</a></td><td align="right">{{.Size}}</td><td>{{.LastModified}}</td></tr>
+{{- end}}
+</table>
+{{- if .NextPageToken}}
+<p><a href="?page_token={{.NextPageToken}}">Next page</a></p>
+{{- end}}
+</body>
+</html>
+`))
+
+// objectIndexMiddleware serves an HTML index of the objects for GET requests to
+// `<pathPrefix><root>/<prefix>/`, where root has rootSegments path segments. The
+// paths not ending with a slash are handled by next.
+func objectIndexMiddleware(pathPrefix string, rootSegments int, list objectLister, logger *log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, pathPrefix) || !strings.HasSuffix(r.URL.Path, "/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, pathPrefix), "/", rootSegments+1)
+			if len(segments) <= rootSegments || slices.Contains(segments[:rootSegments], "") {
+				http.Error(w, "missing bucket", http.StatusBadRequest)
+				return
+			}
+			root, prefix := strings.Join(segments[:rootSegments], "/"), segments[rootSegments]
+
+			index, err := list(r.Context(), root, prefix, r.URL.Query().Get("page_token"))
+			if err != nil {
+				logger.Printf("object index %s: %v", r.URL.Path, err)
+				http.Error(w, "failed to list objects", http.StatusNotFound)
+				return
+			}
+
+			index.Path = r.URL.Path
+			if prefix != "" {
+				index.Parent = "../"
+			}
+			bucketPath := pathPrefix + root + "/"
+			for i := range index.Prefixes {
+				index.Prefixes[i].Link = bucketPath + escapeKey(index.Prefixes[i].Name)
+				index.Prefixes[i].Name = strings.TrimPrefix(index.Prefixes[i].Name, prefix)
+			}
+			for i := range index.Objects {
+				index.Objects[i].Link = bucketPath + escapeKey(index.Objects[i].Name)
+				index.Objects[i].Name = strings.TrimPrefix(index.Objects[i].Name, prefix)
+			}
+
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			if err := objectIndexTemplate.Execute(w, index); err != nil {
+				logger.Printf("object index %s: render: %v", r.URL.Path, err)
+			}
+		})
+	}
+}
+
+// escapeKey escapes each segment of the object key for using in URL path.
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+func ks3ObjectLister(endpoints *ks3.Endpoints) objectLister {
+	return func(ctx context.Context, bucket, prefix, pageToken string) (*objectIndex, error) {
+		p := &ks3.ListObjectsPayload{Bucket: bucket, Prefix: &prefix, Delimiter: "/", MaxKeys: 1000}
+		if pageToken != "" {
+			p.PageToken = &pageToken
+		}
+		res, err := endpoints.ListObjects(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		listing := res.(*ks3.ObjectListing)
+		index := &objectIndex{}
+		for _, p := range listing.Prefixes {
+			index.Prefixes = append(index.Prefixes, objectIndexEntry{Name: p})
+		}
+		for _, o := range listing.Objects {
+			index.Objects = append(index.Objects, objectIndexEntry{Name: o.Key, Size: o.Size, LastModified: derefString(o.LastModified)})
+		}
+		index.NextPageToken = derefString(listing.NextPageToken)
+		return index, nil
+	}
+}
+
+func gcsObjectLister(endpoints *gcs.Endpoints) objectLister {
+	return func(ctx context.Context, bucket, prefix, pageToken string) (*objectIndex, error) {
+		p := &gcs.ListObjectsPayload{Bucket: bucket, Prefix: &prefix, Delimiter: "/", MaxKeys: 1000}
+		if pageToken != "" {
+			p.PageToken = &pageToken
+		}
+		res, err := endpoints.ListObjects(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		listing := res.(*gcs.ObjectListing)
+		index := &objectIndex{}
+		for _, p := range listing.Prefixes {
+			index.Prefixes = append(index.Prefixes, objectIndexEntry{Name: p})
+		}
+		for _, o := range listing.Objects {
+			index.Objects = append(index.Objects, objectIndexEntry{Name: o.Key, Size: o.Size, LastModified: derefString(o.LastModified)})
+		}
+		index.NextPageToken = derefString(listing.NextPageToken)
+		return index, nil
+	}
+}
+
+func s3ObjectLister(endpoints *s3.Endpoints) objectLister {
+	return func(ctx context.Context, root, prefix, pageToken string) (*objectIndex, error) {
+		endpoint, bucket, _ := strings.Cut(root, "/")
+		p := &s3.ListObjectsPayload{Endpoint: endpoint, Bucket: bucket, Prefix: &prefix, Delimiter: "/", MaxKeys: 1000}
+		if pageToken != "" {
+			p.PageToken = &pageToken
+		}
+		res, err := endpoints.ListObjects(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		listing := res.(*s3.ObjectListing)
+		index := &objectIndex{}
+		for _, p := range listing.Prefixes {
+			index.Prefixes = append(index.Prefixes, objectIndexEntry{Name: p})
+		}
+		for _, o := range listing.Objects {
+			index.Objects = append(index.Objects, objectIndexEntry{Name: o.Key, Size: o.Size, LastModified: derefString(o.LastModified)})
+		}
+		index.NextPageToken = derefString(listing.NextPageToken)
+		return index, nil
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestObjectIndexMiddleware(t *testing.T) {
+	var gotBucket, gotPrefix, gotToken string
+	list := func(_ context.Context, bucket, prefix, pageToken string) (*objectIndex, error) {
+		gotBucket, gotPrefix, gotToken = bucket, prefix, pageToken
+		return &objectIndex{
+			Prefixes:      []objectIndexEntry{{Name: "builds/tidb/master/"}},
+			Objects:       []objectIndexEntry{{Name: "builds/tidb/tidb linux.tar.gz", Size: 42}},
+			NextPageToken: "token-2",
+		}, nil
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	handler := objectIndexMiddleware("/s3-obj/", 1, list, log.New(io.Discard, "", 0))(next)
+
+	t.Run("index page", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/s3-obj/my-bucket/builds/tidb/?page_token=token-1", nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, want 200", rec.Code)
+		}
+		if gotBucket != "my-bucket" || gotPrefix != "builds/tidb/" || gotToken != "token-1" {
+			t.Errorf("listed bucket=%q prefix=%q token=%q", gotBucket, gotPrefix, gotToken)
+		}
+		body := rec.Body.String()
+		for _, want := range []string{
+			`href="/s3-obj/my-bucket/builds/tidb/master/">master/</a>`,
+			`href="/s3-obj/my-bucket/builds/tidb/tidb%20linux.tar.gz">tidb linux.tar.gz</a>`,
+			`href="../"`,
+			`href="?page_token=token-2"`,
+		} {
+			if !strings.Contains(body, want) {
+				t.Errorf("body does not contain %s:\n%s", want, body)
+			}
+		}
+	})
+
+	t.Run("object download passes through", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/s3-obj/my-bucket/builds/tidb/tidb.tar.gz", nil))
+		if rec.Code != http.StatusTeapot {
+			t.Errorf("status = %d, want the request handled by next", rec.Code)
+		}
+	})
+}
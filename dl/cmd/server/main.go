@@ -15,10 +15,16 @@ import (
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
 	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
 	gcssvc "github.com/PingCAP-QE/ee-apps/dl/internal/service/gcs"
 	ks3svc "github.com/PingCAP-QE/ee-apps/dl/internal/service/ks3"
 	ocisvc "github.com/PingCAP-QE/ee-apps/dl/internal/service/oci"
+	s3svc "github.com/PingCAP-QE/ee-apps/dl/internal/service/s3"
+	signsvc "github.com/PingCAP-QE/ee-apps/dl/internal/service/sign"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/blobcache"
 	"github.com/PingCAP-QE/ee-apps/dl/pkg/reload"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/signing"
 )
 
 func main() {
@@ -33,6 +39,11 @@ func main() {
 		ks3CfgPathF = flag.String("ks3-config", "ks3.yaml", "ks3 config yaml file path")
 		ociCfgPathF = flag.String("oci-config", "oci.yaml", "oci config yaml file path")
 		gcsCfgPathF = flag.String("gcs-config", "", "gcs config file path (yaml or json, optional: uses ADC if not set)")
+		s3CfgPathF  = flag.String("s3-config", "", "s3 compatible endpoints config yaml file path (optional)")
+		signCfgF    = flag.String("signing-config", "", "download link signing keys config yaml file path (optional: signing is disabled if not set)")
+		publicPortF = flag.String("public-http-port", "", "HTTP port of the public listener, which only serves signed download links (optional)")
+		cacheDirF   = flag.String("cache-dir", "", "OCI blob cache directory (optional: caching is disabled if not set)")
+		cacheSizeF  = flag.Int64("cache-size-mb", 10240, "OCI blob cache size cap in MiB")
 	)
 	flag.Parse()
 
@@ -44,29 +55,61 @@ func main() {
 		logger = log.New(os.Stderr, "[dl] ", log.Ltime)
 	}
 
+	// Initialize the OCI blob cache.
+	var (
+		blobCache *blobcache.Cache
+	)
+	if *cacheDirF != "" {
+		var err error
+		blobCache, err = blobcache.New(*cacheDirF, *cacheSizeF<<20, logger)
+		if err != nil {
+			logger.Fatalf("Failed to initialize blob cache: %v", err)
+		}
+	}
+
+	// Initialize the download link signer.
+	var (
+		signer *signing.Signer
+	)
+	if *signCfgF != "" {
+		var err error
+		signer, err = signing.Load(*signCfgF)
+		if err != nil {
+			logger.Fatalf("Failed to load signing keys: %v", err)
+		}
+	}
+
 	// Initialize the services.
 	var (
-		ociSvc oci.Service
-		ks3Svc ks3.Service
-		gcsSvc gcs.Service
+		ociSvc  oci.Service
+		ks3Svc  ks3.Service
+		gcsSvc  gcs.Service
+		s3Svc   s3.Service
+		signSvc sign.Service
 	)
 	{
-		ociSvc = ocisvc.New(logger, ociCfgPathF)
+		ociSvc = ocisvc.New(logger, ociCfgPathF, blobCache)
 		ks3Svc = ks3svc.New(logger, *ks3CfgPathF)
 		gcsSvc = gcssvc.New(logger, *gcsCfgPathF)
+		s3Svc = s3svc.New(logger, *s3CfgPathF)
+		signSvc = signsvc.New(logger, signer)
 	}
 
 	// Wrap the services in endpoints that can be invoked from other services
 	// potentially running in different processes.
 	var (
-		ociEndpoints *oci.Endpoints
-		ks3Endpoints *ks3.Endpoints
-		gcsEndpoints *gcs.Endpoints
+		ociEndpoints  *oci.Endpoints
+		ks3Endpoints  *ks3.Endpoints
+		gcsEndpoints  *gcs.Endpoints
+		s3Endpoints   *s3.Endpoints
+		signEndpoints *sign.Endpoints
 	)
 	{
 		ociEndpoints = oci.NewEndpoints(ociSvc)
 		ks3Endpoints = ks3.NewEndpoints(ks3Svc)
 		gcsEndpoints = gcs.NewEndpoints(gcsSvc)
+		s3Endpoints = s3.NewEndpoints(s3Svc)
+		signEndpoints = sign.NewEndpoints(signSvc)
 	}
 
 	// Create channel used by both the signal handler and server goroutines
@@ -104,6 +147,16 @@ func main() {
 				return r.Reload(ctx, *ociCfgPathF)
 			})
 		}
+		if r, ok := s3Svc.(reloader); ok {
+			go reload.WatchFile(ctx, *s3CfgPathF, logger, func() error {
+				return r.Reload(ctx, *s3CfgPathF)
+			})
+		}
+		if signer != nil {
+			go reload.WatchFile(ctx, *signCfgF, logger, func() error {
+				return signer.Reload(ctx, *signCfgF)
+			})
+		}
 	}
 
 	// Start the servers and send errors (if any) to the error channel.
@@ -130,7 +183,15 @@ func main() {
 			} else if u.Port() == "" {
 				u.Host = net.JoinHostPort(u.Host, "80")
 			}
-			handleHTTPServer(ctx, u, ociEndpoints, ks3Endpoints, gcsEndpoints, &wg, errc, logger, *dbgF, ociSvc)
+			var publicHost string
+			if *publicPortF != "" {
+				h, _, err := net.SplitHostPort(u.Host)
+				if err != nil {
+					logger.Fatalf("invalid URL %#v: %s\n", u.Host, err)
+				}
+				publicHost = net.JoinHostPort(h, *publicPortF)
+			}
+			handleHTTPServer(ctx, u, ociEndpoints, ks3Endpoints, gcsEndpoints, s3Endpoints, signEndpoints, &wg, errc, logger, *dbgF, ociSvc, ks3Svc, gcsSvc, signer, publicHost)
 		}
 
 	default:
@@ -14,6 +14,75 @@ var _ = API("dl", func() {
 	})
 })
 
+var LayerMetadata = Type("LayerMetadata", func() {
+	Description("Layer of an OCI artifact")
+	Attribute("file", String, "file name of the layer", func() {
+		Example("tidb-v8.1.0-linux-amd64.tar.gz")
+	})
+	Attribute("digest", String, "layer digest", func() {
+		Example("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	})
+	Attribute("size", Int64, "layer size in bytes")
+	Attribute("mediaType", String, "layer media type")
+	Attribute("annotations", MapOf(String, String), "layer annotations")
+	Required("digest", "size", "mediaType")
+})
+
+var ArtifactMetadata = Type("ArtifactMetadata", func() {
+	Description("Metadata of an OCI artifact")
+	Attribute("digest", String, "manifest digest", func() {
+		Example("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	})
+	Attribute("mediaType", String, "manifest media type")
+	Attribute("artifactType", String, "artifact type")
+	Attribute("config", MapOf(String, Any), "artifact config", func() {
+		Example(map[string]any{
+			"net.pingcap.tibuild.git-sha":      "6f1fa5e0d25a3b4fe1d2e4b9a4d7e2b8d38bd35b",
+			"org.opencontainers.image.version": "v8.1.0",
+		})
+	})
+	Attribute("annotations", MapOf(String, String), "manifest annotations")
+	Attribute("layers", ArrayOf(LayerMetadata), "artifact layers")
+	Required("digest", "mediaType", "layers")
+})
+
+var FileChecksum = Type("FileChecksum", func() {
+	Description("Checksum of a file or object")
+	Attribute("file", String, "file name or object key", func() {
+		Example("tidb-v8.1.0-linux-amd64.tar.gz")
+	})
+	Attribute("algorithm", String, "checksum algorithm", func() {
+		Example("sha256")
+	})
+	Attribute("checksum", String, "checksum in hex", func() {
+		Example("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	})
+	Attribute("size", Int64, "content size in bytes")
+	Required("file", "algorithm", "checksum", "size")
+})
+
+var ObjectInfo = Type("ObjectInfo", func() {
+	Description("Object in a bucket")
+	Attribute("key", String, "object key", func() {
+		Example("builds/pingcap/tidb/master/tidb-linux-amd64.tar.gz")
+	})
+	Attribute("size", Int64, "object size in bytes")
+	Attribute("lastModified", String, "last modified time", func() {
+		Format(FormatDateTime)
+	})
+	Required("key", "size")
+})
+
+var ObjectListing = Type("ObjectListing", func() {
+	Description("A page of objects and common prefixes in a bucket")
+	Attribute("prefixes", ArrayOf(String), "common prefixes rolled up by the delimiter", func() {
+		Example([]string{"builds/pingcap/tidb/master/"})
+	})
+	Attribute("objects", ArrayOf(ObjectInfo), "objects under the prefix")
+	Attribute("nextPageToken", String, "token to fetch the next page, absent on the last page")
+	Required("prefixes", "objects")
+})
+
 var _ = Service("oci", func() {
 	Description("OCI artifacts download service")
 
@@ -21,6 +90,9 @@ var _ = Service("oci", func() {
 		Payload(func() {
 			Field(1, "repository", String, "OCI artifact repository")
 			Field(2, "tag", String, "OCI artifact tag")
+			Field(3, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
 			Required("repository", "tag")
 		})
 
@@ -32,6 +104,7 @@ var _ = Service("oci", func() {
 		HTTP(func() {
 			GET("/oci-files/{*repository}")
 			Param("tag:tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
 		})
 	})
 
@@ -50,6 +123,9 @@ var _ = Service("oci", func() {
 				Format(FormatRegexp)
 				Example("tidb-.+[.]tar[.]gz")
 			})
+			Field(5, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
 
 			Required("repository", "tag")
 		})
@@ -73,6 +149,7 @@ var _ = Service("oci", func() {
 		HTTP(func() {
 			GET("/oci-file/{*repository}")
 			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
 			Param("file", String, "file name in OCI artifact")
 			Param("file_regex", String, "file name regex pattern in OCI artifact")
 
@@ -97,6 +174,9 @@ var _ = Service("oci", func() {
 			Field(4, "file_regex", String, "file name regexp pattern", func() {
 				Format(FormatRegexp)
 			})
+			Field(5, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
 			Required("repository", "tag")
 		})
 
@@ -112,6 +192,7 @@ var _ = Service("oci", func() {
 		HTTP(func() {
 			HEAD("/oci-file/{*repository}")
 			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
 			Param("file", String, "file name in OCI artifact")
 			Param("file_regex", String, "file name regex pattern in OCI artifact")
 
@@ -122,11 +203,159 @@ var _ = Service("oci", func() {
 		})
 	})
 
+	Method("list-tarball-entries", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository")
+			Field(2, "tag", String, "OCI artifact tag")
+			Field(3, "file", String, "tarball file name in OCI artifact")
+			Field(4, "file_regex", String, "tarball file name regexp pattern", func() {
+				Format(FormatRegexp)
+			})
+			Field(5, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+			Required("repository", "tag")
+		})
+
+		Result(ArrayOf(String))
+
+		Error("invalid_file_path", ErrorResult, "Could not locate tarball")
+		Error("internal_error", ErrorResult, "Fault while reading tarball.")
+
+		HTTP(func() {
+			GET("/oci-file-entries/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+			Param("file", String, "tarball file name in OCI artifact")
+			Param("file_regex", String, "tarball file name regex pattern in OCI artifact")
+		})
+	})
+
+	Method("download-tarball-entry", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository", func() {
+				Example("hub.pingcap.net/pingcap/tidb/package")
+			})
+			Field(2, "tag", String, "OCI artifact tag", func() {
+				Example("v8.1.0_darwin_arm64")
+			})
+			Field(3, "file", String, "tarball file name in OCI artifact", func() {
+				Example("tidb-v7.5.0-darwin-arm64.tar.gz")
+			})
+			Field(4, "file_regex", String, "tarball file name regexp pattern", func() {
+				Format(FormatRegexp)
+				Example("tidb-.+[.]tar[.]gz")
+			})
+			Field(5, "entry", String, "entry path inside the tarball", func() {
+				Example("bin/tidb-server")
+			})
+			Field(6, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+
+			Required("repository", "tag", "entry")
+		})
+
+		Result(func() {
+			Attribute("length", Int64, "Length is the downloaded content length in bytes.", func() {
+				Example(4 * 1024 * 1024)
+			})
+			Attribute("contentDisposition", String, "Content-Disposition header for downloading", func() {
+				Example("attachment; filename*=UTF-8''tidb-server")
+			})
+			Required("length", "contentDisposition")
+		})
+
+		Error("invalid_file_path", ErrorResult, "Could not locate tarball or entry for download")
+		Error("internal_error", ErrorResult, "Fault while processing download.")
+
+		HTTP(func() {
+			GET("/oci-file-entry/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+			Param("file", String, "tarball file name in OCI artifact")
+			Param("file_regex", String, "tarball file name regex pattern in OCI artifact")
+			Param("entry", String, "entry path inside the tarball")
+
+			// Bypass response body encoder code generation to alleviate need for
+			// loading the entire response body in memory.
+			SkipResponseBodyEncodeDecode()
+
+			Response(func() {
+				// Set the content type for binary data
+				ContentType("application/octet-stream")
+				Header("length:Content-Length")
+				Header("contentDisposition:Content-Disposition")
+			})
+		})
+	})
+
+	Method("download-files-bundle", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository", func() {
+				Example("hub.pingcap.net/pingcap/tidb/package")
+			})
+			Field(2, "tag", String, "OCI artifact tag", func() {
+				Example("v8.1.0_darwin_arm64")
+			})
+			Field(3, "files", ArrayOf(String), "file names in OCI artifact", func() {
+				Example([]string{"tidb-v7.5.0-darwin-arm64.tar.gz", "pd-v7.5.0-darwin-arm64.tar.gz"})
+			})
+			Field(4, "file_regex", String, "file name regexp pattern", func() {
+				Format(FormatRegexp)
+				Example(".+-darwin-.+[.]tar[.]gz")
+			})
+			Field(5, "format", String, "bundle archive format", func() {
+				Enum("tar", "zip")
+				Default("tar")
+			})
+			Field(6, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+
+			Required("repository", "tag")
+		})
+
+		Result(func() {
+			Attribute("contentType", String, "Content-Type header of the bundle", func() {
+				Example("application/x-tar")
+			})
+			Attribute("contentDisposition", String, "Content-Disposition header for downloading", func() {
+				Example("attachment; filename*=UTF-8''package-v8.1.0_darwin_arm64.tar")
+			})
+			Required("contentType", "contentDisposition")
+		})
+
+		Error("invalid_file_path", ErrorResult, "Could not locate files for download")
+		Error("internal_error", ErrorResult, "Fault while processing download.")
+
+		HTTP(func() {
+			GET("/oci-bundle/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+			Param("files", ArrayOf(String), "file names in OCI artifact")
+			Param("file_regex", String, "file name regex pattern in OCI artifact")
+			Param("format", String, "bundle archive format")
+
+			// Bypass response body encoder code generation to alleviate need for
+			// loading the entire response body in memory.
+			SkipResponseBodyEncodeDecode()
+
+			Response(func() {
+				Header("contentType:Content-Type")
+				Header("contentDisposition:Content-Disposition")
+			})
+		})
+	})
+
 	Method("download-file-sha256", func() {
 		Payload(func() {
 			Field(1, "repository", String, "OCI artifact repository")
 			Field(2, "tag", String, "OCI artifact tag")
 			Field(3, "file", String, "file name in OCI artifact")
+			Field(4, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
 			Required("repository", "tag", "file")
 		})
 
@@ -147,6 +376,7 @@ var _ = Service("oci", func() {
 			GET("/oci-file-sha256/{*repository}")
 			Param("file:file", String, "file name in OCI artifact")
 			Param("tag:tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
 
 			// Bypass response body encoder code generation to alleviate need for
 			// loading the entire response body in memory.
@@ -160,6 +390,102 @@ var _ = Service("oci", func() {
 			})
 		})
 	})
+
+	Method("get-artifact-metadata", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository")
+			Field(2, "tag", String, "OCI artifact tag")
+			Field(3, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+			Required("repository", "tag")
+		})
+
+		Result(ArtifactMetadata)
+
+		Error("invalid_file_path", ErrorResult, "Could not locate artifact")
+		Error("internal_error", ErrorResult, "Fault while fetching metadata.")
+
+		HTTP(func() {
+			GET("/oci-metadata/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+		})
+	})
+
+	Method("get-file-checksum", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository")
+			Field(2, "tag", String, "OCI artifact tag")
+			Field(3, "file", String, "file name in OCI artifact")
+			Field(4, "file_regex", String, "file name regex pattern in OCI artifact")
+			Field(5, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+			Field(6, "algorithm", String, "checksum algorithm", func() {
+				Enum("sha256", "sha512", "md5")
+				Default("sha256")
+			})
+			Required("repository", "tag")
+		})
+
+		Result(FileChecksum)
+
+		Error("invalid_file_path", ErrorResult, "Could not locate file")
+		Error("internal_error", ErrorResult, "Fault while computing checksum.")
+
+		HTTP(func() {
+			GET("/oci-file-checksum/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("file", String, "file name in OCI artifact")
+			Param("file_regex", String, "file name regex pattern in OCI artifact")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+			Param("algorithm", String, "checksum algorithm")
+		})
+	})
+
+	Method("download-checksums", func() {
+		Payload(func() {
+			Field(1, "repository", String, "OCI artifact repository")
+			Field(2, "tag", String, "OCI artifact tag")
+			Field(3, "platform", String, "platform of the artifact when the tag points to an image index, in os/arch[/variant] format", func() {
+				Example("linux/amd64")
+			})
+			Field(4, "algorithm", String, "checksum algorithm", func() {
+				Enum("sha256", "sha512", "md5")
+				Default("sha256")
+			})
+			Required("repository", "tag")
+		})
+
+		Result(func() {
+			Attribute("length", Int64, "Length is the downloaded content length in bytes.")
+			Attribute("contentDisposition", String, "Content-Disposition header for downloading", func() {
+				Example("attachment; filename*=UTF-8''SHA256SUMS")
+			})
+			Required("length", "contentDisposition")
+		})
+
+		Error("invalid_file_path", ErrorResult, "Could not locate artifact")
+		Error("internal_error", ErrorResult, "Fault while computing checksums.")
+
+		HTTP(func() {
+			GET("/oci-checksums/{*repository}")
+			Param("tag", String, "OCI artifact tag")
+			Param("platform", String, "platform of the artifact, e.g. linux/amd64")
+			Param("algorithm", String, "checksum algorithm")
+
+			// Bypass response body encoder code generation to alleviate need for
+			// loading the entire response body in memory.
+			SkipResponseBodyEncodeDecode()
+
+			Response(func() {
+				ContentType("text/plain; charset=utf-8")
+				Header("length:Content-Length")
+				Header("contentDisposition:Content-Disposition")
+			})
+		})
+	})
 })
 
 var _ = Service("ks3", func() {
@@ -231,6 +557,62 @@ var _ = Service("ks3", func() {
 			})
 		})
 	})
+
+	Method("get-object-checksum", func() {
+		Payload(func() {
+			Field(1, "bucket", String, "bucket name")
+			Field(2, "key", String, "object key")
+			Field(3, "algorithm", String, "checksum algorithm", func() {
+				Enum("sha256", "sha512", "md5")
+				Default("sha256")
+			})
+
+			Required("bucket", "key")
+		})
+
+		Result(FileChecksum)
+
+		Error("invalid_file_path", ErrorResult, "Could not locate file")
+		Error("internal_error", ErrorResult, "Fault while computing checksum.")
+
+		HTTP(func() {
+			GET("/s3-obj-checksum/{bucket}/{*key}")
+			Param("algorithm", String, "checksum algorithm")
+		})
+	})
+
+	Method("list-objects", func() {
+		Payload(func() {
+			Field(1, "bucket", String, "bucket name")
+			Field(2, "prefix", String, "object key prefix", func() {
+				Example("builds/pingcap/tidb/")
+			})
+			Field(3, "delimiter", String, "delimiter to roll up the keys into common prefixes", func() {
+				Default("/")
+			})
+			Field(4, "max_keys", Int, "max number of objects and prefixes in a page", func() {
+				Minimum(1)
+				Maximum(1000)
+				Default(1000)
+			})
+			Field(5, "page_token", String, "token of the page to fetch")
+
+			Required("bucket")
+		})
+
+		Result(ObjectListing)
+
+		Error("invalid_file_path", ErrorResult, "Could not list the bucket")
+		Error("internal_error", ErrorResult, "Processing error")
+
+		HTTP(func() {
+			GET("/s3-objs/{bucket}")
+			Param("prefix", String, "object key prefix")
+			Param("delimiter", String, "delimiter to roll up the keys into common prefixes")
+			Param("max_keys", Int, "max number of objects and prefixes in a page")
+			Param("page_token", String, "token of the page to fetch")
+		})
+	})
 })
 
 var _ = Service("gcs", func() {
@@ -302,4 +684,200 @@ var _ = Service("gcs", func() {
 			})
 		})
 	})
+
+	Method("get-object-checksum", func() {
+		Payload(func() {
+			Field(1, "bucket", String, "bucket name")
+			Field(2, "key", String, "object key")
+			Field(3, "algorithm", String, "checksum algorithm", func() {
+				Enum("sha256", "sha512", "md5")
+				Default("sha256")
+			})
+
+			Required("bucket", "key")
+		})
+
+		Result(FileChecksum)
+
+		Error("invalid_file_path", ErrorResult, "Could not locate file")
+		Error("internal_error", ErrorResult, "Fault while computing checksum.")
+
+		HTTP(func() {
+			GET("/gcs-obj-checksum/{bucket}/{*key}")
+			Param("algorithm", String, "checksum algorithm")
+		})
+	})
+
+	Method("list-objects", func() {
+		Payload(func() {
+			Field(1, "bucket", String, "bucket name")
+			Field(2, "prefix", String, "object key prefix", func() {
+				Example("builds/pingcap/tidb/")
+			})
+			Field(3, "delimiter", String, "delimiter to roll up the keys into common prefixes", func() {
+				Default("/")
+			})
+			Field(4, "max_keys", Int, "max number of objects and prefixes in a page", func() {
+				Minimum(1)
+				Maximum(1000)
+				Default(1000)
+			})
+			Field(5, "page_token", String, "token of the page to fetch")
+
+			Required("bucket")
+		})
+
+		Result(ObjectListing)
+
+		Error("invalid_file_path", ErrorResult, "Could not list the bucket")
+		Error("internal_error", ErrorResult, "Processing error")
+
+		HTTP(func() {
+			GET("/gcs-objs/{bucket}")
+			Param("prefix", String, "object key prefix")
+			Param("delimiter", String, "delimiter to roll up the keys into common prefixes")
+			Param("max_keys", Int, "max number of objects and prefixes in a page")
+			Param("page_token", String, "token of the page to fetch")
+		})
+	})
+})
+
+var _ = Service("s3", func() {
+	Description("S3 compatible storage download service, such as AWS S3, MinIO and Cloudflare R2")
+
+	Method("download-object", func() {
+		Payload(func() {
+			Field(1, "endpoint", String, "name of the configured endpoint")
+			Field(2, "bucket", String, "bucket name")
+			Field(3, "key", String, "object key")
+
+			Required("endpoint", "bucket", "key")
+		})
+
+		// The use of Result here illustrates how HTTP headers can still be
+		// properly encoded and validated when using SkipResponseBodyEncode. It
+		// is not generally required to implement a download method.
+		Result(func() {
+			Attribute("length", Int64, "Length is the downloaded content length in bytes.", func() {
+				Example(4 * 1024 * 1024)
+			})
+			Attribute("contentDisposition", String, "Content-Disposition header for downloading", func() {
+				Example("attachment; filename*=UTF-8''tidb-v7.5.0-darwin-arm64.tar.gz")
+			})
+			Required("length", "contentDisposition")
+		})
+
+		Error("invalid_file_path", ErrorResult, "Could not locate file for download")
+		Error("internal_error", ErrorResult, "Fault while processing download.")
+
+		HTTP(func() {
+			GET("/s3/{endpoint}/{bucket}/{*key}")
+
+			// Bypass response body encoder code generation to alleviate need for
+			// loading the entire response body in memory.
+			SkipResponseBodyEncodeDecode()
+
+			Response(func() {
+				// Set the content type for binary data
+				ContentType("application/octet-stream")
+				Header("length:Content-Length")
+				Header("contentDisposition:Content-Disposition")
+			})
+		})
+	})
+
+	Method("head-object", func() {
+		Payload(func() {
+			Field(1, "endpoint", String, "name of the configured endpoint")
+			Field(2, "bucket", String, "bucket name")
+			Field(3, "key", String, "object key")
+
+			Required("endpoint", "bucket", "key")
+		})
+
+		Result(func() {
+			Attribute("length", Int64, "Content length in bytes.")
+			Attribute("contentDisposition", String, "Content-Disposition header value")
+			Required("length", "contentDisposition")
+		})
+
+		Error("invalid_file_path", ErrorResult, "Could not locate file")
+		Error("internal_error", ErrorResult, "Processing error")
+
+		HTTP(func() {
+			HEAD("/s3/{endpoint}/{bucket}/{*key}")
+
+			Response(func() {
+				Header("length:Content-Length")
+				Header("contentDisposition:Content-Disposition")
+			})
+		})
+	})
+
+	Method("list-objects", func() {
+		Payload(func() {
+			Field(1, "endpoint", String, "name of the configured endpoint")
+			Field(2, "bucket", String, "bucket name")
+			Field(3, "prefix", String, "object key prefix", func() {
+				Example("builds/pingcap/tidb/")
+			})
+			Field(4, "delimiter", String, "delimiter to roll up the keys into common prefixes, only `/` is supported", func() {
+				Enum("", "/")
+				Default("/")
+			})
+			Field(5, "max_keys", Int, "max number of objects and prefixes in a page", func() {
+				Minimum(1)
+				Maximum(1000)
+				Default(1000)
+			})
+			Field(6, "page_token", String, "token of the page to fetch")
+
+			Required("endpoint", "bucket")
+		})
+
+		Result(ObjectListing)
+
+		Error("invalid_file_path", ErrorResult, "Could not list the bucket")
+		Error("internal_error", ErrorResult, "Processing error")
+
+		HTTP(func() {
+			GET("/s3-list/{endpoint}/{bucket}")
+			Param("prefix", String, "object key prefix")
+			Param("delimiter", String, "delimiter to roll up the keys into common prefixes")
+			Param("max_keys", Int, "max number of objects and prefixes in a page")
+			Param("page_token", String, "token of the page to fetch")
+		})
+	})
+})
+
+var _ = Service("sign", func() {
+	Description("Service for issuing time-limited signed download links")
+
+	Method("sign-url", func() {
+		Payload(func() {
+			Field(1, "path", String, "path and query of the download to sign", func() {
+				Example("/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0_linux_amd64&file=tidb-v8.5.0-linux-amd64.tar.gz")
+			})
+			Field(2, "ttl", String, "lifetime of the link, in Go duration format", func() {
+				Example("24h")
+				Default("1h")
+			})
+
+			Required("path")
+		})
+
+		Result(func() {
+			Attribute("url", String, "signed download link")
+			Attribute("expiresAt", String, "expiry time of the link", func() {
+				Format(FormatDateTime)
+			})
+			Required("url", "expiresAt")
+		})
+
+		Error("invalid_request", ErrorResult, "The link could not be signed")
+
+		HTTP(func() {
+			POST("/sign")
+		})
+	})
 })
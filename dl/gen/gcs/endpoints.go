@@ -16,8 +16,10 @@ import (
 
 // Endpoints wraps the "gcs" service endpoints.
 type Endpoints struct {
-	DownloadObject goa.Endpoint
-	HeadObject     goa.Endpoint
+	DownloadObject    goa.Endpoint
+	HeadObject        goa.Endpoint
+	GetObjectChecksum goa.Endpoint
+	ListObjects       goa.Endpoint
 }
 
 // DownloadObjectResponseData holds both the result and the HTTP response body
@@ -32,8 +34,10 @@ type DownloadObjectResponseData struct {
 // NewEndpoints wraps the methods of the "gcs" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		DownloadObject: NewDownloadObjectEndpoint(s),
-		HeadObject:     NewHeadObjectEndpoint(s),
+		DownloadObject:    NewDownloadObjectEndpoint(s),
+		HeadObject:        NewHeadObjectEndpoint(s),
+		GetObjectChecksum: NewGetObjectChecksumEndpoint(s),
+		ListObjects:       NewListObjectsEndpoint(s),
 	}
 }
 
@@ -41,6 +45,8 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.DownloadObject = m(e.DownloadObject)
 	e.HeadObject = m(e.HeadObject)
+	e.GetObjectChecksum = m(e.GetObjectChecksum)
+	e.ListObjects = m(e.ListObjects)
 }
 
 // NewDownloadObjectEndpoint returns an endpoint function that calls the method
@@ -64,3 +70,21 @@ func NewHeadObjectEndpoint(s Service) goa.Endpoint {
 		return s.HeadObject(ctx, p)
 	}
 }
+
+// NewGetObjectChecksumEndpoint returns an endpoint function that calls the
+// method "get-object-checksum" of service "gcs".
+func NewGetObjectChecksumEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetObjectChecksumPayload)
+		return s.GetObjectChecksum(ctx, p)
+	}
+}
+
+// NewListObjectsEndpoint returns an endpoint function that calls the method
+// "list-objects" of service "gcs".
+func NewListObjectsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListObjectsPayload)
+		return s.ListObjects(ctx, p)
+	}
+}
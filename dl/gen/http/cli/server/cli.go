@@ -16,6 +16,8 @@ import (
 	gcsc "github.com/PingCAP-QE/ee-apps/dl/gen/http/gcs/client"
 	ks3c "github.com/PingCAP-QE/ee-apps/dl/gen/http/ks3/client"
 	ocic "github.com/PingCAP-QE/ee-apps/dl/gen/http/oci/client"
+	s3c "github.com/PingCAP-QE/ee-apps/dl/gen/http/s3/client"
+	signc "github.com/PingCAP-QE/ee-apps/dl/gen/http/sign/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -25,17 +27,24 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"oci (list-files|download-file|head-file|download-file-sha256)",
-		"ks3 (download-object|head-object)",
-		"gcs (download-object|head-object)",
+		"oci (list-files|download-file|head-file|list-tarball-entries|download-tarball-entry|download-files-bundle|download-file-sha256|get-artifact-metadata|get-file-checksum|download-checksums)",
+		"ks3 (download-object|head-object|get-object-checksum|list-objects)",
+		"gcs (download-object|head-object|get-object-checksum|list-objects)",
+		"s3 (download-object|head-object|list-objects)",
+		"sign sign-url",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` oci list-files --repository "Quam et asperiores." --tag "Deserunt esse et."` + "\n" +
-		os.Args[0] + ` ks3 download-object --bucket "Assumenda et eveniet magni quasi consequatur esse." --key "Qui quas."` + "\n" +
-		os.Args[0] + ` gcs download-object --bucket "Et sit recusandae qui totam dolor." --key "Ut doloremque laborum quis voluptas voluptatum voluptate."` + "\n" +
+	return os.Args[0] + ` oci list-files --repository "Vero voluptates aut quae aut officiis aut." --tag "Ut et quia quas qui esse omnis." --platform "linux/amd64"` + "\n" +
+		os.Args[0] + ` ks3 download-object --bucket "Et voluptate illum fuga." --key "At natus."` + "\n" +
+		os.Args[0] + ` gcs download-object --bucket "Harum accusamus corrupti molestiae est optio aut." --key "Itaque officia delectus quisquam dolorum corrupti quibusdam."` + "\n" +
+		os.Args[0] + ` s3 download-object --endpoint "Ea sequi dolor ex facilis." --bucket "Ex ipsum a aut ipsam ea." --key "Consequatur amet molestiae illum aut."` + "\n" +
+		os.Args[0] + ` sign sign-url --body '{
+      "path": "/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0_linux_amd64\u0026file=tidb-v8.5.0-linux-amd64.tar.gz",
+      "ttl": "24h"
+   }'` + "\n" +
 		""
 }
 
@@ -54,23 +63,69 @@ func ParseEndpoint(
 		ociListFilesFlags          = flag.NewFlagSet("list-files", flag.ExitOnError)
 		ociListFilesRepositoryFlag = ociListFilesFlags.String("repository", "REQUIRED", "OCI artifact repository")
 		ociListFilesTagFlag        = ociListFilesFlags.String("tag", "REQUIRED", "")
+		ociListFilesPlatformFlag   = ociListFilesFlags.String("platform", "", "")
 
 		ociDownloadFileFlags          = flag.NewFlagSet("download-file", flag.ExitOnError)
 		ociDownloadFileRepositoryFlag = ociDownloadFileFlags.String("repository", "REQUIRED", "OCI artifact repository")
 		ociDownloadFileTagFlag        = ociDownloadFileFlags.String("tag", "REQUIRED", "")
+		ociDownloadFilePlatformFlag   = ociDownloadFileFlags.String("platform", "", "")
 		ociDownloadFileFileFlag       = ociDownloadFileFlags.String("file", "", "")
 		ociDownloadFileFileRegexFlag  = ociDownloadFileFlags.String("file-regex", "", "")
 
 		ociHeadFileFlags          = flag.NewFlagSet("head-file", flag.ExitOnError)
 		ociHeadFileRepositoryFlag = ociHeadFileFlags.String("repository", "REQUIRED", "OCI artifact repository")
 		ociHeadFileTagFlag        = ociHeadFileFlags.String("tag", "REQUIRED", "")
+		ociHeadFilePlatformFlag   = ociHeadFileFlags.String("platform", "", "")
 		ociHeadFileFileFlag       = ociHeadFileFlags.String("file", "", "")
 		ociHeadFileFileRegexFlag  = ociHeadFileFlags.String("file-regex", "", "")
 
+		ociListTarballEntriesFlags          = flag.NewFlagSet("list-tarball-entries", flag.ExitOnError)
+		ociListTarballEntriesRepositoryFlag = ociListTarballEntriesFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociListTarballEntriesTagFlag        = ociListTarballEntriesFlags.String("tag", "REQUIRED", "")
+		ociListTarballEntriesPlatformFlag   = ociListTarballEntriesFlags.String("platform", "", "")
+		ociListTarballEntriesFileFlag       = ociListTarballEntriesFlags.String("file", "", "")
+		ociListTarballEntriesFileRegexFlag  = ociListTarballEntriesFlags.String("file-regex", "", "")
+
+		ociDownloadTarballEntryFlags          = flag.NewFlagSet("download-tarball-entry", flag.ExitOnError)
+		ociDownloadTarballEntryRepositoryFlag = ociDownloadTarballEntryFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociDownloadTarballEntryTagFlag        = ociDownloadTarballEntryFlags.String("tag", "REQUIRED", "")
+		ociDownloadTarballEntryPlatformFlag   = ociDownloadTarballEntryFlags.String("platform", "", "")
+		ociDownloadTarballEntryFileFlag       = ociDownloadTarballEntryFlags.String("file", "", "")
+		ociDownloadTarballEntryFileRegexFlag  = ociDownloadTarballEntryFlags.String("file-regex", "", "")
+		ociDownloadTarballEntryEntryFlag      = ociDownloadTarballEntryFlags.String("entry", "REQUIRED", "")
+
+		ociDownloadFilesBundleFlags          = flag.NewFlagSet("download-files-bundle", flag.ExitOnError)
+		ociDownloadFilesBundleRepositoryFlag = ociDownloadFilesBundleFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociDownloadFilesBundleTagFlag        = ociDownloadFilesBundleFlags.String("tag", "REQUIRED", "")
+		ociDownloadFilesBundlePlatformFlag   = ociDownloadFilesBundleFlags.String("platform", "", "")
+		ociDownloadFilesBundleFilesFlag      = ociDownloadFilesBundleFlags.String("files", "", "")
+		ociDownloadFilesBundleFileRegexFlag  = ociDownloadFilesBundleFlags.String("file-regex", "", "")
+		ociDownloadFilesBundleFormatFlag     = ociDownloadFilesBundleFlags.String("format", "tar", "")
+
 		ociDownloadFileSha256Flags          = flag.NewFlagSet("download-file-sha256", flag.ExitOnError)
 		ociDownloadFileSha256RepositoryFlag = ociDownloadFileSha256Flags.String("repository", "REQUIRED", "OCI artifact repository")
 		ociDownloadFileSha256FileFlag       = ociDownloadFileSha256Flags.String("file", "REQUIRED", "")
 		ociDownloadFileSha256TagFlag        = ociDownloadFileSha256Flags.String("tag", "REQUIRED", "")
+		ociDownloadFileSha256PlatformFlag   = ociDownloadFileSha256Flags.String("platform", "", "")
+
+		ociGetArtifactMetadataFlags          = flag.NewFlagSet("get-artifact-metadata", flag.ExitOnError)
+		ociGetArtifactMetadataRepositoryFlag = ociGetArtifactMetadataFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociGetArtifactMetadataTagFlag        = ociGetArtifactMetadataFlags.String("tag", "REQUIRED", "")
+		ociGetArtifactMetadataPlatformFlag   = ociGetArtifactMetadataFlags.String("platform", "", "")
+
+		ociGetFileChecksumFlags          = flag.NewFlagSet("get-file-checksum", flag.ExitOnError)
+		ociGetFileChecksumRepositoryFlag = ociGetFileChecksumFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociGetFileChecksumTagFlag        = ociGetFileChecksumFlags.String("tag", "REQUIRED", "")
+		ociGetFileChecksumFileFlag       = ociGetFileChecksumFlags.String("file", "", "")
+		ociGetFileChecksumFileRegexFlag  = ociGetFileChecksumFlags.String("file-regex", "", "")
+		ociGetFileChecksumPlatformFlag   = ociGetFileChecksumFlags.String("platform", "", "")
+		ociGetFileChecksumAlgorithmFlag  = ociGetFileChecksumFlags.String("algorithm", "sha256", "")
+
+		ociDownloadChecksumsFlags          = flag.NewFlagSet("download-checksums", flag.ExitOnError)
+		ociDownloadChecksumsRepositoryFlag = ociDownloadChecksumsFlags.String("repository", "REQUIRED", "OCI artifact repository")
+		ociDownloadChecksumsTagFlag        = ociDownloadChecksumsFlags.String("tag", "REQUIRED", "")
+		ociDownloadChecksumsPlatformFlag   = ociDownloadChecksumsFlags.String("platform", "", "")
+		ociDownloadChecksumsAlgorithmFlag  = ociDownloadChecksumsFlags.String("algorithm", "sha256", "")
 
 		ks3Flags = flag.NewFlagSet("ks3", flag.ContinueOnError)
 
@@ -82,6 +137,18 @@ func ParseEndpoint(
 		ks3HeadObjectBucketFlag = ks3HeadObjectFlags.String("bucket", "REQUIRED", "bucket name")
 		ks3HeadObjectKeyFlag    = ks3HeadObjectFlags.String("key", "REQUIRED", "object key")
 
+		ks3GetObjectChecksumFlags         = flag.NewFlagSet("get-object-checksum", flag.ExitOnError)
+		ks3GetObjectChecksumBucketFlag    = ks3GetObjectChecksumFlags.String("bucket", "REQUIRED", "bucket name")
+		ks3GetObjectChecksumKeyFlag       = ks3GetObjectChecksumFlags.String("key", "REQUIRED", "object key")
+		ks3GetObjectChecksumAlgorithmFlag = ks3GetObjectChecksumFlags.String("algorithm", "sha256", "")
+
+		ks3ListObjectsFlags         = flag.NewFlagSet("list-objects", flag.ExitOnError)
+		ks3ListObjectsBucketFlag    = ks3ListObjectsFlags.String("bucket", "REQUIRED", "bucket name")
+		ks3ListObjectsPrefixFlag    = ks3ListObjectsFlags.String("prefix", "", "")
+		ks3ListObjectsDelimiterFlag = ks3ListObjectsFlags.String("delimiter", "/", "")
+		ks3ListObjectsMaxKeysFlag   = ks3ListObjectsFlags.String("max-keys", "1000", "")
+		ks3ListObjectsPageTokenFlag = ks3ListObjectsFlags.String("page-token", "", "")
+
 		gcsFlags = flag.NewFlagSet("gcs", flag.ContinueOnError)
 
 		gcsDownloadObjectFlags      = flag.NewFlagSet("download-object", flag.ExitOnError)
@@ -91,20 +158,75 @@ func ParseEndpoint(
 		gcsHeadObjectFlags      = flag.NewFlagSet("head-object", flag.ExitOnError)
 		gcsHeadObjectBucketFlag = gcsHeadObjectFlags.String("bucket", "REQUIRED", "bucket name")
 		gcsHeadObjectKeyFlag    = gcsHeadObjectFlags.String("key", "REQUIRED", "object key")
+
+		gcsGetObjectChecksumFlags         = flag.NewFlagSet("get-object-checksum", flag.ExitOnError)
+		gcsGetObjectChecksumBucketFlag    = gcsGetObjectChecksumFlags.String("bucket", "REQUIRED", "bucket name")
+		gcsGetObjectChecksumKeyFlag       = gcsGetObjectChecksumFlags.String("key", "REQUIRED", "object key")
+		gcsGetObjectChecksumAlgorithmFlag = gcsGetObjectChecksumFlags.String("algorithm", "sha256", "")
+
+		gcsListObjectsFlags         = flag.NewFlagSet("list-objects", flag.ExitOnError)
+		gcsListObjectsBucketFlag    = gcsListObjectsFlags.String("bucket", "REQUIRED", "bucket name")
+		gcsListObjectsPrefixFlag    = gcsListObjectsFlags.String("prefix", "", "")
+		gcsListObjectsDelimiterFlag = gcsListObjectsFlags.String("delimiter", "/", "")
+		gcsListObjectsMaxKeysFlag   = gcsListObjectsFlags.String("max-keys", "1000", "")
+		gcsListObjectsPageTokenFlag = gcsListObjectsFlags.String("page-token", "", "")
+
+		s3Flags = flag.NewFlagSet("s3", flag.ContinueOnError)
+
+		s3DownloadObjectFlags        = flag.NewFlagSet("download-object", flag.ExitOnError)
+		s3DownloadObjectEndpointFlag = s3DownloadObjectFlags.String("endpoint", "REQUIRED", "name of the configured endpoint")
+		s3DownloadObjectBucketFlag   = s3DownloadObjectFlags.String("bucket", "REQUIRED", "bucket name")
+		s3DownloadObjectKeyFlag      = s3DownloadObjectFlags.String("key", "REQUIRED", "object key")
+
+		s3HeadObjectFlags        = flag.NewFlagSet("head-object", flag.ExitOnError)
+		s3HeadObjectEndpointFlag = s3HeadObjectFlags.String("endpoint", "REQUIRED", "name of the configured endpoint")
+		s3HeadObjectBucketFlag   = s3HeadObjectFlags.String("bucket", "REQUIRED", "bucket name")
+		s3HeadObjectKeyFlag      = s3HeadObjectFlags.String("key", "REQUIRED", "object key")
+
+		s3ListObjectsFlags         = flag.NewFlagSet("list-objects", flag.ExitOnError)
+		s3ListObjectsEndpointFlag  = s3ListObjectsFlags.String("endpoint", "REQUIRED", "name of the configured endpoint")
+		s3ListObjectsBucketFlag    = s3ListObjectsFlags.String("bucket", "REQUIRED", "bucket name")
+		s3ListObjectsPrefixFlag    = s3ListObjectsFlags.String("prefix", "", "")
+		s3ListObjectsDelimiterFlag = s3ListObjectsFlags.String("delimiter", "/", "")
+		s3ListObjectsMaxKeysFlag   = s3ListObjectsFlags.String("max-keys", "1000", "")
+		s3ListObjectsPageTokenFlag = s3ListObjectsFlags.String("page-token", "", "")
+
+		signFlags = flag.NewFlagSet("sign", flag.ContinueOnError)
+
+		signSignURLFlags    = flag.NewFlagSet("sign-url", flag.ExitOnError)
+		signSignURLBodyFlag = signSignURLFlags.String("body", "REQUIRED", "")
 	)
 	ociFlags.Usage = ociUsage
 	ociListFilesFlags.Usage = ociListFilesUsage
 	ociDownloadFileFlags.Usage = ociDownloadFileUsage
 	ociHeadFileFlags.Usage = ociHeadFileUsage
+	ociListTarballEntriesFlags.Usage = ociListTarballEntriesUsage
+	ociDownloadTarballEntryFlags.Usage = ociDownloadTarballEntryUsage
+	ociDownloadFilesBundleFlags.Usage = ociDownloadFilesBundleUsage
 	ociDownloadFileSha256Flags.Usage = ociDownloadFileSha256Usage
+	ociGetArtifactMetadataFlags.Usage = ociGetArtifactMetadataUsage
+	ociGetFileChecksumFlags.Usage = ociGetFileChecksumUsage
+	ociDownloadChecksumsFlags.Usage = ociDownloadChecksumsUsage
 
 	ks3Flags.Usage = ks3Usage
 	ks3DownloadObjectFlags.Usage = ks3DownloadObjectUsage
 	ks3HeadObjectFlags.Usage = ks3HeadObjectUsage
+	ks3GetObjectChecksumFlags.Usage = ks3GetObjectChecksumUsage
+	ks3ListObjectsFlags.Usage = ks3ListObjectsUsage
 
 	gcsFlags.Usage = gcsUsage
 	gcsDownloadObjectFlags.Usage = gcsDownloadObjectUsage
 	gcsHeadObjectFlags.Usage = gcsHeadObjectUsage
+	gcsGetObjectChecksumFlags.Usage = gcsGetObjectChecksumUsage
+	gcsListObjectsFlags.Usage = gcsListObjectsUsage
+
+	s3Flags.Usage = s3Usage
+	s3DownloadObjectFlags.Usage = s3DownloadObjectUsage
+	s3HeadObjectFlags.Usage = s3HeadObjectUsage
+	s3ListObjectsFlags.Usage = s3ListObjectsUsage
+
+	signFlags.Usage = signUsage
+	signSignURLFlags.Usage = signSignURLUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -127,6 +249,10 @@ func ParseEndpoint(
 			svcf = ks3Flags
 		case "gcs":
 			svcf = gcsFlags
+		case "s3":
+			svcf = s3Flags
+		case "sign":
+			svcf = signFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -153,9 +279,27 @@ func ParseEndpoint(
 			case "head-file":
 				epf = ociHeadFileFlags
 
+			case "list-tarball-entries":
+				epf = ociListTarballEntriesFlags
+
+			case "download-tarball-entry":
+				epf = ociDownloadTarballEntryFlags
+
+			case "download-files-bundle":
+				epf = ociDownloadFilesBundleFlags
+
 			case "download-file-sha256":
 				epf = ociDownloadFileSha256Flags
 
+			case "get-artifact-metadata":
+				epf = ociGetArtifactMetadataFlags
+
+			case "get-file-checksum":
+				epf = ociGetFileChecksumFlags
+
+			case "download-checksums":
+				epf = ociDownloadChecksumsFlags
+
 			}
 
 		case "ks3":
@@ -166,6 +310,12 @@ func ParseEndpoint(
 			case "head-object":
 				epf = ks3HeadObjectFlags
 
+			case "get-object-checksum":
+				epf = ks3GetObjectChecksumFlags
+
+			case "list-objects":
+				epf = ks3ListObjectsFlags
+
 			}
 
 		case "gcs":
@@ -176,6 +326,32 @@ func ParseEndpoint(
 			case "head-object":
 				epf = gcsHeadObjectFlags
 
+			case "get-object-checksum":
+				epf = gcsGetObjectChecksumFlags
+
+			case "list-objects":
+				epf = gcsListObjectsFlags
+
+			}
+
+		case "s3":
+			switch epn {
+			case "download-object":
+				epf = s3DownloadObjectFlags
+
+			case "head-object":
+				epf = s3HeadObjectFlags
+
+			case "list-objects":
+				epf = s3ListObjectsFlags
+
+			}
+
+		case "sign":
+			switch epn {
+			case "sign-url":
+				epf = signSignURLFlags
+
 			}
 
 		}
@@ -203,16 +379,34 @@ func ParseEndpoint(
 			switch epn {
 			case "list-files":
 				endpoint = c.ListFiles()
-				data, err = ocic.BuildListFilesPayload(*ociListFilesRepositoryFlag, *ociListFilesTagFlag)
+				data, err = ocic.BuildListFilesPayload(*ociListFilesRepositoryFlag, *ociListFilesTagFlag, *ociListFilesPlatformFlag)
 			case "download-file":
 				endpoint = c.DownloadFile()
-				data, err = ocic.BuildDownloadFilePayload(*ociDownloadFileRepositoryFlag, *ociDownloadFileTagFlag, *ociDownloadFileFileFlag, *ociDownloadFileFileRegexFlag)
+				data, err = ocic.BuildDownloadFilePayload(*ociDownloadFileRepositoryFlag, *ociDownloadFileTagFlag, *ociDownloadFilePlatformFlag, *ociDownloadFileFileFlag, *ociDownloadFileFileRegexFlag)
 			case "head-file":
 				endpoint = c.HeadFile()
-				data, err = ocic.BuildHeadFilePayload(*ociHeadFileRepositoryFlag, *ociHeadFileTagFlag, *ociHeadFileFileFlag, *ociHeadFileFileRegexFlag)
+				data, err = ocic.BuildHeadFilePayload(*ociHeadFileRepositoryFlag, *ociHeadFileTagFlag, *ociHeadFilePlatformFlag, *ociHeadFileFileFlag, *ociHeadFileFileRegexFlag)
+			case "list-tarball-entries":
+				endpoint = c.ListTarballEntries()
+				data, err = ocic.BuildListTarballEntriesPayload(*ociListTarballEntriesRepositoryFlag, *ociListTarballEntriesTagFlag, *ociListTarballEntriesPlatformFlag, *ociListTarballEntriesFileFlag, *ociListTarballEntriesFileRegexFlag)
+			case "download-tarball-entry":
+				endpoint = c.DownloadTarballEntry()
+				data, err = ocic.BuildDownloadTarballEntryPayload(*ociDownloadTarballEntryRepositoryFlag, *ociDownloadTarballEntryTagFlag, *ociDownloadTarballEntryPlatformFlag, *ociDownloadTarballEntryFileFlag, *ociDownloadTarballEntryFileRegexFlag, *ociDownloadTarballEntryEntryFlag)
+			case "download-files-bundle":
+				endpoint = c.DownloadFilesBundle()
+				data, err = ocic.BuildDownloadFilesBundlePayload(*ociDownloadFilesBundleRepositoryFlag, *ociDownloadFilesBundleTagFlag, *ociDownloadFilesBundlePlatformFlag, *ociDownloadFilesBundleFilesFlag, *ociDownloadFilesBundleFileRegexFlag, *ociDownloadFilesBundleFormatFlag)
 			case "download-file-sha256":
 				endpoint = c.DownloadFileSha256()
-				data, err = ocic.BuildDownloadFileSha256Payload(*ociDownloadFileSha256RepositoryFlag, *ociDownloadFileSha256FileFlag, *ociDownloadFileSha256TagFlag)
+				data, err = ocic.BuildDownloadFileSha256Payload(*ociDownloadFileSha256RepositoryFlag, *ociDownloadFileSha256FileFlag, *ociDownloadFileSha256TagFlag, *ociDownloadFileSha256PlatformFlag)
+			case "get-artifact-metadata":
+				endpoint = c.GetArtifactMetadata()
+				data, err = ocic.BuildGetArtifactMetadataPayload(*ociGetArtifactMetadataRepositoryFlag, *ociGetArtifactMetadataTagFlag, *ociGetArtifactMetadataPlatformFlag)
+			case "get-file-checksum":
+				endpoint = c.GetFileChecksum()
+				data, err = ocic.BuildGetFileChecksumPayload(*ociGetFileChecksumRepositoryFlag, *ociGetFileChecksumTagFlag, *ociGetFileChecksumFileFlag, *ociGetFileChecksumFileRegexFlag, *ociGetFileChecksumPlatformFlag, *ociGetFileChecksumAlgorithmFlag)
+			case "download-checksums":
+				endpoint = c.DownloadChecksums()
+				data, err = ocic.BuildDownloadChecksumsPayload(*ociDownloadChecksumsRepositoryFlag, *ociDownloadChecksumsTagFlag, *ociDownloadChecksumsPlatformFlag, *ociDownloadChecksumsAlgorithmFlag)
 			}
 		case "ks3":
 			c := ks3c.NewClient(scheme, host, doer, enc, dec, restore)
@@ -223,6 +417,12 @@ func ParseEndpoint(
 			case "head-object":
 				endpoint = c.HeadObject()
 				data, err = ks3c.BuildHeadObjectPayload(*ks3HeadObjectBucketFlag, *ks3HeadObjectKeyFlag)
+			case "get-object-checksum":
+				endpoint = c.GetObjectChecksum()
+				data, err = ks3c.BuildGetObjectChecksumPayload(*ks3GetObjectChecksumBucketFlag, *ks3GetObjectChecksumKeyFlag, *ks3GetObjectChecksumAlgorithmFlag)
+			case "list-objects":
+				endpoint = c.ListObjects()
+				data, err = ks3c.BuildListObjectsPayload(*ks3ListObjectsBucketFlag, *ks3ListObjectsPrefixFlag, *ks3ListObjectsDelimiterFlag, *ks3ListObjectsMaxKeysFlag, *ks3ListObjectsPageTokenFlag)
 			}
 		case "gcs":
 			c := gcsc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -233,6 +433,32 @@ func ParseEndpoint(
 			case "head-object":
 				endpoint = c.HeadObject()
 				data, err = gcsc.BuildHeadObjectPayload(*gcsHeadObjectBucketFlag, *gcsHeadObjectKeyFlag)
+			case "get-object-checksum":
+				endpoint = c.GetObjectChecksum()
+				data, err = gcsc.BuildGetObjectChecksumPayload(*gcsGetObjectChecksumBucketFlag, *gcsGetObjectChecksumKeyFlag, *gcsGetObjectChecksumAlgorithmFlag)
+			case "list-objects":
+				endpoint = c.ListObjects()
+				data, err = gcsc.BuildListObjectsPayload(*gcsListObjectsBucketFlag, *gcsListObjectsPrefixFlag, *gcsListObjectsDelimiterFlag, *gcsListObjectsMaxKeysFlag, *gcsListObjectsPageTokenFlag)
+			}
+		case "s3":
+			c := s3c.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "download-object":
+				endpoint = c.DownloadObject()
+				data, err = s3c.BuildDownloadObjectPayload(*s3DownloadObjectEndpointFlag, *s3DownloadObjectBucketFlag, *s3DownloadObjectKeyFlag)
+			case "head-object":
+				endpoint = c.HeadObject()
+				data, err = s3c.BuildHeadObjectPayload(*s3HeadObjectEndpointFlag, *s3HeadObjectBucketFlag, *s3HeadObjectKeyFlag)
+			case "list-objects":
+				endpoint = c.ListObjects()
+				data, err = s3c.BuildListObjectsPayload(*s3ListObjectsEndpointFlag, *s3ListObjectsBucketFlag, *s3ListObjectsPrefixFlag, *s3ListObjectsDelimiterFlag, *s3ListObjectsMaxKeysFlag, *s3ListObjectsPageTokenFlag)
+			}
+		case "sign":
+			c := signc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "sign-url":
+				endpoint = c.SignURL()
+				data, err = signc.BuildSignURLPayload(*signSignURLBodyFlag)
 			}
 		}
 	}
@@ -251,7 +477,13 @@ func ociUsage() {
 	fmt.Fprintln(os.Stderr, `    list-files: ListFiles implements list-files.`)
 	fmt.Fprintln(os.Stderr, `    download-file: DownloadFile implements download-file.`)
 	fmt.Fprintln(os.Stderr, `    head-file: HeadFile implements head-file.`)
+	fmt.Fprintln(os.Stderr, `    list-tarball-entries: ListTarballEntries implements list-tarball-entries.`)
+	fmt.Fprintln(os.Stderr, `    download-tarball-entry: DownloadTarballEntry implements download-tarball-entry.`)
+	fmt.Fprintln(os.Stderr, `    download-files-bundle: DownloadFilesBundle implements download-files-bundle.`)
 	fmt.Fprintln(os.Stderr, `    download-file-sha256: DownloadFileSha256 implements download-file-sha256.`)
+	fmt.Fprintln(os.Stderr, `    get-artifact-metadata: GetArtifactMetadata implements get-artifact-metadata.`)
+	fmt.Fprintln(os.Stderr, `    get-file-checksum: GetFileChecksum implements get-file-checksum.`)
+	fmt.Fprintln(os.Stderr, `    download-checksums: DownloadChecksums implements download-checksums.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s oci COMMAND --help\n", os.Args[0])
@@ -261,6 +493,7 @@ func ociListFilesUsage() {
 	fmt.Fprintf(os.Stderr, "%s [flags] oci list-files", os.Args[0])
 	fmt.Fprint(os.Stderr, " -repository STRING")
 	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
@@ -270,11 +503,12 @@ func ociListFilesUsage() {
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
 	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
 
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci list-files --repository "Quam et asperiores." --tag "Deserunt esse et."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci list-files --repository "Vero voluptates aut quae aut officiis aut." --tag "Ut et quia quas qui esse omnis." --platform "linux/amd64"`)
 }
 
 func ociDownloadFileUsage() {
@@ -282,6 +516,7 @@ func ociDownloadFileUsage() {
 	fmt.Fprintf(os.Stderr, "%s [flags] oci download-file", os.Args[0])
 	fmt.Fprint(os.Stderr, " -repository STRING")
 	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
 	fmt.Fprint(os.Stderr, " -file STRING")
 	fmt.Fprint(os.Stderr, " -file-regex STRING")
 	fmt.Fprintln(os.Stderr)
@@ -293,13 +528,14 @@ func ociDownloadFileUsage() {
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
 	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
 	fmt.Fprintln(os.Stderr, `    -file STRING: `)
 	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
 
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-file --repository "hub.pingcap.net/pingcap/tidb/package" --tag "v8.1.0_darwin_arm64" --file "tidb-v7.5.0-darwin-arm64.tar.gz" --file-regex "tidb-.+[.]tar[.]gz"`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-file --repository "hub.pingcap.net/pingcap/tidb/package" --tag "v8.1.0_darwin_arm64" --platform "linux/amd64" --file "tidb-v7.5.0-darwin-arm64.tar.gz" --file-regex "tidb-.+[.]tar[.]gz"`)
 }
 
 func ociHeadFileUsage() {
@@ -307,6 +543,7 @@ func ociHeadFileUsage() {
 	fmt.Fprintf(os.Stderr, "%s [flags] oci head-file", os.Args[0])
 	fmt.Fprint(os.Stderr, " -repository STRING")
 	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
 	fmt.Fprint(os.Stderr, " -file STRING")
 	fmt.Fprint(os.Stderr, " -file-regex STRING")
 	fmt.Fprintln(os.Stderr)
@@ -318,13 +555,102 @@ func ociHeadFileUsage() {
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
 	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
 	fmt.Fprintln(os.Stderr, `    -file STRING: `)
 	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
 
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci head-file --repository "Sed nihil autem dolor blanditiis accusamus sit." --tag "Neque sunt ut repellendus." --file "Quaerat architecto." --file-regex "m9i.*"`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci head-file --repository "Minus molestias." --tag "Quia delectus sed est ut." --platform "linux/amd64" --file "Id corrupti." --file-regex "el5.*"`)
+}
+
+func ociListTarballEntriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci list-tarball-entries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprint(os.Stderr, " -file STRING")
+	fmt.Fprint(os.Stderr, " -file-regex STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ListTarballEntries implements list-tarball-entries.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci list-tarball-entries --repository "Nam illum rem fugiat mollitia." --tag "Ipsa cupiditate reprehenderit accusantium blanditiis." --platform "linux/amd64" --file "Voluptatem saepe labore." --file-regex "mea.*"`)
+}
+
+func ociDownloadTarballEntryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci download-tarball-entry", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprint(os.Stderr, " -file STRING")
+	fmt.Fprint(os.Stderr, " -file-regex STRING")
+	fmt.Fprint(os.Stderr, " -entry STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `DownloadTarballEntry implements download-tarball-entry.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
+	fmt.Fprintln(os.Stderr, `    -entry STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-tarball-entry --repository "hub.pingcap.net/pingcap/tidb/package" --tag "v8.1.0_darwin_arm64" --platform "linux/amd64" --file "tidb-v7.5.0-darwin-arm64.tar.gz" --file-regex "tidb-.+[.]tar[.]gz" --entry "bin/tidb-server"`)
+}
+
+func ociDownloadFilesBundleUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci download-files-bundle", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprint(os.Stderr, " -files JSON")
+	fmt.Fprint(os.Stderr, " -file-regex STRING")
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `DownloadFilesBundle implements download-files-bundle.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+	fmt.Fprintln(os.Stderr, `    -files JSON: `)
+	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-files-bundle --repository "hub.pingcap.net/pingcap/tidb/package" --tag "v8.1.0_darwin_arm64" --platform "linux/amd64" --files '[
+      "tidb-v7.5.0-darwin-arm64.tar.gz",
+      "pd-v7.5.0-darwin-arm64.tar.gz"
+   ]' --file-regex ".+-darwin-.+[.]tar[.]gz" --format "tar"`)
 }
 
 func ociDownloadFileSha256Usage() {
@@ -333,6 +659,7 @@ func ociDownloadFileSha256Usage() {
 	fmt.Fprint(os.Stderr, " -repository STRING")
 	fmt.Fprint(os.Stderr, " -file STRING")
 	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
@@ -343,11 +670,89 @@ func ociDownloadFileSha256Usage() {
 	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
 	fmt.Fprintln(os.Stderr, `    -file STRING: `)
 	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-file-sha256 --repository "Molestiae ut atque maxime molestias." --file "Voluptatem ut voluptatem quis accusantium." --tag "Rem eligendi nemo." --platform "linux/amd64"`)
+}
+
+func ociGetArtifactMetadataUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci get-artifact-metadata", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetArtifactMetadata implements get-artifact-metadata.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci get-artifact-metadata --repository "Nostrum ex fuga magnam itaque nihil exercitationem." --tag "Est iure sit a libero." --platform "linux/amd64"`)
+}
+
+func ociGetFileChecksumUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci get-file-checksum", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -file STRING")
+	fmt.Fprint(os.Stderr, " -file-regex STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprint(os.Stderr, " -algorithm STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetFileChecksum implements get-file-checksum.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file STRING: `)
+	fmt.Fprintln(os.Stderr, `    -file-regex STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+	fmt.Fprintln(os.Stderr, `    -algorithm STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci get-file-checksum --repository "Temporibus voluptates voluptas." --tag "Suscipit dicta." --file "Dolore amet autem atque quae." --file-regex "Labore non quidem dolorem." --platform "linux/amd64" --algorithm "md5"`)
+}
+
+func ociDownloadChecksumsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] oci download-checksums", os.Args[0])
+	fmt.Fprint(os.Stderr, " -repository STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -platform STRING")
+	fmt.Fprint(os.Stderr, " -algorithm STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `DownloadChecksums implements download-checksums.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -repository STRING: OCI artifact repository`)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: `)
+	fmt.Fprintln(os.Stderr, `    -platform STRING: `)
+	fmt.Fprintln(os.Stderr, `    -algorithm STRING: `)
 
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-file-sha256 --repository "Officia suscipit saepe rem." --file "Repudiandae velit." --tag "Aspernatur tenetur minima voluptas nemo."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `oci download-checksums --repository "Earum quae vel atque qui sint consectetur." --tag "Eius non excepturi quos." --platform "linux/amd64" --algorithm "md5"`)
 }
 
 // ks3Usage displays the usage of the ks3 command and its subcommands.
@@ -357,6 +762,8 @@ func ks3Usage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    download-object: DownloadObject implements download-object.`)
 	fmt.Fprintln(os.Stderr, `    head-object: HeadObject implements head-object.`)
+	fmt.Fprintln(os.Stderr, `    get-object-checksum: GetObjectChecksum implements get-object-checksum.`)
+	fmt.Fprintln(os.Stderr, `    list-objects: ListObjects implements list-objects.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s ks3 COMMAND --help\n", os.Args[0])
@@ -379,7 +786,7 @@ func ks3DownloadObjectUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 download-object --bucket "Assumenda et eveniet magni quasi consequatur esse." --key "Qui quas."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 download-object --bucket "Et voluptate illum fuga." --key "At natus."`)
 }
 
 func ks3HeadObjectUsage() {
@@ -400,7 +807,57 @@ func ks3HeadObjectUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 head-object --bucket "Dolore esse sunt." --key "Ea fugiat corrupti rerum."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 head-object --bucket "Sunt at." --key "Veritatis dolore odit expedita."`)
+}
+
+func ks3GetObjectChecksumUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ks3 get-object-checksum", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprint(os.Stderr, " -algorithm STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetObjectChecksum implements get-object-checksum.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: object key`)
+	fmt.Fprintln(os.Stderr, `    -algorithm STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 get-object-checksum --bucket "Est ut odit quo voluptates facilis." --key "Explicabo ea modi in et possimus." --algorithm "sha256"`)
+}
+
+func ks3ListObjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] ks3 list-objects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -prefix STRING")
+	fmt.Fprint(os.Stderr, " -delimiter STRING")
+	fmt.Fprint(os.Stderr, " -max-keys INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ListObjects implements list-objects.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -delimiter STRING: `)
+	fmt.Fprintln(os.Stderr, `    -max-keys INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `ks3 list-objects --bucket "Perspiciatis omnis provident et est." --prefix "builds/pingcap/tidb/" --delimiter "Distinctio non dicta adipisci alias et et." --max-keys 708 --page-token "Et quos."`)
 }
 
 // gcsUsage displays the usage of the gcs command and its subcommands.
@@ -410,6 +867,8 @@ func gcsUsage() {
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    download-object: DownloadObject implements download-object.`)
 	fmt.Fprintln(os.Stderr, `    head-object: HeadObject implements head-object.`)
+	fmt.Fprintln(os.Stderr, `    get-object-checksum: GetObjectChecksum implements get-object-checksum.`)
+	fmt.Fprintln(os.Stderr, `    list-objects: ListObjects implements list-objects.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s gcs COMMAND --help\n", os.Args[0])
@@ -432,7 +891,7 @@ func gcsDownloadObjectUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs download-object --bucket "Et sit recusandae qui totam dolor." --key "Ut doloremque laborum quis voluptas voluptatum voluptate."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs download-object --bucket "Harum accusamus corrupti molestiae est optio aut." --key "Itaque officia delectus quisquam dolorum corrupti quibusdam."`)
 }
 
 func gcsHeadObjectUsage() {
@@ -453,5 +912,174 @@ func gcsHeadObjectUsage() {
 	// Example block: pass example as parameter to avoid format parsing of % characters
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs head-object --bucket "Amet natus quia eos autem eos laudantium." --key "Quam et ut soluta sed minima dolores."`)
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs head-object --bucket "Corrupti ad aperiam." --key "Dolorem ex."`)
+}
+
+func gcsGetObjectChecksumUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] gcs get-object-checksum", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprint(os.Stderr, " -algorithm STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `GetObjectChecksum implements get-object-checksum.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: object key`)
+	fmt.Fprintln(os.Stderr, `    -algorithm STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs get-object-checksum --bucket "Voluptas laborum rerum et mollitia omnis." --key "Fugiat voluptates aliquam veniam." --algorithm "md5"`)
+}
+
+func gcsListObjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] gcs list-objects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -prefix STRING")
+	fmt.Fprint(os.Stderr, " -delimiter STRING")
+	fmt.Fprint(os.Stderr, " -max-keys INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ListObjects implements list-objects.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -delimiter STRING: `)
+	fmt.Fprintln(os.Stderr, `    -max-keys INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `gcs list-objects --bucket "Ullam architecto." --prefix "builds/pingcap/tidb/" --delimiter "Officiis quia ullam ratione." --max-keys 28 --page-token "Distinctio iste voluptate laborum repellendus sunt est."`)
+}
+
+// s3Usage displays the usage of the s3 command and its subcommands.
+func s3Usage() {
+	fmt.Fprintln(os.Stderr, `S3 compatible storage download service, such as AWS S3, MinIO and Cloudflare R2`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] s3 COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    download-object: DownloadObject implements download-object.`)
+	fmt.Fprintln(os.Stderr, `    head-object: HeadObject implements head-object.`)
+	fmt.Fprintln(os.Stderr, `    list-objects: ListObjects implements list-objects.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s s3 COMMAND --help\n", os.Args[0])
+}
+func s3DownloadObjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] s3 download-object", os.Args[0])
+	fmt.Fprint(os.Stderr, " -endpoint STRING")
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `DownloadObject implements download-object.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -endpoint STRING: name of the configured endpoint`)
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: object key`)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `s3 download-object --endpoint "Ea sequi dolor ex facilis." --bucket "Ex ipsum a aut ipsam ea." --key "Consequatur amet molestiae illum aut."`)
+}
+
+func s3HeadObjectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] s3 head-object", os.Args[0])
+	fmt.Fprint(os.Stderr, " -endpoint STRING")
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -key STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `HeadObject implements head-object.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -endpoint STRING: name of the configured endpoint`)
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -key STRING: object key`)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `s3 head-object --endpoint "Optio perferendis harum possimus voluptatem ut et." --bucket "Ut nulla." --key "Ipsa aliquam omnis consequatur qui assumenda."`)
+}
+
+func s3ListObjectsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] s3 list-objects", os.Args[0])
+	fmt.Fprint(os.Stderr, " -endpoint STRING")
+	fmt.Fprint(os.Stderr, " -bucket STRING")
+	fmt.Fprint(os.Stderr, " -prefix STRING")
+	fmt.Fprint(os.Stderr, " -delimiter STRING")
+	fmt.Fprint(os.Stderr, " -max-keys INT")
+	fmt.Fprint(os.Stderr, " -page-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `ListObjects implements list-objects.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -endpoint STRING: name of the configured endpoint`)
+	fmt.Fprintln(os.Stderr, `    -bucket STRING: bucket name`)
+	fmt.Fprintln(os.Stderr, `    -prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -delimiter STRING: `)
+	fmt.Fprintln(os.Stderr, `    -max-keys INT: `)
+	fmt.Fprintln(os.Stderr, `    -page-token STRING: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `s3 list-objects --endpoint "Totam rerum magni est magnam eos aut." --bucket "Deserunt maxime voluptatibus enim." --prefix "builds/pingcap/tidb/" --delimiter "" --max-keys 3 --page-token "Qui et praesentium dolorem voluptas."`)
+}
+
+// signUsage displays the usage of the sign command and its subcommands.
+func signUsage() {
+	fmt.Fprintln(os.Stderr, `Service for issuing time-limited signed download links`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] sign COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    sign-url: SignURL implements sign-url.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s sign COMMAND --help\n", os.Args[0])
+}
+func signSignURLUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] sign sign-url", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `SignURL implements sign-url.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	// Example block: pass example as parameter to avoid format parsing of % characters
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], `sign sign-url --body '{
+      "path": "/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0_linux_amd64\u0026file=tidb-v8.5.0-linux-amd64.tar.gz",
+      "ttl": "24h"
+   }'`)
 }
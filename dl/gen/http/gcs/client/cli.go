@@ -8,7 +8,11 @@
 package client
 
 import (
+	"fmt"
+	"strconv"
+
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildDownloadObjectPayload builds the payload for the gcs download-object
@@ -46,3 +50,91 @@ func BuildHeadObjectPayload(gcsHeadObjectBucket string, gcsHeadObjectKey string)
 
 	return v, nil
 }
+
+// BuildGetObjectChecksumPayload builds the payload for the gcs
+// get-object-checksum endpoint from CLI flags.
+func BuildGetObjectChecksumPayload(gcsGetObjectChecksumBucket string, gcsGetObjectChecksumKey string, gcsGetObjectChecksumAlgorithm string) (*gcs.GetObjectChecksumPayload, error) {
+	var err error
+	var bucket string
+	{
+		bucket = gcsGetObjectChecksumBucket
+	}
+	var key string
+	{
+		key = gcsGetObjectChecksumKey
+	}
+	var algorithm string
+	{
+		if gcsGetObjectChecksumAlgorithm != "" {
+			algorithm = gcsGetObjectChecksumAlgorithm
+			if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &gcs.GetObjectChecksumPayload{}
+	v.Bucket = bucket
+	v.Key = key
+	v.Algorithm = algorithm
+
+	return v, nil
+}
+
+// BuildListObjectsPayload builds the payload for the gcs list-objects endpoint
+// from CLI flags.
+func BuildListObjectsPayload(gcsListObjectsBucket string, gcsListObjectsPrefix string, gcsListObjectsDelimiter string, gcsListObjectsMaxKeys string, gcsListObjectsPageToken string) (*gcs.ListObjectsPayload, error) {
+	var err error
+	var bucket string
+	{
+		bucket = gcsListObjectsBucket
+	}
+	var prefix *string
+	{
+		if gcsListObjectsPrefix != "" {
+			prefix = &gcsListObjectsPrefix
+		}
+	}
+	var delimiter string
+	{
+		if gcsListObjectsDelimiter != "" {
+			delimiter = gcsListObjectsDelimiter
+		}
+	}
+	var maxKeys int
+	{
+		if gcsListObjectsMaxKeys != "" {
+			var v int64
+			v, err = strconv.ParseInt(gcsListObjectsMaxKeys, 10, strconv.IntSize)
+			maxKeys = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for maxKeys, must be INT")
+			}
+			if maxKeys < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1, true))
+			}
+			if maxKeys > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if gcsListObjectsPageToken != "" {
+			pageToken = &gcsListObjectsPageToken
+		}
+	}
+	v := &gcs.ListObjectsPayload{}
+	v.Bucket = bucket
+	v.Prefix = prefix
+	v.Delimiter = delimiter
+	v.MaxKeys = maxKeys
+	v.PageToken = pageToken
+
+	return v, nil
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -175,3 +176,184 @@ func DecodeHeadObjectResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		}
 	}
 }
+
+// BuildGetObjectChecksumRequest instantiates a HTTP request object with method
+// and path set to call the "gcs" service "get-object-checksum" endpoint
+func (c *Client) BuildGetObjectChecksumRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bucket string
+		key    string
+	)
+	{
+		p, ok := v.(*gcs.GetObjectChecksumPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("gcs", "get-object-checksum", "*gcs.GetObjectChecksumPayload", v)
+		}
+		bucket = p.Bucket
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetObjectChecksumGcsPath(bucket, key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("gcs", "get-object-checksum", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetObjectChecksumRequest returns an encoder for requests sent to the
+// gcs get-object-checksum server.
+func EncodeGetObjectChecksumRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*gcs.GetObjectChecksumPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("gcs", "get-object-checksum", "*gcs.GetObjectChecksumPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("algorithm", p.Algorithm)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetObjectChecksumResponse returns a decoder for responses returned by
+// the gcs get-object-checksum endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeGetObjectChecksumResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetObjectChecksumResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("gcs", "get-object-checksum", err)
+			}
+			err = ValidateGetObjectChecksumResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("gcs", "get-object-checksum", err)
+			}
+			res := NewGetObjectChecksumFileChecksumOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("gcs", "get-object-checksum", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListObjectsRequest instantiates a HTTP request object with method and
+// path set to call the "gcs" service "list-objects" endpoint
+func (c *Client) BuildListObjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bucket string
+	)
+	{
+		p, ok := v.(*gcs.ListObjectsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("gcs", "list-objects", "*gcs.ListObjectsPayload", v)
+		}
+		bucket = p.Bucket
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListObjectsGcsPath(bucket)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("gcs", "list-objects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListObjectsRequest returns an encoder for requests sent to the gcs
+// list-objects server.
+func EncodeListObjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*gcs.ListObjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("gcs", "list-objects", "*gcs.ListObjectsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Prefix != nil {
+			values.Add("prefix", *p.Prefix)
+		}
+		values.Add("delimiter", p.Delimiter)
+		values.Add("max_keys", fmt.Sprintf("%v", p.MaxKeys))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListObjectsResponse returns a decoder for responses returned by the
+// gcs list-objects endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListObjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListObjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("gcs", "list-objects", err)
+			}
+			err = ValidateListObjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("gcs", "list-objects", err)
+			}
+			res := NewListObjectsObjectListingOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("gcs", "list-objects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalObjectInfoResponseBodyToGcsObjectInfo builds a value of type
+// *gcs.ObjectInfo from a value of type *ObjectInfoResponseBody.
+func unmarshalObjectInfoResponseBodyToGcsObjectInfo(v *ObjectInfoResponseBody) *gcs.ObjectInfo {
+	res := &gcs.ObjectInfo{
+		Key:          *v.Key,
+		Size:         *v.Size,
+		LastModified: v.LastModified,
+	}
+
+	return res
+}
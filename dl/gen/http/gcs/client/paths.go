@@ -20,3 +20,13 @@ func DownloadObjectGcsPath(bucket string, key string) string {
 func HeadObjectGcsPath(bucket string, key string) string {
 	return fmt.Sprintf("/gcs-obj/%v/%v", bucket, key)
 }
+
+// GetObjectChecksumGcsPath returns the URL path to the gcs service get-object-checksum HTTP endpoint.
+func GetObjectChecksumGcsPath(bucket string, key string) string {
+	return fmt.Sprintf("/gcs-obj-checksum/%v/%v", bucket, key)
+}
+
+// ListObjectsGcsPath returns the URL path to the gcs service list-objects HTTP endpoint.
+func ListObjectsGcsPath(bucket string) string {
+	return fmt.Sprintf("/gcs-objs/%v", bucket)
+}
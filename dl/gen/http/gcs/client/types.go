@@ -9,8 +9,43 @@ package client
 
 import (
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// GetObjectChecksumResponseBody is the type of the "gcs" service
+// "get-object-checksum" endpoint HTTP response body.
+type GetObjectChecksumResponseBody struct {
+	// file name or object key
+	File *string `form:"file,omitempty" json:"file,omitempty" xml:"file,omitempty"`
+	// checksum algorithm
+	Algorithm *string `form:"algorithm,omitempty" json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	// checksum in hex
+	Checksum *string `form:"checksum,omitempty" json:"checksum,omitempty" xml:"checksum,omitempty"`
+	// content size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+}
+
+// ListObjectsResponseBody is the type of the "gcs" service "list-objects"
+// endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string `form:"prefixes,omitempty" json:"prefixes,omitempty" xml:"prefixes,omitempty"`
+	// objects under the prefix
+	Objects []*ObjectInfoResponseBody `form:"objects,omitempty" json:"objects,omitempty" xml:"objects,omitempty"`
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string `form:"nextPageToken,omitempty" json:"nextPageToken,omitempty" xml:"nextPageToken,omitempty"`
+}
+
+// ObjectInfoResponseBody is used to define fields on response body types.
+type ObjectInfoResponseBody struct {
+	// object key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// object size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// last modified time
+	LastModified *string `form:"lastModified,omitempty" json:"lastModified,omitempty" xml:"lastModified,omitempty"`
+}
+
 // NewDownloadObjectResultOK builds a "gcs" service "download-object" endpoint
 // result from a HTTP "OK" response.
 func NewDownloadObjectResultOK(length int64, contentDisposition string) *gcs.DownloadObjectResult {
@@ -30,3 +65,86 @@ func NewHeadObjectResultOK(length int64, contentDisposition string) *gcs.HeadObj
 
 	return v
 }
+
+// NewGetObjectChecksumFileChecksumOK builds a "gcs" service
+// "get-object-checksum" endpoint result from a HTTP "OK" response.
+func NewGetObjectChecksumFileChecksumOK(body *GetObjectChecksumResponseBody) *gcs.FileChecksum {
+	v := &gcs.FileChecksum{
+		File:      *body.File,
+		Algorithm: *body.Algorithm,
+		Checksum:  *body.Checksum,
+		Size:      *body.Size,
+	}
+
+	return v
+}
+
+// NewListObjectsObjectListingOK builds a "gcs" service "list-objects" endpoint
+// result from a HTTP "OK" response.
+func NewListObjectsObjectListingOK(body *ListObjectsResponseBody) *gcs.ObjectListing {
+	v := &gcs.ObjectListing{
+		NextPageToken: body.NextPageToken,
+	}
+	v.Prefixes = make([]string, len(body.Prefixes))
+	for i, val := range body.Prefixes {
+		v.Prefixes[i] = val
+	}
+	v.Objects = make([]*gcs.ObjectInfo, len(body.Objects))
+	for i, val := range body.Objects {
+		v.Objects[i] = unmarshalObjectInfoResponseBodyToGcsObjectInfo(val)
+	}
+
+	return v
+}
+
+// ValidateGetObjectChecksumResponseBody runs the validations defined on
+// Get-Object-ChecksumResponseBody
+func ValidateGetObjectChecksumResponseBody(body *GetObjectChecksumResponseBody) (err error) {
+	if body.File == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
+	}
+	if body.Algorithm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("algorithm", "body"))
+	}
+	if body.Checksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	return
+}
+
+// ValidateListObjectsResponseBody runs the validations defined on
+// List-ObjectsResponseBody
+func ValidateListObjectsResponseBody(body *ListObjectsResponseBody) (err error) {
+	if body.Prefixes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prefixes", "body"))
+	}
+	if body.Objects == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("objects", "body"))
+	}
+	for _, e := range body.Objects {
+		if e != nil {
+			if err2 := ValidateObjectInfoResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateObjectInfoResponseBody runs the validations defined on
+// ObjectInfoResponseBody
+func ValidateObjectInfoResponseBody(body *ObjectInfoResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.LastModified != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastModified", *body.LastModified, goa.FormatDateTime))
+	}
+	return
+}
@@ -20,9 +20,11 @@ import (
 
 // Server lists the gcs service endpoint HTTP handlers.
 type Server struct {
-	Mounts         []*MountPoint
-	DownloadObject http.Handler
-	HeadObject     http.Handler
+	Mounts            []*MountPoint
+	DownloadObject    http.Handler
+	HeadObject        http.Handler
+	GetObjectChecksum http.Handler
+	ListObjects       http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -54,9 +56,13 @@ func New(
 		Mounts: []*MountPoint{
 			{"DownloadObject", "GET", "/gcs-obj/{bucket}/{*key}"},
 			{"HeadObject", "HEAD", "/gcs-obj/{bucket}/{*key}"},
+			{"GetObjectChecksum", "GET", "/gcs-obj-checksum/{bucket}/{*key}"},
+			{"ListObjects", "GET", "/gcs-objs/{bucket}"},
 		},
-		DownloadObject: NewDownloadObjectHandler(e.DownloadObject, mux, decoder, encoder, errhandler, formatter),
-		HeadObject:     NewHeadObjectHandler(e.HeadObject, mux, decoder, encoder, errhandler, formatter),
+		DownloadObject:    NewDownloadObjectHandler(e.DownloadObject, mux, decoder, encoder, errhandler, formatter),
+		HeadObject:        NewHeadObjectHandler(e.HeadObject, mux, decoder, encoder, errhandler, formatter),
+		GetObjectChecksum: NewGetObjectChecksumHandler(e.GetObjectChecksum, mux, decoder, encoder, errhandler, formatter),
+		ListObjects:       NewListObjectsHandler(e.ListObjects, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -67,6 +73,8 @@ func (s *Server) Service() string { return "gcs" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.DownloadObject = m(s.DownloadObject)
 	s.HeadObject = m(s.HeadObject)
+	s.GetObjectChecksum = m(s.GetObjectChecksum)
+	s.ListObjects = m(s.ListObjects)
 }
 
 // MethodNames returns the methods served.
@@ -76,6 +84,8 @@ func (s *Server) MethodNames() []string { return gcs.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountDownloadObjectHandler(mux, h.DownloadObject)
 	MountHeadObjectHandler(mux, h.HeadObject)
+	MountGetObjectChecksumHandler(mux, h.GetObjectChecksum)
+	MountListObjectsHandler(mux, h.ListObjects)
 }
 
 // Mount configures the mux to serve the gcs endpoints.
@@ -223,3 +233,109 @@ func NewHeadObjectHandler(
 		}
 	})
 }
+
+// MountGetObjectChecksumHandler configures the mux to serve the "gcs" service
+// "get-object-checksum" endpoint.
+func MountGetObjectChecksumHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/gcs-obj-checksum/{bucket}/{*key}", f)
+}
+
+// NewGetObjectChecksumHandler creates a HTTP handler which loads the HTTP
+// request and calls the "gcs" service "get-object-checksum" endpoint.
+func NewGetObjectChecksumHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetObjectChecksumRequest(mux, decoder)
+		encodeResponse = EncodeGetObjectChecksumResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-object-checksum")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "gcs")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListObjectsHandler configures the mux to serve the "gcs" service
+// "list-objects" endpoint.
+func MountListObjectsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/gcs-objs/{bucket}", f)
+}
+
+// NewListObjectsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "gcs" service "list-objects" endpoint.
+func NewListObjectsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListObjectsRequest(mux, decoder)
+		encodeResponse = EncodeListObjectsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-objects")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "gcs")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
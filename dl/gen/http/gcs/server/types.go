@@ -11,6 +11,77 @@ import (
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
 )
 
+// GetObjectChecksumResponseBody is the type of the "gcs" service
+// "get-object-checksum" endpoint HTTP response body.
+type GetObjectChecksumResponseBody struct {
+	// file name or object key
+	File string `form:"file" json:"file" xml:"file"`
+	// checksum algorithm
+	Algorithm string `form:"algorithm" json:"algorithm" xml:"algorithm"`
+	// checksum in hex
+	Checksum string `form:"checksum" json:"checksum" xml:"checksum"`
+	// content size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+}
+
+// ListObjectsResponseBody is the type of the "gcs" service "list-objects"
+// endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string `form:"prefixes" json:"prefixes" xml:"prefixes"`
+	// objects under the prefix
+	Objects []*ObjectInfoResponseBody `form:"objects" json:"objects" xml:"objects"`
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string `form:"nextPageToken,omitempty" json:"nextPageToken,omitempty" xml:"nextPageToken,omitempty"`
+}
+
+// ObjectInfoResponseBody is used to define fields on response body types.
+type ObjectInfoResponseBody struct {
+	// object key
+	Key string `form:"key" json:"key" xml:"key"`
+	// object size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// last modified time
+	LastModified *string `form:"lastModified,omitempty" json:"lastModified,omitempty" xml:"lastModified,omitempty"`
+}
+
+// NewGetObjectChecksumResponseBody builds the HTTP response body from the
+// result of the "get-object-checksum" endpoint of the "gcs" service.
+func NewGetObjectChecksumResponseBody(res *gcs.FileChecksum) *GetObjectChecksumResponseBody {
+	body := &GetObjectChecksumResponseBody{
+		File:      res.File,
+		Algorithm: res.Algorithm,
+		Checksum:  res.Checksum,
+		Size:      res.Size,
+	}
+	return body
+}
+
+// NewListObjectsResponseBody builds the HTTP response body from the result of
+// the "list-objects" endpoint of the "gcs" service.
+func NewListObjectsResponseBody(res *gcs.ObjectListing) *ListObjectsResponseBody {
+	body := &ListObjectsResponseBody{
+		NextPageToken: res.NextPageToken,
+	}
+	if res.Prefixes != nil {
+		body.Prefixes = make([]string, len(res.Prefixes))
+		for i, val := range res.Prefixes {
+			body.Prefixes[i] = val
+		}
+	} else {
+		body.Prefixes = []string{}
+	}
+	if res.Objects != nil {
+		body.Objects = make([]*ObjectInfoResponseBody, len(res.Objects))
+		for i, val := range res.Objects {
+			body.Objects[i] = marshalGcsObjectInfoToObjectInfoResponseBody(val)
+		}
+	} else {
+		body.Objects = []*ObjectInfoResponseBody{}
+	}
+	return body
+}
+
 // NewDownloadObjectPayload builds a gcs service download-object endpoint
 // payload.
 func NewDownloadObjectPayload(bucket string, key string) *gcs.DownloadObjectPayload {
@@ -29,3 +100,26 @@ func NewHeadObjectPayload(bucket string, key string) *gcs.HeadObjectPayload {
 
 	return v
 }
+
+// NewGetObjectChecksumPayload builds a gcs service get-object-checksum
+// endpoint payload.
+func NewGetObjectChecksumPayload(bucket string, key string, algorithm string) *gcs.GetObjectChecksumPayload {
+	v := &gcs.GetObjectChecksumPayload{}
+	v.Bucket = bucket
+	v.Key = key
+	v.Algorithm = algorithm
+
+	return v
+}
+
+// NewListObjectsPayload builds a gcs service list-objects endpoint payload.
+func NewListObjectsPayload(bucket string, prefix *string, delimiter string, maxKeys int, pageToken *string) *gcs.ListObjectsPayload {
+	v := &gcs.ListObjectsPayload{}
+	v.Bucket = bucket
+	v.Prefix = prefix
+	v.Delimiter = delimiter
+	v.MaxKeys = maxKeys
+	v.PageToken = pageToken
+
+	return v
+}
@@ -8,7 +8,11 @@
 package client
 
 import (
+	"fmt"
+	"strconv"
+
 	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildDownloadObjectPayload builds the payload for the ks3 download-object
@@ -46,3 +50,91 @@ func BuildHeadObjectPayload(ks3HeadObjectBucket string, ks3HeadObjectKey string)
 
 	return v, nil
 }
+
+// BuildGetObjectChecksumPayload builds the payload for the ks3
+// get-object-checksum endpoint from CLI flags.
+func BuildGetObjectChecksumPayload(ks3GetObjectChecksumBucket string, ks3GetObjectChecksumKey string, ks3GetObjectChecksumAlgorithm string) (*ks3.GetObjectChecksumPayload, error) {
+	var err error
+	var bucket string
+	{
+		bucket = ks3GetObjectChecksumBucket
+	}
+	var key string
+	{
+		key = ks3GetObjectChecksumKey
+	}
+	var algorithm string
+	{
+		if ks3GetObjectChecksumAlgorithm != "" {
+			algorithm = ks3GetObjectChecksumAlgorithm
+			if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &ks3.GetObjectChecksumPayload{}
+	v.Bucket = bucket
+	v.Key = key
+	v.Algorithm = algorithm
+
+	return v, nil
+}
+
+// BuildListObjectsPayload builds the payload for the ks3 list-objects endpoint
+// from CLI flags.
+func BuildListObjectsPayload(ks3ListObjectsBucket string, ks3ListObjectsPrefix string, ks3ListObjectsDelimiter string, ks3ListObjectsMaxKeys string, ks3ListObjectsPageToken string) (*ks3.ListObjectsPayload, error) {
+	var err error
+	var bucket string
+	{
+		bucket = ks3ListObjectsBucket
+	}
+	var prefix *string
+	{
+		if ks3ListObjectsPrefix != "" {
+			prefix = &ks3ListObjectsPrefix
+		}
+	}
+	var delimiter string
+	{
+		if ks3ListObjectsDelimiter != "" {
+			delimiter = ks3ListObjectsDelimiter
+		}
+	}
+	var maxKeys int
+	{
+		if ks3ListObjectsMaxKeys != "" {
+			var v int64
+			v, err = strconv.ParseInt(ks3ListObjectsMaxKeys, 10, strconv.IntSize)
+			maxKeys = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for maxKeys, must be INT")
+			}
+			if maxKeys < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1, true))
+			}
+			if maxKeys > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if ks3ListObjectsPageToken != "" {
+			pageToken = &ks3ListObjectsPageToken
+		}
+	}
+	v := &ks3.ListObjectsPayload{}
+	v.Bucket = bucket
+	v.Prefix = prefix
+	v.Delimiter = delimiter
+	v.MaxKeys = maxKeys
+	v.PageToken = pageToken
+
+	return v, nil
+}
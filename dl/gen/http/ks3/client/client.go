@@ -26,6 +26,14 @@ type Client struct {
 	// endpoint.
 	HeadObjectDoer goahttp.Doer
 
+	// GetObjectChecksum Doer is the HTTP client used to make requests to the
+	// get-object-checksum endpoint.
+	GetObjectChecksumDoer goahttp.Doer
+
+	// ListObjects Doer is the HTTP client used to make requests to the
+	// list-objects endpoint.
+	ListObjectsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -46,13 +54,15 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		DownloadObjectDoer:  doer,
-		HeadObjectDoer:      doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		DownloadObjectDoer:    doer,
+		HeadObjectDoer:        doer,
+		GetObjectChecksumDoer: doer,
+		ListObjectsDoer:       doer,
+		RestoreResponseBody:   restoreBody,
+		scheme:                scheme,
+		host:                  host,
+		decoder:               dec,
+		encoder:               enc,
 	}
 }
 
@@ -98,3 +108,51 @@ func (c *Client) HeadObject() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// GetObjectChecksum returns an endpoint that makes HTTP requests to the ks3
+// service get-object-checksum server.
+func (c *Client) GetObjectChecksum() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetObjectChecksumRequest(c.encoder)
+		decodeResponse = DecodeGetObjectChecksumResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetObjectChecksumRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetObjectChecksumDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ks3", "get-object-checksum", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListObjects returns an endpoint that makes HTTP requests to the ks3 service
+// list-objects server.
+func (c *Client) ListObjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListObjectsRequest(c.encoder)
+		decodeResponse = DecodeListObjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListObjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListObjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("ks3", "list-objects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
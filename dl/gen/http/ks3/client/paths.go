@@ -20,3 +20,13 @@ func DownloadObjectKs3Path(bucket string, key string) string {
 func HeadObjectKs3Path(bucket string, key string) string {
 	return fmt.Sprintf("/s3-obj/%v/%v", bucket, key)
 }
+
+// GetObjectChecksumKs3Path returns the URL path to the ks3 service get-object-checksum HTTP endpoint.
+func GetObjectChecksumKs3Path(bucket string, key string) string {
+	return fmt.Sprintf("/s3-obj-checksum/%v/%v", bucket, key)
+}
+
+// ListObjectsKs3Path returns the URL path to the ks3 service list-objects HTTP endpoint.
+func ListObjectsKs3Path(bucket string) string {
+	return fmt.Sprintf("/s3-objs/%v", bucket)
+}
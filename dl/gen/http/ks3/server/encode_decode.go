@@ -14,6 +14,7 @@ import (
 
 	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // EncodeDownloadObjectResponse returns an encoder for responses returned by
@@ -84,3 +85,128 @@ func DecodeHeadObjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		return payload, nil
 	}
 }
+
+// EncodeGetObjectChecksumResponse returns an encoder for responses returned by
+// the ks3 get-object-checksum endpoint.
+func EncodeGetObjectChecksumResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*ks3.FileChecksum)
+		enc := encoder(ctx, w)
+		body := NewGetObjectChecksumResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetObjectChecksumRequest returns a decoder for requests sent to the
+// ks3 get-object-checksum endpoint.
+func DecodeGetObjectChecksumRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ks3.GetObjectChecksumPayload, error) {
+	return func(r *http.Request) (*ks3.GetObjectChecksumPayload, error) {
+		var (
+			bucket    string
+			key       string
+			algorithm string
+			err       error
+
+			params = mux.Vars(r)
+		)
+		bucket = params["bucket"]
+		key = params["key"]
+		algorithmRaw := r.URL.Query().Get("algorithm")
+		if algorithmRaw != "" {
+			algorithm = algorithmRaw
+		} else {
+			algorithm = "sha256"
+		}
+		if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetObjectChecksumPayload(bucket, key, algorithm)
+
+		return payload, nil
+	}
+}
+
+// EncodeListObjectsResponse returns an encoder for responses returned by the
+// ks3 list-objects endpoint.
+func EncodeListObjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*ks3.ObjectListing)
+		enc := encoder(ctx, w)
+		body := NewListObjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListObjectsRequest returns a decoder for requests sent to the ks3
+// list-objects endpoint.
+func DecodeListObjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*ks3.ListObjectsPayload, error) {
+	return func(r *http.Request) (*ks3.ListObjectsPayload, error) {
+		var (
+			bucket    string
+			prefix    *string
+			delimiter string
+			maxKeys   int
+			pageToken *string
+			err       error
+
+			params = mux.Vars(r)
+		)
+		bucket = params["bucket"]
+		qp := r.URL.Query()
+		prefixRaw := qp.Get("prefix")
+		if prefixRaw != "" {
+			prefix = &prefixRaw
+		}
+		delimiterRaw := qp.Get("delimiter")
+		if delimiterRaw != "" {
+			delimiter = delimiterRaw
+		} else {
+			delimiter = "/"
+		}
+		{
+			maxKeysRaw := qp.Get("max_keys")
+			if maxKeysRaw == "" {
+				maxKeys = 1000
+			} else {
+				v, err2 := strconv.ParseInt(maxKeysRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("max_keys", maxKeysRaw, "integer"))
+				}
+				maxKeys = int(v)
+			}
+		}
+		if maxKeys < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1, true))
+		}
+		if maxKeys > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1000, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListObjectsPayload(bucket, prefix, delimiter, maxKeys, pageToken)
+
+		return payload, nil
+	}
+}
+
+// marshalKs3ObjectInfoToObjectInfoResponseBody builds a value of type
+// *ObjectInfoResponseBody from a value of type *ks3.ObjectInfo.
+func marshalKs3ObjectInfoToObjectInfoResponseBody(v *ks3.ObjectInfo) *ObjectInfoResponseBody {
+	res := &ObjectInfoResponseBody{
+		Key:          v.Key,
+		Size:         v.Size,
+		LastModified: v.LastModified,
+	}
+
+	return res
+}
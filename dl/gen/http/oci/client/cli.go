@@ -8,13 +8,16 @@
 package client
 
 import (
+	"encoding/json"
+	"fmt"
+
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
 	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildListFilesPayload builds the payload for the oci list-files endpoint
 // from CLI flags.
-func BuildListFilesPayload(ociListFilesRepository string, ociListFilesTag string) (*oci.ListFilesPayload, error) {
+func BuildListFilesPayload(ociListFilesRepository string, ociListFilesTag string, ociListFilesPlatform string) (*oci.ListFilesPayload, error) {
 	var repository string
 	{
 		repository = ociListFilesRepository
@@ -23,16 +26,23 @@ func BuildListFilesPayload(ociListFilesRepository string, ociListFilesTag string
 	{
 		tag = ociListFilesTag
 	}
+	var platform *string
+	{
+		if ociListFilesPlatform != "" {
+			platform = &ociListFilesPlatform
+		}
+	}
 	v := &oci.ListFilesPayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
 
 	return v, nil
 }
 
 // BuildDownloadFilePayload builds the payload for the oci download-file
 // endpoint from CLI flags.
-func BuildDownloadFilePayload(ociDownloadFileRepository string, ociDownloadFileTag string, ociDownloadFileFile string, ociDownloadFileFileRegex string) (*oci.DownloadFilePayload, error) {
+func BuildDownloadFilePayload(ociDownloadFileRepository string, ociDownloadFileTag string, ociDownloadFilePlatform string, ociDownloadFileFile string, ociDownloadFileFileRegex string) (*oci.DownloadFilePayload, error) {
 	var err error
 	var repository string
 	{
@@ -42,6 +52,12 @@ func BuildDownloadFilePayload(ociDownloadFileRepository string, ociDownloadFileT
 	{
 		tag = ociDownloadFileTag
 	}
+	var platform *string
+	{
+		if ociDownloadFilePlatform != "" {
+			platform = &ociDownloadFilePlatform
+		}
+	}
 	var file *string
 	{
 		if ociDownloadFileFile != "" {
@@ -61,6 +77,7 @@ func BuildDownloadFilePayload(ociDownloadFileRepository string, ociDownloadFileT
 	v := &oci.DownloadFilePayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
 	v.File = file
 	v.FileRegex = fileRegex
 
@@ -69,7 +86,7 @@ func BuildDownloadFilePayload(ociDownloadFileRepository string, ociDownloadFileT
 
 // BuildHeadFilePayload builds the payload for the oci head-file endpoint from
 // CLI flags.
-func BuildHeadFilePayload(ociHeadFileRepository string, ociHeadFileTag string, ociHeadFileFile string, ociHeadFileFileRegex string) (*oci.HeadFilePayload, error) {
+func BuildHeadFilePayload(ociHeadFileRepository string, ociHeadFileTag string, ociHeadFilePlatform string, ociHeadFileFile string, ociHeadFileFileRegex string) (*oci.HeadFilePayload, error) {
 	var err error
 	var repository string
 	{
@@ -79,6 +96,12 @@ func BuildHeadFilePayload(ociHeadFileRepository string, ociHeadFileTag string, o
 	{
 		tag = ociHeadFileTag
 	}
+	var platform *string
+	{
+		if ociHeadFilePlatform != "" {
+			platform = &ociHeadFilePlatform
+		}
+	}
 	var file *string
 	{
 		if ociHeadFileFile != "" {
@@ -98,15 +121,169 @@ func BuildHeadFilePayload(ociHeadFileRepository string, ociHeadFileTag string, o
 	v := &oci.HeadFilePayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
+	v.File = file
+	v.FileRegex = fileRegex
+
+	return v, nil
+}
+
+// BuildListTarballEntriesPayload builds the payload for the oci
+// list-tarball-entries endpoint from CLI flags.
+func BuildListTarballEntriesPayload(ociListTarballEntriesRepository string, ociListTarballEntriesTag string, ociListTarballEntriesPlatform string, ociListTarballEntriesFile string, ociListTarballEntriesFileRegex string) (*oci.ListTarballEntriesPayload, error) {
+	var err error
+	var repository string
+	{
+		repository = ociListTarballEntriesRepository
+	}
+	var tag string
+	{
+		tag = ociListTarballEntriesTag
+	}
+	var platform *string
+	{
+		if ociListTarballEntriesPlatform != "" {
+			platform = &ociListTarballEntriesPlatform
+		}
+	}
+	var file *string
+	{
+		if ociListTarballEntriesFile != "" {
+			file = &ociListTarballEntriesFile
+		}
+	}
+	var fileRegex *string
+	{
+		if ociListTarballEntriesFileRegex != "" {
+			fileRegex = &ociListTarballEntriesFileRegex
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &oci.ListTarballEntriesPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.File = file
+	v.FileRegex = fileRegex
+
+	return v, nil
+}
+
+// BuildDownloadTarballEntryPayload builds the payload for the oci
+// download-tarball-entry endpoint from CLI flags.
+func BuildDownloadTarballEntryPayload(ociDownloadTarballEntryRepository string, ociDownloadTarballEntryTag string, ociDownloadTarballEntryPlatform string, ociDownloadTarballEntryFile string, ociDownloadTarballEntryFileRegex string, ociDownloadTarballEntryEntry string) (*oci.DownloadTarballEntryPayload, error) {
+	var err error
+	var repository string
+	{
+		repository = ociDownloadTarballEntryRepository
+	}
+	var tag string
+	{
+		tag = ociDownloadTarballEntryTag
+	}
+	var platform *string
+	{
+		if ociDownloadTarballEntryPlatform != "" {
+			platform = &ociDownloadTarballEntryPlatform
+		}
+	}
+	var file *string
+	{
+		if ociDownloadTarballEntryFile != "" {
+			file = &ociDownloadTarballEntryFile
+		}
+	}
+	var fileRegex *string
+	{
+		if ociDownloadTarballEntryFileRegex != "" {
+			fileRegex = &ociDownloadTarballEntryFileRegex
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var entry string
+	{
+		entry = ociDownloadTarballEntryEntry
+	}
+	v := &oci.DownloadTarballEntryPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
 	v.File = file
 	v.FileRegex = fileRegex
+	v.Entry = entry
+
+	return v, nil
+}
+
+// BuildDownloadFilesBundlePayload builds the payload for the oci
+// download-files-bundle endpoint from CLI flags.
+func BuildDownloadFilesBundlePayload(ociDownloadFilesBundleRepository string, ociDownloadFilesBundleTag string, ociDownloadFilesBundlePlatform string, ociDownloadFilesBundleFiles string, ociDownloadFilesBundleFileRegex string, ociDownloadFilesBundleFormat string) (*oci.DownloadFilesBundlePayload, error) {
+	var err error
+	var repository string
+	{
+		repository = ociDownloadFilesBundleRepository
+	}
+	var tag string
+	{
+		tag = ociDownloadFilesBundleTag
+	}
+	var platform *string
+	{
+		if ociDownloadFilesBundlePlatform != "" {
+			platform = &ociDownloadFilesBundlePlatform
+		}
+	}
+	var files []string
+	{
+		if ociDownloadFilesBundleFiles != "" {
+			err = json.Unmarshal([]byte(ociDownloadFilesBundleFiles), &files)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for files, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"tidb-v7.5.0-darwin-arm64.tar.gz\",\n      \"pd-v7.5.0-darwin-arm64.tar.gz\"\n   ]'")
+			}
+		}
+	}
+	var fileRegex *string
+	{
+		if ociDownloadFilesBundleFileRegex != "" {
+			fileRegex = &ociDownloadFilesBundleFileRegex
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var format string
+	{
+		if ociDownloadFilesBundleFormat != "" {
+			format = ociDownloadFilesBundleFormat
+			if !(format == "tar" || format == "zip") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"tar", "zip"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &oci.DownloadFilesBundlePayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.Files = files
+	v.FileRegex = fileRegex
+	v.Format = format
 
 	return v, nil
 }
 
 // BuildDownloadFileSha256Payload builds the payload for the oci
 // download-file-sha256 endpoint from CLI flags.
-func BuildDownloadFileSha256Payload(ociDownloadFileSha256Repository string, ociDownloadFileSha256File string, ociDownloadFileSha256Tag string) (*oci.DownloadFileSha256Payload, error) {
+func BuildDownloadFileSha256Payload(ociDownloadFileSha256Repository string, ociDownloadFileSha256File string, ociDownloadFileSha256Tag string, ociDownloadFileSha256Platform string) (*oci.DownloadFileSha256Payload, error) {
 	var repository string
 	{
 		repository = ociDownloadFileSha256Repository
@@ -119,10 +296,134 @@ func BuildDownloadFileSha256Payload(ociDownloadFileSha256Repository string, ociD
 	{
 		tag = ociDownloadFileSha256Tag
 	}
+	var platform *string
+	{
+		if ociDownloadFileSha256Platform != "" {
+			platform = &ociDownloadFileSha256Platform
+		}
+	}
 	v := &oci.DownloadFileSha256Payload{}
 	v.Repository = repository
 	v.File = file
 	v.Tag = tag
+	v.Platform = platform
+
+	return v, nil
+}
+
+// BuildGetArtifactMetadataPayload builds the payload for the oci
+// get-artifact-metadata endpoint from CLI flags.
+func BuildGetArtifactMetadataPayload(ociGetArtifactMetadataRepository string, ociGetArtifactMetadataTag string, ociGetArtifactMetadataPlatform string) (*oci.GetArtifactMetadataPayload, error) {
+	var repository string
+	{
+		repository = ociGetArtifactMetadataRepository
+	}
+	var tag string
+	{
+		tag = ociGetArtifactMetadataTag
+	}
+	var platform *string
+	{
+		if ociGetArtifactMetadataPlatform != "" {
+			platform = &ociGetArtifactMetadataPlatform
+		}
+	}
+	v := &oci.GetArtifactMetadataPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+
+	return v, nil
+}
+
+// BuildGetFileChecksumPayload builds the payload for the oci get-file-checksum
+// endpoint from CLI flags.
+func BuildGetFileChecksumPayload(ociGetFileChecksumRepository string, ociGetFileChecksumTag string, ociGetFileChecksumFile string, ociGetFileChecksumFileRegex string, ociGetFileChecksumPlatform string, ociGetFileChecksumAlgorithm string) (*oci.GetFileChecksumPayload, error) {
+	var err error
+	var repository string
+	{
+		repository = ociGetFileChecksumRepository
+	}
+	var tag string
+	{
+		tag = ociGetFileChecksumTag
+	}
+	var file *string
+	{
+		if ociGetFileChecksumFile != "" {
+			file = &ociGetFileChecksumFile
+		}
+	}
+	var fileRegex *string
+	{
+		if ociGetFileChecksumFileRegex != "" {
+			fileRegex = &ociGetFileChecksumFileRegex
+		}
+	}
+	var platform *string
+	{
+		if ociGetFileChecksumPlatform != "" {
+			platform = &ociGetFileChecksumPlatform
+		}
+	}
+	var algorithm string
+	{
+		if ociGetFileChecksumAlgorithm != "" {
+			algorithm = ociGetFileChecksumAlgorithm
+			if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &oci.GetFileChecksumPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.File = file
+	v.FileRegex = fileRegex
+	v.Platform = platform
+	v.Algorithm = algorithm
+
+	return v, nil
+}
+
+// BuildDownloadChecksumsPayload builds the payload for the oci
+// download-checksums endpoint from CLI flags.
+func BuildDownloadChecksumsPayload(ociDownloadChecksumsRepository string, ociDownloadChecksumsTag string, ociDownloadChecksumsPlatform string, ociDownloadChecksumsAlgorithm string) (*oci.DownloadChecksumsPayload, error) {
+	var err error
+	var repository string
+	{
+		repository = ociDownloadChecksumsRepository
+	}
+	var tag string
+	{
+		tag = ociDownloadChecksumsTag
+	}
+	var platform *string
+	{
+		if ociDownloadChecksumsPlatform != "" {
+			platform = &ociDownloadChecksumsPlatform
+		}
+	}
+	var algorithm string
+	{
+		if ociDownloadChecksumsAlgorithm != "" {
+			algorithm = ociDownloadChecksumsAlgorithm
+			if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &oci.DownloadChecksumsPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.Algorithm = algorithm
 
 	return v, nil
 }
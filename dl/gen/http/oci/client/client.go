@@ -30,10 +30,34 @@ type Client struct {
 	// endpoint.
 	HeadFileDoer goahttp.Doer
 
+	// ListTarballEntries Doer is the HTTP client used to make requests to the
+	// list-tarball-entries endpoint.
+	ListTarballEntriesDoer goahttp.Doer
+
+	// DownloadTarballEntry Doer is the HTTP client used to make requests to the
+	// download-tarball-entry endpoint.
+	DownloadTarballEntryDoer goahttp.Doer
+
+	// DownloadFilesBundle Doer is the HTTP client used to make requests to the
+	// download-files-bundle endpoint.
+	DownloadFilesBundleDoer goahttp.Doer
+
 	// DownloadFileSha256 Doer is the HTTP client used to make requests to the
 	// download-file-sha256 endpoint.
 	DownloadFileSha256Doer goahttp.Doer
 
+	// GetArtifactMetadata Doer is the HTTP client used to make requests to the
+	// get-artifact-metadata endpoint.
+	GetArtifactMetadataDoer goahttp.Doer
+
+	// GetFileChecksum Doer is the HTTP client used to make requests to the
+	// get-file-checksum endpoint.
+	GetFileChecksumDoer goahttp.Doer
+
+	// DownloadChecksums Doer is the HTTP client used to make requests to the
+	// download-checksums endpoint.
+	DownloadChecksumsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -54,15 +78,21 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		ListFilesDoer:          doer,
-		DownloadFileDoer:       doer,
-		HeadFileDoer:           doer,
-		DownloadFileSha256Doer: doer,
-		RestoreResponseBody:    restoreBody,
-		scheme:                 scheme,
-		host:                   host,
-		decoder:                dec,
-		encoder:                enc,
+		ListFilesDoer:            doer,
+		DownloadFileDoer:         doer,
+		HeadFileDoer:             doer,
+		ListTarballEntriesDoer:   doer,
+		DownloadTarballEntryDoer: doer,
+		DownloadFilesBundleDoer:  doer,
+		DownloadFileSha256Doer:   doer,
+		GetArtifactMetadataDoer:  doer,
+		GetFileChecksumDoer:      doer,
+		DownloadChecksumsDoer:    doer,
+		RestoreResponseBody:      restoreBody,
+		scheme:                   scheme,
+		host:                     host,
+		decoder:                  dec,
+		encoder:                  enc,
 	}
 }
 
@@ -143,6 +173,88 @@ func (c *Client) HeadFile() goa.Endpoint {
 	}
 }
 
+// ListTarballEntries returns an endpoint that makes HTTP requests to the oci
+// service list-tarball-entries server.
+func (c *Client) ListTarballEntries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListTarballEntriesRequest(c.encoder)
+		decodeResponse = DecodeListTarballEntriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListTarballEntriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListTarballEntriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "list-tarball-entries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DownloadTarballEntry returns an endpoint that makes HTTP requests to the oci
+// service download-tarball-entry server.
+func (c *Client) DownloadTarballEntry() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDownloadTarballEntryRequest(c.encoder)
+		decodeResponse = DecodeDownloadTarballEntryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadTarballEntryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadTarballEntryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "download-tarball-entry", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &oci.DownloadTarballEntryResponseData{Result: res.(*oci.DownloadTarballEntryResult), Body: resp.Body}, nil
+	}
+}
+
+// DownloadFilesBundle returns an endpoint that makes HTTP requests to the oci
+// service download-files-bundle server.
+func (c *Client) DownloadFilesBundle() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDownloadFilesBundleRequest(c.encoder)
+		decodeResponse = DecodeDownloadFilesBundleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadFilesBundleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadFilesBundleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "download-files-bundle", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &oci.DownloadFilesBundleResponseData{Result: res.(*oci.DownloadFilesBundleResult), Body: resp.Body}, nil
+	}
+}
+
 // DownloadFileSha256 returns an endpoint that makes HTTP requests to the oci
 // service download-file-sha256 server.
 func (c *Client) DownloadFileSha256() goa.Endpoint {
@@ -171,3 +283,80 @@ func (c *Client) DownloadFileSha256() goa.Endpoint {
 		return &oci.DownloadFileSha256ResponseData{Result: res.(*oci.DownloadFileSha256Result), Body: resp.Body}, nil
 	}
 }
+
+// GetArtifactMetadata returns an endpoint that makes HTTP requests to the oci
+// service get-artifact-metadata server.
+func (c *Client) GetArtifactMetadata() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetArtifactMetadataRequest(c.encoder)
+		decodeResponse = DecodeGetArtifactMetadataResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetArtifactMetadataRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetArtifactMetadataDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "get-artifact-metadata", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetFileChecksum returns an endpoint that makes HTTP requests to the oci
+// service get-file-checksum server.
+func (c *Client) GetFileChecksum() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetFileChecksumRequest(c.encoder)
+		decodeResponse = DecodeGetFileChecksumResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetFileChecksumRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetFileChecksumDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "get-file-checksum", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DownloadChecksums returns an endpoint that makes HTTP requests to the oci
+// service download-checksums server.
+func (c *Client) DownloadChecksums() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDownloadChecksumsRequest(c.encoder)
+		decodeResponse = DecodeDownloadChecksumsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadChecksumsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadChecksumsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("oci", "download-checksums", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &oci.DownloadChecksumsResponseData{Result: res.(*oci.DownloadChecksumsResult), Body: resp.Body}, nil
+	}
+}
@@ -55,6 +55,9 @@ func EncodeListFilesRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 		}
 		values := req.URL.Query()
 		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -130,6 +133,9 @@ func EncodeDownloadFileRequest(encoder func(*http.Request) goahttp.Encoder) func
 		}
 		values := req.URL.Query()
 		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
 		if p.File != nil {
 			values.Add("file", *p.File)
 		}
@@ -226,6 +232,9 @@ func EncodeHeadFileRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 		}
 		values := req.URL.Query()
 		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
 		if p.File != nil {
 			values.Add("file", *p.File)
 		}
@@ -289,6 +298,286 @@ func DecodeHeadFileResponse(decoder func(*http.Response) goahttp.Decoder, restor
 	}
 }
 
+// BuildListTarballEntriesRequest instantiates a HTTP request object with
+// method and path set to call the "oci" service "list-tarball-entries" endpoint
+func (c *Client) BuildListTarballEntriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.ListTarballEntriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "list-tarball-entries", "*oci.ListTarballEntriesPayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTarballEntriesOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "list-tarball-entries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTarballEntriesRequest returns an encoder for requests sent to the
+// oci list-tarball-entries server.
+func EncodeListTarballEntriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.ListTarballEntriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "list-tarball-entries", "*oci.ListTarballEntriesPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		if p.File != nil {
+			values.Add("file", *p.File)
+		}
+		if p.FileRegex != nil {
+			values.Add("file_regex", *p.FileRegex)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListTarballEntriesResponse returns a decoder for responses returned by
+// the oci list-tarball-entries endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeListTarballEntriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("oci", "list-tarball-entries", err)
+			}
+			return body, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "list-tarball-entries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDownloadTarballEntryRequest instantiates a HTTP request object with
+// method and path set to call the "oci" service "download-tarball-entry"
+// endpoint
+func (c *Client) BuildDownloadTarballEntryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.DownloadTarballEntryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "download-tarball-entry", "*oci.DownloadTarballEntryPayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadTarballEntryOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "download-tarball-entry", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadTarballEntryRequest returns an encoder for requests sent to
+// the oci download-tarball-entry server.
+func EncodeDownloadTarballEntryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.DownloadTarballEntryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "download-tarball-entry", "*oci.DownloadTarballEntryPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		if p.File != nil {
+			values.Add("file", *p.File)
+		}
+		if p.FileRegex != nil {
+			values.Add("file_regex", *p.FileRegex)
+		}
+		values.Add("entry", p.Entry)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDownloadTarballEntryResponse returns a decoder for responses returned
+// by the oci download-tarball-entry endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeDownloadTarballEntryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				length             int64
+				contentDisposition string
+				err                error
+			)
+			{
+				lengthRaw := resp.Header.Get("Content-Length")
+				if lengthRaw == "" {
+					return nil, goahttp.ErrValidationError("oci", "download-tarball-entry", goa.MissingFieldError("length", "header"))
+				}
+				v, err2 := strconv.ParseInt(lengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("length", lengthRaw, "integer"))
+				}
+				length = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("oci", "download-tarball-entry", err)
+			}
+			res := NewDownloadTarballEntryResultOK(length, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "download-tarball-entry", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDownloadFilesBundleRequest instantiates a HTTP request object with
+// method and path set to call the "oci" service "download-files-bundle"
+// endpoint
+func (c *Client) BuildDownloadFilesBundleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.DownloadFilesBundlePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "download-files-bundle", "*oci.DownloadFilesBundlePayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadFilesBundleOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "download-files-bundle", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadFilesBundleRequest returns an encoder for requests sent to the
+// oci download-files-bundle server.
+func EncodeDownloadFilesBundleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.DownloadFilesBundlePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "download-files-bundle", "*oci.DownloadFilesBundlePayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		for _, value := range p.Files {
+			values.Add("files", value)
+		}
+		if p.FileRegex != nil {
+			values.Add("file_regex", *p.FileRegex)
+		}
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDownloadFilesBundleResponse returns a decoder for responses returned
+// by the oci download-files-bundle endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeDownloadFilesBundleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentType", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("oci", "download-files-bundle", err)
+			}
+			res := NewDownloadFilesBundleResultOK(contentType, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "download-files-bundle", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildDownloadFileSha256Request instantiates a HTTP request object with
 // method and path set to call the "oci" service "download-file-sha256" endpoint
 func (c *Client) BuildDownloadFileSha256Request(ctx context.Context, v any) (*http.Request, error) {
@@ -325,6 +614,9 @@ func EncodeDownloadFileSha256Request(encoder func(*http.Request) goahttp.Encoder
 		values := req.URL.Query()
 		values.Add("file", p.File)
 		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -379,3 +671,292 @@ func DecodeDownloadFileSha256Response(decoder func(*http.Response) goahttp.Decod
 		}
 	}
 }
+
+// BuildGetArtifactMetadataRequest instantiates a HTTP request object with
+// method and path set to call the "oci" service "get-artifact-metadata"
+// endpoint
+func (c *Client) BuildGetArtifactMetadataRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.GetArtifactMetadataPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "get-artifact-metadata", "*oci.GetArtifactMetadataPayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetArtifactMetadataOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "get-artifact-metadata", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetArtifactMetadataRequest returns an encoder for requests sent to the
+// oci get-artifact-metadata server.
+func EncodeGetArtifactMetadataRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.GetArtifactMetadataPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "get-artifact-metadata", "*oci.GetArtifactMetadataPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetArtifactMetadataResponse returns a decoder for responses returned
+// by the oci get-artifact-metadata endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeGetArtifactMetadataResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetArtifactMetadataResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("oci", "get-artifact-metadata", err)
+			}
+			err = ValidateGetArtifactMetadataResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("oci", "get-artifact-metadata", err)
+			}
+			res := NewGetArtifactMetadataArtifactMetadataOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "get-artifact-metadata", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetFileChecksumRequest instantiates a HTTP request object with method
+// and path set to call the "oci" service "get-file-checksum" endpoint
+func (c *Client) BuildGetFileChecksumRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.GetFileChecksumPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "get-file-checksum", "*oci.GetFileChecksumPayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetFileChecksumOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "get-file-checksum", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetFileChecksumRequest returns an encoder for requests sent to the oci
+// get-file-checksum server.
+func EncodeGetFileChecksumRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.GetFileChecksumPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "get-file-checksum", "*oci.GetFileChecksumPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.File != nil {
+			values.Add("file", *p.File)
+		}
+		if p.FileRegex != nil {
+			values.Add("file_regex", *p.FileRegex)
+		}
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		values.Add("algorithm", p.Algorithm)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetFileChecksumResponse returns a decoder for responses returned by
+// the oci get-file-checksum endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeGetFileChecksumResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetFileChecksumResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("oci", "get-file-checksum", err)
+			}
+			err = ValidateGetFileChecksumResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("oci", "get-file-checksum", err)
+			}
+			res := NewGetFileChecksumFileChecksumOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "get-file-checksum", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDownloadChecksumsRequest instantiates a HTTP request object with method
+// and path set to call the "oci" service "download-checksums" endpoint
+func (c *Client) BuildDownloadChecksumsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		repository string
+	)
+	{
+		p, ok := v.(*oci.DownloadChecksumsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("oci", "download-checksums", "*oci.DownloadChecksumsPayload", v)
+		}
+		repository = p.Repository
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadChecksumsOciPath(repository)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("oci", "download-checksums", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadChecksumsRequest returns an encoder for requests sent to the
+// oci download-checksums server.
+func EncodeDownloadChecksumsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*oci.DownloadChecksumsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("oci", "download-checksums", "*oci.DownloadChecksumsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("tag", p.Tag)
+		if p.Platform != nil {
+			values.Add("platform", *p.Platform)
+		}
+		values.Add("algorithm", p.Algorithm)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDownloadChecksumsResponse returns a decoder for responses returned by
+// the oci download-checksums endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeDownloadChecksumsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				length             int64
+				contentDisposition string
+				err                error
+			)
+			{
+				lengthRaw := resp.Header.Get("Content-Length")
+				if lengthRaw == "" {
+					return nil, goahttp.ErrValidationError("oci", "download-checksums", goa.MissingFieldError("length", "header"))
+				}
+				v, err2 := strconv.ParseInt(lengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("length", lengthRaw, "integer"))
+				}
+				length = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("oci", "download-checksums", err)
+			}
+			res := NewDownloadChecksumsResultOK(length, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("oci", "download-checksums", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalLayerMetadataResponseBodyToOciLayerMetadata builds a value of type
+// *oci.LayerMetadata from a value of type *LayerMetadataResponseBody.
+func unmarshalLayerMetadataResponseBodyToOciLayerMetadata(v *LayerMetadataResponseBody) *oci.LayerMetadata {
+	res := &oci.LayerMetadata{
+		File:      v.File,
+		Digest:    *v.Digest,
+		Size:      *v.Size,
+		MediaType: *v.MediaType,
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+
+	return res
+}
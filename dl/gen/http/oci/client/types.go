@@ -9,8 +9,53 @@ package client
 
 import (
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// GetArtifactMetadataResponseBody is the type of the "oci" service
+// "get-artifact-metadata" endpoint HTTP response body.
+type GetArtifactMetadataResponseBody struct {
+	// manifest digest
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
+	// manifest media type
+	MediaType *string `form:"mediaType,omitempty" json:"mediaType,omitempty" xml:"mediaType,omitempty"`
+	// artifact type
+	ArtifactType *string `form:"artifactType,omitempty" json:"artifactType,omitempty" xml:"artifactType,omitempty"`
+	// artifact config
+	Config map[string]any `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
+	// manifest annotations
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// artifact layers
+	Layers []*LayerMetadataResponseBody `form:"layers,omitempty" json:"layers,omitempty" xml:"layers,omitempty"`
+}
+
+// GetFileChecksumResponseBody is the type of the "oci" service
+// "get-file-checksum" endpoint HTTP response body.
+type GetFileChecksumResponseBody struct {
+	// file name or object key
+	File *string `form:"file,omitempty" json:"file,omitempty" xml:"file,omitempty"`
+	// checksum algorithm
+	Algorithm *string `form:"algorithm,omitempty" json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	// checksum in hex
+	Checksum *string `form:"checksum,omitempty" json:"checksum,omitempty" xml:"checksum,omitempty"`
+	// content size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+}
+
+// LayerMetadataResponseBody is used to define fields on response body types.
+type LayerMetadataResponseBody struct {
+	// file name of the layer
+	File *string `form:"file,omitempty" json:"file,omitempty" xml:"file,omitempty"`
+	// layer digest
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
+	// layer size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// layer media type
+	MediaType *string `form:"mediaType,omitempty" json:"mediaType,omitempty" xml:"mediaType,omitempty"`
+	// layer annotations
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+}
+
 // NewDownloadFileResultOK builds a "oci" service "download-file" endpoint
 // result from a HTTP "OK" response.
 func NewDownloadFileResultOK(length int64, contentDisposition string) *oci.DownloadFileResult {
@@ -31,6 +76,26 @@ func NewHeadFileResultOK(length int64, contentDisposition string) *oci.HeadFileR
 	return v
 }
 
+// NewDownloadTarballEntryResultOK builds a "oci" service
+// "download-tarball-entry" endpoint result from a HTTP "OK" response.
+func NewDownloadTarballEntryResultOK(length int64, contentDisposition string) *oci.DownloadTarballEntryResult {
+	v := &oci.DownloadTarballEntryResult{}
+	v.Length = length
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewDownloadFilesBundleResultOK builds a "oci" service
+// "download-files-bundle" endpoint result from a HTTP "OK" response.
+func NewDownloadFilesBundleResultOK(contentType string, contentDisposition string) *oci.DownloadFilesBundleResult {
+	v := &oci.DownloadFilesBundleResult{}
+	v.ContentType = contentType
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
 // NewDownloadFileSha256ResultOK builds a "oci" service "download-file-sha256"
 // endpoint result from a HTTP "OK" response.
 func NewDownloadFileSha256ResultOK(length int64, contentDisposition string) *oci.DownloadFileSha256Result {
@@ -40,3 +105,113 @@ func NewDownloadFileSha256ResultOK(length int64, contentDisposition string) *oci
 
 	return v
 }
+
+// NewGetArtifactMetadataArtifactMetadataOK builds a "oci" service
+// "get-artifact-metadata" endpoint result from a HTTP "OK" response.
+func NewGetArtifactMetadataArtifactMetadataOK(body *GetArtifactMetadataResponseBody) *oci.ArtifactMetadata {
+	v := &oci.ArtifactMetadata{
+		Digest:       *body.Digest,
+		MediaType:    *body.MediaType,
+		ArtifactType: body.ArtifactType,
+	}
+	if body.Config != nil {
+		v.Config = make(map[string]any, len(body.Config))
+		for key, val := range body.Config {
+			tk := key
+			tv := val
+			v.Config[tk] = tv
+		}
+	}
+	if body.Annotations != nil {
+		v.Annotations = make(map[string]string, len(body.Annotations))
+		for key, val := range body.Annotations {
+			tk := key
+			tv := val
+			v.Annotations[tk] = tv
+		}
+	}
+	v.Layers = make([]*oci.LayerMetadata, len(body.Layers))
+	for i, val := range body.Layers {
+		v.Layers[i] = unmarshalLayerMetadataResponseBodyToOciLayerMetadata(val)
+	}
+
+	return v
+}
+
+// NewGetFileChecksumFileChecksumOK builds a "oci" service "get-file-checksum"
+// endpoint result from a HTTP "OK" response.
+func NewGetFileChecksumFileChecksumOK(body *GetFileChecksumResponseBody) *oci.FileChecksum {
+	v := &oci.FileChecksum{
+		File:      *body.File,
+		Algorithm: *body.Algorithm,
+		Checksum:  *body.Checksum,
+		Size:      *body.Size,
+	}
+
+	return v
+}
+
+// NewDownloadChecksumsResultOK builds a "oci" service "download-checksums"
+// endpoint result from a HTTP "OK" response.
+func NewDownloadChecksumsResultOK(length int64, contentDisposition string) *oci.DownloadChecksumsResult {
+	v := &oci.DownloadChecksumsResult{}
+	v.Length = length
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// ValidateGetArtifactMetadataResponseBody runs the validations defined on
+// Get-Artifact-MetadataResponseBody
+func ValidateGetArtifactMetadataResponseBody(body *GetArtifactMetadataResponseBody) (err error) {
+	if body.Digest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("digest", "body"))
+	}
+	if body.MediaType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mediaType", "body"))
+	}
+	if body.Layers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("layers", "body"))
+	}
+	for _, e := range body.Layers {
+		if e != nil {
+			if err2 := ValidateLayerMetadataResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetFileChecksumResponseBody runs the validations defined on
+// Get-File-ChecksumResponseBody
+func ValidateGetFileChecksumResponseBody(body *GetFileChecksumResponseBody) (err error) {
+	if body.File == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("file", "body"))
+	}
+	if body.Algorithm == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("algorithm", "body"))
+	}
+	if body.Checksum == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	return
+}
+
+// ValidateLayerMetadataResponseBody runs the validations defined on
+// LayerMetadataResponseBody
+func ValidateLayerMetadataResponseBody(body *LayerMetadataResponseBody) (err error) {
+	if body.Digest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("digest", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.MediaType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mediaType", "body"))
+	}
+	return
+}
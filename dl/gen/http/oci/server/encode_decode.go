@@ -36,19 +36,25 @@ func DecodeListFilesRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 		var (
 			repository string
 			tag        string
+			platform   *string
 			err        error
 
 			params = mux.Vars(r)
 		)
 		repository = params["repository"]
-		tag = r.URL.Query().Get("tag")
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
 		if tag == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
 		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListFilesPayload(repository, tag)
+		payload := NewListFilesPayload(repository, tag, platform)
 
 		return payload, nil
 	}
@@ -78,6 +84,7 @@ func DecodeDownloadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) go
 		var (
 			repository string
 			tag        string
+			platform   *string
 			file       *string
 			fileRegex  *string
 			err        error
@@ -90,6 +97,10 @@ func DecodeDownloadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) go
 		if tag == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
 		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
 		fileRaw := qp.Get("file")
 		if fileRaw != "" {
 			file = &fileRaw
@@ -104,7 +115,7 @@ func DecodeDownloadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) go
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDownloadFilePayload(repository, tag, file, fileRegex)
+		payload := NewDownloadFilePayload(repository, tag, platform, file, fileRegex)
 
 		return payload, nil
 	}
@@ -133,6 +144,7 @@ func DecodeHeadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		var (
 			repository string
 			tag        string
+			platform   *string
 			file       *string
 			fileRegex  *string
 			err        error
@@ -145,6 +157,10 @@ func DecodeHeadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if tag == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
 		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
 		fileRaw := qp.Get("file")
 		if fileRaw != "" {
 			file = &fileRaw
@@ -159,7 +175,192 @@ func DecodeHeadFileRequest(mux goahttp.Muxer, decoder func(*http.Request) goahtt
 		if err != nil {
 			return nil, err
 		}
-		payload := NewHeadFilePayload(repository, tag, file, fileRegex)
+		payload := NewHeadFilePayload(repository, tag, platform, file, fileRegex)
+
+		return payload, nil
+	}
+}
+
+// EncodeListTarballEntriesResponse returns an encoder for responses returned
+// by the oci list-tarball-entries endpoint.
+func EncodeListTarballEntriesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]string)
+		enc := encoder(ctx, w)
+		body := res
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListTarballEntriesRequest returns a decoder for requests sent to the
+// oci list-tarball-entries endpoint.
+func DecodeListTarballEntriesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.ListTarballEntriesPayload, error) {
+	return func(r *http.Request) (*oci.ListTarballEntriesPayload, error) {
+		var (
+			repository string
+			tag        string
+			platform   *string
+			file       *string
+			fileRegex  *string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		fileRaw := qp.Get("file")
+		if fileRaw != "" {
+			file = &fileRaw
+		}
+		fileRegexRaw := qp.Get("file_regex")
+		if fileRegexRaw != "" {
+			fileRegex = &fileRegexRaw
+		}
+		if fileRegex != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListTarballEntriesPayload(repository, tag, platform, file, fileRegex)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadTarballEntryResponse returns an encoder for responses returned
+// by the oci download-tarball-entry endpoint.
+func EncodeDownloadTarballEntryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*oci.DownloadTarballEntryResult)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/octet-stream")
+		{
+			val := res.Length
+			lengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", lengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadTarballEntryRequest returns a decoder for requests sent to the
+// oci download-tarball-entry endpoint.
+func DecodeDownloadTarballEntryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.DownloadTarballEntryPayload, error) {
+	return func(r *http.Request) (*oci.DownloadTarballEntryPayload, error) {
+		var (
+			repository string
+			tag        string
+			platform   *string
+			file       *string
+			fileRegex  *string
+			entry      string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		fileRaw := qp.Get("file")
+		if fileRaw != "" {
+			file = &fileRaw
+		}
+		fileRegexRaw := qp.Get("file_regex")
+		if fileRegexRaw != "" {
+			fileRegex = &fileRegexRaw
+		}
+		if fileRegex != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+		}
+		entry = qp.Get("entry")
+		if entry == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("entry", "query string"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDownloadTarballEntryPayload(repository, tag, platform, file, fileRegex, entry)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadFilesBundleResponse returns an encoder for responses returned
+// by the oci download-files-bundle endpoint.
+func EncodeDownloadFilesBundleResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*oci.DownloadFilesBundleResult)
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadFilesBundleRequest returns a decoder for requests sent to the
+// oci download-files-bundle endpoint.
+func DecodeDownloadFilesBundleRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.DownloadFilesBundlePayload, error) {
+	return func(r *http.Request) (*oci.DownloadFilesBundlePayload, error) {
+		var (
+			repository string
+			tag        string
+			platform   *string
+			files      []string
+			fileRegex  *string
+			format     string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		files = qp["files"]
+		fileRegexRaw := qp.Get("file_regex")
+		if fileRegexRaw != "" {
+			fileRegex = &fileRegexRaw
+		}
+		if fileRegex != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("file_regex", *fileRegex, goa.FormatRegexp))
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "tar"
+		}
+		if !(format == "tar" || format == "zip") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"tar", "zip"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDownloadFilesBundlePayload(repository, tag, platform, files, fileRegex, format)
 
 		return payload, nil
 	}
@@ -190,6 +391,7 @@ func DecodeDownloadFileSha256Request(mux goahttp.Muxer, decoder func(*http.Reque
 			repository string
 			file       string
 			tag        string
+			platform   *string
 			err        error
 
 			params = mux.Vars(r)
@@ -204,11 +406,200 @@ func DecodeDownloadFileSha256Request(mux goahttp.Muxer, decoder func(*http.Reque
 		if tag == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
 		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDownloadFileSha256Payload(repository, file, tag)
+		payload := NewDownloadFileSha256Payload(repository, file, tag, platform)
 
 		return payload, nil
 	}
 }
+
+// EncodeGetArtifactMetadataResponse returns an encoder for responses returned
+// by the oci get-artifact-metadata endpoint.
+func EncodeGetArtifactMetadataResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*oci.ArtifactMetadata)
+		enc := encoder(ctx, w)
+		body := NewGetArtifactMetadataResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetArtifactMetadataRequest returns a decoder for requests sent to the
+// oci get-artifact-metadata endpoint.
+func DecodeGetArtifactMetadataRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.GetArtifactMetadataPayload, error) {
+	return func(r *http.Request) (*oci.GetArtifactMetadataPayload, error) {
+		var (
+			repository string
+			tag        string
+			platform   *string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetArtifactMetadataPayload(repository, tag, platform)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetFileChecksumResponse returns an encoder for responses returned by
+// the oci get-file-checksum endpoint.
+func EncodeGetFileChecksumResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*oci.FileChecksum)
+		enc := encoder(ctx, w)
+		body := NewGetFileChecksumResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetFileChecksumRequest returns a decoder for requests sent to the oci
+// get-file-checksum endpoint.
+func DecodeGetFileChecksumRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.GetFileChecksumPayload, error) {
+	return func(r *http.Request) (*oci.GetFileChecksumPayload, error) {
+		var (
+			repository string
+			tag        string
+			file       *string
+			fileRegex  *string
+			platform   *string
+			algorithm  string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		fileRaw := qp.Get("file")
+		if fileRaw != "" {
+			file = &fileRaw
+		}
+		fileRegexRaw := qp.Get("file_regex")
+		if fileRegexRaw != "" {
+			fileRegex = &fileRegexRaw
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		algorithmRaw := qp.Get("algorithm")
+		if algorithmRaw != "" {
+			algorithm = algorithmRaw
+		} else {
+			algorithm = "sha256"
+		}
+		if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetFileChecksumPayload(repository, tag, file, fileRegex, platform, algorithm)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadChecksumsResponse returns an encoder for responses returned by
+// the oci download-checksums endpoint.
+func EncodeDownloadChecksumsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*oci.DownloadChecksumsResult)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "text/plain; charset=utf-8")
+		{
+			val := res.Length
+			lengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", lengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadChecksumsRequest returns a decoder for requests sent to the
+// oci download-checksums endpoint.
+func DecodeDownloadChecksumsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*oci.DownloadChecksumsPayload, error) {
+	return func(r *http.Request) (*oci.DownloadChecksumsPayload, error) {
+		var (
+			repository string
+			tag        string
+			platform   *string
+			algorithm  string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		repository = params["repository"]
+		qp := r.URL.Query()
+		tag = qp.Get("tag")
+		if tag == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tag", "query string"))
+		}
+		platformRaw := qp.Get("platform")
+		if platformRaw != "" {
+			platform = &platformRaw
+		}
+		algorithmRaw := qp.Get("algorithm")
+		if algorithmRaw != "" {
+			algorithm = algorithmRaw
+		} else {
+			algorithm = "sha256"
+		}
+		if !(algorithm == "sha256" || algorithm == "sha512" || algorithm == "md5") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("algorithm", algorithm, []any{"sha256", "sha512", "md5"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDownloadChecksumsPayload(repository, tag, platform, algorithm)
+
+		return payload, nil
+	}
+}
+
+// marshalOciLayerMetadataToLayerMetadataResponseBody builds a value of type
+// *LayerMetadataResponseBody from a value of type *oci.LayerMetadata.
+func marshalOciLayerMetadataToLayerMetadataResponseBody(v *oci.LayerMetadata) *LayerMetadataResponseBody {
+	res := &LayerMetadataResponseBody{
+		File:      v.File,
+		Digest:    v.Digest,
+		Size:      v.Size,
+		MediaType: v.MediaType,
+	}
+	if v.Annotations != nil {
+		res.Annotations = make(map[string]string, len(v.Annotations))
+		for key, val := range v.Annotations {
+			tk := key
+			tv := val
+			res.Annotations[tk] = tv
+		}
+	}
+
+	return res
+}
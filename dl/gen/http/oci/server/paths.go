@@ -26,7 +26,37 @@ func HeadFileOciPath(repository string) string {
 	return fmt.Sprintf("/oci-file/%v", repository)
 }
 
+// ListTarballEntriesOciPath returns the URL path to the oci service list-tarball-entries HTTP endpoint.
+func ListTarballEntriesOciPath(repository string) string {
+	return fmt.Sprintf("/oci-file-entries/%v", repository)
+}
+
+// DownloadTarballEntryOciPath returns the URL path to the oci service download-tarball-entry HTTP endpoint.
+func DownloadTarballEntryOciPath(repository string) string {
+	return fmt.Sprintf("/oci-file-entry/%v", repository)
+}
+
+// DownloadFilesBundleOciPath returns the URL path to the oci service download-files-bundle HTTP endpoint.
+func DownloadFilesBundleOciPath(repository string) string {
+	return fmt.Sprintf("/oci-bundle/%v", repository)
+}
+
 // DownloadFileSha256OciPath returns the URL path to the oci service download-file-sha256 HTTP endpoint.
 func DownloadFileSha256OciPath(repository string) string {
 	return fmt.Sprintf("/oci-file-sha256/%v", repository)
 }
+
+// GetArtifactMetadataOciPath returns the URL path to the oci service get-artifact-metadata HTTP endpoint.
+func GetArtifactMetadataOciPath(repository string) string {
+	return fmt.Sprintf("/oci-metadata/%v", repository)
+}
+
+// GetFileChecksumOciPath returns the URL path to the oci service get-file-checksum HTTP endpoint.
+func GetFileChecksumOciPath(repository string) string {
+	return fmt.Sprintf("/oci-file-checksum/%v", repository)
+}
+
+// DownloadChecksumsOciPath returns the URL path to the oci service download-checksums HTTP endpoint.
+func DownloadChecksumsOciPath(repository string) string {
+	return fmt.Sprintf("/oci-checksums/%v", repository)
+}
@@ -20,11 +20,17 @@ import (
 
 // Server lists the oci service endpoint HTTP handlers.
 type Server struct {
-	Mounts             []*MountPoint
-	ListFiles          http.Handler
-	DownloadFile       http.Handler
-	HeadFile           http.Handler
-	DownloadFileSha256 http.Handler
+	Mounts               []*MountPoint
+	ListFiles            http.Handler
+	DownloadFile         http.Handler
+	HeadFile             http.Handler
+	ListTarballEntries   http.Handler
+	DownloadTarballEntry http.Handler
+	DownloadFilesBundle  http.Handler
+	DownloadFileSha256   http.Handler
+	GetArtifactMetadata  http.Handler
+	GetFileChecksum      http.Handler
+	DownloadChecksums    http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -57,12 +63,24 @@ func New(
 			{"ListFiles", "GET", "/oci-files/{*repository}"},
 			{"DownloadFile", "GET", "/oci-file/{*repository}"},
 			{"HeadFile", "HEAD", "/oci-file/{*repository}"},
+			{"ListTarballEntries", "GET", "/oci-file-entries/{*repository}"},
+			{"DownloadTarballEntry", "GET", "/oci-file-entry/{*repository}"},
+			{"DownloadFilesBundle", "GET", "/oci-bundle/{*repository}"},
 			{"DownloadFileSha256", "GET", "/oci-file-sha256/{*repository}"},
+			{"GetArtifactMetadata", "GET", "/oci-metadata/{*repository}"},
+			{"GetFileChecksum", "GET", "/oci-file-checksum/{*repository}"},
+			{"DownloadChecksums", "GET", "/oci-checksums/{*repository}"},
 		},
-		ListFiles:          NewListFilesHandler(e.ListFiles, mux, decoder, encoder, errhandler, formatter),
-		DownloadFile:       NewDownloadFileHandler(e.DownloadFile, mux, decoder, encoder, errhandler, formatter),
-		HeadFile:           NewHeadFileHandler(e.HeadFile, mux, decoder, encoder, errhandler, formatter),
-		DownloadFileSha256: NewDownloadFileSha256Handler(e.DownloadFileSha256, mux, decoder, encoder, errhandler, formatter),
+		ListFiles:            NewListFilesHandler(e.ListFiles, mux, decoder, encoder, errhandler, formatter),
+		DownloadFile:         NewDownloadFileHandler(e.DownloadFile, mux, decoder, encoder, errhandler, formatter),
+		HeadFile:             NewHeadFileHandler(e.HeadFile, mux, decoder, encoder, errhandler, formatter),
+		ListTarballEntries:   NewListTarballEntriesHandler(e.ListTarballEntries, mux, decoder, encoder, errhandler, formatter),
+		DownloadTarballEntry: NewDownloadTarballEntryHandler(e.DownloadTarballEntry, mux, decoder, encoder, errhandler, formatter),
+		DownloadFilesBundle:  NewDownloadFilesBundleHandler(e.DownloadFilesBundle, mux, decoder, encoder, errhandler, formatter),
+		DownloadFileSha256:   NewDownloadFileSha256Handler(e.DownloadFileSha256, mux, decoder, encoder, errhandler, formatter),
+		GetArtifactMetadata:  NewGetArtifactMetadataHandler(e.GetArtifactMetadata, mux, decoder, encoder, errhandler, formatter),
+		GetFileChecksum:      NewGetFileChecksumHandler(e.GetFileChecksum, mux, decoder, encoder, errhandler, formatter),
+		DownloadChecksums:    NewDownloadChecksumsHandler(e.DownloadChecksums, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -74,7 +92,13 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.ListFiles = m(s.ListFiles)
 	s.DownloadFile = m(s.DownloadFile)
 	s.HeadFile = m(s.HeadFile)
+	s.ListTarballEntries = m(s.ListTarballEntries)
+	s.DownloadTarballEntry = m(s.DownloadTarballEntry)
+	s.DownloadFilesBundle = m(s.DownloadFilesBundle)
 	s.DownloadFileSha256 = m(s.DownloadFileSha256)
+	s.GetArtifactMetadata = m(s.GetArtifactMetadata)
+	s.GetFileChecksum = m(s.GetFileChecksum)
+	s.DownloadChecksums = m(s.DownloadChecksums)
 }
 
 // MethodNames returns the methods served.
@@ -85,7 +109,13 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountListFilesHandler(mux, h.ListFiles)
 	MountDownloadFileHandler(mux, h.DownloadFile)
 	MountHeadFileHandler(mux, h.HeadFile)
+	MountListTarballEntriesHandler(mux, h.ListTarballEntries)
+	MountDownloadTarballEntryHandler(mux, h.DownloadTarballEntry)
+	MountDownloadFilesBundleHandler(mux, h.DownloadFilesBundle)
 	MountDownloadFileSha256Handler(mux, h.DownloadFileSha256)
+	MountGetArtifactMetadataHandler(mux, h.GetArtifactMetadata)
+	MountGetFileChecksumHandler(mux, h.GetFileChecksum)
+	MountDownloadChecksumsHandler(mux, h.DownloadChecksums)
 }
 
 // Mount configures the mux to serve the oci endpoints.
@@ -287,6 +317,235 @@ func NewHeadFileHandler(
 	})
 }
 
+// MountListTarballEntriesHandler configures the mux to serve the "oci" service
+// "list-tarball-entries" endpoint.
+func MountListTarballEntriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-file-entries/{*repository}", f)
+}
+
+// NewListTarballEntriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "list-tarball-entries" endpoint.
+func NewListTarballEntriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTarballEntriesRequest(mux, decoder)
+		encodeResponse = EncodeListTarballEntriesResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list-tarball-entries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDownloadTarballEntryHandler configures the mux to serve the "oci"
+// service "download-tarball-entry" endpoint.
+func MountDownloadTarballEntryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-file-entry/{*repository}", f)
+}
+
+// NewDownloadTarballEntryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "download-tarball-entry" endpoint.
+func NewDownloadTarballEntryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadTarballEntryRequest(mux, decoder)
+		encodeResponse = EncodeDownloadTarballEntryResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download-tarball-entry")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*oci.DownloadTarballEntryResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountDownloadFilesBundleHandler configures the mux to serve the "oci"
+// service "download-files-bundle" endpoint.
+func MountDownloadFilesBundleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-bundle/{*repository}", f)
+}
+
+// NewDownloadFilesBundleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "download-files-bundle" endpoint.
+func NewDownloadFilesBundleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadFilesBundleRequest(mux, decoder)
+		encodeResponse = EncodeDownloadFilesBundleResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download-files-bundle")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*oci.DownloadFilesBundleResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
 // MountDownloadFileSha256Handler configures the mux to serve the "oci" service
 // "download-file-sha256" endpoint.
 func MountDownloadFileSha256Handler(mux goahttp.Muxer, h http.Handler) {
@@ -374,3 +633,197 @@ func NewDownloadFileSha256Handler(
 		}
 	})
 }
+
+// MountGetArtifactMetadataHandler configures the mux to serve the "oci"
+// service "get-artifact-metadata" endpoint.
+func MountGetArtifactMetadataHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-metadata/{*repository}", f)
+}
+
+// NewGetArtifactMetadataHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "get-artifact-metadata" endpoint.
+func NewGetArtifactMetadataHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetArtifactMetadataRequest(mux, decoder)
+		encodeResponse = EncodeGetArtifactMetadataResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-artifact-metadata")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetFileChecksumHandler configures the mux to serve the "oci" service
+// "get-file-checksum" endpoint.
+func MountGetFileChecksumHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-file-checksum/{*repository}", f)
+}
+
+// NewGetFileChecksumHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "get-file-checksum" endpoint.
+func NewGetFileChecksumHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetFileChecksumRequest(mux, decoder)
+		encodeResponse = EncodeGetFileChecksumResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get-file-checksum")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDownloadChecksumsHandler configures the mux to serve the "oci" service
+// "download-checksums" endpoint.
+func MountDownloadChecksumsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/oci-checksums/{*repository}", f)
+}
+
+// NewDownloadChecksumsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "oci" service "download-checksums" endpoint.
+func NewDownloadChecksumsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadChecksumsRequest(mux, decoder)
+		encodeResponse = EncodeDownloadChecksumsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download-checksums")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "oci")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*oci.DownloadChecksumsResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
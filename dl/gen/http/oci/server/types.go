@@ -11,20 +11,113 @@ import (
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
 )
 
+// GetArtifactMetadataResponseBody is the type of the "oci" service
+// "get-artifact-metadata" endpoint HTTP response body.
+type GetArtifactMetadataResponseBody struct {
+	// manifest digest
+	Digest string `form:"digest" json:"digest" xml:"digest"`
+	// manifest media type
+	MediaType string `form:"mediaType" json:"mediaType" xml:"mediaType"`
+	// artifact type
+	ArtifactType *string `form:"artifactType,omitempty" json:"artifactType,omitempty" xml:"artifactType,omitempty"`
+	// artifact config
+	Config map[string]any `form:"config,omitempty" json:"config,omitempty" xml:"config,omitempty"`
+	// manifest annotations
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// artifact layers
+	Layers []*LayerMetadataResponseBody `form:"layers" json:"layers" xml:"layers"`
+}
+
+// GetFileChecksumResponseBody is the type of the "oci" service
+// "get-file-checksum" endpoint HTTP response body.
+type GetFileChecksumResponseBody struct {
+	// file name or object key
+	File string `form:"file" json:"file" xml:"file"`
+	// checksum algorithm
+	Algorithm string `form:"algorithm" json:"algorithm" xml:"algorithm"`
+	// checksum in hex
+	Checksum string `form:"checksum" json:"checksum" xml:"checksum"`
+	// content size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+}
+
+// LayerMetadataResponseBody is used to define fields on response body types.
+type LayerMetadataResponseBody struct {
+	// file name of the layer
+	File *string `form:"file,omitempty" json:"file,omitempty" xml:"file,omitempty"`
+	// layer digest
+	Digest string `form:"digest" json:"digest" xml:"digest"`
+	// layer size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// layer media type
+	MediaType string `form:"mediaType" json:"mediaType" xml:"mediaType"`
+	// layer annotations
+	Annotations map[string]string `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+}
+
+// NewGetArtifactMetadataResponseBody builds the HTTP response body from the
+// result of the "get-artifact-metadata" endpoint of the "oci" service.
+func NewGetArtifactMetadataResponseBody(res *oci.ArtifactMetadata) *GetArtifactMetadataResponseBody {
+	body := &GetArtifactMetadataResponseBody{
+		Digest:       res.Digest,
+		MediaType:    res.MediaType,
+		ArtifactType: res.ArtifactType,
+	}
+	if res.Config != nil {
+		body.Config = make(map[string]any, len(res.Config))
+		for key, val := range res.Config {
+			tk := key
+			tv := val
+			body.Config[tk] = tv
+		}
+	}
+	if res.Annotations != nil {
+		body.Annotations = make(map[string]string, len(res.Annotations))
+		for key, val := range res.Annotations {
+			tk := key
+			tv := val
+			body.Annotations[tk] = tv
+		}
+	}
+	if res.Layers != nil {
+		body.Layers = make([]*LayerMetadataResponseBody, len(res.Layers))
+		for i, val := range res.Layers {
+			body.Layers[i] = marshalOciLayerMetadataToLayerMetadataResponseBody(val)
+		}
+	} else {
+		body.Layers = []*LayerMetadataResponseBody{}
+	}
+	return body
+}
+
+// NewGetFileChecksumResponseBody builds the HTTP response body from the result
+// of the "get-file-checksum" endpoint of the "oci" service.
+func NewGetFileChecksumResponseBody(res *oci.FileChecksum) *GetFileChecksumResponseBody {
+	body := &GetFileChecksumResponseBody{
+		File:      res.File,
+		Algorithm: res.Algorithm,
+		Checksum:  res.Checksum,
+		Size:      res.Size,
+	}
+	return body
+}
+
 // NewListFilesPayload builds a oci service list-files endpoint payload.
-func NewListFilesPayload(repository string, tag string) *oci.ListFilesPayload {
+func NewListFilesPayload(repository string, tag string, platform *string) *oci.ListFilesPayload {
 	v := &oci.ListFilesPayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
 
 	return v
 }
 
 // NewDownloadFilePayload builds a oci service download-file endpoint payload.
-func NewDownloadFilePayload(repository string, tag string, file *string, fileRegex *string) *oci.DownloadFilePayload {
+func NewDownloadFilePayload(repository string, tag string, platform *string, file *string, fileRegex *string) *oci.DownloadFilePayload {
 	v := &oci.DownloadFilePayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
 	v.File = file
 	v.FileRegex = fileRegex
 
@@ -32,23 +125,103 @@ func NewDownloadFilePayload(repository string, tag string, file *string, fileReg
 }
 
 // NewHeadFilePayload builds a oci service head-file endpoint payload.
-func NewHeadFilePayload(repository string, tag string, file *string, fileRegex *string) *oci.HeadFilePayload {
+func NewHeadFilePayload(repository string, tag string, platform *string, file *string, fileRegex *string) *oci.HeadFilePayload {
 	v := &oci.HeadFilePayload{}
 	v.Repository = repository
 	v.Tag = tag
+	v.Platform = platform
 	v.File = file
 	v.FileRegex = fileRegex
 
 	return v
 }
 
+// NewListTarballEntriesPayload builds a oci service list-tarball-entries
+// endpoint payload.
+func NewListTarballEntriesPayload(repository string, tag string, platform *string, file *string, fileRegex *string) *oci.ListTarballEntriesPayload {
+	v := &oci.ListTarballEntriesPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.File = file
+	v.FileRegex = fileRegex
+
+	return v
+}
+
+// NewDownloadTarballEntryPayload builds a oci service download-tarball-entry
+// endpoint payload.
+func NewDownloadTarballEntryPayload(repository string, tag string, platform *string, file *string, fileRegex *string, entry string) *oci.DownloadTarballEntryPayload {
+	v := &oci.DownloadTarballEntryPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.File = file
+	v.FileRegex = fileRegex
+	v.Entry = entry
+
+	return v
+}
+
+// NewDownloadFilesBundlePayload builds a oci service download-files-bundle
+// endpoint payload.
+func NewDownloadFilesBundlePayload(repository string, tag string, platform *string, files []string, fileRegex *string, format string) *oci.DownloadFilesBundlePayload {
+	v := &oci.DownloadFilesBundlePayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.Files = files
+	v.FileRegex = fileRegex
+	v.Format = format
+
+	return v
+}
+
 // NewDownloadFileSha256Payload builds a oci service download-file-sha256
 // endpoint payload.
-func NewDownloadFileSha256Payload(repository string, file string, tag string) *oci.DownloadFileSha256Payload {
+func NewDownloadFileSha256Payload(repository string, file string, tag string, platform *string) *oci.DownloadFileSha256Payload {
 	v := &oci.DownloadFileSha256Payload{}
 	v.Repository = repository
 	v.File = file
 	v.Tag = tag
+	v.Platform = platform
+
+	return v
+}
+
+// NewGetArtifactMetadataPayload builds a oci service get-artifact-metadata
+// endpoint payload.
+func NewGetArtifactMetadataPayload(repository string, tag string, platform *string) *oci.GetArtifactMetadataPayload {
+	v := &oci.GetArtifactMetadataPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+
+	return v
+}
+
+// NewGetFileChecksumPayload builds a oci service get-file-checksum endpoint
+// payload.
+func NewGetFileChecksumPayload(repository string, tag string, file *string, fileRegex *string, platform *string, algorithm string) *oci.GetFileChecksumPayload {
+	v := &oci.GetFileChecksumPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.File = file
+	v.FileRegex = fileRegex
+	v.Platform = platform
+	v.Algorithm = algorithm
+
+	return v
+}
+
+// NewDownloadChecksumsPayload builds a oci service download-checksums endpoint
+// payload.
+func NewDownloadChecksumsPayload(repository string, tag string, platform *string, algorithm string) *oci.DownloadChecksumsPayload {
+	v := &oci.DownloadChecksumsPayload{}
+	v.Repository = repository
+	v.Tag = tag
+	v.Platform = platform
+	v.Algorithm = algorithm
 
 	return v
 }
@@ -0,0 +1,129 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"fmt"
+	"strconv"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildDownloadObjectPayload builds the payload for the s3 download-object
+// endpoint from CLI flags.
+func BuildDownloadObjectPayload(s3DownloadObjectEndpoint string, s3DownloadObjectBucket string, s3DownloadObjectKey string) (*s3.DownloadObjectPayload, error) {
+	var endpoint string
+	{
+		endpoint = s3DownloadObjectEndpoint
+	}
+	var bucket string
+	{
+		bucket = s3DownloadObjectBucket
+	}
+	var key string
+	{
+		key = s3DownloadObjectKey
+	}
+	v := &s3.DownloadObjectPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Key = key
+
+	return v, nil
+}
+
+// BuildHeadObjectPayload builds the payload for the s3 head-object endpoint
+// from CLI flags.
+func BuildHeadObjectPayload(s3HeadObjectEndpoint string, s3HeadObjectBucket string, s3HeadObjectKey string) (*s3.HeadObjectPayload, error) {
+	var endpoint string
+	{
+		endpoint = s3HeadObjectEndpoint
+	}
+	var bucket string
+	{
+		bucket = s3HeadObjectBucket
+	}
+	var key string
+	{
+		key = s3HeadObjectKey
+	}
+	v := &s3.HeadObjectPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Key = key
+
+	return v, nil
+}
+
+// BuildListObjectsPayload builds the payload for the s3 list-objects endpoint
+// from CLI flags.
+func BuildListObjectsPayload(s3ListObjectsEndpoint string, s3ListObjectsBucket string, s3ListObjectsPrefix string, s3ListObjectsDelimiter string, s3ListObjectsMaxKeys string, s3ListObjectsPageToken string) (*s3.ListObjectsPayload, error) {
+	var err error
+	var endpoint string
+	{
+		endpoint = s3ListObjectsEndpoint
+	}
+	var bucket string
+	{
+		bucket = s3ListObjectsBucket
+	}
+	var prefix *string
+	{
+		if s3ListObjectsPrefix != "" {
+			prefix = &s3ListObjectsPrefix
+		}
+	}
+	var delimiter string
+	{
+		if s3ListObjectsDelimiter != "" {
+			delimiter = s3ListObjectsDelimiter
+			if !(delimiter == "" || delimiter == "/") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("delimiter", delimiter, []any{"", "/"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var maxKeys int
+	{
+		if s3ListObjectsMaxKeys != "" {
+			var v int64
+			v, err = strconv.ParseInt(s3ListObjectsMaxKeys, 10, strconv.IntSize)
+			maxKeys = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for maxKeys, must be INT")
+			}
+			if maxKeys < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1, true))
+			}
+			if maxKeys > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageToken *string
+	{
+		if s3ListObjectsPageToken != "" {
+			pageToken = &s3ListObjectsPageToken
+		}
+	}
+	v := &s3.ListObjectsPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Prefix = prefix
+	v.Delimiter = delimiter
+	v.MaxKeys = maxKeys
+	v.PageToken = pageToken
+
+	return v, nil
+}
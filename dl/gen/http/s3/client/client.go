@@ -0,0 +1,129 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 client HTTP transport
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the s3 service endpoint HTTP clients.
+type Client struct {
+	// DownloadObject Doer is the HTTP client used to make requests to the
+	// download-object endpoint.
+	DownloadObjectDoer goahttp.Doer
+
+	// HeadObject Doer is the HTTP client used to make requests to the head-object
+	// endpoint.
+	HeadObjectDoer goahttp.Doer
+
+	// ListObjects Doer is the HTTP client used to make requests to the
+	// list-objects endpoint.
+	ListObjectsDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the s3 service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		DownloadObjectDoer:  doer,
+		HeadObjectDoer:      doer,
+		ListObjectsDoer:     doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// DownloadObject returns an endpoint that makes HTTP requests to the s3
+// service download-object server.
+func (c *Client) DownloadObject() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDownloadObjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadObjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadObjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("s3", "download-object", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &s3.DownloadObjectResponseData{Result: res.(*s3.DownloadObjectResult), Body: resp.Body}, nil
+	}
+}
+
+// HeadObject returns an endpoint that makes HTTP requests to the s3 service
+// head-object server.
+func (c *Client) HeadObject() goa.Endpoint {
+	var (
+		decodeResponse = DecodeHeadObjectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildHeadObjectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.HeadObjectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("s3", "head-object", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListObjects returns an endpoint that makes HTTP requests to the s3 service
+// list-objects server.
+func (c *Client) ListObjects() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListObjectsRequest(c.encoder)
+		decodeResponse = DecodeListObjectsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListObjectsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListObjectsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("s3", "list-objects", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,283 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildDownloadObjectRequest instantiates a HTTP request object with method
+// and path set to call the "s3" service "download-object" endpoint
+func (c *Client) BuildDownloadObjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		endpoint string
+		bucket   string
+		key      string
+	)
+	{
+		p, ok := v.(*s3.DownloadObjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("s3", "download-object", "*s3.DownloadObjectPayload", v)
+		}
+		endpoint = p.Endpoint
+		bucket = p.Bucket
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadObjectS3Path(endpoint, bucket, key)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("s3", "download-object", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDownloadObjectResponse returns a decoder for responses returned by the
+// s3 download-object endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeDownloadObjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				length             int64
+				contentDisposition string
+				err                error
+			)
+			{
+				lengthRaw := resp.Header.Get("Content-Length")
+				if lengthRaw == "" {
+					return nil, goahttp.ErrValidationError("s3", "download-object", goa.MissingFieldError("length", "header"))
+				}
+				v, err2 := strconv.ParseInt(lengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("length", lengthRaw, "integer"))
+				}
+				length = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("s3", "download-object", err)
+			}
+			res := NewDownloadObjectResultOK(length, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("s3", "download-object", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildHeadObjectRequest instantiates a HTTP request object with method and
+// path set to call the "s3" service "head-object" endpoint
+func (c *Client) BuildHeadObjectRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		endpoint string
+		bucket   string
+		key      string
+	)
+	{
+		p, ok := v.(*s3.HeadObjectPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("s3", "head-object", "*s3.HeadObjectPayload", v)
+		}
+		endpoint = p.Endpoint
+		bucket = p.Bucket
+		key = p.Key
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: HeadObjectS3Path(endpoint, bucket, key)}
+	req, err := http.NewRequest("HEAD", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("s3", "head-object", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeHeadObjectResponse returns a decoder for responses returned by the s3
+// head-object endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+func DecodeHeadObjectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				length             int64
+				contentDisposition string
+				err                error
+			)
+			{
+				lengthRaw := resp.Header.Get("Content-Length")
+				if lengthRaw == "" {
+					return nil, goahttp.ErrValidationError("s3", "head-object", goa.MissingFieldError("length", "header"))
+				}
+				v, err2 := strconv.ParseInt(lengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("length", lengthRaw, "integer"))
+				}
+				length = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("contentDisposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("s3", "head-object", err)
+			}
+			res := NewHeadObjectResultOK(length, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("s3", "head-object", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListObjectsRequest instantiates a HTTP request object with method and
+// path set to call the "s3" service "list-objects" endpoint
+func (c *Client) BuildListObjectsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		endpoint string
+		bucket   string
+	)
+	{
+		p, ok := v.(*s3.ListObjectsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("s3", "list-objects", "*s3.ListObjectsPayload", v)
+		}
+		endpoint = p.Endpoint
+		bucket = p.Bucket
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListObjectsS3Path(endpoint, bucket)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("s3", "list-objects", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListObjectsRequest returns an encoder for requests sent to the s3
+// list-objects server.
+func EncodeListObjectsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*s3.ListObjectsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("s3", "list-objects", "*s3.ListObjectsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Prefix != nil {
+			values.Add("prefix", *p.Prefix)
+		}
+		values.Add("delimiter", p.Delimiter)
+		values.Add("max_keys", fmt.Sprintf("%v", p.MaxKeys))
+		if p.PageToken != nil {
+			values.Add("page_token", *p.PageToken)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListObjectsResponse returns a decoder for responses returned by the s3
+// list-objects endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+func DecodeListObjectsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListObjectsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("s3", "list-objects", err)
+			}
+			err = ValidateListObjectsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("s3", "list-objects", err)
+			}
+			res := NewListObjectsObjectListingOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("s3", "list-objects", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalObjectInfoResponseBodyToS3ObjectInfo builds a value of type
+// *s3.ObjectInfo from a value of type *ObjectInfoResponseBody.
+func unmarshalObjectInfoResponseBodyToS3ObjectInfo(v *ObjectInfoResponseBody) *s3.ObjectInfo {
+	res := &s3.ObjectInfo{
+		Key:          *v.Key,
+		Size:         *v.Size,
+		LastModified: v.LastModified,
+	}
+
+	return res
+}
@@ -0,0 +1,27 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// HTTP request path constructors for the s3 service.
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// DownloadObjectS3Path returns the URL path to the s3 service download-object HTTP endpoint.
+func DownloadObjectS3Path(endpoint string, bucket string, key string) string {
+	return fmt.Sprintf("/s3/%v/%v/%v", endpoint, bucket, key)
+}
+
+// HeadObjectS3Path returns the URL path to the s3 service head-object HTTP endpoint.
+func HeadObjectS3Path(endpoint string, bucket string, key string) string {
+	return fmt.Sprintf("/s3/%v/%v/%v", endpoint, bucket, key)
+}
+
+// ListObjectsS3Path returns the URL path to the s3 service list-objects HTTP endpoint.
+func ListObjectsS3Path(endpoint string, bucket string) string {
+	return fmt.Sprintf("/s3-list/%v/%v", endpoint, bucket)
+}
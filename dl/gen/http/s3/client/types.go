@@ -0,0 +1,106 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 HTTP client types
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ListObjectsResponseBody is the type of the "s3" service "list-objects"
+// endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string `form:"prefixes,omitempty" json:"prefixes,omitempty" xml:"prefixes,omitempty"`
+	// objects under the prefix
+	Objects []*ObjectInfoResponseBody `form:"objects,omitempty" json:"objects,omitempty" xml:"objects,omitempty"`
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string `form:"nextPageToken,omitempty" json:"nextPageToken,omitempty" xml:"nextPageToken,omitempty"`
+}
+
+// ObjectInfoResponseBody is used to define fields on response body types.
+type ObjectInfoResponseBody struct {
+	// object key
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// object size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// last modified time
+	LastModified *string `form:"lastModified,omitempty" json:"lastModified,omitempty" xml:"lastModified,omitempty"`
+}
+
+// NewDownloadObjectResultOK builds a "s3" service "download-object" endpoint
+// result from a HTTP "OK" response.
+func NewDownloadObjectResultOK(length int64, contentDisposition string) *s3.DownloadObjectResult {
+	v := &s3.DownloadObjectResult{}
+	v.Length = length
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewHeadObjectResultOK builds a "s3" service "head-object" endpoint result
+// from a HTTP "OK" response.
+func NewHeadObjectResultOK(length int64, contentDisposition string) *s3.HeadObjectResult {
+	v := &s3.HeadObjectResult{}
+	v.Length = length
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewListObjectsObjectListingOK builds a "s3" service "list-objects" endpoint
+// result from a HTTP "OK" response.
+func NewListObjectsObjectListingOK(body *ListObjectsResponseBody) *s3.ObjectListing {
+	v := &s3.ObjectListing{
+		NextPageToken: body.NextPageToken,
+	}
+	v.Prefixes = make([]string, len(body.Prefixes))
+	for i, val := range body.Prefixes {
+		v.Prefixes[i] = val
+	}
+	v.Objects = make([]*s3.ObjectInfo, len(body.Objects))
+	for i, val := range body.Objects {
+		v.Objects[i] = unmarshalObjectInfoResponseBodyToS3ObjectInfo(val)
+	}
+
+	return v
+}
+
+// ValidateListObjectsResponseBody runs the validations defined on
+// List-ObjectsResponseBody
+func ValidateListObjectsResponseBody(body *ListObjectsResponseBody) (err error) {
+	if body.Prefixes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prefixes", "body"))
+	}
+	if body.Objects == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("objects", "body"))
+	}
+	for _, e := range body.Objects {
+		if e != nil {
+			if err2 := ValidateObjectInfoResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateObjectInfoResponseBody runs the validations defined on
+// ObjectInfoResponseBody
+func ValidateObjectInfoResponseBody(body *ObjectInfoResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.LastModified != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastModified", *body.LastModified, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,177 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeDownloadObjectResponse returns an encoder for responses returned by
+// the s3 download-object endpoint.
+func EncodeDownloadObjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*s3.DownloadObjectResult)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/octet-stream")
+		{
+			val := res.Length
+			lengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", lengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadObjectRequest returns a decoder for requests sent to the s3
+// download-object endpoint.
+func DecodeDownloadObjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*s3.DownloadObjectPayload, error) {
+	return func(r *http.Request) (*s3.DownloadObjectPayload, error) {
+		var (
+			endpoint string
+			bucket   string
+			key      string
+
+			params = mux.Vars(r)
+		)
+		endpoint = params["endpoint"]
+		bucket = params["bucket"]
+		key = params["key"]
+		payload := NewDownloadObjectPayload(endpoint, bucket, key)
+
+		return payload, nil
+	}
+}
+
+// EncodeHeadObjectResponse returns an encoder for responses returned by the s3
+// head-object endpoint.
+func EncodeHeadObjectResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*s3.HeadObjectResult)
+		{
+			val := res.Length
+			lengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", lengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeHeadObjectRequest returns a decoder for requests sent to the s3
+// head-object endpoint.
+func DecodeHeadObjectRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*s3.HeadObjectPayload, error) {
+	return func(r *http.Request) (*s3.HeadObjectPayload, error) {
+		var (
+			endpoint string
+			bucket   string
+			key      string
+
+			params = mux.Vars(r)
+		)
+		endpoint = params["endpoint"]
+		bucket = params["bucket"]
+		key = params["key"]
+		payload := NewHeadObjectPayload(endpoint, bucket, key)
+
+		return payload, nil
+	}
+}
+
+// EncodeListObjectsResponse returns an encoder for responses returned by the
+// s3 list-objects endpoint.
+func EncodeListObjectsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*s3.ObjectListing)
+		enc := encoder(ctx, w)
+		body := NewListObjectsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListObjectsRequest returns a decoder for requests sent to the s3
+// list-objects endpoint.
+func DecodeListObjectsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*s3.ListObjectsPayload, error) {
+	return func(r *http.Request) (*s3.ListObjectsPayload, error) {
+		var (
+			endpoint  string
+			bucket    string
+			prefix    *string
+			delimiter string
+			maxKeys   int
+			pageToken *string
+			err       error
+
+			params = mux.Vars(r)
+		)
+		endpoint = params["endpoint"]
+		bucket = params["bucket"]
+		qp := r.URL.Query()
+		prefixRaw := qp.Get("prefix")
+		if prefixRaw != "" {
+			prefix = &prefixRaw
+		}
+		delimiterRaw := qp.Get("delimiter")
+		if delimiterRaw != "" {
+			delimiter = delimiterRaw
+		} else {
+			delimiter = "/"
+		}
+		if !(delimiter == "" || delimiter == "/") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("delimiter", delimiter, []any{"", "/"}))
+		}
+		{
+			maxKeysRaw := qp.Get("max_keys")
+			if maxKeysRaw == "" {
+				maxKeys = 1000
+			} else {
+				v, err2 := strconv.ParseInt(maxKeysRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("max_keys", maxKeysRaw, "integer"))
+				}
+				maxKeys = int(v)
+			}
+		}
+		if maxKeys < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1, true))
+		}
+		if maxKeys > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("max_keys", maxKeys, 1000, false))
+		}
+		pageTokenRaw := qp.Get("page_token")
+		if pageTokenRaw != "" {
+			pageToken = &pageTokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListObjectsPayload(endpoint, bucket, prefix, delimiter, maxKeys, pageToken)
+
+		return payload, nil
+	}
+}
+
+// marshalS3ObjectInfoToObjectInfoResponseBody builds a value of type
+// *ObjectInfoResponseBody from a value of type *s3.ObjectInfo.
+func marshalS3ObjectInfoToObjectInfoResponseBody(v *s3.ObjectInfo) *ObjectInfoResponseBody {
+	res := &ObjectInfoResponseBody{
+		Key:          v.Key,
+		Size:         v.Size,
+		LastModified: v.LastModified,
+	}
+
+	return res
+}
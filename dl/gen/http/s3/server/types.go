@@ -0,0 +1,92 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 HTTP server types
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package server
+
+import (
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+)
+
+// ListObjectsResponseBody is the type of the "s3" service "list-objects"
+// endpoint HTTP response body.
+type ListObjectsResponseBody struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string `form:"prefixes" json:"prefixes" xml:"prefixes"`
+	// objects under the prefix
+	Objects []*ObjectInfoResponseBody `form:"objects" json:"objects" xml:"objects"`
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string `form:"nextPageToken,omitempty" json:"nextPageToken,omitempty" xml:"nextPageToken,omitempty"`
+}
+
+// ObjectInfoResponseBody is used to define fields on response body types.
+type ObjectInfoResponseBody struct {
+	// object key
+	Key string `form:"key" json:"key" xml:"key"`
+	// object size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// last modified time
+	LastModified *string `form:"lastModified,omitempty" json:"lastModified,omitempty" xml:"lastModified,omitempty"`
+}
+
+// NewListObjectsResponseBody builds the HTTP response body from the result of
+// the "list-objects" endpoint of the "s3" service.
+func NewListObjectsResponseBody(res *s3.ObjectListing) *ListObjectsResponseBody {
+	body := &ListObjectsResponseBody{
+		NextPageToken: res.NextPageToken,
+	}
+	if res.Prefixes != nil {
+		body.Prefixes = make([]string, len(res.Prefixes))
+		for i, val := range res.Prefixes {
+			body.Prefixes[i] = val
+		}
+	} else {
+		body.Prefixes = []string{}
+	}
+	if res.Objects != nil {
+		body.Objects = make([]*ObjectInfoResponseBody, len(res.Objects))
+		for i, val := range res.Objects {
+			body.Objects[i] = marshalS3ObjectInfoToObjectInfoResponseBody(val)
+		}
+	} else {
+		body.Objects = []*ObjectInfoResponseBody{}
+	}
+	return body
+}
+
+// NewDownloadObjectPayload builds a s3 service download-object endpoint
+// payload.
+func NewDownloadObjectPayload(endpoint string, bucket string, key string) *s3.DownloadObjectPayload {
+	v := &s3.DownloadObjectPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Key = key
+
+	return v
+}
+
+// NewHeadObjectPayload builds a s3 service head-object endpoint payload.
+func NewHeadObjectPayload(endpoint string, bucket string, key string) *s3.HeadObjectPayload {
+	v := &s3.HeadObjectPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Key = key
+
+	return v
+}
+
+// NewListObjectsPayload builds a s3 service list-objects endpoint payload.
+func NewListObjectsPayload(endpoint string, bucket string, prefix *string, delimiter string, maxKeys int, pageToken *string) *s3.ListObjectsPayload {
+	v := &s3.ListObjectsPayload{}
+	v.Endpoint = endpoint
+	v.Bucket = bucket
+	v.Prefix = prefix
+	v.Delimiter = delimiter
+	v.MaxKeys = maxKeys
+	v.PageToken = pageToken
+
+	return v
+}
@@ -0,0 +1,40 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+)
+
+// BuildSignURLPayload builds the payload for the sign sign-url endpoint from
+// CLI flags.
+func BuildSignURLPayload(signSignURLBody string) (*sign.SignURLPayload, error) {
+	var err error
+	var body SignURLRequestBody
+	{
+		err = json.Unmarshal([]byte(signSignURLBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"path\": \"/oci-file/hub.pingcap.net/pingcap/tidb/package?tag=v8.5.0_linux_amd64\\u0026file=tidb-v8.5.0-linux-amd64.tar.gz\",\n      \"ttl\": \"24h\"\n   }'")
+		}
+	}
+	v := &sign.SignURLPayload{
+		Path: body.Path,
+		TTL:  body.TTL,
+	}
+	{
+		var zero string
+		if v.TTL == zero {
+			v.TTL = "1h"
+		}
+	}
+
+	return v, nil
+}
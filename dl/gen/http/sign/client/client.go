@@ -0,0 +1,75 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign client HTTP transport
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the sign service endpoint HTTP clients.
+type Client struct {
+	// SignURL Doer is the HTTP client used to make requests to the sign-url
+	// endpoint.
+	SignURLDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the sign service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		SignURLDoer:         doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// SignURL returns an endpoint that makes HTTP requests to the sign service
+// sign-url server.
+func (c *Client) SignURL() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSignURLRequest(c.encoder)
+		decodeResponse = DecodeSignURLResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSignURLRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SignURLDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("sign", "sign-url", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildSignURLRequest instantiates a HTTP request object with method and path
+// set to call the "sign" service "sign-url" endpoint
+func (c *Client) BuildSignURLRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SignURLSignPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("sign", "sign-url", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSignURLRequest returns an encoder for requests sent to the sign
+// sign-url server.
+func EncodeSignURLRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*sign.SignURLPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("sign", "sign-url", "*sign.SignURLPayload", v)
+		}
+		body := NewSignURLRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("sign", "sign-url", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSignURLResponse returns a decoder for responses returned by the sign
+// sign-url endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeSignURLResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SignURLResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("sign", "sign-url", err)
+			}
+			err = ValidateSignURLResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("sign", "sign-url", err)
+			}
+			res := NewSignURLResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("sign", "sign-url", resp.StatusCode, string(body))
+		}
+	}
+}
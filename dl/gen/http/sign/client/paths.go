@@ -0,0 +1,13 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// HTTP request path constructors for the sign service.
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+// SignURLSignPath returns the URL path to the sign service sign-url HTTP endpoint.
+func SignURLSignPath() string {
+	return "/sign"
+}
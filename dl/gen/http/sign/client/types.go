@@ -0,0 +1,73 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign HTTP client types
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package client
+
+import (
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SignURLRequestBody is the type of the "sign" service "sign-url" endpoint
+// HTTP request body.
+type SignURLRequestBody struct {
+	// path and query of the download to sign
+	Path string `form:"path" json:"path" xml:"path"`
+	// lifetime of the link, in Go duration format
+	TTL string `form:"ttl" json:"ttl" xml:"ttl"`
+}
+
+// SignURLResponseBody is the type of the "sign" service "sign-url" endpoint
+// HTTP response body.
+type SignURLResponseBody struct {
+	// signed download link
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// expiry time of the link
+	ExpiresAt *string `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+}
+
+// NewSignURLRequestBody builds the HTTP request body from the payload of the
+// "sign-url" endpoint of the "sign" service.
+func NewSignURLRequestBody(p *sign.SignURLPayload) *SignURLRequestBody {
+	body := &SignURLRequestBody{
+		Path: p.Path,
+		TTL:  p.TTL,
+	}
+	{
+		var zero string
+		if body.TTL == zero {
+			body.TTL = "1h"
+		}
+	}
+	return body
+}
+
+// NewSignURLResultOK builds a "sign" service "sign-url" endpoint result from a
+// HTTP "OK" response.
+func NewSignURLResultOK(body *SignURLResponseBody) *sign.SignURLResult {
+	v := &sign.SignURLResult{
+		URL:       *body.URL,
+		ExpiresAt: *body.ExpiresAt,
+	}
+
+	return v
+}
+
+// ValidateSignURLResponseBody runs the validations defined on
+// Sign-UrlResponseBody
+func ValidateSignURLResponseBody(body *SignURLResponseBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expiresAt", "body"))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expiresAt", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -0,0 +1,60 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeSignURLResponse returns an encoder for responses returned by the sign
+// sign-url endpoint.
+func EncodeSignURLResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*sign.SignURLResult)
+		enc := encoder(ctx, w)
+		body := NewSignURLResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSignURLRequest returns a decoder for requests sent to the sign
+// sign-url endpoint.
+func DecodeSignURLRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*sign.SignURLPayload, error) {
+	return func(r *http.Request) (*sign.SignURLPayload, error) {
+		var (
+			body SignURLRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSignURLRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSignURLPayload(&body)
+
+		return payload, nil
+	}
+}
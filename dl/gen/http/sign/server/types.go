@@ -0,0 +1,65 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign HTTP server types
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package server
+
+import (
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// SignURLRequestBody is the type of the "sign" service "sign-url" endpoint
+// HTTP request body.
+type SignURLRequestBody struct {
+	// path and query of the download to sign
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// lifetime of the link, in Go duration format
+	TTL *string `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+}
+
+// SignURLResponseBody is the type of the "sign" service "sign-url" endpoint
+// HTTP response body.
+type SignURLResponseBody struct {
+	// signed download link
+	URL string `form:"url" json:"url" xml:"url"`
+	// expiry time of the link
+	ExpiresAt string `form:"expiresAt" json:"expiresAt" xml:"expiresAt"`
+}
+
+// NewSignURLResponseBody builds the HTTP response body from the result of the
+// "sign-url" endpoint of the "sign" service.
+func NewSignURLResponseBody(res *sign.SignURLResult) *SignURLResponseBody {
+	body := &SignURLResponseBody{
+		URL:       res.URL,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return body
+}
+
+// NewSignURLPayload builds a sign service sign-url endpoint payload.
+func NewSignURLPayload(body *SignURLRequestBody) *sign.SignURLPayload {
+	v := &sign.SignURLPayload{
+		Path: *body.Path,
+	}
+	if body.TTL != nil {
+		v.TTL = *body.TTL
+	}
+	if body.TTL == nil {
+		v.TTL = "1h"
+	}
+
+	return v
+}
+
+// ValidateSignURLRequestBody runs the validations defined on
+// Sign-UrlRequestBody
+func ValidateSignURLRequestBody(body *SignURLRequestBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	return
+}
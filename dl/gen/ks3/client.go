@@ -16,15 +16,19 @@ import (
 
 // Client is the "ks3" service client.
 type Client struct {
-	DownloadObjectEndpoint goa.Endpoint
-	HeadObjectEndpoint     goa.Endpoint
+	DownloadObjectEndpoint    goa.Endpoint
+	HeadObjectEndpoint        goa.Endpoint
+	GetObjectChecksumEndpoint goa.Endpoint
+	ListObjectsEndpoint       goa.Endpoint
 }
 
 // NewClient initializes a "ks3" service client given the endpoints.
-func NewClient(downloadObject, headObject goa.Endpoint) *Client {
+func NewClient(downloadObject, headObject, getObjectChecksum, listObjects goa.Endpoint) *Client {
 	return &Client{
-		DownloadObjectEndpoint: downloadObject,
-		HeadObjectEndpoint:     headObject,
+		DownloadObjectEndpoint:    downloadObject,
+		HeadObjectEndpoint:        headObject,
+		GetObjectChecksumEndpoint: getObjectChecksum,
+		ListObjectsEndpoint:       listObjects,
 	}
 }
 
@@ -56,3 +60,32 @@ func (c *Client) HeadObject(ctx context.Context, p *HeadObjectPayload) (res *Hea
 	}
 	return ires.(*HeadObjectResult), nil
 }
+
+// GetObjectChecksum calls the "get-object-checksum" endpoint of the "ks3"
+// service.
+// GetObjectChecksum may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate file
+//   - "internal_error" (type *goa.ServiceError): Fault while computing checksum.
+//   - error: internal error
+func (c *Client) GetObjectChecksum(ctx context.Context, p *GetObjectChecksumPayload) (res *FileChecksum, err error) {
+	var ires any
+	ires, err = c.GetObjectChecksumEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*FileChecksum), nil
+}
+
+// ListObjects calls the "list-objects" endpoint of the "ks3" service.
+// ListObjects may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not list the bucket
+//   - "internal_error" (type *goa.ServiceError): Processing error
+//   - error: internal error
+func (c *Client) ListObjects(ctx context.Context, p *ListObjectsPayload) (res *ObjectListing, err error) {
+	var ires any
+	ires, err = c.ListObjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ObjectListing), nil
+}
@@ -24,6 +24,10 @@ type Service interface {
 	DownloadObject(context.Context, *DownloadObjectPayload) (res *DownloadObjectResult, body io.ReadCloser, err error)
 	// HeadObject implements head-object.
 	HeadObject(context.Context, *HeadObjectPayload) (res *HeadObjectResult, err error)
+	// GetObjectChecksum implements get-object-checksum.
+	GetObjectChecksum(context.Context, *GetObjectChecksumPayload) (res *FileChecksum, err error)
+	// ListObjects implements list-objects.
+	ListObjects(context.Context, *ListObjectsPayload) (res *ObjectListing, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -40,7 +44,7 @@ const ServiceName = "ks3"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"download-object", "head-object"}
+var MethodNames = [4]string{"download-object", "head-object", "get-object-checksum", "list-objects"}
 
 // DownloadObjectPayload is the payload type of the ks3 service download-object
 // method.
@@ -60,6 +64,30 @@ type DownloadObjectResult struct {
 	ContentDisposition string
 }
 
+// FileChecksum is the result type of the ks3 service get-object-checksum
+// method.
+type FileChecksum struct {
+	// file name or object key
+	File string
+	// checksum algorithm
+	Algorithm string
+	// checksum in hex
+	Checksum string
+	// content size in bytes
+	Size int64
+}
+
+// GetObjectChecksumPayload is the payload type of the ks3 service
+// get-object-checksum method.
+type GetObjectChecksumPayload struct {
+	// bucket name
+	Bucket string
+	// object key
+	Key string
+	// checksum algorithm
+	Algorithm string
+}
+
 // HeadObjectPayload is the payload type of the ks3 service head-object method.
 type HeadObjectPayload struct {
 	// bucket name
@@ -76,6 +104,41 @@ type HeadObjectResult struct {
 	ContentDisposition string
 }
 
+// ListObjectsPayload is the payload type of the ks3 service list-objects
+// method.
+type ListObjectsPayload struct {
+	// bucket name
+	Bucket string
+	// object key prefix
+	Prefix *string
+	// delimiter to roll up the keys into common prefixes
+	Delimiter string
+	// max number of objects and prefixes in a page
+	MaxKeys int
+	// token of the page to fetch
+	PageToken *string
+}
+
+// Object in a bucket
+type ObjectInfo struct {
+	// object key
+	Key string
+	// object size in bytes
+	Size int64
+	// last modified time
+	LastModified *string
+}
+
+// ObjectListing is the result type of the ks3 service list-objects method.
+type ObjectListing struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string
+	// objects under the prefix
+	Objects []*ObjectInfo
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string
+}
+
 // MakeInvalidFilePath builds a goa.ServiceError from an error.
 func MakeInvalidFilePath(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "invalid_file_path", false, false, false)
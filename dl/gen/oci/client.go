@@ -16,19 +16,31 @@ import (
 
 // Client is the "oci" service client.
 type Client struct {
-	ListFilesEndpoint          goa.Endpoint
-	DownloadFileEndpoint       goa.Endpoint
-	HeadFileEndpoint           goa.Endpoint
-	DownloadFileSha256Endpoint goa.Endpoint
+	ListFilesEndpoint            goa.Endpoint
+	DownloadFileEndpoint         goa.Endpoint
+	HeadFileEndpoint             goa.Endpoint
+	ListTarballEntriesEndpoint   goa.Endpoint
+	DownloadTarballEntryEndpoint goa.Endpoint
+	DownloadFilesBundleEndpoint  goa.Endpoint
+	DownloadFileSha256Endpoint   goa.Endpoint
+	GetArtifactMetadataEndpoint  goa.Endpoint
+	GetFileChecksumEndpoint      goa.Endpoint
+	DownloadChecksumsEndpoint    goa.Endpoint
 }
 
 // NewClient initializes a "oci" service client given the endpoints.
-func NewClient(listFiles, downloadFile, headFile, downloadFileSha256 goa.Endpoint) *Client {
+func NewClient(listFiles, downloadFile, headFile, listTarballEntries, downloadTarballEntry, downloadFilesBundle, downloadFileSha256, getArtifactMetadata, getFileChecksum, downloadChecksums goa.Endpoint) *Client {
 	return &Client{
-		ListFilesEndpoint:          listFiles,
-		DownloadFileEndpoint:       downloadFile,
-		HeadFileEndpoint:           headFile,
-		DownloadFileSha256Endpoint: downloadFileSha256,
+		ListFilesEndpoint:            listFiles,
+		DownloadFileEndpoint:         downloadFile,
+		HeadFileEndpoint:             headFile,
+		ListTarballEntriesEndpoint:   listTarballEntries,
+		DownloadTarballEntryEndpoint: downloadTarballEntry,
+		DownloadFilesBundleEndpoint:  downloadFilesBundle,
+		DownloadFileSha256Endpoint:   downloadFileSha256,
+		GetArtifactMetadataEndpoint:  getArtifactMetadata,
+		GetFileChecksumEndpoint:      getFileChecksum,
+		DownloadChecksumsEndpoint:    downloadChecksums,
 	}
 }
 
@@ -71,6 +83,53 @@ func (c *Client) HeadFile(ctx context.Context, p *HeadFilePayload) (res *HeadFil
 	return ires.(*HeadFileResult), nil
 }
 
+// ListTarballEntries calls the "list-tarball-entries" endpoint of the "oci"
+// service.
+// ListTarballEntries may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate tarball
+//   - "internal_error" (type *goa.ServiceError): Fault while reading tarball.
+//   - error: internal error
+func (c *Client) ListTarballEntries(ctx context.Context, p *ListTarballEntriesPayload) (res []string, err error) {
+	var ires any
+	ires, err = c.ListTarballEntriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]string), nil
+}
+
+// DownloadTarballEntry calls the "download-tarball-entry" endpoint of the
+// "oci" service.
+// DownloadTarballEntry may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate tarball or entry for download
+//   - "internal_error" (type *goa.ServiceError): Fault while processing download.
+//   - error: internal error
+func (c *Client) DownloadTarballEntry(ctx context.Context, p *DownloadTarballEntryPayload) (res *DownloadTarballEntryResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadTarballEntryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadTarballEntryResponseData)
+	return o.Result, o.Body, nil
+}
+
+// DownloadFilesBundle calls the "download-files-bundle" endpoint of the "oci"
+// service.
+// DownloadFilesBundle may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate files for download
+//   - "internal_error" (type *goa.ServiceError): Fault while processing download.
+//   - error: internal error
+func (c *Client) DownloadFilesBundle(ctx context.Context, p *DownloadFilesBundlePayload) (res *DownloadFilesBundleResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadFilesBundleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadFilesBundleResponseData)
+	return o.Result, o.Body, nil
+}
+
 // DownloadFileSha256 calls the "download-file-sha256" endpoint of the "oci"
 // service.
 // DownloadFileSha256 may return the following errors:
@@ -86,3 +145,48 @@ func (c *Client) DownloadFileSha256(ctx context.Context, p *DownloadFileSha256Pa
 	o := ires.(*DownloadFileSha256ResponseData)
 	return o.Result, o.Body, nil
 }
+
+// GetArtifactMetadata calls the "get-artifact-metadata" endpoint of the "oci"
+// service.
+// GetArtifactMetadata may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate artifact
+//   - "internal_error" (type *goa.ServiceError): Fault while fetching metadata.
+//   - error: internal error
+func (c *Client) GetArtifactMetadata(ctx context.Context, p *GetArtifactMetadataPayload) (res *ArtifactMetadata, err error) {
+	var ires any
+	ires, err = c.GetArtifactMetadataEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ArtifactMetadata), nil
+}
+
+// GetFileChecksum calls the "get-file-checksum" endpoint of the "oci" service.
+// GetFileChecksum may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate file
+//   - "internal_error" (type *goa.ServiceError): Fault while computing checksum.
+//   - error: internal error
+func (c *Client) GetFileChecksum(ctx context.Context, p *GetFileChecksumPayload) (res *FileChecksum, err error) {
+	var ires any
+	ires, err = c.GetFileChecksumEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*FileChecksum), nil
+}
+
+// DownloadChecksums calls the "download-checksums" endpoint of the "oci"
+// service.
+// DownloadChecksums may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate artifact
+//   - "internal_error" (type *goa.ServiceError): Fault while computing checksums.
+//   - error: internal error
+func (c *Client) DownloadChecksums(ctx context.Context, p *DownloadChecksumsPayload) (res *DownloadChecksumsResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadChecksumsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadChecksumsResponseData)
+	return o.Result, o.Body, nil
+}
@@ -16,10 +16,16 @@ import (
 
 // Endpoints wraps the "oci" service endpoints.
 type Endpoints struct {
-	ListFiles          goa.Endpoint
-	DownloadFile       goa.Endpoint
-	HeadFile           goa.Endpoint
-	DownloadFileSha256 goa.Endpoint
+	ListFiles            goa.Endpoint
+	DownloadFile         goa.Endpoint
+	HeadFile             goa.Endpoint
+	ListTarballEntries   goa.Endpoint
+	DownloadTarballEntry goa.Endpoint
+	DownloadFilesBundle  goa.Endpoint
+	DownloadFileSha256   goa.Endpoint
+	GetArtifactMetadata  goa.Endpoint
+	GetFileChecksum      goa.Endpoint
+	DownloadChecksums    goa.Endpoint
 }
 
 // DownloadFileResponseData holds both the result and the HTTP response body
@@ -31,6 +37,24 @@ type DownloadFileResponseData struct {
 	Body io.ReadCloser
 }
 
+// DownloadTarballEntryResponseData holds both the result and the HTTP response
+// body reader of the "download-tarball-entry" method.
+type DownloadTarballEntryResponseData struct {
+	// Result is the method result.
+	Result *DownloadTarballEntryResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// DownloadFilesBundleResponseData holds both the result and the HTTP response
+// body reader of the "download-files-bundle" method.
+type DownloadFilesBundleResponseData struct {
+	// Result is the method result.
+	Result *DownloadFilesBundleResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
 // DownloadFileSha256ResponseData holds both the result and the HTTP response
 // body reader of the "download-file-sha256" method.
 type DownloadFileSha256ResponseData struct {
@@ -40,13 +64,28 @@ type DownloadFileSha256ResponseData struct {
 	Body io.ReadCloser
 }
 
+// DownloadChecksumsResponseData holds both the result and the HTTP response
+// body reader of the "download-checksums" method.
+type DownloadChecksumsResponseData struct {
+	// Result is the method result.
+	Result *DownloadChecksumsResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
 // NewEndpoints wraps the methods of the "oci" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		ListFiles:          NewListFilesEndpoint(s),
-		DownloadFile:       NewDownloadFileEndpoint(s),
-		HeadFile:           NewHeadFileEndpoint(s),
-		DownloadFileSha256: NewDownloadFileSha256Endpoint(s),
+		ListFiles:            NewListFilesEndpoint(s),
+		DownloadFile:         NewDownloadFileEndpoint(s),
+		HeadFile:             NewHeadFileEndpoint(s),
+		ListTarballEntries:   NewListTarballEntriesEndpoint(s),
+		DownloadTarballEntry: NewDownloadTarballEntryEndpoint(s),
+		DownloadFilesBundle:  NewDownloadFilesBundleEndpoint(s),
+		DownloadFileSha256:   NewDownloadFileSha256Endpoint(s),
+		GetArtifactMetadata:  NewGetArtifactMetadataEndpoint(s),
+		GetFileChecksum:      NewGetFileChecksumEndpoint(s),
+		DownloadChecksums:    NewDownloadChecksumsEndpoint(s),
 	}
 }
 
@@ -55,7 +94,13 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.ListFiles = m(e.ListFiles)
 	e.DownloadFile = m(e.DownloadFile)
 	e.HeadFile = m(e.HeadFile)
+	e.ListTarballEntries = m(e.ListTarballEntries)
+	e.DownloadTarballEntry = m(e.DownloadTarballEntry)
+	e.DownloadFilesBundle = m(e.DownloadFilesBundle)
 	e.DownloadFileSha256 = m(e.DownloadFileSha256)
+	e.GetArtifactMetadata = m(e.GetArtifactMetadata)
+	e.GetFileChecksum = m(e.GetFileChecksum)
+	e.DownloadChecksums = m(e.DownloadChecksums)
 }
 
 // NewListFilesEndpoint returns an endpoint function that calls the method
@@ -89,6 +134,41 @@ func NewHeadFileEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewListTarballEntriesEndpoint returns an endpoint function that calls the
+// method "list-tarball-entries" of service "oci".
+func NewListTarballEntriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListTarballEntriesPayload)
+		return s.ListTarballEntries(ctx, p)
+	}
+}
+
+// NewDownloadTarballEntryEndpoint returns an endpoint function that calls the
+// method "download-tarball-entry" of service "oci".
+func NewDownloadTarballEntryEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadTarballEntryPayload)
+		res, body, err := s.DownloadTarballEntry(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadTarballEntryResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewDownloadFilesBundleEndpoint returns an endpoint function that calls the
+// method "download-files-bundle" of service "oci".
+func NewDownloadFilesBundleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadFilesBundlePayload)
+		res, body, err := s.DownloadFilesBundle(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadFilesBundleResponseData{Result: res, Body: body}, nil
+	}
+}
+
 // NewDownloadFileSha256Endpoint returns an endpoint function that calls the
 // method "download-file-sha256" of service "oci".
 func NewDownloadFileSha256Endpoint(s Service) goa.Endpoint {
@@ -101,3 +181,34 @@ func NewDownloadFileSha256Endpoint(s Service) goa.Endpoint {
 		return &DownloadFileSha256ResponseData{Result: res, Body: body}, nil
 	}
 }
+
+// NewGetArtifactMetadataEndpoint returns an endpoint function that calls the
+// method "get-artifact-metadata" of service "oci".
+func NewGetArtifactMetadataEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetArtifactMetadataPayload)
+		return s.GetArtifactMetadata(ctx, p)
+	}
+}
+
+// NewGetFileChecksumEndpoint returns an endpoint function that calls the
+// method "get-file-checksum" of service "oci".
+func NewGetFileChecksumEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetFileChecksumPayload)
+		return s.GetFileChecksum(ctx, p)
+	}
+}
+
+// NewDownloadChecksumsEndpoint returns an endpoint function that calls the
+// method "download-checksums" of service "oci".
+func NewDownloadChecksumsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadChecksumsPayload)
+		res, body, err := s.DownloadChecksums(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadChecksumsResponseData{Result: res, Body: body}, nil
+	}
+}
@@ -26,12 +26,36 @@ type Service interface {
 	DownloadFile(context.Context, *DownloadFilePayload) (res *DownloadFileResult, body io.ReadCloser, err error)
 	// HeadFile implements head-file.
 	HeadFile(context.Context, *HeadFilePayload) (res *HeadFileResult, err error)
+	// ListTarballEntries implements list-tarball-entries.
+	ListTarballEntries(context.Context, *ListTarballEntriesPayload) (res []string, err error)
+	// DownloadTarballEntry implements download-tarball-entry.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadTarballEntry(context.Context, *DownloadTarballEntryPayload) (res *DownloadTarballEntryResult, body io.ReadCloser, err error)
+	// DownloadFilesBundle implements download-files-bundle.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadFilesBundle(context.Context, *DownloadFilesBundlePayload) (res *DownloadFilesBundleResult, body io.ReadCloser, err error)
 	// DownloadFileSha256 implements download-file-sha256.
 
 	// If body implements [io.WriterTo], that implementation will be used instead.
 	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
 	// implementations.
 	DownloadFileSha256(context.Context, *DownloadFileSha256Payload) (res *DownloadFileSha256Result, body io.ReadCloser, err error)
+	// GetArtifactMetadata implements get-artifact-metadata.
+	GetArtifactMetadata(context.Context, *GetArtifactMetadataPayload) (res *ArtifactMetadata, err error)
+	// GetFileChecksum implements get-file-checksum.
+	GetFileChecksum(context.Context, *GetFileChecksumPayload) (res *FileChecksum, err error)
+	// DownloadChecksums implements download-checksums.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadChecksums(context.Context, *DownloadChecksumsPayload) (res *DownloadChecksumsResult, body io.ReadCloser, err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -48,7 +72,47 @@ const ServiceName = "oci"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [4]string{"list-files", "download-file", "head-file", "download-file-sha256"}
+var MethodNames = [10]string{"list-files", "download-file", "head-file", "list-tarball-entries", "download-tarball-entry", "download-files-bundle", "download-file-sha256", "get-artifact-metadata", "get-file-checksum", "download-checksums"}
+
+// ArtifactMetadata is the result type of the oci service get-artifact-metadata
+// method.
+type ArtifactMetadata struct {
+	// manifest digest
+	Digest string
+	// manifest media type
+	MediaType string
+	// artifact type
+	ArtifactType *string
+	// artifact config
+	Config map[string]any
+	// manifest annotations
+	Annotations map[string]string
+	// artifact layers
+	Layers []*LayerMetadata
+}
+
+// DownloadChecksumsPayload is the payload type of the oci service
+// download-checksums method.
+type DownloadChecksumsPayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+	// checksum algorithm
+	Algorithm string
+}
+
+// DownloadChecksumsResult is the result type of the oci service
+// download-checksums method.
+type DownloadChecksumsResult struct {
+	// Length is the downloaded content length in bytes.
+	Length int64
+	// Content-Disposition header for downloading
+	ContentDisposition string
+}
 
 // DownloadFilePayload is the payload type of the oci service download-file
 // method.
@@ -61,6 +125,9 @@ type DownloadFilePayload struct {
 	File *string
 	// file name regexp pattern
 	FileRegex *string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
 }
 
 // DownloadFileResult is the result type of the oci service download-file
@@ -81,6 +148,9 @@ type DownloadFileSha256Payload struct {
 	Tag string
 	// file name in OCI artifact
 	File string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
 }
 
 // DownloadFileSha256Result is the result type of the oci service
@@ -92,6 +162,102 @@ type DownloadFileSha256Result struct {
 	ContentDisposition string
 }
 
+// DownloadFilesBundlePayload is the payload type of the oci service
+// download-files-bundle method.
+type DownloadFilesBundlePayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// file names in OCI artifact
+	Files []string
+	// file name regexp pattern
+	FileRegex *string
+	// bundle archive format
+	Format string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+}
+
+// DownloadFilesBundleResult is the result type of the oci service
+// download-files-bundle method.
+type DownloadFilesBundleResult struct {
+	// Content-Type header of the bundle
+	ContentType string
+	// Content-Disposition header for downloading
+	ContentDisposition string
+}
+
+// DownloadTarballEntryPayload is the payload type of the oci service
+// download-tarball-entry method.
+type DownloadTarballEntryPayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// tarball file name in OCI artifact
+	File *string
+	// tarball file name regexp pattern
+	FileRegex *string
+	// entry path inside the tarball
+	Entry string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+}
+
+// DownloadTarballEntryResult is the result type of the oci service
+// download-tarball-entry method.
+type DownloadTarballEntryResult struct {
+	// Length is the downloaded content length in bytes.
+	Length int64
+	// Content-Disposition header for downloading
+	ContentDisposition string
+}
+
+// FileChecksum is the result type of the oci service get-file-checksum method.
+type FileChecksum struct {
+	// file name or object key
+	File string
+	// checksum algorithm
+	Algorithm string
+	// checksum in hex
+	Checksum string
+	// content size in bytes
+	Size int64
+}
+
+// GetArtifactMetadataPayload is the payload type of the oci service
+// get-artifact-metadata method.
+type GetArtifactMetadataPayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+}
+
+// GetFileChecksumPayload is the payload type of the oci service
+// get-file-checksum method.
+type GetFileChecksumPayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// file name in OCI artifact
+	File *string
+	// file name regex pattern in OCI artifact
+	FileRegex *string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+	// checksum algorithm
+	Algorithm string
+}
+
 // HeadFilePayload is the payload type of the oci service head-file method.
 type HeadFilePayload struct {
 	// OCI artifact repository
@@ -102,6 +268,9 @@ type HeadFilePayload struct {
 	File *string
 	// file name regexp pattern
 	FileRegex *string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
 }
 
 // HeadFileResult is the result type of the oci service head-file method.
@@ -112,12 +281,45 @@ type HeadFileResult struct {
 	ContentDisposition string
 }
 
+// Layer of an OCI artifact
+type LayerMetadata struct {
+	// file name of the layer
+	File *string
+	// layer digest
+	Digest string
+	// layer size in bytes
+	Size int64
+	// layer media type
+	MediaType string
+	// layer annotations
+	Annotations map[string]string
+}
+
 // ListFilesPayload is the payload type of the oci service list-files method.
 type ListFilesPayload struct {
 	// OCI artifact repository
 	Repository string
 	// OCI artifact tag
 	Tag string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
+}
+
+// ListTarballEntriesPayload is the payload type of the oci service
+// list-tarball-entries method.
+type ListTarballEntriesPayload struct {
+	// OCI artifact repository
+	Repository string
+	// OCI artifact tag
+	Tag string
+	// tarball file name in OCI artifact
+	File *string
+	// tarball file name regexp pattern
+	FileRegex *string
+	// platform of the artifact when the tag points to an image index, in
+	// os/arch[/variant] format
+	Platform *string
 }
 
 // MakeInvalidFilePath builds a goa.ServiceError from an error.
@@ -0,0 +1,74 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 client
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package s3
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "s3" service client.
+type Client struct {
+	DownloadObjectEndpoint goa.Endpoint
+	HeadObjectEndpoint     goa.Endpoint
+	ListObjectsEndpoint    goa.Endpoint
+}
+
+// NewClient initializes a "s3" service client given the endpoints.
+func NewClient(downloadObject, headObject, listObjects goa.Endpoint) *Client {
+	return &Client{
+		DownloadObjectEndpoint: downloadObject,
+		HeadObjectEndpoint:     headObject,
+		ListObjectsEndpoint:    listObjects,
+	}
+}
+
+// DownloadObject calls the "download-object" endpoint of the "s3" service.
+// DownloadObject may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate file for download
+//   - "internal_error" (type *goa.ServiceError): Fault while processing download.
+//   - error: internal error
+func (c *Client) DownloadObject(ctx context.Context, p *DownloadObjectPayload) (res *DownloadObjectResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadObjectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadObjectResponseData)
+	return o.Result, o.Body, nil
+}
+
+// HeadObject calls the "head-object" endpoint of the "s3" service.
+// HeadObject may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not locate file
+//   - "internal_error" (type *goa.ServiceError): Processing error
+//   - error: internal error
+func (c *Client) HeadObject(ctx context.Context, p *HeadObjectPayload) (res *HeadObjectResult, err error) {
+	var ires any
+	ires, err = c.HeadObjectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*HeadObjectResult), nil
+}
+
+// ListObjects calls the "list-objects" endpoint of the "s3" service.
+// ListObjects may return the following errors:
+//   - "invalid_file_path" (type *goa.ServiceError): Could not list the bucket
+//   - "internal_error" (type *goa.ServiceError): Processing error
+//   - error: internal error
+func (c *Client) ListObjects(ctx context.Context, p *ListObjectsPayload) (res *ObjectListing, err error) {
+	var ires any
+	ires, err = c.ListObjectsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ObjectListing), nil
+}
@@ -0,0 +1,78 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 endpoints
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package s3
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "s3" service endpoints.
+type Endpoints struct {
+	DownloadObject goa.Endpoint
+	HeadObject     goa.Endpoint
+	ListObjects    goa.Endpoint
+}
+
+// DownloadObjectResponseData holds both the result and the HTTP response body
+// reader of the "download-object" method.
+type DownloadObjectResponseData struct {
+	// Result is the method result.
+	Result *DownloadObjectResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "s3" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		DownloadObject: NewDownloadObjectEndpoint(s),
+		HeadObject:     NewHeadObjectEndpoint(s),
+		ListObjects:    NewListObjectsEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "s3" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.DownloadObject = m(e.DownloadObject)
+	e.HeadObject = m(e.HeadObject)
+	e.ListObjects = m(e.ListObjects)
+}
+
+// NewDownloadObjectEndpoint returns an endpoint function that calls the method
+// "download-object" of service "s3".
+func NewDownloadObjectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadObjectPayload)
+		res, body, err := s.DownloadObject(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadObjectResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewHeadObjectEndpoint returns an endpoint function that calls the method
+// "head-object" of service "s3".
+func NewHeadObjectEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*HeadObjectPayload)
+		return s.HeadObject(ctx, p)
+	}
+}
+
+// NewListObjectsEndpoint returns an endpoint function that calls the method
+// "list-objects" of service "s3".
+func NewListObjectsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListObjectsPayload)
+		return s.ListObjects(ctx, p)
+	}
+}
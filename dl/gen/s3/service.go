@@ -0,0 +1,130 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// s3 service
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package s3
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// S3 compatible storage download service, such as AWS S3, MinIO and Cloudflare
+// R2
+type Service interface {
+	// DownloadObject implements download-object.
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadObject(context.Context, *DownloadObjectPayload) (res *DownloadObjectResult, body io.ReadCloser, err error)
+	// HeadObject implements head-object.
+	HeadObject(context.Context, *HeadObjectPayload) (res *HeadObjectResult, err error)
+	// ListObjects implements list-objects.
+	ListObjects(context.Context, *ListObjectsPayload) (res *ObjectListing, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "dl"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "s3"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"download-object", "head-object", "list-objects"}
+
+// DownloadObjectPayload is the payload type of the s3 service download-object
+// method.
+type DownloadObjectPayload struct {
+	// name of the configured endpoint
+	Endpoint string
+	// bucket name
+	Bucket string
+	// object key
+	Key string
+}
+
+// DownloadObjectResult is the result type of the s3 service download-object
+// method.
+type DownloadObjectResult struct {
+	// Length is the downloaded content length in bytes.
+	Length int64
+	// Content-Disposition header for downloading
+	ContentDisposition string
+}
+
+// HeadObjectPayload is the payload type of the s3 service head-object method.
+type HeadObjectPayload struct {
+	// name of the configured endpoint
+	Endpoint string
+	// bucket name
+	Bucket string
+	// object key
+	Key string
+}
+
+// HeadObjectResult is the result type of the s3 service head-object method.
+type HeadObjectResult struct {
+	// Content length in bytes.
+	Length int64
+	// Content-Disposition header value
+	ContentDisposition string
+}
+
+// ListObjectsPayload is the payload type of the s3 service list-objects method.
+type ListObjectsPayload struct {
+	// name of the configured endpoint
+	Endpoint string
+	// bucket name
+	Bucket string
+	// object key prefix
+	Prefix *string
+	// delimiter to roll up the keys into common prefixes, only `/` is supported
+	Delimiter string
+	// max number of objects and prefixes in a page
+	MaxKeys int
+	// token of the page to fetch
+	PageToken *string
+}
+
+// Object in a bucket
+type ObjectInfo struct {
+	// object key
+	Key string
+	// object size in bytes
+	Size int64
+	// last modified time
+	LastModified *string
+}
+
+// ObjectListing is the result type of the s3 service list-objects method.
+type ObjectListing struct {
+	// common prefixes rolled up by the delimiter
+	Prefixes []string
+	// objects under the prefix
+	Objects []*ObjectInfo
+	// token to fetch the next page, absent on the last page
+	NextPageToken *string
+}
+
+// MakeInvalidFilePath builds a goa.ServiceError from an error.
+func MakeInvalidFilePath(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_file_path", false, false, false)
+}
+
+// MakeInternalError builds a goa.ServiceError from an error.
+func MakeInternalError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "internal_error", false, false, false)
+}
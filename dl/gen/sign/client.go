@@ -0,0 +1,39 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign client
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package sign
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "sign" service client.
+type Client struct {
+	SignURLEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "sign" service client given the endpoints.
+func NewClient(signURL goa.Endpoint) *Client {
+	return &Client{
+		SignURLEndpoint: signURL,
+	}
+}
+
+// SignURL calls the "sign-url" endpoint of the "sign" service.
+// SignURL may return the following errors:
+//   - "invalid_request" (type *goa.ServiceError): The link could not be signed
+//   - error: internal error
+func (c *Client) SignURL(ctx context.Context, p *SignURLPayload) (res *SignURLResult, err error) {
+	var ires any
+	ires, err = c.SignURLEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SignURLResult), nil
+}
@@ -0,0 +1,40 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign endpoints
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package sign
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "sign" service endpoints.
+type Endpoints struct {
+	SignURL goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "sign" service with endpoints.
+func NewEndpoints(s Service) *Endpoints {
+	return &Endpoints{
+		SignURL: NewSignURLEndpoint(s),
+	}
+}
+
+// Use applies the given middleware to all the "sign" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.SignURL = m(e.SignURL)
+}
+
+// NewSignURLEndpoint returns an endpoint function that calls the method
+// "sign-url" of service "sign".
+func NewSignURLEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SignURLPayload)
+		return s.SignURL(ctx, p)
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by goa v3.22.2, DO NOT EDIT.
+//
+// sign service
+//
+// Command:
+// $ goa gen github.com/PingCAP-QE/ee-apps/dl/design
+
+package sign
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Service for issuing time-limited signed download links
+type Service interface {
+	// SignURL implements sign-url.
+	SignURL(context.Context, *SignURLPayload) (res *SignURLResult, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "dl"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "sign"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"sign-url"}
+
+// SignURLPayload is the payload type of the sign service sign-url method.
+type SignURLPayload struct {
+	// path and query of the download to sign
+	Path string
+	// lifetime of the link, in Go duration format
+	TTL string
+}
+
+// SignURLResult is the result type of the sign service sign-url method.
+type SignURLResult struct {
+	// signed download link
+	URL string
+	// expiry time of the link
+	ExpiresAt string
+}
+
+// MakeInvalidRequest builds a goa.ServiceError from an error.
+func MakeInvalidRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_request", false, false, false)
+}
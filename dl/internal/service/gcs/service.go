@@ -2,30 +2,38 @@ package gcs
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 	"gopkg.in/yaml.v3"
 
-	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
 	gcs "github.com/PingCAP-QE/ee-apps/dl/gen/gcs"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/checksum"
 	pkggcs "github.com/PingCAP-QE/ee-apps/dl/pkg/gcs"
 )
 
 var errGCSNotConfigured = errors.New("GCS is not configured")
 
 type gcssrvc struct {
-	logger *log.Logger
-	client *storage.Client
-	mu     sync.RWMutex
+	logger    *log.Logger
+	client    *storage.Client
+	redirect  bool
+	checksums *checksum.Cache
+	mu        sync.RWMutex
 }
 
 func newClient(ctx context.Context, cfg *pkggcs.Config) (*storage.Client, error) {
@@ -47,7 +55,7 @@ func isJSONFile(filename string) bool {
 
 func New(logger *log.Logger, cfgFile string) gcs.Service {
 	if cfgFile == "" {
-		return &gcssrvc{logger: logger}
+		return &gcssrvc{logger: logger, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 	}
 
 	var cfg pkggcs.Config
@@ -55,7 +63,7 @@ func New(logger *log.Logger, cfgFile string) gcs.Service {
 	cfgBytes, err := os.ReadFile(cfgFile)
 	if err != nil {
 		logger.Printf("Config file %q not found, GCS service unavailable", cfgFile)
-		return &gcssrvc{logger: logger}
+		return &gcssrvc{logger: logger, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 	}
 
 	if isJSONFile(cfgFile) {
@@ -69,10 +77,10 @@ func New(logger *log.Logger, cfgFile string) gcs.Service {
 	client, err := newClient(context.Background(), &cfg)
 	if err != nil {
 		logger.Printf("Failed to create GCS client: %v", err)
-		return &gcssrvc{logger: logger}
+		return &gcssrvc{logger: logger, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 	}
 
-	return &gcssrvc{logger: logger, client: client}
+	return &gcssrvc{logger: logger, client: client, redirect: cfg.Redirect, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 }
 
 func (s *gcssrvc) getClient() (*storage.Client, error) {
@@ -107,6 +115,7 @@ func (s *gcssrvc) Reload(ctx context.Context, cfgFile string) error {
 	s.mu.Lock()
 	old := s.client
 	s.client = client
+	s.redirect = cfg.Redirect
 	s.mu.Unlock()
 
 	if old != nil {
@@ -168,3 +177,109 @@ func (s *gcssrvc) HeadObject(ctx context.Context, p *gcs.HeadObjectPayload) (*gc
 	}
 	return res, nil
 }
+
+// ListObjects implements list-objects.
+func (s *gcssrvc) ListObjects(ctx context.Context, p *gcs.ListObjectsPayload) (*gcs.ObjectListing, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	query := &storage.Query{Delimiter: p.Delimiter}
+	if p.Prefix != nil {
+		query.Prefix = *p.Prefix
+	}
+	if err := query.SetAttrSelection([]string{"Name", "Size", "Updated", "Prefix"}); err != nil {
+		return nil, err
+	}
+
+	var pageToken string
+	if p.PageToken != nil {
+		pageToken = *p.PageToken
+	}
+
+	var attrs []*storage.ObjectAttrs
+	it := client.Bucket(p.Bucket).Objects(ctx, query)
+	nextPageToken, err := iterator.NewPager(it, p.MaxKeys, pageToken).NextPage(&attrs)
+	if err != nil {
+		return nil, gcs.MakeInvalidFilePath(err)
+	}
+
+	res := &gcs.ObjectListing{Prefixes: []string{}, Objects: []*gcs.ObjectInfo{}}
+	for _, a := range attrs {
+		// prefixes rolled up by the delimiter are returned as attrs with only
+		// the Prefix set.
+		if a.Prefix != "" {
+			res.Prefixes = append(res.Prefixes, a.Prefix)
+			continue
+		}
+		lastModified := a.Updated.UTC().Format(time.RFC3339)
+		res.Objects = append(res.Objects, &gcs.ObjectInfo{
+			Key:          a.Name,
+			Size:         a.Size,
+			LastModified: &lastModified,
+		})
+	}
+	if nextPageToken != "" {
+		res.NextPageToken = &nextPageToken
+	}
+
+	return res, nil
+}
+
+// RedirectByDefault reports whether object downloads are redirected to signed
+// URLs when the request does not choose.
+func (s *gcssrvc) RedirectByDefault() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.redirect
+}
+
+// PresignObject returns a signed download URL of the object valid for ttl.
+func (s *gcssrvc) PresignObject(ctx context.Context, bucket, key string, ttl time.Duration) (string, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return "", err
+	}
+
+	return client.Bucket(bucket).SignedURL(key, &storage.SignedURLOptions{
+		Method:  http.MethodGet,
+		Expires: time.Now().Add(ttl),
+		Scheme:  storage.SigningSchemeV4,
+		QueryParameters: url.Values{
+			"response-content-disposition": {attachment.ContentDisposition(filepath.Base(key))},
+		},
+	})
+}
+
+// GetObjectChecksum implements get-object-checksum. The md5 checksum kept by GCS
+// is used when available, otherwise the checksum is computed by streaming the
+// object and cached by the object generation.
+func (s *gcssrvc) GetObjectChecksum(ctx context.Context, p *gcs.GetObjectChecksumPayload) (*gcs.FileChecksum, error) {
+	client, err := s.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := client.Bucket(p.Bucket).Object(p.Key)
+	attrs, err := obj.Attrs(ctx)
+	if err != nil {
+		return nil, gcs.MakeInvalidFilePath(err)
+	}
+
+	res := &gcs.FileChecksum{File: p.Key, Algorithm: p.Algorithm, Size: attrs.Size}
+	// composite objects have no md5 checksum.
+	if p.Algorithm == checksum.MD5 && len(attrs.MD5) > 0 {
+		res.Checksum = hex.EncodeToString(attrs.MD5)
+		return res, nil
+	}
+
+	content := fmt.Sprintf("%s/%s#%d", p.Bucket, p.Key, attrs.Generation)
+	res.Checksum, err = s.checksums.Get(ctx, content, p.Algorithm, func(ctx context.Context) (io.ReadCloser, error) {
+		return obj.Generation(attrs.Generation).NewReader(ctx)
+	})
+	if err != nil {
+		return nil, gcs.MakeInternalError(err)
+	}
+	return res, nil
+}
@@ -7,23 +7,28 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/ks3sdklib/aws-sdk-go/aws"
 	"github.com/ks3sdklib/aws-sdk-go/aws/credentials"
 	"github.com/ks3sdklib/aws-sdk-go/service/s3"
 	"gopkg.in/yaml.v3"
 
-	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
 	ks3 "github.com/PingCAP-QE/ee-apps/dl/gen/ks3"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/checksum"
 	pkgks3 "github.com/PingCAP-QE/ee-apps/dl/pkg/ks3"
 )
 
 // ks3srvc implements the KS3 service.
 type ks3srvc struct {
-	logger *log.Logger
-	client *s3.S3
-	mu     sync.RWMutex
+	logger    *log.Logger
+	client    *s3.S3
+	redirect  bool
+	checksums *checksum.Cache
+	mu        sync.RWMutex
 }
 
 func newClient(cfg *pkgks3.Config) *s3.S3 {
@@ -55,7 +60,7 @@ func New(logger *log.Logger, cfgFile string) ks3.Service {
 	if err := yaml.Unmarshal(cfgBytes, &cfg); err != nil {
 		logger.Fatalf("Failed to load configuration: %v", err)
 	}
-	return &ks3srvc{logger: logger, client: newClient(&cfg)}
+	return &ks3srvc{logger: logger, client: newClient(&cfg), redirect: cfg.Redirect, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 }
 
 func (s *ks3srvc) getClient() *s3.S3 {
@@ -78,6 +83,7 @@ func (s *ks3srvc) Reload(ctx context.Context, cfgFile string) error {
 
 	s.mu.Lock()
 	s.client = newClient(&cfg)
+	s.redirect = cfg.Redirect
 	s.mu.Unlock()
 
 	s.logger.Printf("KS3 client reloaded from %q", cfgFile)
@@ -131,3 +137,125 @@ func (s *ks3srvc) HeadObject(ctx context.Context, p *ks3.HeadObjectPayload) (*ks
 	}
 	return res, nil
 }
+
+// ListObjects implements list-objects.
+func (s *ks3srvc) ListObjects(ctx context.Context, p *ks3.ListObjectsPayload) (*ks3.ObjectListing, error) {
+	input := &s3.ListObjectsInput{
+		Bucket:  aws.String(p.Bucket),
+		Prefix:  p.Prefix,
+		Marker:  p.PageToken,
+		MaxKeys: aws.Long(int64(p.MaxKeys)),
+	}
+	if p.Delimiter != "" {
+		input.Delimiter = aws.String(p.Delimiter)
+	}
+
+	output, err := s.getClient().ListObjects(input)
+	if err != nil {
+		return nil, ks3.MakeInvalidFilePath(err)
+	}
+
+	res := &ks3.ObjectListing{Prefixes: []string{}, Objects: []*ks3.ObjectInfo{}}
+	for _, cp := range output.CommonPrefixes {
+		if cp.Prefix != nil {
+			res.Prefixes = append(res.Prefixes, *cp.Prefix)
+		}
+	}
+	for _, o := range output.Contents {
+		if o.Key == nil {
+			continue
+		}
+		obj := &ks3.ObjectInfo{Key: *o.Key}
+		if o.Size != nil {
+			obj.Size = *o.Size
+		}
+		if o.LastModified != nil {
+			lastModified := o.LastModified.UTC().Format(time.RFC3339)
+			obj.LastModified = &lastModified
+		}
+		res.Objects = append(res.Objects, obj)
+	}
+
+	if output.IsTruncated != nil && *output.IsTruncated {
+		// NextMarker is only returned when a delimiter is given, otherwise the
+		// last key or common prefix of the page is the marker.
+		switch {
+		case output.NextMarker != nil && *output.NextMarker != "":
+			res.NextPageToken = output.NextMarker
+		case len(res.Objects) > 0 && (len(res.Prefixes) == 0 || res.Objects[len(res.Objects)-1].Key > res.Prefixes[len(res.Prefixes)-1]):
+			res.NextPageToken = &res.Objects[len(res.Objects)-1].Key
+		case len(res.Prefixes) > 0:
+			res.NextPageToken = &res.Prefixes[len(res.Prefixes)-1]
+		}
+	}
+
+	return res, nil
+}
+
+// RedirectByDefault reports whether object downloads are redirected to
+// presigned URLs when the request does not choose.
+func (s *ks3srvc) RedirectByDefault() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.redirect
+}
+
+// PresignObject returns a presigned download URL of the object valid for ttl.
+func (s *ks3srvc) PresignObject(ctx context.Context, bucket, key string, ttl time.Duration) (string, error) {
+	return s.getClient().GeneratePresignedUrl(&s3.GeneratePresignedUrlInput{
+		Bucket:                     aws.String(bucket),
+		Key:                        aws.String(key),
+		HTTPMethod:                 s3.GET,
+		Expires:                    int64(ttl.Seconds()),
+		ResponseContentDisposition: aws.String(attachment.ContentDisposition(filepath.Base(key))),
+	})
+}
+
+// GetObjectChecksum implements get-object-checksum. The ETag is used as the md5
+// checksum for objects not uploaded in parts, otherwise the checksum is computed
+// by streaming the object and cached by the ETag when it's returned.
+func (s *ks3srvc) GetObjectChecksum(ctx context.Context, p *ks3.GetObjectChecksumPayload) (*ks3.FileChecksum, error) {
+	client := s.getClient()
+	head, err := client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(p.Bucket),
+		Key:    aws.String(p.Key),
+	})
+	if err != nil {
+		return nil, ks3.MakeInvalidFilePath(err)
+	}
+
+	res := &ks3.FileChecksum{File: p.Key, Algorithm: p.Algorithm}
+	if head.ContentLength != nil {
+		res.Size = *head.ContentLength
+	}
+	var etag string
+	if head.ETag != nil {
+		etag = strings.Trim(*head.ETag, `"`)
+	}
+	if p.Algorithm == checksum.MD5 && etag != "" && !strings.Contains(etag, "-") {
+		res.Checksum = etag
+		return res, nil
+	}
+
+	open := func(ctx context.Context) (io.ReadCloser, error) {
+		output, err := client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket:  aws.String(p.Bucket),
+			Key:     aws.String(p.Key),
+			IfMatch: head.ETag,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return output.Body, nil
+	}
+	// without the ETag, the content can't be told from the others of the key.
+	if etag == "" {
+		res.Checksum, err = checksum.ComputeContent(ctx, p.Algorithm, open)
+	} else {
+		res.Checksum, err = s.checksums.Get(ctx, p.Bucket+"/"+p.Key+"@"+etag, p.Algorithm, open)
+	}
+	if err != nil {
+		return nil, ks3.MakeInternalError(err)
+	}
+	return res, nil
+}
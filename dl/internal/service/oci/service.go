@@ -7,13 +7,18 @@ import (
 	"io"
 	"log"
 	"os"
+	"path"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
 	oci "github.com/PingCAP-QE/ee-apps/dl/gen/oci"
 	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/blobcache"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/checksum"
 	pkgoci "github.com/PingCAP-QE/ee-apps/dl/pkg/oci"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"gopkg.in/yaml.v3"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
@@ -24,14 +29,18 @@ import (
 type ocisrvc struct {
 	logger     *log.Logger
 	credential *auth.Credential
+	redirect   bool
+	cache      *blobcache.Cache // optional, nil when caching is disabled.
+	checksums  *checksum.Cache
 	mu         sync.RWMutex
 }
 
-// New returns the oci service implementation.
-func New(logger *log.Logger, cfgFile *string) oci.Service {
+// New returns the oci service implementation. Layer blobs are served through
+// cache when it's not nil.
+func New(logger *log.Logger, cfgFile *string, cache *blobcache.Cache) oci.Service {
 	var cfg pkgoci.Config
 	if cfgFile == nil {
-		return &ocisrvc{logger: logger, credential: &auth.EmptyCredential}
+		return &ocisrvc{logger: logger, credential: &auth.EmptyCredential, cache: cache, checksums: checksum.NewCache(checksum.DefaultCacheSize)}
 	}
 
 	cfgBytes, err := os.ReadFile(*cfgFile)
@@ -42,7 +51,7 @@ func New(logger *log.Logger, cfgFile *string) oci.Service {
 		logger.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	return &ocisrvc{logger: logger, credential: &auth.Credential{
+	return &ocisrvc{logger: logger, cache: cache, checksums: checksum.NewCache(checksum.DefaultCacheSize), redirect: cfg.Redirect, credential: &auth.Credential{
 		Username: cfg.Username,
 		Password: cfg.Password,
 	}}
@@ -57,7 +66,12 @@ func (s *ocisrvc) ListFiles(ctx context.Context, p *oci.ListFilesPayload) (res [
 		return nil, err
 	}
 
-	files, err := pkgoci.ListFiles(ctx, repository, p.Tag)
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := pkgoci.ListFiles(ctx, repository, ref)
 	if err != nil {
 		return nil, oci.MakeInvalidFilePath(err)
 	}
@@ -90,12 +104,21 @@ func (s *ocisrvc) Reload(ctx context.Context, cfgFile string) error {
 
 	s.mu.Lock()
 	s.credential = cred
+	s.redirect = cfg.Redirect
 	s.mu.Unlock()
 
 	s.logger.Printf("OCI credentials reloaded from %q", cfgFile)
 	return nil
 }
 
+// RedirectByDefault reports whether file downloads are redirected to the blob
+// location of the registry when the request does not choose.
+func (s *ocisrvc) RedirectByDefault() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.redirect
+}
+
 // GetTargetRepo creates a remote.Repository with auth configured for the given repo string.
 func (s *ocisrvc) GetTargetRepo(repo string) (*remote.Repository, error) {
 	repository, err := remote.NewRepository(repo)
@@ -122,16 +145,21 @@ func (s *ocisrvc) DownloadFile(ctx context.Context, p *oci.DownloadFilePayload)
 		return nil, nil, err
 	}
 
-	targetFile, err := s.resolveFile(ctx, repository, p.Tag, p.File, p.FileRegex)
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetFile, err := s.resolveFile(ctx, repository, ref, p.File, p.FileRegex)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return s.downloadFile(ctx, repository, p.Tag, targetFile)
+	return s.downloadFile(ctx, repository, ref, targetFile)
 }
 
 func (s *ocisrvc) downloadFile(ctx context.Context, repo *remote.Repository, tag, file string) (res *oci.DownloadFileResult, resp io.ReadCloser, err error) {
-	rc, length, err := pkgoci.NewFileReadCloser(ctx, repo, tag, file)
+	rc, length, err := s.openFile(ctx, repo, tag, file)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -152,12 +180,17 @@ func (s *ocisrvc) HeadFile(ctx context.Context, p *oci.HeadFilePayload) (*oci.He
 		return nil, err
 	}
 
-	targetFile, err := s.resolveFile(ctx, repository, p.Tag, p.File, p.FileRegex)
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
 	if err != nil {
 		return nil, err
 	}
 
-	descriptor, err := pkgoci.FetchFileDescriptor(ctx, repository, p.Tag, targetFile)
+	targetFile, err := s.resolveFile(ctx, repository, ref, p.File, p.FileRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptor, err := pkgoci.FetchFileDescriptor(ctx, repository, ref, targetFile)
 	if err != nil {
 		return nil, oci.MakeInvalidFilePath(err)
 	}
@@ -168,6 +201,50 @@ func (s *ocisrvc) HeadFile(ctx context.Context, p *oci.HeadFilePayload) (*oci.He
 	}, nil
 }
 
+// openFile opens the layer blob of the file in the artifact. The tag is always
+// resolved against the registry, so moved tags are served correctly even when
+// the blobs are cached.
+func (s *ocisrvc) openFile(ctx context.Context, repo *remote.Repository, tag, file string) (io.ReadCloser, int64, error) {
+	desc, err := pkgoci.FetchFileDescriptor(ctx, repo, tag, file)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rc, err := s.fetchBlob(ctx, repo)(*desc)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rc, desc.Size, nil
+}
+
+// fetchBlob returns a blob fetcher of the repository, which reads through the
+// cache when it's enabled.
+func (s *ocisrvc) fetchBlob(ctx context.Context, repo *remote.Repository) pkgoci.BlobFetcher {
+	return func(desc ocispec.Descriptor) (io.ReadCloser, error) {
+		if s.cache == nil {
+			return repo.Blobs().Fetch(ctx, desc)
+		}
+		return s.cache.Open(ctx, desc, func(ctx context.Context) (io.ReadCloser, error) {
+			return repo.Blobs().Fetch(ctx, desc)
+		})
+	}
+}
+
+// resolveReference resolves the tag to the manifest of the given platform when
+// it points to an image index.
+func (s *ocisrvc) resolveReference(ctx context.Context, repo *remote.Repository, tag string, platform *string) (string, error) {
+	var p string
+	if platform != nil {
+		p = *platform
+	}
+
+	ref, err := pkgoci.ResolveReference(ctx, repo, tag, p)
+	if err != nil {
+		return "", oci.MakeInvalidFilePath(err)
+	}
+	return ref, nil
+}
+
 func (s *ocisrvc) resolveFile(ctx context.Context, repo *remote.Repository, tag string, file *string, fileRegex *string) (string, error) {
 	if file != nil {
 		return *file, nil
@@ -196,6 +273,178 @@ func (s *ocisrvc) resolveFile(ctx context.Context, repo *remote.Repository, tag
 	return "", oci.MakeInvalidFilePath(errors.New("none `file` or `file_regex` param given"))
 }
 
+// ListTarballEntries implements list-tarball-entries.
+func (s *ocisrvc) ListTarballEntries(ctx context.Context, p *oci.ListTarballEntriesPayload) (res []string, err error) {
+	s.logger.Print("oci.list-tarball-entries")
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	targetFile, err := s.resolveFile(ctx, repository, ref, p.File, p.FileRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, _, err := s.openFile(ctx, repository, ref, targetFile)
+	if err != nil {
+		return nil, oci.MakeInvalidFilePath(err)
+	}
+	defer rc.Close()
+
+	entries, err := pkgoci.ListTarballEntries(rc)
+	if err != nil {
+		return nil, oci.MakeInvalidFilePath(err)
+	}
+
+	return entries, nil
+}
+
+// DownloadTarballEntry implements download-tarball-entry.
+func (s *ocisrvc) DownloadTarballEntry(ctx context.Context, p *oci.DownloadTarballEntryPayload) (res *oci.DownloadTarballEntryResult, resp io.ReadCloser, err error) {
+	s.logger.Print("oci.download-tarball-entry")
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targetFile, err := s.resolveFile(ctx, repository, ref, p.File, p.FileRegex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rc, _, err := s.openFile(ctx, repository, ref, targetFile)
+	if err != nil {
+		return nil, nil, oci.MakeInvalidFilePath(err)
+	}
+
+	rc, length, err := pkgoci.OpenTarballEntry(rc, p.Entry)
+	if err != nil {
+		if errors.Is(err, pkgoci.ErrTarballEntryNotFound) {
+			return nil, nil, oci.MakeInvalidFilePath(err)
+		}
+		return nil, nil, err
+	}
+
+	res = &oci.DownloadTarballEntryResult{
+		Length:             length,
+		ContentDisposition: attachment.ContentDisposition(path.Base(p.Entry)),
+	}
+	return res, rc, nil
+}
+
+// DownloadFilesBundle implements download-files-bundle.
+func (s *ocisrvc) DownloadFilesBundle(ctx context.Context, p *oci.DownloadFilesBundlePayload) (res *oci.DownloadFilesBundleResult, resp io.ReadCloser, err error) {
+	s.logger.Print("oci.download-files-bundle")
+
+	match, err := bundleFileMatcher(p.Files, p.FileRegex)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rc, err := pkgoci.NewBundleReadCloser(ctx, repository, ref, p.Format, match, s.fetchBlob(ctx, repository))
+	if err != nil {
+		return nil, nil, oci.MakeInvalidFilePath(err)
+	}
+
+	contentType := "application/x-tar"
+	if p.Format == pkgoci.BundleFormatZip {
+		contentType = "application/zip"
+	}
+	bundleName := fmt.Sprintf("%s-%s.%s", path.Base(p.Repository), p.Tag, p.Format)
+	res = &oci.DownloadFilesBundleResult{
+		ContentType:        contentType,
+		ContentDisposition: attachment.ContentDisposition(bundleName),
+	}
+	return res, rc, nil
+}
+
+// bundleFileMatcher returns a function matching the file names listed in files
+// or matched by fileRegex.
+func bundleFileMatcher(files []string, fileRegex *string) (func(string) bool, error) {
+	var pattern *regexp.Regexp
+	if fileRegex != nil {
+		var err error
+		if pattern, err = regexp.Compile(*fileRegex); err != nil {
+			return nil, oci.MakeInvalidFilePath(err)
+		}
+	}
+	if len(files) == 0 && pattern == nil {
+		return nil, oci.MakeInvalidFilePath(errors.New("none `files` or `file_regex` param given"))
+	}
+
+	return func(name string) bool {
+		return slices.Contains(files, name) || (pattern != nil && pattern.MatchString(name))
+	}, nil
+}
+
+// GetArtifactMetadata implements get-artifact-metadata.
+func (s *ocisrvc) GetArtifactMetadata(ctx context.Context, p *oci.GetArtifactMetadataPayload) (*oci.ArtifactMetadata, error) {
+	s.logger.Print("oci.get-artifact-metadata")
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := pkgoci.FetchArtifactMetadata(ctx, repository, ref)
+	if err != nil {
+		return nil, oci.MakeInvalidFilePath(err)
+	}
+
+	res := &oci.ArtifactMetadata{
+		Digest:      metadata.Digest,
+		MediaType:   metadata.MediaType,
+		Config:      metadata.Config,
+		Annotations: metadata.Annotations,
+		Layers:      make([]*oci.LayerMetadata, 0, len(metadata.Layers)),
+	}
+	if metadata.ArtifactType != "" {
+		res.ArtifactType = &metadata.ArtifactType
+	}
+	for _, l := range metadata.Layers {
+		layer := &oci.LayerMetadata{
+			Digest:      l.Digest.String(),
+			Size:        l.Size,
+			MediaType:   l.MediaType,
+			Annotations: l.Annotations,
+		}
+		if file, ok := l.Annotations[pkgoci.AnnotationKeyFileName]; ok {
+			layer.File = &file
+		}
+		res.Layers = append(res.Layers, layer)
+	}
+
+	return res, nil
+}
+
 // DownloadFileSha256 implements download-file-sha256.
 func (s *ocisrvc) DownloadFileSha256(ctx context.Context, p *oci.DownloadFileSha256Payload) (res *oci.DownloadFileSha256Result, resp io.ReadCloser, err error) {
 	s.logger.Print("oci.download-file-sha256")
@@ -204,7 +453,13 @@ func (s *ocisrvc) DownloadFileSha256(ctx context.Context, p *oci.DownloadFileSha
 	if err != nil {
 		return nil, nil, err
 	}
-	value, err := pkgoci.GetFileSHA256(ctx, repository, p.Tag, p.File)
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, err := pkgoci.GetFileSHA256(ctx, repository, ref, p.File)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,3 +470,84 @@ func (s *ocisrvc) DownloadFileSha256(ctx context.Context, p *oci.DownloadFileSha
 	}
 	return res, io.NopCloser(strings.NewReader(value)), nil
 }
+
+// layerChecksum returns the checksum of the layer blob. The digest is used when
+// it's in the algorithm, otherwise the checksum is computed by streaming the
+// blob and cached by the digest.
+func (s *ocisrvc) layerChecksum(ctx context.Context, repo *remote.Repository, desc ocispec.Descriptor, algorithm string) (string, error) {
+	if string(desc.Digest.Algorithm()) == algorithm {
+		return desc.Digest.Encoded(), nil
+	}
+
+	return s.checksums.Get(ctx, desc.Digest.String(), algorithm, func(ctx context.Context) (io.ReadCloser, error) {
+		return s.fetchBlob(ctx, repo)(desc)
+	})
+}
+
+// GetFileChecksum implements get-file-checksum.
+func (s *ocisrvc) GetFileChecksum(ctx context.Context, p *oci.GetFileChecksumPayload) (*oci.FileChecksum, error) {
+	s.logger.Print("oci.get-file-checksum")
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := s.resolveFile(ctx, repository, ref, p.File, p.FileRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := pkgoci.FetchFileDescriptor(ctx, repository, ref, file)
+	if err != nil {
+		return nil, oci.MakeInvalidFilePath(err)
+	}
+
+	value, err := s.layerChecksum(ctx, repository, *desc, p.Algorithm)
+	if err != nil {
+		return nil, oci.MakeInternalError(err)
+	}
+
+	return &oci.FileChecksum{File: file, Algorithm: p.Algorithm, Checksum: value, Size: desc.Size}, nil
+}
+
+// DownloadChecksums implements download-checksums.
+func (s *ocisrvc) DownloadChecksums(ctx context.Context, p *oci.DownloadChecksumsPayload) (res *oci.DownloadChecksumsResult, resp io.ReadCloser, err error) {
+	s.logger.Print("oci.download-checksums")
+
+	repository, err := s.GetTargetRepo(p.Repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ref, err := s.resolveReference(ctx, repository, p.Tag, p.Platform)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	layers, err := pkgoci.ListFileDescriptors(ctx, repository, ref)
+	if err != nil {
+		return nil, nil, oci.MakeInvalidFilePath(err)
+	}
+
+	entries := make([]checksum.Entry, 0, len(layers))
+	for _, l := range layers {
+		value, err := s.layerChecksum(ctx, repository, l, p.Algorithm)
+		if err != nil {
+			return nil, nil, oci.MakeInternalError(err)
+		}
+		entries = append(entries, checksum.Entry{Checksum: value, File: l.Annotations[pkgoci.AnnotationKeyFileName]})
+	}
+
+	sums := checksum.FormatSums(entries)
+	res = &oci.DownloadChecksumsResult{
+		Length:             int64(len(sums)),
+		ContentDisposition: attachment.ContentDisposition(checksum.SumsFileName(p.Algorithm)),
+	}
+	return res, io.NopCloser(strings.NewReader(sums)), nil
+}
@@ -0,0 +1,215 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"gopkg.in/yaml.v3"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/attachment"
+	pkgs3 "github.com/PingCAP-QE/ee-apps/dl/pkg/s3"
+)
+
+// s3srvc implements the S3 service.
+type s3srvc struct {
+	logger  *log.Logger
+	clients map[string]*minio.Client
+	mu      sync.RWMutex
+}
+
+func newClients(cfg *pkgs3.Config) (map[string]*minio.Client, error) {
+	clients := make(map[string]*minio.Client, len(cfg.Endpoints))
+	for name, ep := range cfg.Endpoints {
+		opts := &minio.Options{
+			Secure: !ep.Insecure,
+			Region: ep.Region,
+		}
+		if ep.AccessKey != "" && ep.SecretKey != "" {
+			opts.Creds = credentials.NewStaticV4(ep.AccessKey, ep.SecretKey, "")
+		}
+		if ep.PathStyle {
+			opts.BucketLookup = minio.BucketLookupPath
+		}
+
+		client, err := minio.New(ep.Endpoint, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for endpoint %q: %w", name, err)
+		}
+		clients[name] = client
+	}
+
+	return clients, nil
+}
+
+func loadClients(cfgFile string) (map[string]*minio.Client, error) {
+	var cfg pkgs3.Config
+	cfgBytes, err := os.ReadFile(cfgFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %q: %w", cfgFile, err)
+	}
+	if err := yaml.Unmarshal(cfgBytes, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %q: %w", cfgFile, err)
+	}
+
+	return newClients(&cfg)
+}
+
+// New returns the s3 service implementation, no endpoint is available when
+// cfgFile is empty.
+func New(logger *log.Logger, cfgFile string) s3.Service {
+	if cfgFile == "" {
+		return &s3srvc{logger: logger}
+	}
+
+	clients, err := loadClients(cfgFile)
+	if err != nil {
+		logger.Fatalf("Failed to load configuration: %v", err)
+	}
+	return &s3srvc{logger: logger, clients: clients}
+}
+
+func (s *s3srvc) getClient(endpoint string) (*minio.Client, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	client, ok := s.clients[endpoint]
+	if !ok {
+		return nil, s3.MakeInvalidFilePath(fmt.Errorf("S3 endpoint %q is not configured", endpoint))
+	}
+	return client, nil
+}
+
+// Reload re-reads the config file and recreates the S3 clients. It is safe for
+// concurrent use with all other methods.
+func (s *s3srvc) Reload(ctx context.Context, cfgFile string) error {
+	clients, err := loadClients(cfgFile)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.clients = clients
+	s.mu.Unlock()
+
+	s.logger.Printf("S3 clients reloaded from %q", cfgFile)
+	return nil
+}
+
+// DownloadObject implements download-object.
+func (s *s3srvc) DownloadObject(ctx context.Context, p *s3.DownloadObjectPayload) (res *s3.DownloadObjectResult, resp io.ReadCloser, err error) {
+	client, err := s.getClient(p.Endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	obj, err := client.GetObject(ctx, p.Bucket, p.Key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	// GetObject is lazy, Stat sends the request.
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, nil, s3.MakeInvalidFilePath(err)
+	}
+
+	res = &s3.DownloadObjectResult{
+		Length:             info.Size,
+		ContentDisposition: contentDisposition(info, p.Key),
+	}
+	return res, obj, nil
+}
+
+// HeadObject implements head-object.
+func (s *s3srvc) HeadObject(ctx context.Context, p *s3.HeadObjectPayload) (*s3.HeadObjectResult, error) {
+	client, err := s.getClient(p.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := client.StatObject(ctx, p.Bucket, p.Key, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, s3.MakeInvalidFilePath(err)
+	}
+
+	return &s3.HeadObjectResult{
+		Length:             info.Size,
+		ContentDisposition: contentDisposition(info, p.Key),
+	}, nil
+}
+
+// ListObjects implements list-objects.
+func (s *s3srvc) ListObjects(ctx context.Context, p *s3.ListObjectsPayload) (*s3.ObjectListing, error) {
+	client, err := s.getClient(p.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := minio.ListObjectsOptions{
+		Recursive: p.Delimiter == "",
+		MaxKeys:   p.MaxKeys,
+	}
+	if p.Prefix != nil {
+		opts.Prefix = *p.Prefix
+	}
+	if p.PageToken != nil {
+		opts.StartAfter = *p.PageToken
+	}
+
+	// the client lists all the pages, stop it once a page is filled.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	res := &s3.ObjectListing{Prefixes: []string{}, Objects: []*s3.ObjectInfo{}}
+	var count int
+	var last string
+	for obj := range client.ListObjects(ctx, p.Bucket, opts) {
+		if obj.Err != nil {
+			return nil, s3.MakeInvalidFilePath(obj.Err)
+		}
+		if count == p.MaxKeys {
+			res.NextPageToken = &last
+			break
+		}
+		count++
+
+		// common prefixes are returned as objects with keys ending with the
+		// delimiter.
+		if !opts.Recursive && strings.HasSuffix(obj.Key, "/") && obj.Size == 0 && obj.LastModified.IsZero() {
+			res.Prefixes = append(res.Prefixes, obj.Key)
+			// skip all the keys under the prefix in the next page.
+			last = max(last, obj.Key+string(utf8.MaxRune))
+			continue
+		}
+
+		lastModified := obj.LastModified.UTC().Format(time.RFC3339)
+		res.Objects = append(res.Objects, &s3.ObjectInfo{
+			Key:          obj.Key,
+			Size:         obj.Size,
+			LastModified: &lastModified,
+		})
+		// the client sends the objects before the common prefixes of a page,
+		// so the token is the greatest listed key.
+		last = max(last, obj.Key)
+	}
+
+	return res, nil
+}
+
+func contentDisposition(info minio.ObjectInfo, key string) string {
+	if v := info.Metadata.Get("Content-Disposition"); v != "" {
+		return v
+	}
+	return attachment.ContentDisposition(filepath.Base(key))
+}
@@ -0,0 +1,358 @@
+package s3
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	s3 "github.com/PingCAP-QE/ee-apps/dl/gen/s3"
+)
+
+// s3Stub serves the ListObjectsV2, HeadObject and GetObject APIs of one bucket.
+type s3Stub struct {
+	bucket  string
+	objects map[string]string // key => content
+}
+
+type listBucketResult struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string
+	Prefix                string
+	KeyCount              int
+	MaxKeys               int
+	IsTruncated           bool
+	NextContinuationToken string         `xml:",omitempty"`
+	Contents              []stubContent  `xml:"Contents"`
+	CommonPrefixes        []stubPrefixes `xml:"CommonPrefixes"`
+}
+
+type stubContent struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         int
+}
+
+type stubPrefixes struct {
+	Prefix string
+}
+
+var stubModTime = time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != s.bucket {
+		http.Error(w, "no such bucket", http.StatusNotFound)
+		return
+	}
+	if key == "" && r.URL.Query().Get("list-type") == "2" {
+		s.list(w, r.URL.Query())
+		return
+	}
+
+	content, ok := s.objects[key]
+	if !ok {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		if r.Method != http.MethodHead {
+			fmt.Fprintf(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message><Key>%s</Key></Error>`, key)
+		}
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.Header().Set("Last-Modified", stubModTime.Format(http.TimeFormat))
+	w.Header().Set("ETag", `"etag"`)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		io.WriteString(w, content)
+	}
+}
+
+// list pages the sorted keys like S3, the token is the last returned key or
+// common prefix, and the keys under a common prefix token are skipped.
+func (s *s3Stub) list(w http.ResponseWriter, q url.Values) {
+	prefix, delimiter := q.Get("prefix"), q.Get("delimiter")
+	maxKeys, _ := strconv.Atoi(q.Get("max-keys"))
+	if maxKeys <= 0 {
+		maxKeys = 1000
+	}
+	start := q.Get("start-after")
+	if token := q.Get("continuation-token"); token != "" {
+		start = token
+	}
+
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	res := listBucketResult{Name: s.bucket, Prefix: prefix, MaxKeys: maxKeys}
+	var last string
+	for _, k := range keys {
+		if !strings.HasPrefix(k, prefix) || k <= start || (delimiter != "" && strings.HasSuffix(start, delimiter) && strings.HasPrefix(k, start)) {
+			continue
+		}
+		entry, isPrefix := k, false
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				entry, isPrefix = k[:len(prefix)+i+len(delimiter)], true
+			}
+		}
+		if entry == last {
+			continue
+		}
+		if res.KeyCount == maxKeys {
+			res.IsTruncated = true
+			res.NextContinuationToken = last
+			break
+		}
+		res.KeyCount++
+		last = entry
+		if isPrefix {
+			res.CommonPrefixes = append(res.CommonPrefixes, stubPrefixes{Prefix: entry})
+		} else {
+			res.Contents = append(res.Contents, stubContent{
+				Key:          k,
+				LastModified: stubModTime.Format(time.RFC3339),
+				ETag:         `"etag"`,
+				Size:         len(s.objects[k]),
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(res)
+}
+
+func writeConfig(t *testing.T, dir, content string) string {
+	t.Helper()
+	cfgFile := filepath.Join(dir, "s3.yaml")
+	if err := os.WriteFile(cfgFile, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return cfgFile
+}
+
+func newStubService(t *testing.T, objects map[string]string) *s3srvc {
+	t.Helper()
+	server := httptest.NewServer(&s3Stub{bucket: "builds", objects: objects})
+	t.Cleanup(server.Close)
+
+	cfgFile := writeConfig(t, t.TempDir(), fmt.Sprintf(`endpoints:
+  minio:
+    endpoint: %s
+    region: us-east-1
+    insecure: true
+    path_style: true
+`, strings.TrimPrefix(server.URL, "http://")))
+	return New(log.New(io.Discard, "", 0), cfgFile).(*s3srvc)
+}
+
+func TestListObjectsPaging(t *testing.T) {
+	objects := map[string]string{
+		"a.txt":           "a",
+		"b/1.txt":         "b1",
+		"b/2.txt":         "b2",
+		"c.txt":           "c",
+		"d/1.txt":         "d1",
+		"e.txt":           "e",
+		"f/g/1.txt":       "fg1",
+		"other/skip.text": "skip",
+	}
+	svc := newStubService(t, objects)
+
+	// listAll follows the page tokens and returns the listed pages.
+	listAll := func(t *testing.T, prefix, delimiter string, maxKeys int) [][]string {
+		t.Helper()
+		var pages [][]string
+		var token *string
+		for i := 0; ; i++ {
+			if i > len(objects) {
+				t.Fatal("too many pages")
+			}
+			res, err := svc.ListObjects(context.Background(), &s3.ListObjectsPayload{
+				Endpoint:  "minio",
+				Bucket:    "builds",
+				Prefix:    &prefix,
+				Delimiter: delimiter,
+				MaxKeys:   maxKeys,
+				PageToken: token,
+			})
+			if err != nil {
+				t.Fatalf("ListObjects() error: %v", err)
+			}
+			var page []string
+			for _, o := range res.Objects {
+				page = append(page, o.Key)
+			}
+			page = append(page, res.Prefixes...)
+			slices.Sort(page)
+			pages = append(pages, page)
+			if res.NextPageToken == nil {
+				return pages
+			}
+			token = res.NextPageToken
+		}
+	}
+
+	tests := []struct {
+		name      string
+		prefix    string
+		delimiter string
+		maxKeys   int
+		want      [][]string
+	}{
+		{
+			name:    "recursive pages are cut by max keys",
+			maxKeys: 3,
+			want: [][]string{
+				{"a.txt", "b/1.txt", "b/2.txt"},
+				{"c.txt", "d/1.txt", "e.txt"},
+				{"f/g/1.txt", "other/skip.text"},
+			},
+		},
+		{
+			name:      "common prefixes are skipped in the next pages",
+			delimiter: "/",
+			maxKeys:   2,
+			want: [][]string{
+				{"a.txt", "b/"},
+				{"c.txt", "d/"},
+				{"e.txt", "f/"},
+				{"other/"},
+			},
+		},
+		{
+			name:      "prefix before objects in a page",
+			delimiter: "/",
+			maxKeys:   3,
+			want: [][]string{
+				{"a.txt", "b/", "c.txt"},
+				{"d/", "e.txt", "f/"},
+				{"other/"},
+			},
+		},
+		{
+			name:      "prefix",
+			prefix:    "f/",
+			delimiter: "/",
+			maxKeys:   1000,
+			want:      [][]string{{"f/g/"}},
+		},
+		{
+			name:      "page size equals the keys",
+			prefix:    "b/",
+			delimiter: "/",
+			maxKeys:   2,
+			want:      [][]string{{"b/1.txt", "b/2.txt"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listAll(t, tt.prefix, tt.delimiter, tt.maxKeys); !slices.EqualFunc(got, tt.want, slices.Equal[[]string]) {
+				t.Errorf("pages = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHeadAndDownloadObject(t *testing.T) {
+	svc := newStubService(t, map[string]string{"builds/tidb.tar.gz": "tidb"})
+	ctx := context.Background()
+
+	head, err := svc.HeadObject(ctx, &s3.HeadObjectPayload{Endpoint: "minio", Bucket: "builds", Key: "builds/tidb.tar.gz"})
+	if err != nil {
+		t.Fatalf("HeadObject() error: %v", err)
+	}
+	if head.Length != 4 || !strings.Contains(head.ContentDisposition, "tidb.tar.gz") {
+		t.Errorf("HeadObject() = %+v", head)
+	}
+
+	res, body, err := svc.DownloadObject(ctx, &s3.DownloadObjectPayload{Endpoint: "minio", Bucket: "builds", Key: "builds/tidb.tar.gz"})
+	if err != nil {
+		t.Fatalf("DownloadObject() error: %v", err)
+	}
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Length != 4 || string(content) != "tidb" {
+		t.Errorf("DownloadObject() = %+v, %q", res, content)
+	}
+
+	_, err = svc.HeadObject(ctx, &s3.HeadObjectPayload{Endpoint: "minio", Bucket: "builds", Key: "missing"})
+	if err == nil {
+		t.Error("HeadObject() of missing object returns no error")
+	}
+}
+
+func TestEndpointLookupAndReload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cfgFile := writeConfig(t, dir, `endpoints:
+  minio:
+    endpoint: minio.example.com:9000
+    insecure: true
+    path_style: true
+`)
+	svc := New(log.New(io.Discard, "", 0), cfgFile).(*s3srvc)
+
+	if _, err := svc.getClient("minio"); err != nil {
+		t.Errorf("getClient(minio) error: %v", err)
+	}
+	var serviceErr interface{ GoaErrorName() string }
+	if _, err := svc.getClient("aws"); !errors.As(err, &serviceErr) {
+		t.Errorf("getClient(aws) error = %v, want the service error", err)
+	}
+
+	writeConfig(t, dir, `endpoints:
+  aws:
+    endpoint: s3.amazonaws.com
+    region: us-west-2
+`)
+	if err := svc.Reload(ctx, cfgFile); err != nil {
+		t.Fatalf("Reload() error: %v", err)
+	}
+	if _, err := svc.getClient("aws"); err != nil {
+		t.Errorf("getClient(aws) after reload error: %v", err)
+	}
+	if _, err := svc.getClient("minio"); err == nil {
+		t.Error("getClient(minio) after reload returns the removed endpoint")
+	}
+
+	// the clients are kept when the config is broken.
+	writeConfig(t, dir, "endpoints: [")
+	if err := svc.Reload(ctx, cfgFile); err == nil {
+		t.Error("Reload() of broken config returns no error")
+	}
+	if _, err := svc.getClient("aws"); err != nil {
+		t.Errorf("getClient(aws) after failed reload error: %v", err)
+	}
+
+	if err := svc.Reload(ctx, filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("Reload() of missing config returns no error")
+	}
+}
+
+func TestNewWithoutConfig(t *testing.T) {
+	svc := New(log.New(io.Discard, "", 0), "").(*s3srvc)
+	if _, err := svc.getClient("minio"); err == nil {
+		t.Error("getClient() without config returns no error")
+	}
+}
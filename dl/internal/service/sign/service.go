@@ -0,0 +1,78 @@
+package sign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	sign "github.com/PingCAP-QE/ee-apps/dl/gen/sign"
+	"github.com/PingCAP-QE/ee-apps/dl/pkg/signing"
+)
+
+// signablePaths are the download paths which links can be signed for.
+var signablePaths = []string{
+	"/oci-file/",
+	"/oci-file-entry/",
+	"/oci-bundle/",
+	"/s3-obj/",
+	"/gcs-obj/",
+	"/s3/",
+}
+
+// signsrvc implements the sign service.
+type signsrvc struct {
+	logger *log.Logger
+	signer *signing.Signer
+}
+
+// New returns the sign service implementation, links can not be signed when
+// signer is nil.
+func New(logger *log.Logger, signer *signing.Signer) sign.Service {
+	return &signsrvc{logger: logger, signer: signer}
+}
+
+// SignURL implements sign-url.
+func (s *signsrvc) SignURL(ctx context.Context, p *sign.SignURLPayload) (*sign.SignURLResult, error) {
+	s.logger.Print("sign.sign-url")
+	if s.signer == nil {
+		return nil, sign.MakeInvalidRequest(errors.New("link signing is not configured"))
+	}
+
+	u, err := url.Parse(p.Path)
+	if err != nil {
+		return nil, sign.MakeInvalidRequest(fmt.Errorf("invalid path: %w", err))
+	}
+	if u.IsAbs() || u.Host != "" || !isSignable(u.Path) {
+		return nil, sign.MakeInvalidRequest(fmt.Errorf("only download paths can be signed, such as %s", strings.Join(signablePaths, ", ")))
+	}
+	ttl, err := time.ParseDuration(p.TTL)
+	if err != nil {
+		return nil, sign.MakeInvalidRequest(fmt.Errorf("invalid ttl: %w", err))
+	}
+
+	link, expires, err := s.signer.Sign(u, ttl, time.Now())
+	if err != nil {
+		return nil, sign.MakeInvalidRequest(err)
+	}
+
+	return &sign.SignURLResult{URL: link, ExpiresAt: expires.UTC().Format(time.RFC3339)}, nil
+}
+
+// isSignable reports whether p is a download path, the paths with dot segments
+// are refused since they may be routed to other handlers.
+func isSignable(p string) bool {
+	if path.Clean(p) != p {
+		return false
+	}
+	for _, prefix := range signablePaths {
+		if strings.HasPrefix(p, prefix) {
+			return true
+		}
+	}
+	return false
+}
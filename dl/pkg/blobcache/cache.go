@@ -0,0 +1,273 @@
+// Package blobcache implements an on-disk cache of OCI blobs addressed by their
+// digests.
+//
+// Blobs are immutable once addressed by digest, so cached content never needs
+// to be invalidated; mutable references such as tags must be resolved to
+// descriptors by the caller before hitting the cache.
+package blobcache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// defaultIdleTimeout of the upstream fetches.
+const defaultIdleTimeout = time.Minute
+
+// FetchFunc opens the blob from the upstream when it's not in the cache.
+type FetchFunc func(ctx context.Context) (io.ReadCloser, error)
+
+// Cache is a size capped on-disk blob cache with LRU eviction. Concurrent
+// misses of the same digest share one upstream fetch, and the blob is served
+// to the clients while it's being written to the disk.
+type Cache struct {
+	dir     string
+	maxSize int64
+	logger  *log.Logger
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List // front is the most recently used.
+	entries map[digest.Digest]*list.Element
+	fills   map[digest.Digest]*fill
+
+	// idleTimeout fails the fills which receive no data from the upstream for
+	// the duration.
+	idleTimeout time.Duration
+}
+
+type entry struct {
+	digest digest.Digest
+	size   int64
+}
+
+// New creates a cache stored in dir, which holds at most maxSize bytes of
+// blobs. Blobs left in dir by previous runs are loaded into the cache.
+func New(dir string, maxSize int64, logger *log.Logger) (*Cache, error) {
+	c := &Cache{
+		dir:     dir,
+		maxSize: maxSize,
+		logger:  logger,
+		lru:     list.New(),
+		entries: make(map[digest.Digest]*list.Element),
+		fills:   make(map[digest.Digest]*fill),
+
+		idleTimeout: defaultIdleTimeout,
+	}
+
+	if err := os.MkdirAll(c.tmpDir(), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create cache dir %q: %w", dir, err)
+	}
+	// partial blobs of previous runs are useless.
+	if err := clearDir(c.tmpDir()); err != nil {
+		return nil, err
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Open returns a reader of the blob described by desc, it's served from the
+// disk when cached, otherwise the blob is fetched by fetch and cached. Readers
+// of a blob being fetched return when ctx is done.
+func (c *Cache) Open(ctx context.Context, desc ocispec.Descriptor, fetch FetchFunc) (io.ReadCloser, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if el, ok := c.entries[desc.Digest]; ok {
+		c.lru.MoveToFront(el)
+		c.mu.Unlock()
+
+		f, err := os.Open(c.blobPath(desc.Digest))
+		if err == nil {
+			return f, nil
+		}
+		// the blob file disappeared, drop it and fetch again.
+		c.logger.Printf("blobcache: failed to open cached blob %s: %v", desc.Digest, err)
+		c.mu.Lock()
+		if c.entries[desc.Digest] == el {
+			c.removeElement(el)
+		}
+	}
+
+	fl, joined := c.fills[desc.Digest]
+	if !joined {
+		fl = newFill()
+		c.fills[desc.Digest] = fl
+		fl.acquire() // released by finishFill.
+	}
+	// the file of the fill is kept open until its readers are closed.
+	fl.acquire()
+	c.mu.Unlock()
+
+	if !joined {
+		c.startFill(ctx, desc, fetch, fl)
+	}
+	return fl.newReader(ctx)
+}
+
+// startFill fetches the blob from upstream into a temporary file in the
+// background.
+func (c *Cache) startFill(ctx context.Context, desc ocispec.Descriptor, fetch FetchFunc, fl *fill) {
+	tmp, err := os.CreateTemp(c.tmpDir(), desc.Digest.Encoded()+"-*")
+	if err != nil {
+		c.finishFill(desc, fl, err)
+		return
+	}
+
+	fl.setFile(tmp)
+	go func() {
+		// the fill continues after the first client goes away, others may be
+		// waiting for it, a stalled upstream is bounded by the idle timeout.
+		err := fl.run(context.WithoutCancel(ctx), desc, fetch, c.idleTimeout)
+		c.finishFill(desc, fl, err)
+	}()
+}
+
+func (c *Cache) finishFill(desc ocispec.Descriptor, fl *fill, err error) {
+	// the readers of the fill keep reading the opened file after the rename.
+	path := fl.filePath()
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(c.blobPath(desc.Digest)), 0o755)
+	}
+	if err == nil {
+		err = os.Rename(path, c.blobPath(desc.Digest))
+	}
+
+	var evicted []digest.Digest
+	c.mu.Lock()
+	delete(c.fills, desc.Digest)
+	if err == nil {
+		evicted = c.add(desc.Digest, desc.Size)
+	}
+	c.mu.Unlock()
+	c.removeBlobs(evicted)
+
+	if err != nil {
+		c.logger.Printf("blobcache: failed to cache blob %s: %v", desc.Digest, err)
+		if path != "" {
+			os.Remove(path)
+		}
+	}
+	fl.finish(err)
+	fl.release()
+}
+
+// add records a blob in the cache and evicts the least recently used blobs
+// when the size cap is exceeded, the files of the evicted blobs should be
+// removed by removeBlobs. It must be called with c.mu held.
+func (c *Cache) add(d digest.Digest, size int64) []digest.Digest {
+	if _, ok := c.entries[d]; ok {
+		return nil
+	}
+	c.entries[d] = c.lru.PushFront(&entry{digest: d, size: size})
+	c.size += size
+
+	var evicted []digest.Digest
+	for c.size > c.maxSize && c.lru.Len() > 0 {
+		el := c.lru.Back()
+		c.removeElement(el)
+		evicted = append(evicted, el.Value.(*entry).digest)
+	}
+	return evicted
+}
+
+// removeBlobs removes the files of the evicted blobs, opened readers of the
+// removed files are not affected on unix.
+func (c *Cache) removeBlobs(digests []digest.Digest) {
+	for _, d := range digests {
+		if err := os.Remove(c.blobPath(d)); err != nil && !os.IsNotExist(err) {
+			c.logger.Printf("blobcache: failed to evict blob %s: %v", d, err)
+		}
+	}
+}
+
+// removeElement must be called with c.mu held.
+func (c *Cache) removeElement(el *list.Element) {
+	e := el.Value.(*entry)
+	c.lru.Remove(el)
+	delete(c.entries, e.digest)
+	c.size -= e.size
+}
+
+// load indexes the blobs stored in the cache dir, the recently modified ones
+// are treated as recently used.
+func (c *Cache) load() error {
+	type blob struct {
+		digest digest.Digest
+		info   fs.FileInfo
+	}
+
+	var blobs []blob
+	blobsDir := filepath.Join(c.dir, "blobs")
+	err := filepath.WalkDir(blobsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(blobsDir, path)
+		if err != nil {
+			return err
+		}
+		dg := digest.Digest(filepath.Dir(rel) + ":" + filepath.Base(rel))
+		if dg.Validate() != nil {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		blobs = append(blobs, blob{digest: dg, info: info})
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to load cache dir %q: %w", c.dir, err)
+	}
+
+	sort.Slice(blobs, func(i, j int) bool { return blobs[i].info.ModTime().Before(blobs[j].info.ModTime()) })
+	var evicted []digest.Digest
+	c.mu.Lock()
+	for _, b := range blobs {
+		evicted = append(evicted, c.add(b.digest, b.info.Size())...)
+	}
+	c.mu.Unlock()
+	c.removeBlobs(evicted)
+
+	return nil
+}
+
+func (c *Cache) blobPath(d digest.Digest) string {
+	return filepath.Join(c.dir, "blobs", d.Algorithm().String(), d.Encoded())
+}
+
+func (c *Cache) tmpDir() string {
+	return filepath.Join(c.dir, "tmp")
+}
+
+func clearDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
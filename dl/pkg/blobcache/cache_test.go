@@ -0,0 +1,238 @@
+package blobcache
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func newDesc(content string) ocispec.Descriptor {
+	return ocispec.Descriptor{
+		Digest: digest.FromString(content),
+		Size:   int64(len(content)),
+	}
+}
+
+func countingFetch(content string, calls *atomic.Int32) FetchFunc {
+	return func(context.Context) (io.ReadCloser, error) {
+		calls.Add(1)
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+}
+
+func readAll(t *testing.T, c *Cache, desc ocispec.Descriptor, fetch FetchFunc) string {
+	t.Helper()
+	rc, err := c.Open(context.Background(), desc, fetch)
+	if err != nil {
+		t.Fatalf("Open(%s) error: %v", desc.Digest, err)
+	}
+	defer rc.Close()
+
+	b, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", desc.Digest, err)
+	}
+	return string(b)
+}
+
+func TestCacheHit(t *testing.T) {
+	c, err := New(t.TempDir(), 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	content := "tidb-server binary"
+	desc := newDesc(content)
+	var calls atomic.Int32
+	for range 3 {
+		if got := readAll(t, c, desc, countingFetch(content, &calls)); got != content {
+			t.Fatalf("got %q, want %q", got, content)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("fetched upstream %d times, want 1", calls.Load())
+	}
+}
+
+func TestCacheConcurrentMisses(t *testing.T) {
+	c, err := New(t.TempDir(), 1<<20, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	content := strings.Repeat("0123456789", 50000)
+	desc := newDesc(content)
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fetch := func(context.Context) (io.ReadCloser, error) {
+		calls.Add(1)
+		<-release
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, 8)
+	for i := range results {
+		wg.Go(func() { results[i] = readAll(t, c, desc, fetch) })
+	}
+	close(release)
+	wg.Wait()
+
+	for i, got := range results {
+		if got != content {
+			t.Errorf("reader %d got %d bytes, want %d", i, len(got), len(content))
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("fetched upstream %d times, want 1", calls.Load())
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	c, err := New(t.TempDir(), 10, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	var callsA, callsB, callsC atomic.Int32
+	a, b, cc := "aaaa", "bbbb", "cccc"
+	readAll(t, c, newDesc(a), countingFetch(a, &callsA))
+	readAll(t, c, newDesc(b), countingFetch(b, &callsB))
+	// touch a, so b is the least recently used one.
+	readAll(t, c, newDesc(a), countingFetch(a, &callsA))
+	readAll(t, c, newDesc(cc), countingFetch(cc, &callsC))
+
+	readAll(t, c, newDesc(a), countingFetch(a, &callsA))
+	readAll(t, c, newDesc(b), countingFetch(b, &callsB))
+	if callsA.Load() != 1 {
+		t.Errorf("a fetched %d times, want 1", callsA.Load())
+	}
+	if callsB.Load() != 2 {
+		t.Errorf("b fetched %d times, want 2 since it should be evicted", callsB.Load())
+	}
+}
+
+func TestCacheDigestMismatch(t *testing.T) {
+	c, err := New(t.TempDir(), 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	desc := newDesc("expected")
+	rc, err := c.Open(context.Background(), desc, func(context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("tampered")), nil
+	})
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer rc.Close()
+	if _, err := io.ReadAll(rc); err == nil {
+		t.Fatal("reading a blob with mismatched digest should fail")
+	}
+
+	var calls atomic.Int32
+	if got := readAll(t, c, desc, countingFetch("expected", &calls)); got != "expected" || calls.Load() != 1 {
+		t.Errorf("the mismatched blob should not be cached, got %q with %d fetches", got, calls.Load())
+	}
+}
+
+func TestCacheFetchError(t *testing.T) {
+	c, err := New(t.TempDir(), 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	wantErr := errors.New("unauthorized")
+	_, err = c.Open(context.Background(), newDesc("x"), func(context.Context) (io.ReadCloser, error) {
+		return nil, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Open error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestCacheReload(t *testing.T) {
+	dir := t.TempDir()
+	c, err := New(dir, 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	var calls atomic.Int32
+	desc := newDesc("persisted")
+	readAll(t, c, desc, countingFetch("persisted", &calls))
+
+	c2, err := New(dir, 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	readAll(t, c2, desc, countingFetch("persisted", &calls))
+	if calls.Load() != 1 {
+		t.Errorf("fetched upstream %d times, want 1 after reloading cache dir", calls.Load())
+	}
+}
+
+// stalledReader returns the head, then blocks until it's closed.
+type stalledReader struct {
+	head   io.Reader
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (r *stalledReader) Read(p []byte) (int, error) {
+	if n, err := r.head.Read(p); err == nil {
+		return n, nil
+	}
+	<-r.closed
+	return 0, errors.New("read on closed body")
+}
+
+func (r *stalledReader) Close() error {
+	r.once.Do(func() { close(r.closed) })
+	return nil
+}
+
+func TestCacheStalledUpstream(t *testing.T) {
+	c, err := New(t.TempDir(), 1024, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+	c.idleTimeout = 50 * time.Millisecond
+
+	content := "stalled blob"
+	desc := newDesc(content)
+	stalled := func(context.Context) (io.ReadCloser, error) {
+		return &stalledReader{head: strings.NewReader(content[:4]), closed: make(chan struct{})}, nil
+	}
+
+	// the readers fail when the upstream stalls.
+	rc, err := c.Open(context.Background(), desc, stalled)
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer rc.Close()
+	if _, err := io.ReadAll(rc); err == nil {
+		t.Fatal("reading a stalled blob should fail")
+	}
+
+	// the readers return when their requests are cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	c.idleTimeout = time.Second
+	rc, err = c.Open(ctx, desc, stalled)
+	if err != nil {
+		t.Fatalf("Open error: %v", err)
+	}
+	defer rc.Close()
+	time.AfterFunc(50*time.Millisecond, cancel)
+	if _, err := io.ReadAll(rc); !errors.Is(err, context.Canceled) {
+		t.Errorf("read error = %v, want %v", err, context.Canceled)
+	}
+}
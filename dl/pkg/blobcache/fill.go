@@ -0,0 +1,221 @@
+package blobcache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// fill tracks a blob being written to a temporary file, readers follow the
+// written bytes until the fill finishes. The file is shared by the fill and
+// its readers, it's closed when all of them released it.
+type fill struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	file    *os.File
+	refs    int
+	started bool // the upstream fetch returned.
+	written int64
+	done    bool
+	err     error
+}
+
+func newFill() *fill {
+	fl := &fill{}
+	fl.cond = sync.NewCond(&fl.mu)
+	return fl
+}
+
+func (fl *fill) acquire() {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	fl.refs++
+}
+
+func (fl *fill) release() {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	fl.refs--
+	if fl.refs == 0 && fl.file != nil {
+		fl.file.Close()
+		fl.file = nil
+	}
+}
+
+func (fl *fill) setFile(f *os.File) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	fl.file = f
+}
+
+// filePath returns the path of the temporary file, it's empty when the file
+// failed to be created.
+func (fl *fill) filePath() string {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	if fl.file == nil {
+		return ""
+	}
+	return fl.file.Name()
+}
+
+// run fetches the blob and copies it into the file, verifying the size and
+// digest. The fetch fails when no data is received in idleTimeout.
+func (fl *fill) run(ctx context.Context, desc ocispec.Descriptor, fetch FetchFunc, idleTimeout time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	idle := time.AfterFunc(idleTimeout, cancel)
+	defer idle.Stop()
+
+	rc, err := fetch(ctx)
+	if err != nil {
+		return err
+	}
+	// the reads are interrupted by closing the body as well, in case it does
+	// not follow ctx.
+	stop := context.AfterFunc(ctx, func() { rc.Close() })
+	defer func() {
+		if stop() {
+			rc.Close()
+		}
+	}()
+
+	fl.mu.Lock()
+	fl.started = true
+	file := fl.file
+	fl.cond.Broadcast()
+	fl.mu.Unlock()
+
+	verifier := desc.Digest.Verifier()
+	buf := make([]byte, 256*1024)
+	for {
+		n, rerr := rc.Read(buf)
+		idle.Reset(idleTimeout)
+		if n > 0 {
+			if _, err := file.Write(buf[:n]); err != nil {
+				return err
+			}
+			verifier.Write(buf[:n])
+
+			fl.mu.Lock()
+			fl.written += int64(n)
+			fl.cond.Broadcast()
+			fl.mu.Unlock()
+		}
+		if errors.Is(rerr, io.EOF) {
+			break
+		}
+		if rerr != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("no data received from upstream in %s: %w", idleTimeout, rerr)
+			}
+			return rerr
+		}
+	}
+
+	fl.mu.Lock()
+	written := fl.written
+	fl.mu.Unlock()
+	if written != desc.Size {
+		return fmt.Errorf("size mismatch: got %d, want %d", written, desc.Size)
+	}
+	if !verifier.Verified() {
+		return fmt.Errorf("digest mismatch: want %s", desc.Digest)
+	}
+
+	return nil
+}
+
+func (fl *fill) finish(err error) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	fl.started = true
+	fl.done = true
+	fl.err = err
+	fl.cond.Broadcast()
+}
+
+// newReader waits for the upstream fetch, then returns a reader following the
+// file. It takes over the reference acquired for the reader, which is released
+// on failures or when the reader is closed.
+func (fl *fill) newReader(ctx context.Context) (io.ReadCloser, error) {
+	// wake up the waits when ctx is done.
+	stop := context.AfterFunc(ctx, func() {
+		fl.mu.Lock()
+		defer fl.mu.Unlock()
+		fl.cond.Broadcast()
+	})
+
+	fl.mu.Lock()
+	for !fl.started && ctx.Err() == nil {
+		fl.cond.Wait()
+	}
+	err := ctx.Err()
+	if err == nil && fl.done && fl.err != nil && fl.written == 0 {
+		err = fl.err
+	}
+	file := fl.file
+	fl.mu.Unlock()
+
+	if err != nil {
+		stop()
+		fl.release()
+		return nil, err
+	}
+	return &fillReader{ctx: ctx, fill: fl, file: file, stop: stop}, nil
+}
+
+type fillReader struct {
+	ctx    context.Context
+	fill   *fill
+	file   *os.File
+	stop   func() bool
+	offset int64
+}
+
+func (r *fillReader) Read(p []byte) (int, error) {
+	fl := r.fill
+	fl.mu.Lock()
+	for r.offset >= fl.written && !fl.done && r.ctx.Err() == nil {
+		fl.cond.Wait()
+	}
+	written, err := fl.written, fl.err
+	fl.mu.Unlock()
+
+	if r.offset >= written {
+		if cerr := r.ctx.Err(); cerr != nil {
+			return 0, cerr
+		}
+		if err != nil {
+			return 0, err
+		}
+		return 0, io.EOF
+	}
+
+	if remain := written - r.offset; int64(len(p)) > remain {
+		p = p[:remain]
+	}
+	n, rerr := r.file.ReadAt(p, r.offset)
+	r.offset += int64(n)
+	if errors.Is(rerr, io.EOF) {
+		rerr = nil
+	}
+	return n, rerr
+}
+
+func (r *fillReader) Close() error {
+	if r.fill == nil {
+		return nil
+	}
+	r.stop()
+	r.fill.release()
+	r.fill = nil
+	return nil
+}
@@ -0,0 +1,194 @@
+package checksum
+
+import (
+	"container/list"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Supported checksum algorithms.
+const (
+	SHA256 = "sha256"
+	SHA512 = "sha512"
+	MD5    = "md5"
+)
+
+// NewHash returns a new hash of the algorithm.
+func NewHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case SHA256:
+		return sha256.New(), nil
+	case SHA512:
+		return sha512.New(), nil
+	case MD5:
+		return md5.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+}
+
+// Compute reads r to the end and returns its checksum in hex.
+func Compute(r io.Reader, algorithm string) (string, error) {
+	h, err := NewHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// SumsFileName returns the conventional name of the checksum file, such as
+// `SHA256SUMS`.
+func SumsFileName(algorithm string) string {
+	return strings.ToUpper(algorithm) + "SUMS"
+}
+
+// Entry is a line of the checksum file.
+type Entry struct {
+	Checksum string
+	File     string
+}
+
+// FormatSums formats the entries in the format of `sha256sum` and friends, so
+// the file can be verified with `sha256sum -c SHA256SUMS`.
+func FormatSums(entries []Entry) string {
+	var b strings.Builder
+	for _, e := range entries {
+		b.WriteString(e.Checksum)
+		b.WriteString("  ")
+		b.WriteString(e.File)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// DefaultCacheSize bounds the number of computed checksums kept in memory.
+const DefaultCacheSize = 10000
+
+// DefaultComputeTimeout bounds the streaming of the content for a checksum.
+const DefaultComputeTimeout = 30 * time.Minute
+
+// Cache keeps the computed checksums keyed by the content identity, such as
+// the digest of the content, so the content is streamed once for each
+// algorithm, including the concurrent requests of the same content. The least
+// recently used entries are evicted.
+//
+// The computing is shared by the concurrent requests, so it's not canceled with
+// the request that started it, but bounded by the compute timeout.
+type Cache struct {
+	maxEntries int
+	timeout    time.Duration
+	group      singleflight.Group
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key   string
+	value string
+}
+
+// NewCache returns a cache holding up to maxEntries checksums.
+func NewCache(maxEntries int) *Cache {
+	return &Cache{
+		maxEntries: maxEntries,
+		timeout:    DefaultComputeTimeout,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get returns the checksum of the content in the algorithm, open is called to
+// stream the content when it's not cached. It returns when ctx is done, while
+// the computing goes on for the other requests and the cache.
+func (c *Cache) Get(ctx context.Context, content, algorithm string, open func(context.Context) (io.ReadCloser, error)) (string, error) {
+	key := content + "|" + algorithm
+	if value, ok := c.lookup(key); ok {
+		return value, nil
+	}
+
+	ch := c.group.DoChan(key, func() (any, error) {
+		// computed by the flight finished after the lookup above.
+		if value, ok := c.lookup(key); ok {
+			return value, nil
+		}
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+		value, err := ComputeContent(ctx, algorithm, open)
+		if err != nil {
+			return "", err
+		}
+		c.add(key, value)
+		return value, nil
+	})
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case res := <-ch:
+		return res.Val.(string), res.Err
+	}
+}
+
+// ComputeContent streams the content opened by open and returns its checksum,
+// the stream is closed when ctx is done in case the reads do not follow ctx.
+func ComputeContent(ctx context.Context, algorithm string, open func(context.Context) (io.ReadCloser, error)) (string, error) {
+	rc, err := open(ctx)
+	if err != nil {
+		return "", err
+	}
+	stop := context.AfterFunc(ctx, func() { rc.Close() })
+	defer func() {
+		if stop() {
+			rc.Close()
+		}
+	}()
+
+	value, err := Compute(rc, algorithm)
+	if err != nil {
+		if cerr := ctx.Err(); cerr != nil {
+			return "", fmt.Errorf("%w: %w", cerr, err)
+		}
+		return "", err
+	}
+	return value, nil
+}
+
+func (c *Cache) lookup(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return "", false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*cacheEntry).value, true
+}
+
+func (c *Cache) add(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.items[key]; ok {
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, value: value})
+	for c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,154 @@
+package checksum
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		want      string
+		wantErr   bool
+	}{
+		{algorithm: SHA256, want: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"},
+		{algorithm: SHA512, want: "309ecc489c12d6eb4cc40f50c902f2b4d0ed77ee511a7c7a9bcd3ca86d4cd86f989dd35bc5ff499670da34255b45b0cfd830e81f605dcf7dc5542e93ae9cd76f"},
+		{algorithm: MD5, want: "5eb63bbbe01eeed093cb22bb8f5acdc3"},
+		{algorithm: "crc32", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			got, err := Compute(strings.NewReader("hello world"), tt.algorithm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Compute() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSums(t *testing.T) {
+	got := FormatSums([]Entry{{Checksum: "abc", File: "a.tar.gz"}, {Checksum: "def", File: "b.tar.gz"}})
+	if want := "abc  a.tar.gz\ndef  b.tar.gz\n"; got != want {
+		t.Errorf("FormatSums() = %q, want %q", got, want)
+	}
+	if got := SumsFileName(SHA512); got != "SHA512SUMS" {
+		t.Errorf("SumsFileName() = %s, want SHA512SUMS", got)
+	}
+}
+
+func TestCache(t *testing.T) {
+	cache := NewCache(2)
+	var streamed int
+	open := func(context.Context) (io.ReadCloser, error) {
+		streamed++
+		return io.NopCloser(strings.NewReader("hello world")), nil
+	}
+
+	for _, content := range []string{"a", "a", "b", "c", "a"} {
+		if _, err := cache.Get(context.Background(), content, MD5, open); err != nil {
+			t.Fatalf("Get(%s) error: %v", content, err)
+		}
+	}
+	// "a" is cached at first, then evicted by "b" and "c".
+	if streamed != 4 {
+		t.Errorf("content streamed %d times, want 4", streamed)
+	}
+}
+
+func TestCacheConcurrentGet(t *testing.T) {
+	cache := NewCache(DefaultCacheSize)
+	var streamed atomic.Int32
+	release := make(chan struct{})
+	open := func(context.Context) (io.ReadCloser, error) {
+		streamed.Add(1)
+		<-release
+		return io.NopCloser(strings.NewReader("hello world")), nil
+	}
+
+	const n = 8
+	var wg sync.WaitGroup
+	results := make([]string, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = cache.Get(context.Background(), "a", SHA256, open)
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := streamed.Load(); got != 1 {
+		t.Errorf("content streamed %d times by %d concurrent requests, want 1", got, n)
+	}
+	for i, r := range results {
+		if r != "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9" {
+			t.Errorf("result %d = %q", i, r)
+		}
+	}
+}
+
+func TestCacheCanceledGet(t *testing.T) {
+	cache := NewCache(DefaultCacheSize)
+	release := make(chan struct{})
+	open := func(ctx context.Context) (io.ReadCloser, error) {
+		<-release
+		return io.NopCloser(strings.NewReader("hello world")), ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cache.Get(ctx, "a", SHA256, open); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Get() with canceled ctx error = %v, want context.Canceled", err)
+	}
+
+	// the computing started by the canceled request goes on and is cached.
+	close(release)
+	deadline := time.Now().Add(time.Second)
+	for {
+		if got, ok := cache.lookup("a|" + SHA256); ok {
+			if got != "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9" {
+				t.Errorf("cached checksum = %q", got)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("checksum not cached after the request was canceled")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// blockingReader blocks the reads until it's closed.
+type blockingReader struct{ closed chan struct{} }
+
+func (r *blockingReader) Read([]byte) (int, error) {
+	<-r.closed
+	return 0, io.ErrClosedPipe
+}
+
+func (r *blockingReader) Close() error {
+	close(r.closed)
+	return nil
+}
+
+func TestCacheComputeTimeout(t *testing.T) {
+	cache := NewCache(DefaultCacheSize)
+	cache.timeout = 10 * time.Millisecond
+	open := func(context.Context) (io.ReadCloser, error) {
+		return &blockingReader{closed: make(chan struct{})}, nil
+	}
+
+	if _, err := cache.Get(context.Background(), "a", SHA256, open); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() of stalled content error = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -3,4 +3,7 @@ package gcs
 type Config struct {
 	CredentialsFile string `yaml:"credentials_file,omitempty" json:"credentials_file,omitempty"`
 	CredentialsJSON string `yaml:"credentials_json,omitempty" json:"credentials_json,omitempty"`
+	// Redirect makes object downloads redirected to signed URLs by default,
+	// the credentials must be able to sign, such as a service account key.
+	Redirect bool `yaml:"redirect,omitempty" json:"redirect,omitempty"`
 }
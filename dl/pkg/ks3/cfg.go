@@ -5,4 +5,6 @@ type Config struct {
 	Endpoint  string `yaml:"endpoint,omitempty" json:"endpoint,omitempty"`
 	AccessKey string `yaml:"access_key,omitempty" json:"access_key,omitempty"`
 	SecretKey string `yaml:"secret_key,omitempty" json:"secret_key,omitempty"`
+	// Redirect makes object downloads redirected to presigned URLs by default.
+	Redirect bool `yaml:"redirect,omitempty" json:"redirect,omitempty"`
 }
@@ -0,0 +1,130 @@
+package oci
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+)
+
+// Supported bundle archive formats.
+const (
+	BundleFormatTar = "tar"
+	BundleFormatZip = "zip"
+)
+
+// BlobFetcher opens the blob content of a layer.
+type BlobFetcher func(ocispec.Descriptor) (io.ReadCloser, error)
+
+// NewBundleReadCloser returns a reader streaming a tar or zip archive built
+// from the artifact files accepted by match. The archive is generated on the
+// fly, so only one layer blob is open at any time.
+func NewBundleReadCloser(ctx context.Context, target oras.ReadOnlyTarget, tag, format string, match func(string) bool, fetch BlobFetcher) (io.ReadCloser, error) {
+	if format != BundleFormatTar && format != BundleFormatZip {
+		return nil, fmt.Errorf("unsupported bundle format: %s", format)
+	}
+
+	layers, err := listArtifactLayers(ctx, target, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []ocispec.Descriptor
+	for _, l := range layers {
+		if match(l.Annotations[AnnotationKeyFileName]) {
+			selected = append(selected, l)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no files matched in %s", tag)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeBundle(pw, format, selected, fetch))
+	}()
+
+	return pr, nil
+}
+
+func writeBundle(w io.Writer, format string, layers []ocispec.Descriptor, fetch BlobFetcher) error {
+	switch format {
+	case BundleFormatTar:
+		return writeTarBundle(w, layers, fetch)
+	case BundleFormatZip:
+		return writeZipBundle(w, layers, fetch)
+	default:
+		return fmt.Errorf("unsupported bundle format: %s", format)
+	}
+}
+
+func writeTarBundle(w io.Writer, layers []ocispec.Descriptor, fetch BlobFetcher) error {
+	tw := tar.NewWriter(w)
+	for _, l := range layers {
+		hdr := &tar.Header{
+			Name:     l.Annotations[AnnotationKeyFileName],
+			Typeflag: tar.TypeReg,
+			Mode:     0o644,
+			Size:     l.Size,
+			ModTime:  layerModTime(l),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if err := copyBlob(tw, l, fetch); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+func writeZipBundle(w io.Writer, layers []ocispec.Descriptor, fetch BlobFetcher) error {
+	zw := zip.NewWriter(w)
+	for _, l := range layers {
+		fh := &zip.FileHeader{
+			Name:     l.Annotations[AnnotationKeyFileName],
+			Method:   zip.Store, // layers are mostly compressed already.
+			Modified: layerModTime(l),
+		}
+		fh.SetMode(0o644)
+		fw, err := zw.CreateHeader(fh)
+		if err != nil {
+			return err
+		}
+		if err := copyBlob(fw, l, fetch); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func copyBlob(w io.Writer, desc ocispec.Descriptor, fetch BlobFetcher) error {
+	rc, err := fetch(desc)
+	if err != nil {
+		return fmt.Errorf("failed to fetch %s: %w", desc.Annotations[AnnotationKeyFileName], err)
+	}
+	defer rc.Close()
+
+	if _, err := io.CopyN(w, rc, desc.Size); err != nil {
+		return fmt.Errorf("failed to copy %s: %w", desc.Annotations[AnnotationKeyFileName], err)
+	}
+	return nil
+}
+
+// layerModTime returns the creation time annotated on the layer, or the current
+// time when it is absent or malformed.
+func layerModTime(desc ocispec.Descriptor) time.Time {
+	if v, ok := desc.Annotations[ocispec.AnnotationCreated]; ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+	return time.Now()
+}
@@ -0,0 +1,91 @@
+package oci
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestWriteBundle(t *testing.T) {
+	contents := map[string]string{
+		"tidb-v8.5.0-darwin-arm64.tar.gz": "tidb",
+		"pd-v8.5.0-darwin-arm64.tar.gz":   "pd-server",
+	}
+	var layers []ocispec.Descriptor
+	for _, name := range []string{"tidb-v8.5.0-darwin-arm64.tar.gz", "pd-v8.5.0-darwin-arm64.tar.gz"} {
+		layers = append(layers, ocispec.Descriptor{
+			Size:        int64(len(contents[name])),
+			Annotations: map[string]string{AnnotationKeyFileName: name},
+		})
+	}
+	fetch := func(desc ocispec.Descriptor) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(contents[desc.Annotations[AnnotationKeyFileName]])), nil
+	}
+
+	t.Run("tar", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeBundle(&buf, BundleFormatTar, layers, fetch); err != nil {
+			t.Fatalf("writeBundle error: %v", err)
+		}
+
+		tr := tar.NewReader(&buf)
+		got := map[string]string{}
+		for {
+			hdr, err := tr.Next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				t.Fatalf("failed to read tar: %v", err)
+			}
+			b, _ := io.ReadAll(tr)
+			got[hdr.Name] = string(b)
+		}
+		if len(got) != len(contents) {
+			t.Fatalf("got %d entries, want %d", len(got), len(contents))
+		}
+		for name, want := range contents {
+			if got[name] != want {
+				t.Errorf("entry %q = %q, want %q", name, got[name], want)
+			}
+		}
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := writeBundle(&buf, BundleFormatZip, layers, fetch); err != nil {
+			t.Fatalf("writeBundle error: %v", err)
+		}
+
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatalf("failed to read zip: %v", err)
+		}
+		if len(zr.File) != len(contents) {
+			t.Fatalf("got %d entries, want %d", len(zr.File), len(contents))
+		}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			if err != nil {
+				t.Fatalf("failed to open %q: %v", f.Name, err)
+			}
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			if string(b) != contents[f.Name] {
+				t.Errorf("entry %q = %q, want %q", f.Name, b, contents[f.Name])
+			}
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		if err := writeBundle(io.Discard, "rar", layers, fetch); err == nil {
+			t.Error("writeBundle with unsupported format should fail")
+		}
+	})
+}
@@ -3,4 +3,7 @@ package oci
 type Config struct {
 	Username string `yaml:"username,omitempty" json:"username,omitempty"`
 	Password string `yaml:"password,omitempty" json:"password,omitempty"`
+	// Redirect makes file downloads redirected to the blob location of the
+	// registry by default, when the registry redirects blob downloads.
+	Redirect bool `yaml:"redirect,omitempty" json:"redirect,omitempty"`
 }
@@ -58,10 +58,21 @@ func GetFileSHA256(ctx context.Context, repository oras.ReadOnlyTarget, tag, fil
 
 func listArtifactLayers(ctx context.Context, target oras.ReadOnlyTarget, ref string) ([]ocispec.Descriptor, error) {
 	// fetch manifest manifestBytes
-	_, manifestBytes, err := oras.FetchBytes(ctx, target, ref, oras.DefaultFetchBytesOptions)
+	desc, manifestBytes, err := oras.FetchBytes(ctx, target, ref, oras.DefaultFetchBytesOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch the content of %q: %w", ref, err)
 	}
+	// the index is usable without platform when it has one manifest or the tag
+	// hints the platform.
+	if isIndex(desc.MediaType) {
+		manifestDesc, err := selectPlatformManifest(manifestBytes, "", PlatformHint(ref))
+		if err != nil {
+			return nil, err
+		}
+		if _, manifestBytes, err = oras.FetchBytes(ctx, target, manifestDesc.Digest.String(), oras.DefaultFetchBytesOptions); err != nil {
+			return nil, fmt.Errorf("failed to fetch the content of %q: %w", manifestDesc.Digest, err)
+		}
+	}
 	var manifest ocispec.Manifest
 	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
 		return nil, err
@@ -70,6 +81,23 @@ func listArtifactLayers(ctx context.Context, target oras.ReadOnlyTarget, ref str
 	return manifest.Layers, nil
 }
 
+// ListFileDescriptors returns the descriptors of the file layers in an OCI
+// artifact, the layers without file names are left out.
+func ListFileDescriptors(ctx context.Context, target oras.ReadOnlyTarget, ref string) ([]ocispec.Descriptor, error) {
+	layers, err := listArtifactLayers(ctx, target, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []ocispec.Descriptor
+	for _, l := range layers {
+		if l.Annotations[AnnotationKeyFileName] != "" {
+			ret = append(ret, l)
+		}
+	}
+	return ret, nil
+}
+
 // FetchFileDescriptor finds the descriptor for a specific file in an OCI artifact.
 func FetchFileDescriptor(ctx context.Context, target oras.ReadOnlyTarget, ref, filename string) (*ocispec.Descriptor, error) {
 	layers, err := listArtifactLayers(ctx, target, ref)
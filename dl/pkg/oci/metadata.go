@@ -0,0 +1,67 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// maxConfigSize limits the artifact config to be fetched, the configs of our
+// artifacts are tiny json documents.
+const maxConfigSize = 4 * 1024 * 1024
+
+// ArtifactMetadata is the metadata of an OCI artifact.
+type ArtifactMetadata struct {
+	// Digest is the manifest digest.
+	Digest       string
+	MediaType    string
+	ArtifactType string
+	// Config is the parsed config json, nil when the config is not a json object.
+	Config      map[string]any
+	Annotations map[string]string
+	Layers      []ocispec.Descriptor
+}
+
+// FetchArtifactMetadata fetches the manifest and config of the artifact.
+func FetchArtifactMetadata(ctx context.Context, target oras.ReadOnlyTarget, ref string) (*ArtifactMetadata, error) {
+	desc, manifestBytes, err := oras.FetchBytes(ctx, target, ref, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the content of %q: %w", ref, err)
+	}
+	if isIndex(desc.MediaType) {
+		return nil, fmt.Errorf("%q is an image index, a platform is required", ref)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, err
+	}
+
+	ret := &ArtifactMetadata{
+		Digest:       desc.Digest.String(),
+		MediaType:    desc.MediaType,
+		ArtifactType: manifest.ArtifactType,
+		Annotations:  manifest.Annotations,
+		Layers:       manifest.Layers,
+	}
+	if ret.ArtifactType == "" {
+		ret.ArtifactType = manifest.Config.MediaType
+	}
+
+	if manifest.Config.Size > 0 && manifest.Config.Size <= maxConfigSize {
+		configBytes, err := content.FetchAll(ctx, target, manifest.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch artifact config: %w", err)
+		}
+		// configs of other formats are left out.
+		if err := json.Unmarshal(configBytes, &ret.Config); err != nil {
+			ret.Config = nil
+		}
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,66 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	specs "github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+func pushBlob(t *testing.T, store *memory.Store, mediaType string, blob []byte) ocispec.Descriptor {
+	t.Helper()
+	desc := ocispec.Descriptor{MediaType: mediaType, Digest: digest.FromBytes(blob), Size: int64(len(blob))}
+	if err := store.Push(context.Background(), desc, bytes.NewReader(blob)); err != nil {
+		t.Fatalf("failed to push blob: %v", err)
+	}
+	return desc
+}
+
+func TestFetchArtifactMetadata(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	config := pushBlob(t, store, "application/vnd.pingcap.tidb.config.v1+json", []byte(`{"net.pingcap.tibuild.git-sha":"abc123","org.opencontainers.image.version":"v8.5.0"}`))
+	layer := pushBlob(t, store, "application/gzip", []byte("tarball"))
+	layer.Annotations = map[string]string{AnnotationKeyFileName: "tidb-v8.5.0-linux-amd64.tar.gz"}
+	manifestBytes, err := json.Marshal(ocispec.Manifest{
+		Versioned:   specs.Versioned{SchemaVersion: 2},
+		MediaType:   ocispec.MediaTypeImageManifest,
+		Config:      config,
+		Layers:      []ocispec.Descriptor{layer},
+		Annotations: map[string]string{"net.pingcap.tibuild.os": "linux"},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal manifest: %v", err)
+	}
+	manifest := pushBlob(t, store, ocispec.MediaTypeImageManifest, manifestBytes)
+	if err := store.Tag(ctx, manifest, "v8.5.0_linux_amd64"); err != nil {
+		t.Fatalf("failed to tag manifest: %v", err)
+	}
+
+	got, err := FetchArtifactMetadata(ctx, store, "v8.5.0_linux_amd64")
+	if err != nil {
+		t.Fatalf("FetchArtifactMetadata error: %v", err)
+	}
+
+	if got.Digest != manifest.Digest.String() {
+		t.Errorf("Digest = %s, want %s", got.Digest, manifest.Digest)
+	}
+	if got.ArtifactType != config.MediaType {
+		t.Errorf("ArtifactType = %s, want %s", got.ArtifactType, config.MediaType)
+	}
+	if got.Config["net.pingcap.tibuild.git-sha"] != "abc123" {
+		t.Errorf("Config = %v, want git sha abc123", got.Config)
+	}
+	if got.Annotations["net.pingcap.tibuild.os"] != "linux" {
+		t.Errorf("Annotations = %v, want os linux", got.Annotations)
+	}
+	if len(got.Layers) != 1 || got.Layers[0].Annotations[AnnotationKeyFileName] != "tidb-v8.5.0-linux-amd64.tar.gz" {
+		t.Errorf("Layers = %v, want the tarball layer", got.Layers)
+	}
+}
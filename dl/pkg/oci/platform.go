@@ -0,0 +1,146 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+)
+
+// Media types of image indexes.
+const (
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+)
+
+// AmbiguousPlatformError is returned when an image index contains manifests for
+// several platforms and none of them is chosen.
+type AmbiguousPlatformError struct {
+	Platforms []string
+}
+
+func (e *AmbiguousPlatformError) Error() string {
+	return fmt.Sprintf("multiple platforms are available, please specify one of: %s", strings.Join(e.Platforms, ", "))
+}
+
+// ResolveReference resolves ref to the digest of the manifest for the given
+// platform when it points to an image index, platform is in `os/arch[/variant]`
+// format. When it is empty, the platform hinted by the tag suffix is used, see
+// PlatformHint. The returned digest pins the manifest, so following lookups are
+// not affected by tag updates.
+func ResolveReference(ctx context.Context, target oras.ReadOnlyTarget, ref, platform string) (string, error) {
+	desc, content, err := oras.FetchBytes(ctx, target, ref, oras.DefaultFetchBytesOptions)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the content of %q: %w", ref, err)
+	}
+	if !isIndex(desc.MediaType) {
+		return desc.Digest.String(), nil
+	}
+
+	manifestDesc, err := selectPlatformManifest(content, platform, PlatformHint(ref))
+	if err != nil {
+		return "", err
+	}
+	return manifestDesc.Digest.String(), nil
+}
+
+// PlatformHint returns the platform in `os/arch` format hinted by the
+// `_<os>_<arch>` suffix of our tags, such as `v8.5.0_linux_amd64`. It is empty
+// when the tag has no such suffix.
+func PlatformHint(tag string) string {
+	_, suffix := splitTagSuffix(tag)
+	os, arch, ok := strings.Cut(suffix, "_")
+	if !ok || os == "" || arch == "" || strings.Contains(arch, "_") {
+		return ""
+	}
+	return os + "/" + arch
+}
+
+func isIndex(mediaType string) bool {
+	return mediaType == ocispec.MediaTypeImageIndex || mediaType == mediaTypeDockerManifestList
+}
+
+// selectPlatformManifest picks the manifest matching platform in the image index.
+// Without platform, the only manifest or the one matching the hint is picked,
+// else AmbiguousPlatformError is returned.
+func selectPlatformManifest(indexBytes []byte, platform, hint string) (*ocispec.Descriptor, error) {
+	var index ocispec.Index
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return nil, err
+	}
+	if len(index.Manifests) == 0 {
+		return nil, fmt.Errorf("no manifests in the image index")
+	}
+
+	if platform == "" {
+		if len(index.Manifests) == 1 {
+			return &index.Manifests[0], nil
+		}
+		if want, err := ParsePlatform(hint); err == nil {
+			if m := findPlatformManifest(index, want); m != nil {
+				return m, nil
+			}
+		}
+		return nil, &AmbiguousPlatformError{Platforms: indexPlatforms(index)}
+	}
+
+	want, err := ParsePlatform(platform)
+	if err != nil {
+		return nil, err
+	}
+	if m := findPlatformManifest(index, want); m != nil {
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("platform %s not found, available: %s", platform, strings.Join(indexPlatforms(index), ", "))
+}
+
+func findPlatformManifest(index ocispec.Index, want ocispec.Platform) *ocispec.Descriptor {
+	for i, m := range index.Manifests {
+		if m.Platform != nil && matchPlatform(*m.Platform, want) {
+			return &index.Manifests[i]
+		}
+	}
+	return nil
+}
+
+// ParsePlatform parses platform string in `os/arch[/variant]` format.
+func ParsePlatform(platform string) (ocispec.Platform, error) {
+	parts := strings.Split(platform, "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform %q, want format os/arch[/variant]", platform)
+	}
+
+	p := ocispec.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+func matchPlatform(got, want ocispec.Platform) bool {
+	if got.OS != want.OS || got.Architecture != want.Architecture {
+		return false
+	}
+	return want.Variant == "" || got.Variant == want.Variant
+}
+
+func formatPlatform(p ocispec.Platform) string {
+	ret := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		ret += "/" + p.Variant
+	}
+	return ret
+}
+
+func indexPlatforms(index ocispec.Index) []string {
+	var ret []string
+	for _, m := range index.Manifests {
+		if m.Platform != nil {
+			ret = append(ret, formatPlatform(*m.Platform))
+		}
+	}
+	return ret
+}
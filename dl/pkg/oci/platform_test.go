@@ -0,0 +1,96 @@
+package oci
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestSelectPlatformManifest(t *testing.T) {
+	newManifest := func(content string, p *ocispec.Platform) ocispec.Descriptor {
+		return ocispec.Descriptor{
+			MediaType: ocispec.MediaTypeImageManifest,
+			Digest:    digest.FromString(content),
+			Platform:  p,
+		}
+	}
+	amd64 := newManifest("amd64", &ocispec.Platform{OS: "linux", Architecture: "amd64"})
+	arm64 := newManifest("arm64", &ocispec.Platform{OS: "linux", Architecture: "arm64", Variant: "v8"})
+	darwin := newManifest("darwin", &ocispec.Platform{OS: "darwin", Architecture: "arm64"})
+
+	indexOf := func(manifests ...ocispec.Descriptor) []byte {
+		b, err := json.Marshal(ocispec.Index{MediaType: ocispec.MediaTypeImageIndex, Manifests: manifests})
+		if err != nil {
+			t.Fatalf("failed to marshal index: %v", err)
+		}
+		return b
+	}
+
+	tests := []struct {
+		name          string
+		index         []byte
+		platform      string
+		hint          string
+		want          digest.Digest
+		wantAmbiguous bool
+		wantErr       bool
+	}{
+		{name: "exact platform", index: indexOf(amd64, arm64, darwin), platform: "linux/arm64/v8", want: arm64.Digest},
+		{name: "platform without variant", index: indexOf(amd64, arm64, darwin), platform: "linux/arm64", want: arm64.Digest},
+		{name: "os distinguishes", index: indexOf(amd64, arm64, darwin), platform: "darwin/arm64", want: darwin.Digest},
+		{name: "single manifest without platform", index: indexOf(amd64), want: amd64.Digest},
+		{name: "ambiguous without platform", index: indexOf(amd64, arm64), wantAmbiguous: true},
+		{name: "hinted platform without platform", index: indexOf(amd64, arm64, darwin), hint: "darwin/arm64", want: darwin.Digest},
+		{name: "platform overrides hint", index: indexOf(amd64, arm64), platform: "linux/amd64", hint: "linux/arm64", want: amd64.Digest},
+		{name: "ambiguous with unmatched hint", index: indexOf(amd64, arm64), hint: "windows/amd64", wantAmbiguous: true},
+		{name: "platform not found", index: indexOf(amd64, arm64), platform: "windows/amd64", wantErr: true},
+		{name: "malformed platform", index: indexOf(amd64, arm64), platform: "linux", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectPlatformManifest(tt.index, tt.platform, tt.hint)
+			if tt.wantAmbiguous {
+				var ambiguous *AmbiguousPlatformError
+				if !errors.As(err, &ambiguous) {
+					t.Fatalf("error = %v, want AmbiguousPlatformError", err)
+				}
+				if len(ambiguous.Platforms) != 2 {
+					t.Errorf("available platforms = %v, want 2 items", ambiguous.Platforms)
+				}
+				return
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("want error, got %v", got.Digest)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Digest != tt.want {
+				t.Errorf("selected %s, want %s", got.Digest, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlatformHint(t *testing.T) {
+	tests := map[string]string{
+		"v8.5.0_linux_amd64":           "linux/amd64",
+		"v8.5.0-pre_darwin_arm64":      "darwin/arm64",
+		"v8.5.0":                       "",
+		"master_linux":                 "",
+		"v8.5.0_linux_amd64_extra":     "",
+		"sha256:0123456789abcdef01234": "",
+	}
+	for tag, want := range tests {
+		if got := PlatformHint(tag); got != want {
+			t.Errorf("PlatformHint(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// BlobLocation returns the URL which the registry redirects the blob download
+// to, such as a presigned URL of the storage backend. When the registry serves
+// the blob by itself, the location is empty and the blob content is returned
+// instead, so it is not fetched twice. The caller must close the content.
+func BlobLocation(ctx context.Context, repository *remote.Repository, desc ocispec.Descriptor) (string, io.ReadCloser, error) {
+	client, ok := repository.Client.(*auth.Client)
+	if !ok {
+		return "", nil, errors.New("blob redirects require an auth client")
+	}
+
+	// keep the auth flow of the client, but stop at the first redirect.
+	httpClient := http.Client{}
+	if client.Client != nil {
+		httpClient = *client.Client
+	}
+	httpClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	noRedirectClient := *client
+	noRedirectClient.Client = &httpClient
+
+	scheme := "https"
+	if repository.PlainHTTP {
+		scheme = "http"
+	}
+	blobURL := fmt.Sprintf("%s://%s/v2/%s/blobs/%s", scheme, repository.Reference.Host(), repository.Reference.Repository, desc.Digest)
+	// GET rather than HEAD, since the presigned URLs of the storage backends
+	// are signed for the request method.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, blobURL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := noRedirectClient.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to request blob %s: %w", desc.Digest, err)
+	}
+	if resp.StatusCode == http.StatusOK {
+		return "", resp.Body, nil
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		location, err := resp.Location()
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid redirect of blob %s: %w", desc.Digest, err)
+		}
+		return location.String(), nil, nil
+	default:
+		return "", nil, fmt.Errorf("failed to request blob %s: unexpected status %s", desc.Digest, resp.Status)
+	}
+}
@@ -0,0 +1,76 @@
+package oci
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+func TestBlobLocation(t *testing.T) {
+	redirected := ocispec.Descriptor{Digest: digest.FromString("redirected")}
+	served := ocispec.Descriptor{Digest: digest.FromString("served")}
+	missing := ocispec.Descriptor{Digest: digest.FromString("missing")}
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/pingcap/tidb/package/blobs/" + redirected.Digest.String():
+			http.Redirect(w, r, "https://storage.example.com/blob?sig=abc", http.StatusTemporaryRedirect)
+		case "/v2/pingcap/tidb/package/blobs/" + served.Digest.String():
+			_, _ = w.Write([]byte("served"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer registry.Close()
+
+	repository, err := remote.NewRepository(strings.TrimPrefix(registry.URL, "http://") + "/pingcap/tidb/package")
+	if err != nil {
+		t.Fatalf("failed to create repository: %v", err)
+	}
+	repository.PlainHTTP = true
+	repository.Client = &auth.Client{Client: registry.Client()}
+
+	tests := []struct {
+		name    string
+		desc    ocispec.Descriptor
+		want    string
+		content string
+		wantErr bool
+	}{
+		{name: "redirected", desc: redirected, want: "https://storage.example.com/blob?sig=abc"},
+		{name: "served by registry", desc: served, want: "", content: "served"},
+		{name: "missing", desc: missing, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, content, err := BlobLocation(context.Background(), repository, tt.desc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BlobLocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BlobLocation() = %q, want %q", got, tt.want)
+			}
+			if (content != nil) != (tt.content != "") {
+				t.Fatalf("BlobLocation() content = %v, want %q", content, tt.content)
+			}
+			if content != nil {
+				defer content.Close()
+				b, err := io.ReadAll(content)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(b) != tt.content {
+					t.Errorf("BlobLocation() content = %q, want %q", b, tt.content)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/mod/semver"
+	"golang.org/x/sync/singleflight"
+	"oras.land/oras-go/v2/registry"
+)
+
+// Tag selectors resolved to the highest matched tag.
+//
+//   - `~vX` and `~vX.Y`: the highest GA version in the major or minor series.
+//   - `latest-ga`: the highest GA version.
+//
+// A literal `latest` is not a selector, since real `latest` tags exist.
+//
+// A platform suffix in the same format as our tags can be appended, such as
+// `~v8.5_linux_amd64`, then only tags with the same suffix are selected.
+const (
+	selectorPrefixSeries = "~"
+	selectorLatestGA     = "latest-ga"
+)
+
+// IsTagSelector reports whether the tag is a selector to be resolved.
+func IsTagSelector(tag string) bool {
+	constraint, _ := splitTagSuffix(tag)
+	return strings.HasPrefix(constraint, selectorPrefixSeries) || constraint == selectorLatestGA
+}
+
+// ResolveTagSelector lists the tags of the repository and returns the highest
+// one matched by the selector.
+func ResolveTagSelector(ctx context.Context, repo registry.TagLister, selector string) (string, error) {
+	tags, err := listTags(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	return selectTag(tags, selector)
+}
+
+func listTags(ctx context.Context, repo registry.TagLister) ([]string, error) {
+	var tags []string
+	if err := repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// DefaultTagCacheTTL is how long the listed tags of a repository are reused
+// for resolving the selectors.
+const DefaultTagCacheTTL = time.Minute
+
+// tagListTimeout bounds the listing shared by the concurrent resolvings, it's
+// not canceled with the request that started it.
+const tagListTimeout = time.Minute
+
+// TagCache resolves the tag selectors with the tags of the repositories listed
+// in the last ttl, the concurrent resolvings of a repository share the listing.
+type TagCache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mu    sync.Mutex
+	items map[string]tagCacheEntry
+}
+
+type tagCacheEntry struct {
+	tags    []string
+	expires time.Time
+}
+
+// NewTagCache returns a cache keeping the listed tags for ttl.
+func NewTagCache(ttl time.Duration) *TagCache {
+	return &TagCache{ttl: ttl, now: time.Now, items: make(map[string]tagCacheEntry)}
+}
+
+// Resolve returns the highest tag of the repository matched by the selector,
+// the repository name is the key of the cached tags of repo.
+func (c *TagCache) Resolve(ctx context.Context, repository string, repo registry.TagLister, selector string) (string, error) {
+	tags, ok := c.lookup(repository)
+	if !ok {
+		ch := c.group.DoChan(repository, func() (any, error) {
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tagListTimeout)
+			defer cancel()
+			tags, err := listTags(ctx, repo)
+			if err != nil {
+				return nil, err
+			}
+			c.add(repository, tags)
+			return tags, nil
+		})
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case res := <-ch:
+			if res.Err != nil {
+				return "", res.Err
+			}
+			tags = res.Val.([]string)
+		}
+	}
+
+	return selectTag(tags, selector)
+}
+
+func (c *TagCache) lookup(repository string) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[repository]
+	if !ok || !c.now().Before(e.expires) {
+		return nil, false
+	}
+	return e.tags, true
+}
+
+// add keeps the tags of the repository, dropping the expired entries.
+func (c *TagCache) add(repository string, tags []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for k, e := range c.items {
+		if !now.Before(e.expires) {
+			delete(c.items, k)
+		}
+	}
+	c.items[repository] = tagCacheEntry{tags: tags, expires: now.Add(c.ttl)}
+}
+
+func selectTag(tags []string, selector string) (string, error) {
+	constraint, suffix := splitTagSuffix(selector)
+	match, err := versionMatcher(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	var best, bestVersion string
+	for _, tag := range tags {
+		version, tagSuffix := splitTagSuffix(tag)
+		if tagSuffix != suffix || !semver.IsValid(version) || !match(version) {
+			continue
+		}
+		// build metadata is ignored by semver comparing, the tag name breaks ties.
+		if best == "" || semver.Compare(version, bestVersion) > 0 || (semver.Compare(version, bestVersion) == 0 && tag > best) {
+			best, bestVersion = tag, version
+		}
+	}
+	if best == "" {
+		return "", fmt.Errorf("no tag matched selector %q", selector)
+	}
+
+	return best, nil
+}
+
+func versionMatcher(constraint string) (func(string) bool, error) {
+	switch {
+	case constraint == selectorLatestGA:
+		return func(v string) bool { return semver.Prerelease(v) == "" }, nil
+	case strings.HasPrefix(constraint, selectorPrefixSeries):
+		series := strings.TrimPrefix(constraint, selectorPrefixSeries)
+		if !strings.HasPrefix(series, "v") {
+			series = "v" + series
+		}
+		if !semver.IsValid(series) || strings.Count(series, ".") > 1 {
+			return nil, fmt.Errorf("invalid selector %q, want ~vX or ~vX.Y", constraint)
+		}
+		seriesOf := semver.Major
+		if strings.Contains(series, ".") {
+			seriesOf = semver.MajorMinor
+		}
+		return func(v string) bool {
+			return semver.Prerelease(v) == "" && seriesOf(v) == seriesOf(series)
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid selector %q", constraint)
+	}
+}
+
+// splitTagSuffix splits tags in `<version>_<os>_<arch>` format into the version
+// and the platform suffix.
+func splitTagSuffix(tag string) (string, string) {
+	version, suffix, _ := strings.Cut(tag, "_")
+	return version, suffix
+}
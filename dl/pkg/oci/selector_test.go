@@ -0,0 +1,109 @@
+package oci
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSelectTag(t *testing.T) {
+	tags := []string{
+		"v8.5.0_linux_amd64",
+		"v8.5.2_linux_amd64",
+		"v8.5.10_linux_amd64",
+		"v8.5.11-alpha_linux_amd64",
+		"v8.5.3_darwin_arm64",
+		"v8.4.9_linux_amd64",
+		"v9.0.0-beta.1_linux_amd64",
+		"v8.5.4",
+		"master_linux_amd64",
+	}
+
+	tests := []struct {
+		selector string
+		want     string
+		wantErr  bool
+	}{
+		{selector: "~v8.5_linux_amd64", want: "v8.5.10_linux_amd64"},
+		{selector: "~8.5_linux_amd64", want: "v8.5.10_linux_amd64"},
+		{selector: "~v8_linux_amd64", want: "v8.5.10_linux_amd64"},
+		{selector: "~v8.4_linux_amd64", want: "v8.4.9_linux_amd64"},
+		{selector: "~v8.5_darwin_arm64", want: "v8.5.3_darwin_arm64"},
+		{selector: "~v8.5", want: "v8.5.4"},
+		{selector: "latest-ga_linux_amd64", want: "v8.5.10_linux_amd64"},
+		{selector: "~v7.5_linux_amd64", wantErr: true},
+		{selector: "~v8.5.1_linux_amd64", wantErr: true},
+		{selector: "~master_linux_amd64", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			if !IsTagSelector(tt.selector) {
+				t.Fatalf("IsTagSelector(%q) = false", tt.selector)
+			}
+			got, err := selectTag(tags, tt.selector)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("selectTag(%q) = %q, want error", tt.selector, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectTag(%q) error: %v", tt.selector, err)
+			}
+			if got != tt.want {
+				t.Errorf("selectTag(%q) = %q, want %q", tt.selector, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTagSelector(t *testing.T) {
+	for _, tag := range []string{"v8.5.0_linux_amd64", "master", "nightly_linux_amd64", "latest-dev", "latest", "latest_linux_amd64"} {
+		if IsTagSelector(tag) {
+			t.Errorf("IsTagSelector(%q) = true, want false", tag)
+		}
+	}
+}
+
+type countingTagLister struct {
+	tags  []string
+	calls int
+}
+
+func (l *countingTagLister) Tags(_ context.Context, _ string, fn func([]string) error) error {
+	l.calls++
+	return fn(l.tags)
+}
+
+func TestTagCache(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	c := NewTagCache(time.Minute)
+	c.now = func() time.Time { return now }
+	repo := &countingTagLister{tags: []string{"v8.5.0_linux_amd64", "v8.5.1_linux_amd64"}}
+
+	for _, selector := range []string{"~v8.5_linux_amd64", "latest-ga_linux_amd64"} {
+		got, err := c.Resolve(ctx, "pingcap/tidb/package", repo, selector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "v8.5.1_linux_amd64" {
+			t.Errorf("Resolve(%q) = %q, want v8.5.1_linux_amd64", selector, got)
+		}
+	}
+	if repo.calls != 1 {
+		t.Errorf("tags listed %d times, want 1", repo.calls)
+	}
+
+	// the tags are listed again after the ttl.
+	repo.tags = append(repo.tags, "v8.5.2_linux_amd64")
+	now = now.Add(time.Minute)
+	got, err := c.Resolve(ctx, "pingcap/tidb/package", repo, "~v8.5_linux_amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "v8.5.2_linux_amd64" || repo.calls != 2 {
+		t.Errorf("Resolve() after ttl = %q with %d listings, want v8.5.2_linux_amd64 with 2", got, repo.calls)
+	}
+}
@@ -0,0 +1,113 @@
+package oci
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// ErrTarballEntryNotFound is returned when the requested entry does not exist
+// in the tarball.
+var ErrTarballEntryNotFound = errors.New("entry not found in tarball")
+
+// newTarReader wraps r with a gzip reader when the stream starts with the gzip
+// magic bytes.
+func newTarReader(r io.Reader) (*tar.Reader, io.Closer, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(gzipMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, err
+	}
+
+	if !bytes.Equal(magic, gzipMagic) {
+		return tar.NewReader(br), io.NopCloser(nil), nil
+	}
+
+	gr, err := gzip.NewReader(br)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tar.NewReader(gr), gr, nil
+}
+
+// ListTarballEntries lists the regular file entries of a tarball stream. Both
+// plain and gzip compressed tarballs are supported.
+func ListTarballEntries(r io.Reader) ([]string, error) {
+	tr, closer, err := newTarReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	var ret []string
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tarball: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg {
+			ret = append(ret, cleanEntryName(hdr.Name))
+		}
+	}
+
+	return ret, nil
+}
+
+// OpenTarballEntry seeks the tarball stream in rc to the given entry, the stream
+// is decompressed on the fly. rc is closed when the returned reader is closed
+// or any error occurs.
+func OpenTarballEntry(rc io.ReadCloser, entry string) (io.ReadCloser, int64, error) {
+	tr, closer, err := newTarReader(rc)
+	if err != nil {
+		rc.Close()
+		return nil, 0, err
+	}
+
+	want := cleanEntryName(entry)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			closer.Close()
+			rc.Close()
+			return nil, 0, fmt.Errorf("%w: %s", ErrTarballEntryNotFound, entry)
+		}
+		if err != nil {
+			closer.Close()
+			rc.Close()
+			return nil, 0, fmt.Errorf("failed to read tarball: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && cleanEntryName(hdr.Name) == want {
+			return &tarEntryReadCloser{Reader: tr, closers: []io.Closer{closer, rc}}, hdr.Size, nil
+		}
+	}
+}
+
+// cleanEntryName normalizes tar entry names, so `./bin/tidb-server` and
+// `bin/tidb-server` are treated as the same entry.
+func cleanEntryName(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
+
+type tarEntryReadCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (t *tarEntryReadCloser) Close() error {
+	var errs []error
+	for _, c := range t.closers {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
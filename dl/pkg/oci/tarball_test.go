@@ -0,0 +1,109 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func buildTarball(t *testing.T, compress bool, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var w io.Writer = &buf
+	var gw *gzip.Writer
+	if compress {
+		gw = gzip.NewWriter(&buf)
+		w = gw
+	}
+
+	tw := tar.NewWriter(w)
+	if err := tw.WriteHeader(&tar.Header{Name: "./bin/", Typeflag: tar.TypeDir, Mode: 0o755}); err != nil {
+		t.Fatalf("failed to write dir header: %v", err)
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		content := files[name]
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0o755, Size: int64(len(content))}); err != nil {
+			t.Fatalf("failed to write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write content: %v", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			t.Fatalf("failed to close gzip writer: %v", err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func TestListTarballEntries(t *testing.T) {
+	files := map[string]string{
+		"./bin/tidb-server": "tidb",
+		"bin/pd-server":     "pd",
+	}
+
+	for _, compress := range []bool{false, true} {
+		got, err := ListTarballEntries(bytes.NewReader(buildTarball(t, compress, files)))
+		if err != nil {
+			t.Fatalf("ListTarballEntries(compress=%v) error: %v", compress, err)
+		}
+		want := []string{"bin/tidb-server", "bin/pd-server"}
+		if !slices.Equal(got, want) {
+			t.Errorf("ListTarballEntries(compress=%v) = %v, want %v", compress, got, want)
+		}
+	}
+}
+
+func TestOpenTarballEntry(t *testing.T) {
+	files := map[string]string{
+		"./bin/tidb-server": "tidb-content",
+		"bin/pd-server":     "pd-content",
+	}
+
+	tests := []struct {
+		name    string
+		entry   string
+		want    string
+		wantErr error
+	}{
+		{name: "with dot prefix in tarball", entry: "bin/tidb-server", want: "tidb-content"},
+		{name: "with dot prefix in request", entry: "./bin/pd-server", want: "pd-content"},
+		{name: "not exist", entry: "bin/tikv-server", wantErr: ErrTarballEntryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := io.NopCloser(bytes.NewReader(buildTarball(t, true, files)))
+			entryRC, size, err := OpenTarballEntry(rc, tt.entry)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("OpenTarballEntry(%q) error = %v, want %v", tt.entry, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenTarballEntry(%q) error: %v", tt.entry, err)
+			}
+			defer entryRC.Close()
+
+			got, err := io.ReadAll(entryRC)
+			if err != nil {
+				t.Fatalf("failed to read entry: %v", err)
+			}
+			if string(got) != tt.want || size != int64(len(tt.want)) {
+				t.Errorf("OpenTarballEntry(%q) = %q (size %d), want %q", tt.entry, got, size, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package s3
+
+// Config holds the named S3 compatible endpoints.
+type Config struct {
+	Endpoints map[string]EndpointConfig `yaml:"endpoints,omitempty" json:"endpoints,omitempty"`
+}
+
+type EndpointConfig struct {
+	// Endpoint is the host[:port] of the service, such as `s3.amazonaws.com`
+	// or `minio.example.com:9000`.
+	Endpoint  string `yaml:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Region    string `yaml:"region,omitempty" json:"region,omitempty"`
+	AccessKey string `yaml:"access_key,omitempty" json:"access_key,omitempty"`
+	SecretKey string `yaml:"secret_key,omitempty" json:"secret_key,omitempty"`
+	// Insecure disables TLS, it's mostly used for local MinIO servers.
+	Insecure bool `yaml:"insecure,omitempty" json:"insecure,omitempty"`
+	// PathStyle forces path style bucket lookup, which is required by MinIO.
+	PathStyle bool `yaml:"path_style,omitempty" json:"path_style,omitempty"`
+}
@@ -0,0 +1,195 @@
+package signing
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Query parameters carried by signed links.
+const (
+	ParamExpires   = "expires"
+	ParamKeyID     = "key_id"
+	ParamSignature = "signature"
+)
+
+// defaultMaxTTL caps the lifetime of links when it's not configured.
+const defaultMaxTTL = 7 * 24 * time.Hour
+
+var (
+	ErrUnsigned         = errors.New("link is not signed")
+	ErrExpired          = errors.New("link is expired")
+	ErrUnknownKey       = errors.New("link is signed by an unknown key")
+	ErrInvalidSignature = errors.New("link signature is invalid")
+)
+
+// Config holds the keys to sign download links.
+type Config struct {
+	// Keys are the signing keys. The first one signs new links and all of them
+	// verify links, so a key is rotated by prepending the new key and removing
+	// the old one after the links signed by it are expired.
+	Keys []Key `yaml:"keys" json:"keys"`
+	// BaseURL is prepended to the signed links, such as `https://dl.example.com`,
+	// links are relative when it's empty.
+	BaseURL string `yaml:"base_url,omitempty" json:"base_url,omitempty"`
+	// MaxTTL caps the lifetime of links, it's 7 days by default.
+	MaxTTL time.Duration `yaml:"max_ttl,omitempty" json:"max_ttl,omitempty"`
+}
+
+type Key struct {
+	ID     string `yaml:"id" json:"id"`
+	Secret string `yaml:"secret" json:"secret"`
+}
+
+func (c *Config) validate() error {
+	if len(c.Keys) == 0 {
+		return errors.New("no signing keys")
+	}
+	for i, k := range c.Keys {
+		if k.ID == "" || k.Secret == "" {
+			return fmt.Errorf("signing key #%d requires both id and secret", i)
+		}
+	}
+	if c.MaxTTL <= 0 {
+		c.MaxTTL = defaultMaxTTL
+	}
+	return nil
+}
+
+func loadConfig(cfgFile string) (*Config, error) {
+	var cfg Config
+	cfgBytes, err := os.ReadFile(cfgFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %q: %w", cfgFile, err)
+	}
+	if err := yaml.Unmarshal(cfgBytes, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %q: %w", cfgFile, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid config file %q: %w", cfgFile, err)
+	}
+
+	return &cfg, nil
+}
+
+// Signer signs and verifies download links with HMAC-SHA256.
+type Signer struct {
+	mu  sync.RWMutex
+	cfg *Config
+}
+
+// New returns a signer with the keys in cfg.
+func New(cfg *Config) (*Signer, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &Signer{cfg: cfg}, nil
+}
+
+// Load returns a signer with the keys in the config file.
+func Load(cfgFile string) (*Signer, error) {
+	cfg, err := loadConfig(cfgFile)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{cfg: cfg}, nil
+}
+
+// Reload re-reads the config file, links signed by removed keys are rejected
+// after it.
+func (s *Signer) Reload(ctx context.Context, cfgFile string) error {
+	cfg, err := loadConfig(cfgFile)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.cfg = cfg
+	s.mu.Unlock()
+	return nil
+}
+
+// Sign returns the signed link of u, which is a path with optional query. The
+// ttl is capped by the configured max TTL.
+func (s *Signer) Sign(u *url.URL, ttl time.Duration, now time.Time) (string, time.Time, error) {
+	s.mu.RLock()
+	cfg := s.cfg
+	s.mu.RUnlock()
+
+	if ttl <= 0 || ttl > cfg.MaxTTL {
+		return "", time.Time{}, fmt.Errorf("ttl %s is out of range (0, %s]", ttl, cfg.MaxTTL)
+	}
+	expires := now.Add(ttl).Truncate(time.Second)
+
+	query := u.Query()
+	query.Del(ParamSignature)
+	query.Set(ParamExpires, strconv.FormatInt(expires.Unix(), 10))
+	query.Set(ParamKeyID, cfg.Keys[0].ID)
+	query.Set(ParamSignature, signature(cfg.Keys[0].Secret, u.Path, query))
+
+	signed := url.URL{Path: u.Path, RawQuery: query.Encode()}
+	return cfg.BaseURL + signed.String(), expires, nil
+}
+
+// Verify checks the signature and expiry of the signed link u.
+func (s *Signer) Verify(u *url.URL, now time.Time) error {
+	query := u.Query()
+	if !IsSigned(u) {
+		return ErrUnsigned
+	}
+
+	expires, err := strconv.ParseInt(query.Get(ParamExpires), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if now.Unix() > expires {
+		return ErrExpired
+	}
+
+	s.mu.RLock()
+	cfg := s.cfg
+	s.mu.RUnlock()
+
+	keyID := query.Get(ParamKeyID)
+	for _, k := range cfg.Keys {
+		if k.ID != keyID {
+			continue
+		}
+		if !hmac.Equal([]byte(query.Get(ParamSignature)), []byte(signature(k.Secret, u.Path, query))) {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	return ErrUnknownKey
+}
+
+// IsSigned reports whether u carries a signature.
+func IsSigned(u *url.URL) bool {
+	return u.Query().Has(ParamSignature)
+}
+
+// signature computes the signature over the path and all query parameters
+// except the signature itself.
+func signature(secret, path string, query url.Values) string {
+	signed := url.Values{}
+	for k, v := range query {
+		if k != ParamSignature {
+			signed[k] = v
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(path + "\n" + signed.Encode()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
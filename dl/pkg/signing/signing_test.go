@@ -0,0 +1,85 @@
+package signing
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	signer, err := New(&Config{
+		Keys:    []Key{{ID: "k2", Secret: "new-secret"}, {ID: "k1", Secret: "old-secret"}},
+		BaseURL: "https://dl.example.com",
+	})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	link, expires, err := signer.Sign(&url.URL{Path: "/s3-obj/bucket/hotfix/tidb.tar.gz", RawQuery: "a=1"}, 24*time.Hour, now)
+	if err != nil {
+		t.Fatalf("Sign error: %v", err)
+	}
+	if !expires.Equal(now.Add(24 * time.Hour)) {
+		t.Errorf("expires = %v, want %v", expires, now.Add(24*time.Hour))
+	}
+	signed, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("invalid signed link %q: %v", link, err)
+	}
+	if signed.Host != "dl.example.com" || signed.Query().Get(ParamKeyID) != "k2" {
+		t.Errorf("signed link = %s, want signed by k2 on dl.example.com", link)
+	}
+
+	oldLink := *signed
+	oldQuery := oldLink.Query()
+	oldQuery.Set(ParamKeyID, "k1")
+	oldQuery.Set(ParamSignature, signature("old-secret", oldLink.Path, oldQuery))
+	oldLink.RawQuery = oldQuery.Encode()
+
+	tampered := *signed
+	tamperedQuery := tampered.Query()
+	tamperedQuery.Set("a", "2")
+	tampered.RawQuery = tamperedQuery.Encode()
+
+	unknown := *signed
+	unknownQuery := unknown.Query()
+	unknownQuery.Set(ParamKeyID, "k0")
+	unknown.RawQuery = unknownQuery.Encode()
+
+	tests := []struct {
+		name string
+		u    *url.URL
+		now  time.Time
+		want error
+	}{
+		{name: "valid", u: signed, now: now.Add(time.Hour)},
+		{name: "signed by a rotated key", u: &oldLink, now: now},
+		{name: "expired", u: signed, now: now.Add(25 * time.Hour), want: ErrExpired},
+		{name: "tampered query", u: &tampered, now: now, want: ErrInvalidSignature},
+		{name: "other path", u: &url.URL{Path: "/s3-obj/bucket/other", RawQuery: signed.RawQuery}, now: now, want: ErrInvalidSignature},
+		{name: "unknown key", u: &unknown, now: now, want: ErrUnknownKey},
+		{name: "unsigned", u: &url.URL{Path: signed.Path}, now: now, want: ErrUnsigned},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := signer.Verify(tt.u, tt.now); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignTTL(t *testing.T) {
+	signer, err := New(&Config{Keys: []Key{{ID: "k1", Secret: "secret"}}, MaxTTL: time.Hour})
+	if err != nil {
+		t.Fatalf("New error: %v", err)
+	}
+
+	for _, ttl := range []time.Duration{0, 2 * time.Hour} {
+		if _, _, err := signer.Sign(&url.URL{Path: "/gcs-obj/bucket/key"}, ttl, time.Now()); err == nil {
+			t.Errorf("Sign with ttl %s succeeded, want error", ttl)
+		}
+	}
+}